@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
+	entword "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+)
+
+// backfillNormalizedCmd recomputes the normalized column of words and learned lexemes written
+// before normalization existed or imported around it.
+var backfillNormalizedCmd = &cobra.Command{
+	Use:   "backfill-normalized",
+	Short: "重新计算词条与生词的规范化词形 (normalized)",
+	Long:  "分批扫描 words 与 learned_lexemes，使用 NormalizeWordToken 重新计算 normalized 列，仅写入与当前规范化结果不一致的行，每批在独立事务中写入，可重复执行。使用 --dry-run 仅统计将要修改的行数。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		batch, _ := cmd.Flags().GetInt("batch")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("加载配置失败: %w", err)
+		}
+		entClient, cleanup, err := newEntClient(cfg)
+		if err != nil {
+			return fmt.Errorf("连接目标数据库失败: %w", err)
+		}
+		defer cleanup()
+
+		stats, err := backfillNormalized(cmd.Context(), entClient, batch, dryRun)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			log.Printf("词条扫描 %d 条, 需要更新 %d 条; 生词扫描 %d 条, 需要更新 %d 条 (dry-run, 未写入)",
+				stats.WordsScanned, stats.WordsChanged, stats.LexemesScanned, stats.LexemesChanged)
+		} else {
+			log.Printf("词条扫描 %d 条, 已更新 %d 条; 生词扫描 %d 条, 已更新 %d 条",
+				stats.WordsScanned, stats.WordsChanged, stats.LexemesScanned, stats.LexemesChanged)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backfillNormalizedCmd)
+	backfillNormalizedCmd.Flags().Int("batch", defaultBatchSize, "每个事务处理的行数")
+	backfillNormalizedCmd.Flags().Bool("dry-run", false, "仅统计将要修改的行数，不写入数据库")
+}
+
+type backfillNormalizedStats struct {
+	WordsScanned, WordsChanged     int
+	LexemesScanned, LexemesChanged int
+}
+
+// backfillNormalized walks words and then learned lexemes by id and rewrites normalized where it
+// differs from entity.NormalizeWordToken, one transaction per batch.
+func backfillNormalized(ctx context.Context, client *entdb.Client, batchSize int, dryRun bool) (stats backfillNormalizedStats, err error) {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	for lastID := 0; ; {
+		rows, err := client.Word.Query().
+			Where(entword.IDGT(lastID)).
+			Order(entword.ByID()).
+			Limit(batchSize).
+			Select(entword.FieldID, entword.FieldText, entword.FieldNormalized).
+			All(ctx)
+		if err != nil {
+			return stats, fmt.Errorf("读取词条失败: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		lastID = rows[len(rows)-1].ID
+		stats.WordsScanned += len(rows)
+
+		updates := make(map[int]string)
+		for _, row := range rows {
+			if normalized := entity.NormalizeWordToken(row.Text); normalized != row.Normalized {
+				updates[row.ID] = normalized
+			}
+		}
+		stats.WordsChanged += len(updates)
+		if dryRun || len(updates) == 0 {
+			continue
+		}
+		if err := applyNormalizedUpdates(ctx, client, updates, func(tx *entdb.Tx, id int, normalized string) error {
+			return tx.Word.UpdateOneID(id).SetNormalized(normalized).Exec(ctx)
+		}); err != nil {
+			return stats, fmt.Errorf("更新词条失败: %w", err)
+		}
+	}
+
+	for lastID := 0; ; {
+		rows, err := client.LearnedLexeme.Query().
+			Where(learnedlexeme.IDGT(lastID)).
+			Order(learnedlexeme.ByID()).
+			Limit(batchSize).
+			Select(learnedlexeme.FieldID, learnedlexeme.FieldTerm, learnedlexeme.FieldNormalized).
+			All(ctx)
+		if err != nil {
+			return stats, fmt.Errorf("读取生词失败: %w", err)
+		}
+		if len(rows) == 0 {
+			return stats, nil
+		}
+		lastID = rows[len(rows)-1].ID
+		stats.LexemesScanned += len(rows)
+
+		updates := make(map[int]string)
+		for _, row := range rows {
+			if normalized := entity.NormalizeWordToken(row.Term); normalized != row.Normalized {
+				updates[row.ID] = normalized
+			}
+		}
+		stats.LexemesChanged += len(updates)
+		if dryRun || len(updates) == 0 {
+			continue
+		}
+		if err := applyNormalizedUpdates(ctx, client, updates, func(tx *entdb.Tx, id int, normalized string) error {
+			return tx.LearnedLexeme.UpdateOneID(id).SetNormalized(normalized).Exec(ctx)
+		}); err != nil {
+			return stats, fmt.Errorf("更新生词失败: %w", err)
+		}
+	}
+}
+
+func applyNormalizedUpdates(ctx context.Context, client *entdb.Client, updates map[int]string, set func(tx *entdb.Tx, id int, normalized string) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	for id, normalized := range updates {
+		if err := set(tx, id, normalized); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("行 %d: %w", id, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
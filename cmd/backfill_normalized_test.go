@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+)
+
+func TestBackfillNormalized(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:backfill_normalized?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	stale := client.Word.Create().SetText("Apple").SetNormalized("").SetLanguage("en").SaveX(ctx)
+	fresh := client.Word.Create().SetText("pear").SetNormalized("pear").SetLanguage("en").SaveX(ctx)
+	lexeme := client.LearnedLexeme.Create().SetUserID(1).SetTerm("Running").SetNormalized("Running").SaveX(ctx)
+
+	stats, err := backfillNormalized(ctx, client, 1, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	want := backfillNormalizedStats{WordsScanned: 2, WordsChanged: 1, LexemesScanned: 1, LexemesChanged: 1}
+	if stats != want {
+		t.Fatalf("dry run stats = %+v, want %+v", stats, want)
+	}
+	if got := client.Word.GetX(ctx, stale.ID).Normalized; got != "" {
+		t.Fatalf("dry run wrote normalized = %q", got)
+	}
+
+	if stats, err = backfillNormalized(ctx, client, 1, false); err != nil || stats != want {
+		t.Fatalf("backfill: stats %+v, err %v", stats, err)
+	}
+	if got := client.Word.GetX(ctx, stale.ID).Normalized; got != "apple" {
+		t.Errorf("word normalized = %q, want apple", got)
+	}
+	if got := client.Word.GetX(ctx, fresh.ID).Normalized; got != "pear" {
+		t.Errorf("untouched word normalized = %q, want pear", got)
+	}
+	if got := client.LearnedLexeme.GetX(ctx, lexeme.ID).Normalized; got != "running" {
+		t.Errorf("lexeme normalized = %q, want running", got)
+	}
+
+	if stats, err = backfillNormalized(ctx, client, 1, false); err != nil || stats.WordsChanged != 0 || stats.LexemesChanged != 0 {
+		t.Fatalf("second run: stats %+v, err %v", stats, err)
+	}
+}
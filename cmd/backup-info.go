@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/vocnet/internal/usecase/backup"
+)
+
+// backupInfoCmd prints the meta record of a backup without importing it.
+var backupInfoCmd = &cobra.Command{
+	Use:   "backup-info",
+	Short: "查看备份文件的元信息（导出时间、schema 哈希、表与行数），不连接数据库",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		inputPath, _ := cmd.Flags().GetString("input")
+		format, _ := cmd.Flags().GetString("format")
+		if inputPath == "" {
+			return fmt.Errorf("请通过 --input 指定备份文件或使用 - 表示标准输入")
+		}
+		if format != "table" && format != "json" {
+			return fmt.Errorf("不支持的输出格式 %q (可选 table|json)", format)
+		}
+
+		var reader io.Reader = cmd.InOrStdin()
+		if inputPath != "-" {
+			file, openErr := os.Open(filepath.Clean(inputPath))
+			if openErr != nil {
+				return fmt.Errorf("打开备份文件失败: %w", openErr)
+			}
+			defer file.Close()
+			reader = file
+		}
+
+		reader, closeReader, err := decompressBackup(reader)
+		if err != nil {
+			return err
+		}
+		defer closeReader()
+
+		meta, err := backup.ReadMeta(reader)
+		if err != nil {
+			return fmt.Errorf("读取备份元信息失败: %w", err)
+		}
+		if format == "json" {
+			enc := json.NewEncoder(cmd.OutOrStdout())
+			enc.SetIndent("", "  ")
+			return enc.Encode(meta)
+		}
+		return printBackupMeta(cmd.OutOrStdout(), meta)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backupInfoCmd)
+
+	backupInfoCmd.Flags().StringP("input", "i", "", "备份文件路径，使用 - 表示标准输入 (自动识别 gzip/zstd 压缩)")
+	backupInfoCmd.Flags().String("format", "table", "输出格式: table|json")
+}
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// decompressBackup detects gzip or zstd input by its magic bytes and returns a reader over the
+// plain NDJSON stream; uncompressed input is passed through.
+func decompressBackup(r io.Reader) (io.Reader, func(), error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, nil, fmt.Errorf("读取备份文件失败: %w", err)
+	}
+	switch {
+	case bytes.HasPrefix(head, gzipMagic):
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, nil, fmt.Errorf("创建 gzip 读取器失败: %w", err)
+		}
+		return gzr, func() { _ = gzr.Close() }, nil
+	case bytes.HasPrefix(head, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, nil, fmt.Errorf("创建 zstd 读取器失败: %w", err)
+		}
+		return zr, zr.Close, nil
+	default:
+		return br, func() {}, nil
+	}
+}
+
+func printBackupMeta(w io.Writer, meta backup.Meta) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	// Labels mirror the JSON field names; tabwriter cannot align double-width CJK text.
+	fmt.Fprintf(tw, "version\t%d.%d\n", meta.Version, meta.MinorVersion)
+	fmt.Fprintf(tw, "exported_at\t%s\n", meta.ExportedAt.Format(time.RFC3339))
+	fmt.Fprintf(tw, "ent_schema_hash\t%s\n", meta.EntSchemaHash)
+	if meta.UserID != 0 {
+		fmt.Fprintf(tw, "user_id\t%d\n", meta.UserID)
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "TABLE\tROWS")
+
+	tables := append([]string(nil), meta.Tables...)
+	for name := range meta.RowCounts {
+		if !slices.Contains(tables, name) {
+			tables = append(tables, name)
+		}
+	}
+	sort.Strings(tables)
+	total := 0
+	for _, name := range tables {
+		total += meta.RowCounts[name]
+		fmt.Fprintf(tw, "%s\t%d\n", name, meta.RowCounts[name])
+	}
+	fmt.Fprintf(tw, "total\t%d\n", total)
+	return tw.Flush()
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/vocnet/internal/usecase/backup"
+)
+
+// backupVerifyCmd checks a backup's structure offline so a broken file is caught before import.
+var backupVerifyCmd = &cobra.Command{
+	Use:   "backup-verify",
+	Short: "离线校验备份文件的结构完整性（元信息、表名、必填列、行数），不连接数据库",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		inputPath, _ := cmd.Flags().GetString("input")
+		maxErrors, _ := cmd.Flags().GetInt("max-errors")
+		if inputPath == "" {
+			return fmt.Errorf("请通过 --input 指定备份文件或使用 - 表示标准输入")
+		}
+
+		var reader io.Reader = cmd.InOrStdin()
+		if inputPath != "-" {
+			file, err := os.Open(filepath.Clean(inputPath))
+			if err != nil {
+				return fmt.Errorf("打开备份文件失败: %w", err)
+			}
+			defer file.Close()
+			reader = file
+		}
+
+		reader, closeReader, err := decompressBackup(reader)
+		if err != nil {
+			return err
+		}
+		defer closeReader()
+
+		report, err := backup.Verify(reader, maxErrors)
+		if err != nil {
+			return fmt.Errorf("校验备份失败: %w", err)
+		}
+
+		out := cmd.OutOrStdout()
+		if report.OK() {
+			fmt.Fprintf(out, "备份校验通过: %d 条记录, %d 张表\n", report.Records, len(report.Counts))
+			return nil
+		}
+		for _, issue := range report.Issues {
+			fmt.Fprintln(out, issue.String())
+		}
+		if report.Omitted > 0 {
+			fmt.Fprintf(out, "... 另有 %d 个问题未显示\n", report.Omitted)
+		}
+		return fmt.Errorf("备份校验失败: 发现 %d 个问题", len(report.Issues)+report.Omitted)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backupVerifyCmd)
+
+	backupVerifyCmd.Flags().StringP("input", "i", "", "备份文件路径，使用 - 表示标准输入 (自动识别 gzip/zstd 压缩)")
+	backupVerifyCmd.Flags().Int("max-errors", 10, "最多显示的问题数量，0 表示全部显示")
+}
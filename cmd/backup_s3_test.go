@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/infrastructure/objectstore/objectstoretest"
+)
+
+func TestBackupRoundTripThroughObjectStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store := objectstoretest.NewServer(t)
+	s3 := store.Config()
+
+	t.Setenv("BACKUP_S3_ENDPOINT", s3.Endpoint)
+	t.Setenv("BACKUP_S3_PATH_STYLE", "true")
+	t.Setenv("AWS_ACCESS_KEY_ID", s3.AccessKeyID)
+	t.Setenv("AWS_SECRET_ACCESS_KEY", s3.SecretAccessKey)
+	t.Cleanup(func() {
+		rootCmd.SetArgs(nil)
+		rootCmd.SetOut(nil)
+		_ = exportCmd.Flags().Set("output", "")
+		_ = importCmd.Flags().Set("input", "")
+	})
+	rootCmd.SetOut(io.Discard)
+	const object = "s3://backups/nightly/vocnet.jsonl.gz"
+
+	srcPath := filepath.Join(dir, "src.db")
+	src := enttest.Open(t, dialect.SQLite, "file:"+srcPath+"?_fk=1")
+	t.Cleanup(func() { src.Close() })
+	src.Word.Create().SetText("apple").SetLanguage(entity.LanguageEnglish.Code()).SetWordType(string(entity.WordTypeLemma)).SaveX(ctx)
+
+	t.Setenv("DATABASE_DSN", "file:"+srcPath)
+	rootCmd.SetArgs([]string{"export", "--output", object})
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		t.Fatalf("export to %s: %v", object, err)
+	}
+	uploaded, _ := store.Object("backups", "nightly/vocnet.jsonl.gz")
+	if len(uploaded) < 2 || uploaded[0] != 0x1f || uploaded[1] != 0x8b {
+		t.Fatalf("expected a gzipped backup object, got %d bytes", len(uploaded))
+	}
+
+	dstPath := filepath.Join(dir, "dst.db")
+	t.Setenv("DATABASE_DSN", "file:"+dstPath)
+	rootCmd.SetArgs([]string{"import", "--input", object})
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		t.Fatalf("import from %s: %v", object, err)
+	}
+
+	dst := enttest.Open(t, dialect.SQLite, "file:"+dstPath+"?_fk=1")
+	t.Cleanup(func() { dst.Close() })
+	if n := dst.Word.Query().Where(word.Text("apple")).CountX(ctx); n != 1 {
+		t.Fatalf("imported apple rows = %d, want 1", n)
+	}
+
+	rootCmd.SetArgs([]string{"import", "--input", "s3://backups/missing.jsonl"})
+	if err := rootCmd.ExecuteContext(ctx); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("import of a missing object: got %v, want fs.ErrNotExist", err)
+	}
+}
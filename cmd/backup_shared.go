@@ -1,13 +1,57 @@
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"strings"
 
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/infrastructure/objectstore"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
+// createObject starts an upload to the s3://bucket/key path using the backup.s3 settings.
+func createObject(ctx context.Context, cfg *config.Config, path string) (*objectstore.Upload, error) {
+	client, loc, err := objectClient(cfg, path)
+	if err != nil {
+		return nil, err
+	}
+	upload, err := client.Create(ctx, loc)
+	if err != nil {
+		return nil, fmt.Errorf("创建对象存储上传失败: %w", err)
+	}
+	return upload, nil
+}
+
+// openObject streams the object at the s3://bucket/key path using the backup.s3 settings.
+func openObject(ctx context.Context, cfg *config.Config, path string) (io.ReadCloser, error) {
+	client, loc, err := objectClient(cfg, path)
+	if err != nil {
+		return nil, err
+	}
+	body, err := client.Open(ctx, loc)
+	if err != nil {
+		return nil, fmt.Errorf("下载备份对象失败: %w", err)
+	}
+	return body, nil
+}
+
+func objectClient(cfg *config.Config, path string) (*objectstore.Client, objectstore.Location, error) {
+	loc, err := objectstore.ParseURL(path)
+	if err != nil {
+		return nil, objectstore.Location{}, err
+	}
+	client, err := objectstore.NewClient(cfg.Backup.S3)
+	if err != nil {
+		return nil, objectstore.Location{}, fmt.Errorf("创建对象存储客户端失败: %w", err)
+	}
+	return client, loc, nil
+}
+
 func tablesFromConfig(key string) []string {
 	return normalizeTables(viper.GetStringSlice(key))
 }
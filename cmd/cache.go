@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ecdictCachePattern names cached ECDICT archives after the CRC-32 of their download URL.
+const ecdictCachePattern = "ecdict-%08x.zip"
+
+// cacheCmd groups maintenance of the ECDICT download cache used by db-init.
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "管理 ECDICT 下载缓存",
+}
+
+var cacheInfoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "列出缓存的词库压缩包及其大小和时间",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cacheDirFlag, _ := cmd.Flags().GetString("cache-dir")
+		dir, err := ecdictCacheDir(cacheDirFlag)
+		if err != nil {
+			return err
+		}
+		archives, err := listCachedArchives(dir)
+		if err != nil {
+			return err
+		}
+		return printCachedArchives(cmd.OutOrStdout(), dir, archives, time.Now())
+	},
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "删除缓存的词库压缩包（全部，或仅删除早于 --older-than 的）",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cacheDirFlag, _ := cmd.Flags().GetString("cache-dir")
+		olderThan, _ := cmd.Flags().GetDuration("older-than")
+		if olderThan < 0 {
+			return fmt.Errorf("--older-than 不能为负数")
+		}
+		dir, err := ecdictCacheDir(cacheDirFlag)
+		if err != nil {
+			return err
+		}
+		removed, err := pruneCachedArchives(dir, olderThan, time.Now())
+		var freed int64
+		for _, a := range removed {
+			freed += a.Size
+			fmt.Fprintf(cmd.OutOrStdout(), "已删除 %s\n", a.Path)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "共删除 %d 个文件，释放 %d 字节\n", len(removed), freed)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(cacheCmd)
+	cacheCmd.AddCommand(cacheInfoCmd, cacheClearCmd)
+
+	cacheCmd.PersistentFlags().String("cache-dir", "", "ECDICT 缓存目录 (默认: 用户缓存目录/vocnet)")
+	cacheClearCmd.Flags().Duration("older-than", 0, "仅删除修改时间早于该时长的压缩包，如 720h；0 表示全部删除")
+}
+
+// ecdictCacheDir resolves the ECDICT cache directory: the flag value when set, otherwise
+// vocnet under the user cache directory.
+func ecdictCacheDir(cacheDirFlag string) (string, error) {
+	if cacheDirFlag != "" {
+		return cacheDirFlag, nil
+	}
+	userCache, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("获取用户缓存目录失败: %w", err)
+	}
+	return filepath.Join(userCache, "vocnet"), nil
+}
+
+// cachedArchive is one ECDICT archive found in the cache directory.
+type cachedArchive struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+}
+
+// listCachedArchives returns the cached ECDICT archives in dir, oldest first; a missing directory
+// holds none.
+func listCachedArchives(dir string) ([]cachedArchive, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "ecdict-*.zip"))
+	if err != nil {
+		return nil, err
+	}
+	archives := make([]cachedArchive, 0, len(paths))
+	for _, path := range paths {
+		st, err := os.Stat(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("读取缓存文件失败: %w", err)
+		}
+		if !st.Mode().IsRegular() {
+			continue
+		}
+		archives = append(archives, cachedArchive{Path: path, Size: st.Size(), ModTime: st.ModTime()})
+	}
+	sort.Slice(archives, func(i, j int) bool { return archives[i].ModTime.Before(archives[j].ModTime) })
+	return archives, nil
+}
+
+// pruneCachedArchives deletes the cached archives last modified more than olderThan before now,
+// or all of them when olderThan is 0, and returns the ones removed.
+func pruneCachedArchives(dir string, olderThan time.Duration, now time.Time) ([]cachedArchive, error) {
+	archives, err := listCachedArchives(dir)
+	if err != nil {
+		return nil, err
+	}
+	var removed []cachedArchive
+	for _, a := range archives {
+		if olderThan > 0 && now.Sub(a.ModTime) <= olderThan {
+			continue
+		}
+		if err := os.Remove(a.Path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, fmt.Errorf("删除缓存文件失败: %w", err)
+		}
+		removed = append(removed, a)
+	}
+	return removed, nil
+}
+
+func printCachedArchives(w io.Writer, dir string, archives []cachedArchive, now time.Time) error {
+	fmt.Fprintf(w, "缓存目录: %s\n", dir)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FILE\tBYTES\tMODIFIED\tAGE")
+	var total int64
+	for _, a := range archives {
+		total += a.Size
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\n", filepath.Base(a.Path), a.Size, a.ModTime.Format(time.RFC3339), now.Sub(a.ModTime).Truncate(time.Second))
+	}
+	fmt.Fprintf(tw, "total\t%d\t\t\n", total)
+	return tw.Flush()
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_pruneCachedArchives(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	seed := func(name string, age time.Duration) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("zip"), 0o600); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+		if err := os.Chtimes(path, now.Add(-age), now.Add(-age)); err != nil {
+			t.Fatalf("chtimes %s: %v", name, err)
+		}
+		return path
+	}
+	old := seed("ecdict-00000001.zip", 90*24*time.Hour)
+	stale := seed("ecdict-00000002.zip", 40*24*time.Hour)
+	fresh := seed("ecdict-00000003.zip", time.Hour)
+	other := seed("notes.txt", 90*24*time.Hour)
+
+	removed, err := pruneCachedArchives(dir, 30*24*time.Hour, now)
+	if err != nil {
+		t.Fatalf("prune older than 30 days: %v", err)
+	}
+	if len(removed) != 2 || removed[0].Path != old || removed[1].Path != stale {
+		t.Fatalf("removed %+v, want the 90 and 40 day old archives", removed)
+	}
+	for path, want := range map[string]bool{old: false, stale: false, fresh: true, other: true} {
+		if _, err := os.Stat(path); (err == nil) != want {
+			t.Fatalf("%s exists = %v, want %v", filepath.Base(path), err == nil, want)
+		}
+	}
+
+	removed, err = pruneCachedArchives(dir, 0, now)
+	if err != nil {
+		t.Fatalf("prune all: %v", err)
+	}
+	if len(removed) != 1 || removed[0].Path != fresh {
+		t.Fatalf("removed %+v, want only the remaining archive", removed)
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Fatalf("non-archive file was removed: %v", err)
+	}
+
+	archives, err := listCachedArchives(filepath.Join(dir, "missing"))
+	if err != nil || len(archives) != 0 {
+		t.Fatalf("missing cache dir = %+v, %v; want no archives", archives, err)
+	}
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/server"
+)
+
+// newEntClient opens the database for a one-off command. SQL logging, when enabled, goes to the
+// configured log output with the same level and format as the server's logger.
+func newEntClient(cfg *config.Config) (*entdb.Client, func(), error) {
+	out, closeOut, err := server.NewLogOutput(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	logger, err := server.NewLogger(cfg, out)
+	if err != nil {
+		closeOut()
+		return nil, nil, fmt.Errorf("create logger: %w", err)
+	}
+	client, cleanup, err := database.NewEntClient(cfg, logger)
+	if err != nil {
+		closeOut()
+		return nil, nil, err
+	}
+	return client, func() {
+		cleanup()
+		closeOut()
+	}, nil
+}
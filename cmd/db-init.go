@@ -24,24 +24,31 @@ package cmd
 import (
 	"archive/zip"
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"hash/crc32"
 	"io"
 	"log"
+	"maps"
 	"math"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
+	"unicode"
 
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/eslsoft/vocnet/internal/entity"
 	"github.com/eslsoft/vocnet/internal/infrastructure/config"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database"
 	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/usecase/inflection"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/spf13/cobra"
 )
@@ -57,13 +64,19 @@ var dbInitCmd = &cobra.Command{
 		schemaOnly, _ := cmd.Flags().GetBool("schema-only")
 		cacheDir, _ := cmd.Flags().GetString("cache-dir")
 		noCache, _ := cmd.Flags().GetBool("no-cache")
+		checksum, _ := cmd.Flags().GetString("sha256")
+		cefrMap, _ := cmd.Flags().GetString("cefr-map")
+		cefrLevels, err := parseCEFRMap(cefrMap)
+		if err != nil {
+			return err
+		}
 		if err := runMigrations(); err != nil {
 			return err
 		}
 		if schemaOnly {
 			return nil
 		}
-		return importECDICT(cmd.Context(), url, batch, cacheDir, noCache)
+		return importECDICT(cmd.Context(), url, batch, cacheDir, noCache, checksum, cefrLevels)
 	},
 }
 
@@ -73,6 +86,53 @@ const (
 	defaultBatchSize      = 1000
 )
 
+// defaultCEFRTagLevels maps ECDICT exam tags to the CEFR level their word lists roughly target:
+// zk (中考), gk (高考), cet4/cet6 (大学英语四/六级), ky (考研), ielts, toefl and gre.
+var defaultCEFRTagLevels = map[string]entity.CEFRLevel{
+	"zk":    entity.CEFRA2,
+	"gk":    entity.CEFRB1,
+	"cet4":  entity.CEFRB1,
+	"cet6":  entity.CEFRB2,
+	"ky":    entity.CEFRB2,
+	"ielts": entity.CEFRB2,
+	"toefl": entity.CEFRC1,
+	"gre":   entity.CEFRC2,
+}
+
+// parseCEFRMap merges a "tag=level,..." spec over defaultCEFRTagLevels; an empty level, as in
+// "gre=", drops the tag's mapping.
+func parseCEFRMap(spec string) (map[string]entity.CEFRLevel, error) {
+	levels := maps.Clone(defaultCEFRTagLevels)
+	for _, pair := range strings.Split(spec, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		tag, raw, ok := strings.Cut(pair, "=")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !ok || tag == "" {
+			return nil, fmt.Errorf("无效的 CEFR 映射 %q, 应为 tag=level", pair)
+		}
+		if strings.TrimSpace(raw) == "" {
+			delete(levels, tag)
+			continue
+		}
+		level, err := entity.ParseCEFRLevel(raw)
+		if err != nil {
+			return nil, fmt.Errorf("无效的 CEFR 映射 %q: %w", pair, err)
+		}
+		levels[tag] = level
+	}
+	return levels, nil
+}
+
+// knownECDICTDigests pins the expected SHA-256 of well-known ECDICT release archives. Entries are
+// consulted when --sha256 is not provided; an archive with neither is refused rather than imported
+// unchecked.
+var knownECDICTDigests = map[string]string{}
+
+// errNoECDICTDigest is returned when an archive has no pinned digest and --sha256 was not given.
+var errNoECDICTDigest = errors.New("未找到 ECDICT 压缩包的期望 SHA-256, 请通过 --sha256 指定")
+
 func safeUint64ToInt64(v uint64) (int64, error) {
 	if v > math.MaxInt64 {
 		return 0, fmt.Errorf("value %d exceeds int64 capacity", v)
@@ -87,6 +147,8 @@ func init() {
 	dbInitCmd.Flags().Bool("schema-only", false, "仅执行数据库迁移，不导入词库")
 	dbInitCmd.Flags().String("cache-dir", "", "ECDICT 缓存目录 (默认: 用户缓存目录/vocnet)")
 	dbInitCmd.Flags().Bool("no-cache", false, "忽略本地缓存, 强制重新下载")
+	dbInitCmd.Flags().String("sha256", "", "ECDICT 压缩包的期望 SHA-256 (默认使用内置摘要，无内置摘要时必须指定)")
+	dbInitCmd.Flags().String("cefr-map", "", "覆盖或补充标签到 CEFR 等级的映射, 如 cet4=B2,toefl=C1; 留空等级 (gre=) 表示删除该映射")
 }
 
 type wordRecord struct {
@@ -96,16 +158,12 @@ type wordRecord struct {
 	Pos         sql.NullString
 	Translation sql.NullString
 	Exchange    sql.NullString
-	Tags        sql.NullString // retained but currently unused for words import
+	Tags        sql.NullString // stored as categories and mapped to a CEFR level
+	Frq         sql.NullInt64  // rank in the contemporary corpus, 0 when unranked
+	BNC         sql.NullInt64  // rank in the British National Corpus, 0 when unranked
 }
 
-// inflection relation extracted from exchange field
-type inflectionRel struct {
-	Lemma string
-	Type  string
-}
-
-func importECDICT(ctx context.Context, url string, batchSize int, cacheDirFlag string, noCache bool) error { //nolint:gocognit,gocyclo // orchestration pulls IO, decompression, and batching into one workflow
+func importECDICT(ctx context.Context, url string, batchSize int, cacheDirFlag string, noCache bool, checksum string, cefrLevels map[string]entity.CEFRLevel) error { //nolint:gocognit,gocyclo // orchestration pulls IO, decompression, and batching into one workflow
 	start := time.Now()
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 	log.Printf("开始导入 ECDICT: %s", url)
@@ -121,27 +179,10 @@ func importECDICT(ctx context.Context, url string, batchSize int, cacheDirFlag s
 	}
 	defer os.RemoveAll(tmpDir)
 
-	// Resolve cache directory
-	cacheDir, zipPath, fromCache, err := prepareCachePath(url, cacheDirFlag, noCache)
-	if err != nil {
-		return err
-	}
-	if !fromCache {
-		if err := os.MkdirAll(cacheDir, 0o755); err != nil {
-			return fmt.Errorf("创建缓存目录失败: %w", err)
-		}
-		log.Printf("下载 ECDICT 到缓存: %s", zipPath)
-		if err := downloadFile(ctx, url, zipPath); err != nil {
-			return err
-		}
-	} else {
-		log.Printf("使用缓存文件: %s", zipPath)
-	}
-	sqlitePath, err := unzipSingle(func(name string) bool { return strings.HasSuffix(name, ".db") || strings.HasSuffix(name, ".sqlite") }, zipPath, tmpDir)
+	sqlitePath, err := fetchECDICT(ctx, url, cacheDirFlag, noCache, checksum, tmpDir)
 	if err != nil {
 		return err
 	}
-	log.Printf("已解压 sqlite: %s", sqlitePath)
 
 	sqldb, err := sql.Open("sqlite3", sqlitePath)
 	if err != nil {
@@ -149,56 +190,20 @@ func importECDICT(ctx context.Context, url string, batchSize int, cacheDirFlag s
 	}
 	defer sqldb.Close()
 
-	// NOTE: ECDICT schema sample (stardict): word, phonetic, definition, translation, pos, collins, oxford, tag, bnc, frq, exchange, detail, audio
-	// We pull translation, tag, exchange if present; tolerate missing columns via COALESCE where possible.
-	rows, err := sqldb.QueryContext(ctx, `SELECT word, phonetic, definition, pos, translation, exchange, tag FROM stardict`)
-	if err != nil {
-		return err
-	}
-	defer rows.Close()
-
 	// First collect all records (we need a global map to know which words are inflections of which lemma)
-	records := make([]wordRecord, 0, 500000)
-	for rows.Next() {
-		var r wordRecord
-		if err := rows.Scan(&r.Word, &r.Phonetic, &r.Definition, &r.Pos, &r.Translation, &r.Exchange, &r.Tags); err != nil {
-			return err
-		}
-		r.Word = strings.TrimSpace(r.Word)
-		if r.Word == "" || !isSingleWord(r.Word) || isAllEmpty(r) {
-			continue
-		}
-		records = append(records, r)
-	}
-	if err := rows.Err(); err != nil {
+	records, err := readECDICTRecords(ctx, sqldb)
+	if err != nil {
 		return err
 	}
 
 	// Build inflection map: word(lower) -> (lemma, type)
-	inflectionMap := make(map[string]inflectionRel)
+	entries := make([]inflection.Entry, 0, len(records))
 	for _, r := range records {
-		exchange := strings.TrimSpace(nullStringVal(r.Exchange))
-		if exchange == "" {
-			continue
-		}
-		pairs := parseExchangePairs(exchange)
-		for _, p := range pairs { // p.word is inflected form, p.code is normalized type
-			// 忽略 code=lemma (0:root) 这种“指向原形”的反向信息，避免把真正的原形标成别人的变形
-			if p.code == entity.WordTypeLemma {
-				continue
-			}
-			lw := strings.ToLower(p.word)
-			if lw == "" || lw == strings.ToLower(r.Word) {
-				continue
-			}
-			// only set if not already set (first lemma wins)
-			if _, exists := inflectionMap[lw]; !exists {
-				inflectionMap[lw] = inflectionRel{Lemma: r.Word, Type: p.code}
-			}
-		}
+		entries = append(entries, inflection.Entry{Word: r.Word, Exchange: nullStringVal(r.Exchange)})
 	}
+	inflectionMap := inflection.BuildMap(entries)
 
-	entClient, cleanup, err := database.NewEntClient(cfg)
+	entClient, cleanup, err := newEntClient(cfg)
 	if err != nil {
 		return fmt.Errorf("连接目标数据库失败: %w", err)
 	}
@@ -221,7 +226,7 @@ func importECDICT(ctx context.Context, url string, batchSize int, cacheDirFlag s
 		if end > len(records) {
 			end = len(records)
 		}
-		if err := insertBatchEnt(ctx, entClient, records[batchStart:end], inflectionMap); err != nil {
+		if err := insertBatchEnt(ctx, entClient, records[batchStart:end], inflectionMap, cefrLevels); err != nil {
 			return err
 		}
 		total += (end - batchStart)
@@ -232,6 +237,70 @@ func importECDICT(ctx context.Context, url string, batchSize int, cacheDirFlag s
 	return nil
 }
 
+// readECDICTRecords loads the importable single-word entries from an ECDICT stardict table.
+func readECDICTRecords(ctx context.Context, sqldb *sql.DB) ([]wordRecord, error) {
+	// NOTE: ECDICT schema sample (stardict): word, phonetic, definition, translation, pos, collins, oxford, tag, bnc, frq, exchange, detail, audio
+	// We pull translation, tag, exchange and the frequency ranks if present; tolerate missing columns via COALESCE where possible.
+	rows, err := sqldb.QueryContext(ctx, `SELECT word, phonetic, definition, pos, translation, exchange, tag, frq, bnc FROM stardict`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	records := make([]wordRecord, 0, 500000)
+	for rows.Next() {
+		var r wordRecord
+		if err := rows.Scan(&r.Word, &r.Phonetic, &r.Definition, &r.Pos, &r.Translation, &r.Exchange, &r.Tags, &r.Frq, &r.BNC); err != nil {
+			return nil, err
+		}
+		r.Word = strings.TrimSpace(r.Word)
+		if r.Word == "" || !isSingleWordFor(entity.LanguageEnglish, r.Word) || isAllEmpty(r) {
+			continue
+		}
+		records = append(records, r)
+	}
+	return records, rows.Err()
+}
+
+// frequencyRank prefers the contemporary corpus rank and falls back to the BNC rank; 0 means unranked.
+func frequencyRank(r wordRecord) int {
+	for _, rank := range []sql.NullInt64{r.Frq, r.BNC} {
+		if rank.Valid && rank.Int64 > 0 && rank.Int64 <= math.MaxInt32 {
+			return int(rank.Int64)
+		}
+	}
+	return 0
+}
+
+// fetchECDICT resolves the ECDICT archive through the local cache (downloading when needed),
+// verifies its checksum and extracts the sqlite database into dstDir.
+func fetchECDICT(ctx context.Context, url, cacheDirFlag string, noCache bool, checksum, dstDir string) (string, error) {
+	cacheDir, zipPath, fromCache, err := prepareCachePath(url, cacheDirFlag, noCache)
+	if err != nil {
+		return "", err
+	}
+	if !fromCache {
+		if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+			return "", fmt.Errorf("创建缓存目录失败: %w", err)
+		}
+		log.Printf("下载 ECDICT 到缓存: %s", zipPath)
+		if err := downloadFile(ctx, url, zipPath); err != nil {
+			return "", err
+		}
+	} else {
+		log.Printf("使用缓存文件: %s", zipPath)
+	}
+	if err := verifyChecksum(zipPath, expectedDigest(url, checksum)); err != nil {
+		return "", err
+	}
+	sqlitePath, err := unzipSingle(func(name string) bool { return strings.HasSuffix(name, ".db") || strings.HasSuffix(name, ".sqlite") }, zipPath, dstDir)
+	if err != nil {
+		return "", err
+	}
+	log.Printf("已解压 sqlite: %s", sqlitePath)
+	return sqlitePath, nil
+}
+
 // helpers
 func downloadFile(ctx context.Context, url, path string) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -257,6 +326,40 @@ func downloadFile(ctx context.Context, url, path string) error {
 	return nil
 }
 
+// expectedDigest resolves the digest to verify against: the explicit flag wins over the built-in table.
+func expectedDigest(url, flagValue string) string {
+	if v := strings.TrimSpace(flagValue); v != "" {
+		return strings.ToLower(v)
+	}
+	return knownECDICTDigests[url]
+}
+
+// verifyChecksum validates the archive's SHA-256. A mismatching file is removed from the cache
+// so the next run re-downloads it; without an expected digest it fails with errNoECDICTDigest.
+func verifyChecksum(path, expected string) error {
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	_, err = io.Copy(h, f)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("计算 SHA-256 失败: %w", err)
+	}
+	actual := hex.EncodeToString(h.Sum(nil))
+	if expected == "" {
+		return fmt.Errorf("%w (实际 %s)", errNoECDICTDigest, actual)
+	}
+	if actual != expected {
+		if rmErr := os.Remove(path); rmErr != nil && !errors.Is(rmErr, os.ErrNotExist) {
+			log.Printf("删除校验失败的缓存文件失败: %v", rmErr)
+		}
+		return fmt.Errorf("ECDICT 校验失败: 期望 SHA-256 %s, 实际 %s", expected, actual)
+	}
+	return nil
+}
+
 func unzipSingle(match func(string) bool, zipPath, dstDir string) (string, error) {
 	zr, err := zip.OpenReader(zipPath)
 	if err != nil {
@@ -306,7 +409,8 @@ func unzipSingle(match func(string) bool, zipPath, dstDir string) (string, error
 
 // (legacy single-pass insert function removed)
 
-func insertBatchEnt(ctx context.Context, client *entdb.Client, batch []wordRecord, inflectionMap map[string]inflectionRel) error {
+// insertBatchEnt upserts one batch of ECDICT records; cefrLevels maps their tags to a CEFR level.
+func insertBatchEnt(ctx context.Context, client *entdb.Client, batch []wordRecord, inflectionMap map[string]inflection.Relation, cefrLevels map[string]entity.CEFRLevel) error {
 	if len(batch) == 0 {
 		return nil
 	}
@@ -320,69 +424,89 @@ func insertBatchEnt(ctx context.Context, client *entdb.Client, batch []wordRecor
 		if len(meanings) == 0 && len(phonetics) == 0 {
 			continue
 		}
-		wordType := entity.WordTypeLemma
-		var lemmaPtr *string
-		if rel, ok := inflectionMap[strings.ToLower(w.Word)]; ok {
-			if !strings.EqualFold(rel.Lemma, w.Word) {
-				wordType = rel.Type
-				lemmaPtr = &rel.Lemma
-			}
-		}
+		wordType, lemmaPtr := inflection.Resolve(w.Word, inflectionMap)
 		builder := client.Word.Create().
 			SetText(w.Word).
 			SetLanguage("en").
-			SetWordType(wordType).
-			SetNillableLemma(lemmaPtr)
+			SetWordType(string(wordType)).
+			SetNillableLemma(lemmaPtr).
+			SetSource(string(entity.WordSourceECDICT)).
+			SetFrequency(frequencyRank(w))
 		if len(phonetics) > 0 {
 			builder.SetPhonetics(phonetics)
 		}
 		if len(meanings) > 0 {
 			builder.SetDefinitions(meanings)
 		}
-		if tags := buildTags(w.Tags); len(tags) > 0 {
+		tags := buildTags(w.Tags)
+		if len(tags) > 0 {
 			builder.SetCategories(tags)
 		}
+		builder.SetCefr(string(entity.CEFRFromTags(tags, cefrLevels)))
 		builders = append(builders, builder)
 	}
 	if len(builders) == 0 {
 		return nil
 	}
-	return client.Word.CreateBulk(builders...).
-		OnConflictColumns(word.FieldLanguage, word.FieldText, word.FieldWordType).
+	// Re-imports refresh imported rows but leave hand-curated entries untouched.
+	err := client.Word.CreateBulk(builders...).
+		OnConflict(
+			entsql.ConflictColumns(word.FieldLanguage, word.FieldText),
+			entsql.UpdateWhere(entsql.NEQ(word.FieldSource, string(entity.WordSourceManual))),
+		).
 		UpdateNewValues().
 		Exec(ctx)
+	if v, ok := database.AsUniqueViolation(err); ok {
+		return fmt.Errorf("批量写入词条违反唯一约束 (%s): %w", strings.Join(v.Columns, ", "), err)
+	}
+	if err != nil {
+		return err
+	}
+	return rankManualWords(ctx, client, batch)
 }
 
-func buildTags(ns sql.NullString) []string {
-	if !ns.Valid {
-		return nil
+// rankManualWords copies ECDICT frequency ranks onto the hand-curated words of batch. The upsert
+// leaves manual rows alone, but a corpus rank is not curated content; without it those words
+// would sort as unranked when words are ordered by frequency.
+func rankManualWords(ctx context.Context, client *entdb.Client, batch []wordRecord) error {
+	ranks := make(map[string]int, len(batch))
+	for _, w := range batch {
+		if rank := frequencyRank(w); rank > 0 {
+			ranks[w.Word] = rank
+		}
 	}
-	s := strings.TrimSpace(ns.String)
-	if s == "" {
+	if len(ranks) == 0 {
 		return nil
 	}
-	s = strings.ReplaceAll(s, ",", " ")
-	parts := strings.Fields(s)
-	if len(parts) == 0 {
-		return nil
+	manual, err := client.Word.Query().
+		Where(
+			word.SourceEQ(string(entity.WordSourceManual)),
+			word.LanguageEQ("en"),
+			word.TextIn(slices.Collect(maps.Keys(ranks))...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("查询手工词条失败: %w", err)
 	}
-	seen := make(map[string]struct{}, len(parts))
-	ordered := make([]string, 0, len(parts))
-	for _, p := range parts {
-		p = strings.TrimSpace(p)
-		if p == "" {
-			continue
-		}
-		if _, ok := seen[p]; ok {
-			continue
+	for _, m := range manual {
+		if rank := ranks[m.Text]; rank != m.Frequency {
+			if err := client.Word.UpdateOneID(m.ID).SetFrequency(rank).Exec(ctx); err != nil {
+				return fmt.Errorf("更新手工词条 %s 的词频失败: %w", m.Text, err)
+			}
 		}
-		seen[p] = struct{}{}
-		ordered = append(ordered, p)
 	}
-	if len(ordered) == 0 {
+	return nil
+}
+
+func buildTags(ns sql.NullString) []string {
+	if !ns.Valid {
+		return nil
+	}
+	tags := entity.NormalizeTags(strings.Fields(strings.ReplaceAll(ns.String, ",", " ")))
+	if len(tags) == 0 {
 		return nil
 	}
-	return ordered
+	return tags
 }
 
 func buildPhonetics(ns sql.NullString) []entity.WordPhonetic {
@@ -418,13 +542,13 @@ func buildMeanings(w wordRecord) ([]entity.WordDefinition, error) {
 
 	// Definitions: capture lines
 	for _, line := range defLines {
-		pos, rest := extractLeadingPOS(line)
+		pos, rest := extractLeadingPOS(line, entity.LanguageEnglish)
 		// we don't try to merge definitions of same pos; always new group
 		groups = append(groups, &agg{pos: pos, defs: []string{rest}})
 	}
 	// Translations: appended after all definitions, keep independent
 	for _, line := range transLines {
-		pos, rest := extractLeadingPOS(line)
+		pos, rest := extractLeadingPOS(line, entity.LanguageChinese)
 		groups = append(groups, &agg{pos: pos, trans: []string{rest}})
 	}
 
@@ -451,7 +575,7 @@ func buildMeanings(w wordRecord) ([]entity.WordDefinition, error) {
 		return nil, nil
 	}
 
-	// 构建 meanings: 逐行转换，无权重。
+	// 构建 meanings: 逐行转换，无权重；Order 沿用源数据的行序。
 	meaningsSlice := make([]entity.WordDefinition, 0, len(lm))
 	for _, it := range lm {
 		text := strings.TrimSpace(it.text)
@@ -463,6 +587,7 @@ func buildMeanings(w wordRecord) ([]entity.WordDefinition, error) {
 			Pos:      strings.TrimSpace(it.pos),
 			Text:     text,
 			Language: lang,
+			Order:    len(meaningsSlice) + 1,
 		})
 	}
 	if len(meaningsSlice) == 0 {
@@ -471,12 +596,23 @@ func buildMeanings(w wordRecord) ([]entity.WordDefinition, error) {
 	return meaningsSlice, nil
 }
 
-// extractLeadingPOS 尝试解析行首词性标记，返回 (pos, 剩余文本)。若没有匹配返回 pos=""。
-func extractLeadingPOS(line string) (string, string) {
+// posMarkerSeparators 按行的语言列出词性标记后允许出现的字符；未列出的语言不解析词性，整行保留。
+var posMarkerSeparators = map[entity.Language]string{
+	entity.LanguageEnglish: ". \t",
+	// ECDICT 中文翻译的词性总是带点 ("n. 苹果")；"n 个数" 之类是译文本身，不是标记。
+	entity.LanguageChinese: ".",
+}
+
+// extractLeadingPOS 按 language 的约定解析行首词性标记，返回 (pos, 剩余文本)。若没有匹配返回 pos=""。
+func extractLeadingPOS(line string, language entity.Language) (string, string) {
 	s := strings.TrimSpace(line)
 	if s == "" {
 		return "", ""
 	}
+	separators, ok := posMarkerSeparators[language]
+	if !ok {
+		return "", s
+	}
 	lower := strings.ToLower(s)
 	// 候选列表按长度排序，先匹配更长的 (vt, vi 在 v 之前)
 	candidates := []string{"vt", "vi", "adj", "adv", "prep", "pron", "conj", "interj", "int", "num", "art", "aux", "abbr", "pref", "suf", "noun", "n", "v"}
@@ -491,8 +627,7 @@ func extractLeadingPOS(line string) (string, string) {
 				// 完整行只包含候选字符串，不视为标记
 				break
 			}
-			next := rest[0]
-			if next != '.' && next != ' ' && next != '\t' {
+			if !strings.ContainsRune(separators, rune(rest[0])) {
 				continue
 			}
 			// 跳过可选的 '.' 以及随后的空白
@@ -528,95 +663,81 @@ func splitLines(s string) []string {
 
 // (weight parsing removed)
 
-// Exchange parsing restored (classification only, no extra rows inserted)
-type exchangePair struct{ code, word string }
+const (
+	_maxWordRunes    = 64 // longer alphabetic "words" are almost always pasted phrases or junk
+	_maxCJKWordRunes = 8  // idioms fit; longer unspaced runs are definitions or sentences
+)
 
-func parseExchangePairs(s string) []exchangePair {
-	if s == "" {
-		return nil
+// isSingleWordFor reports whether text looks like a single dictionary headword in lang.
+// Letters of any script are accepted so loanwords and mixed-script entries survive. Apostrophes,
+// hyphens and periods may join letters ("don't", "mother-in-law", "e.g."), but whitespace and list
+// or sentence punctuation mark the row as a phrase or definition. CJK languages are written without
+// spaces, so there a length cap stands in for the whitespace check.
+func isSingleWordFor(lang entity.Language, text string) bool {
+	runes := []rune(strings.TrimSpace(text))
+	if len(runes) == 0 {
+		return false
 	}
-	parts := strings.Split(s, "/")
-	out := make([]exchangePair, 0, len(parts))
-	seen := make(map[string]struct{})
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if part == "" {
-			continue
-		}
-		code := "other"
-		val := part
-		if left, right, ok := strings.Cut(part, ":"); ok {
-			code = left
-			val = right
-		}
-		val = strings.TrimSpace(val)
-		if val == "" {
-			continue
-		}
-		norm := normalizeExchangeCode(code)
-		key := norm + "|" + strings.ToLower(val)
-		if _, ok := seen[key]; ok {
-			continue
+	if isCJKLanguage(lang) && isCJKRun(runes) {
+		return len(runes) <= _maxCJKWordRunes
+	}
+	if len(runes) > _maxWordRunes {
+		return false
+	}
+
+	hasLetter := false
+	for i, r := range runes {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r), unicode.Is(unicode.Mn, r):
+		case isWordJoiner(r):
+			first, last := i == 0, i == len(runes)-1
+			if i > 0 && isWordJoiner(runes[i-1]) {
+				return false
+			}
+			if first && !isApostrophe(r) { // 'tis
+				return false
+			}
+			if last && r == '-' { // goin' and etc. are fine, dangling hyphens are not
+				return false
+			}
+		default:
+			return false
 		}
-		seen[key] = struct{}{}
-		out = append(out, exchangePair{code: norm, word: val})
-	}
-	return out
-}
-
-// normalizeExchangeCode maps ECDICT exchange codes to word_type strings.
-// Rule (finalized): Only these three get abbreviations: past participle -> pp, present participle -> ing, third person singular -> 3sg.
-// Others keep readable full words without underscores.
-// Mapping:
-//
-//	p -> past
-//	d -> pp            (past participle)
-//	i -> ing           (present participle / gerund)
-//	3 -> 3sg           (third person singular present)
-//	r -> comparative
-//	t -> superlative
-//	s -> plural
-//	0 -> lemma
-//	1 -> variant
-//
-// Unrecognized codes returned unchanged (may be treated as "other").
-func normalizeExchangeCode(c string) string {
-	switch c {
-	case "p":
-		return "past"
-	case "d":
-		return "pp"
-	case "i":
-		return "ing"
-	case "3":
-		return "3sg"
-	case "r":
-		return "comparative"
-	case "t":
-		return "superlative"
-	case "s":
-		return "plural"
-	case "0":
-		return entity.WordTypeLemma
-	case "1":
-		return "variant"
-	default:
-		return c
 	}
+	return hasLetter
 }
 
-func isSingleWord(w string) bool {
-	if strings.ContainsAny(w, " \t\n") {
+func isCJKLanguage(lang entity.Language) bool {
+	switch entity.NormalizeLanguage(lang) {
+	case entity.LanguageChinese, entity.LanguageJapanese, entity.LanguageKorean:
+		return true
+	default:
 		return false
 	}
-	// Exclude obvious multi-item constructs containing commas or semicolons
-	if strings.ContainsAny(w, ",;") {
+}
+
+// isCJKRun reports whether every rune is a Han, kana or Hangul letter (including the
+// katakana prolonged sound mark and the interpunct used in transliterated names).
+func isCJKRun(runes []rune) bool {
+	for _, r := range runes {
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) || r == 'ー' || r == '·' || r == '・' {
+			continue
+		}
 		return false
 	}
-	// Basic sanity: allow hyphenated and apostrophes
 	return true
 }
 
+func isWordJoiner(r rune) bool {
+	return r == '-' || r == '.' || isApostrophe(r)
+}
+
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
 func isAllEmpty(r wordRecord) bool {
 	return strings.TrimSpace(nullStringVal(r.Phonetic)) == "" &&
 		strings.TrimSpace(nullStringVal(r.Definition)) == "" &&
@@ -638,7 +759,7 @@ func runMigrations() error {
 	if err != nil {
 		return fmt.Errorf("加载配置失败: %w", err)
 	}
-	client, cleanup, err := database.NewEntClient(cfg)
+	client, cleanup, err := newEntClient(cfg)
 	if err != nil {
 		return fmt.Errorf("创建 ent 客户端失败: %w", err)
 	}
@@ -700,20 +821,13 @@ func ensurePostgresJSONTags(ctx context.Context, dsn string) error {
 
 // prepareCachePath decides cache location and returns (cacheDir, zipPath, fromCache, error)
 func prepareCachePath(url, cacheDirFlag string, noCache bool) (string, string, bool, error) {
-	// Determine base cache dir
-	var base string
-	if cacheDirFlag != "" {
-		base = cacheDirFlag
-	} else {
-		userCache, err := os.UserCacheDir()
-		if err != nil {
-			return "", "", false, fmt.Errorf("获取用户缓存目录失败: %w", err)
-		}
-		base = filepath.Join(userCache, "vocnet")
+	base, err := ecdictCacheDir(cacheDirFlag)
+	if err != nil {
+		return "", "", false, err
 	}
 	// stable filename from URL hash
 	h := crc32.ChecksumIEEE([]byte(url))
-	name := fmt.Sprintf("ecdict-%08x.zip", h)
+	name := fmt.Sprintf(ecdictCachePattern, h)
 	zipPath := filepath.Join(base, name)
 	if !noCache {
 		if st, err := os.Stat(zipPath); err == nil && st.Size() > 0 {
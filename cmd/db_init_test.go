@@ -1,10 +1,22 @@
 package cmd
 
 import (
+	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
 	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/usecase"
 )
 
 func Test_buildMeanings_alignment(t *testing.T) {
@@ -42,21 +54,382 @@ func Test_buildMeanings_alignment(t *testing.T) {
 	if m[5].Pos != "vi." || m[5].Text == "" || m[5].Language != entity.LanguageChinese {
 		t.Fatalf("bad sixth: %+v", m[5])
 	}
+	// Order follows the source line order.
+	for i, def := range m {
+		if def.Order != i+1 {
+			t.Fatalf("meaning %d has order %d, want %d", i, def.Order, i+1)
+		}
+	}
 }
 
 func Test_extractLeadingPOS(t *testing.T) {
-	cases := []struct{ in, pos, rest string }{
-		{"vt. do sth", "vt.", "do sth"},
-		{"v change", "v.", "change"},
-		{"Adj. big", "adj.", "big"},           // case-insensitive
-		{"noun something", "n.", "something"}, // 'n' followed by space
-		{"adv. quickly", "adv.", "quickly"},
-		{"no marker line", "", "no marker line"},
+	cases := []struct {
+		lang          entity.Language
+		in, pos, rest string
+	}{
+		{entity.LanguageEnglish, "vt. do sth", "vt.", "do sth"},
+		{entity.LanguageEnglish, "v change", "v.", "change"},
+		{entity.LanguageEnglish, "Adj. big", "adj.", "big"},           // case-insensitive
+		{entity.LanguageEnglish, "noun something", "n.", "something"}, // 'n' followed by space
+		{entity.LanguageEnglish, "adv. quickly", "adv.", "quickly"},
+		{entity.LanguageEnglish, "no marker line", "", "no marker line"},
+		// Chinese translations only carry dotted markers
+		{entity.LanguageChinese, "n. 苹果", "n.", "苹果"},
+		{entity.LanguageChinese, "n 个数", "", "n 个数"},
+		{entity.LanguageChinese, "art 艺术品", "", "art 艺术品"},
+		{entity.LanguageChinese, "v 字母v", "", "v 字母v"},
+		// Languages without POS markers keep the whole line
+		{entity.LanguageJapanese, "n. りんご", "", "n. りんご"},
 	}
 	for _, c := range cases {
-		p, r := extractLeadingPOS(c.in)
+		p, r := extractLeadingPOS(c.in, c.lang)
 		if p != c.pos || r != c.rest {
-			t.Fatalf("%q -> got (%q,%q) want (%q,%q)", c.in, p, r, c.pos, c.rest)
+			t.Fatalf("%s %q -> got (%q,%q) want (%q,%q)", c.lang, c.in, p, r, c.pos, c.rest)
+		}
+	}
+}
+
+func Test_buildMeanings_keepsChineseTranslations(t *testing.T) {
+	w := wordRecord{
+		Definition:  sql.NullString{String: "art the arts\nint. interjection", Valid: true},
+		Translation: sql.NullString{String: "art 艺术品\nint 整型\nn 个数", Valid: true},
+	}
+	m, err := buildMeanings(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []entity.WordDefinition{
+		{Pos: "art.", Text: "the arts", Language: entity.LanguageEnglish, Order: 1},
+		{Pos: "int.", Text: "interjection", Language: entity.LanguageEnglish, Order: 2},
+		{Text: "art 艺术品", Language: entity.LanguageChinese, Order: 3},
+		{Text: "int 整型", Language: entity.LanguageChinese, Order: 4},
+		{Text: "n 个数", Language: entity.LanguageChinese, Order: 5},
+	}
+	if !reflect.DeepEqual(m, want) {
+		t.Fatalf("meanings = %+v, want %+v", m, want)
+	}
+}
+
+func Test_verifyChecksum(t *testing.T) {
+	payload := []byte("ecdict fixture archive")
+	sum := sha256.Sum256(payload)
+	digest := hex.EncodeToString(sum[:])
+
+	writeFixture := func(t *testing.T) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "ecdict.zip")
+		if err := os.WriteFile(path, payload, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("match", func(t *testing.T) {
+		path := writeFixture(t)
+		if err := verifyChecksum(path, digest); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := os.Stat(path); err != nil {
+			t.Fatalf("fixture should be kept: %v", err)
+		}
+	})
+
+	t.Run("mismatch removes cached file", func(t *testing.T) {
+		path := writeFixture(t)
+		wrong := hex.EncodeToString(make([]byte, sha256.Size))
+		if err := verifyChecksum(path, wrong); err == nil {
+			t.Fatal("expected checksum mismatch error")
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("expected fixture to be removed, stat err=%v", err)
+		}
+	})
+
+	t.Run("no expectation is refused", func(t *testing.T) {
+		path := writeFixture(t)
+		if err := verifyChecksum(path, ""); !errors.Is(err, errNoECDICTDigest) {
+			t.Fatalf("expected errNoECDICTDigest, got %v", err)
+		}
+	})
+}
+
+func Test_fetchECDICTRejectsTamperedArchive(t *testing.T) {
+	const url = "https://example.com/pinned-ecdict.zip"
+	sum := sha256.Sum256([]byte("genuine archive"))
+	knownECDICTDigests[url] = hex.EncodeToString(sum[:])
+	t.Cleanup(func() { delete(knownECDICTDigests, url) })
+
+	for _, tc := range []struct {
+		url  string
+		want error
+	}{
+		{url: url},
+		{url: "https://example.com/unpinned-ecdict.zip", want: errNoECDICTDigest},
+	} {
+		cacheDir := t.TempDir()
+		_, zipPath, _, err := prepareCachePath(tc.url, cacheDir, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(zipPath, []byte("tampered archive"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = fetchECDICT(context.Background(), tc.url, cacheDir, false, "", t.TempDir())
+		if err == nil {
+			t.Fatalf("%s: tampered archive was accepted without --sha256", tc.url)
+		}
+		if tc.want != nil && !errors.Is(err, tc.want) {
+			t.Fatalf("%s: error = %v, want %v", tc.url, err, tc.want)
+		}
+	}
+}
+
+func Test_expectedDigest(t *testing.T) {
+	knownECDICTDigests["https://example.com/ecdict.zip"] = "abc"
+	t.Cleanup(func() { delete(knownECDICTDigests, "https://example.com/ecdict.zip") })
+
+	if got := expectedDigest("https://example.com/ecdict.zip", ""); got != "abc" {
+		t.Fatalf("expected built-in digest, got %q", got)
+	}
+	if got := expectedDigest("https://example.com/ecdict.zip", " DEF "); got != "def" {
+		t.Fatalf("expected flag digest to win, got %q", got)
+	}
+	if got := expectedDigest("https://example.com/other.zip", ""); got != "" {
+		t.Fatalf("expected empty digest for unknown url, got %q", got)
+	}
+}
+
+func Test_isSingleWordFor(t *testing.T) {
+	cases := []struct {
+		lang entity.Language
+		in   string
+		want bool
+	}{
+		// English
+		{entity.LanguageEnglish, "apple", true},
+		{entity.LanguageEnglish, "  apple ", true},
+		{entity.LanguageEnglish, "mother-in-law", true},
+		{entity.LanguageEnglish, "don't", true},
+		{entity.LanguageEnglish, "don’t", true},
+		{entity.LanguageEnglish, "'tis", true},
+		{entity.LanguageEnglish, "e.g.", true},
+		{entity.LanguageEnglish, "mp3", true},
+		{entity.LanguageEnglish, "naïve", true},
+		{entity.LanguageEnglish, "ice cream", false},
+		{entity.LanguageEnglish, "apple, pear", false},
+		{entity.LanguageEnglish, "see: apple", false},
+		{entity.LanguageEnglish, "(of a fruit)", false},
+		{entity.LanguageEnglish, "well--known", false},
+		{entity.LanguageEnglish, "-ism", false},
+		{entity.LanguageEnglish, "anti-", false},
+		{entity.LanguageEnglish, "1984", false},
+		{entity.LanguageEnglish, "", false},
+		// German
+		{entity.LanguageGerman, "Straße", true},
+		{entity.LanguageGerman, "Mädchen", true},
+		{entity.LanguageGerman, "Größe", true},
+		{entity.LanguageGerman, "Schritt-für-Schritt", true},
+		{entity.LanguageGerman, "der Hund", false},
+		// French
+		{entity.LanguageFrench, "aujourd'hui", true},
+		{entity.LanguageFrench, "l’hôpital", true},
+		{entity.LanguageFrench, "garçon", true},
+		{entity.LanguageFrench, "c'est-à-dire", true},
+		{entity.LanguageFrench, "qu''il", false},
+		{entity.LanguageFrench, "bonjour !", false},
+		// Chinese
+		{entity.LanguageChinese, "苹果", true},
+		{entity.LanguageChinese, "一石二鸟", true},
+		{entity.LanguageChinese, "达·芬奇", true},
+		{entity.LanguageChinese, "苹果，梨", false},
+		{entity.LanguageChinese, "苹果；梨", false},
+		{entity.LanguageChinese, "一种常见的红色或绿色水果", false},
+		{entity.LanguageChinese, "苹果 梨", false},
+		// Japanese and Korean share the CJK rules
+		{entity.LanguageJapanese, "コーヒー", true},
+		{entity.LanguageKorean, "사과", true},
+		// Other scripts in an English dictionary are kept
+		{entity.LanguageEnglish, "苹果", true},
+	}
+	for _, c := range cases {
+		if got := isSingleWordFor(c.lang, c.in); got != c.want {
+			t.Fatalf("isSingleWordFor(%s, %q) = %v, want %v", c.lang, c.in, got, c.want)
+		}
+	}
+}
+
+func Test_insertBatchEnt_keepsManualEdits(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "reimport.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	record := func(text, translation string) wordRecord {
+		return wordRecord{Word: text, Translation: sql.NullString{String: translation, Valid: true}}
+	}
+	if err := insertBatchEnt(ctx, client, []wordRecord{record("apple", "n. 苹果"), record("pear", "n. 梨")}, nil, nil); err != nil {
+		t.Fatalf("first import: %v", err)
+	}
+
+	words := usecase.NewWordUsecase(repository.NewWordRepository(client))
+	apple, err := words.Lookup(ctx, "apple", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup apple: %v", err)
+	}
+	if apple.Source != entity.WordSourceECDICT {
+		t.Fatalf("imported source = %q, want %q", apple.Source, entity.WordSourceECDICT)
+	}
+	apple.Definitions = []entity.WordDefinition{{Pos: "n.", Text: "苹果（手工校订）", Language: entity.LanguageChinese}}
+	if _, err := words.Update(ctx, apple, entity.WordFieldDefinitions); err != nil {
+		t.Fatalf("edit apple: %v", err)
+	}
+
+	if err := insertBatchEnt(ctx, client, []wordRecord{record("apple", "n. 苹果树的果实"), record("pear", "n. 梨子")}, nil, nil); err != nil {
+		t.Fatalf("re-import: %v", err)
+	}
+
+	apple, err = words.Lookup(ctx, "apple", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup apple: %v", err)
+	}
+	if apple.Source != entity.WordSourceManual || len(apple.Definitions) != 1 || apple.Definitions[0].Text != "苹果（手工校订）" {
+		t.Fatalf("manual edit was overwritten: source=%q definitions=%+v", apple.Source, apple.Definitions)
+	}
+	pear, err := words.Lookup(ctx, "pear", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup pear: %v", err)
+	}
+	if pear.Source != entity.WordSourceECDICT || len(pear.Definitions) != 1 || pear.Definitions[0].Text != "梨子" {
+		t.Fatalf("imported row was not refreshed: source=%q definitions=%+v", pear.Source, pear.Definitions)
+	}
+}
+
+func Test_importECDICT_storesFrequency(t *testing.T) {
+	ctx := context.Background()
+	sqldb, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "ecdict.db"))
+	if err != nil {
+		t.Fatalf("open fixture: %v", err)
+	}
+	t.Cleanup(func() { sqldb.Close() })
+	if _, err := sqldb.ExecContext(ctx, `CREATE TABLE stardict (word TEXT, phonetic TEXT, definition TEXT, pos TEXT, translation TEXT, exchange TEXT, tag TEXT, frq INTEGER, bnc INTEGER)`); err != nil {
+		t.Fatalf("create stardict: %v", err)
+	}
+	for _, row := range []struct {
+		word     string
+		frq, bnc any
+	}{
+		{"the", 1, 1},
+		{"apple", 0, 2600},
+		{"zymurgy", nil, nil},
+	} {
+		if _, err := sqldb.ExecContext(ctx, `INSERT INTO stardict (word, translation, frq, bnc) VALUES (?, ?, ?, ?)`, row.word, "n. 释义", row.frq, row.bnc); err != nil {
+			t.Fatalf("insert %s: %v", row.word, err)
+		}
+	}
+
+	records, err := readECDICTRecords(ctx, sqldb)
+	if err != nil {
+		t.Fatalf("read records: %v", err)
+	}
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "frequency.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	if err := insertBatchEnt(ctx, client, records, nil, nil); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	words := usecase.NewWordUsecase(repository.NewWordRepository(client))
+	for text, want := range map[string]int{"the": 1, "apple": 2600, "zymurgy": 0} {
+		w, err := words.Lookup(ctx, text, entity.LanguageEnglish, entity.LookupPreferLemma)
+		if err != nil {
+			t.Fatalf("lookup %s: %v", text, err)
+		}
+		if w.Frequency != want {
+			t.Fatalf("%s frequency = %d, want %d", text, w.Frequency, want)
+		}
+	}
+
+	// A hand-curated word keeps its content but still picks up the corpus rank.
+	apple, err := words.Lookup(ctx, "apple", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup apple: %v", err)
+	}
+	apple.Definitions = []entity.WordDefinition{{Pos: "n.", Text: "苹果（手工校订）", Language: entity.LanguageChinese}}
+	if _, err := words.Update(ctx, apple, entity.WordFieldDefinitions); err != nil {
+		t.Fatalf("edit apple: %v", err)
+	}
+	if _, err := client.Word.Update().Where(word.TextEQ("apple")).SetFrequency(0).Save(ctx); err != nil {
+		t.Fatalf("clear apple frequency: %v", err)
+	}
+	if err := insertBatchEnt(ctx, client, records, nil, nil); err != nil {
+		t.Fatalf("re-import: %v", err)
+	}
+	apple, err = words.Lookup(ctx, "apple", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup apple: %v", err)
+	}
+	if apple.Source != entity.WordSourceManual || apple.Definitions[0].Text != "苹果（手工校订）" || apple.Frequency != 2600 {
+		t.Fatalf("manual apple = source %q definitions %+v frequency %d, want its edit kept and rank 2600",
+			apple.Source, apple.Definitions, apple.Frequency)
+	}
+}
+
+func Test_importECDICT_mapsTagsToCEFR(t *testing.T) {
+	ctx := context.Background()
+	tagged := func(word, tags string) wordRecord {
+		return wordRecord{
+			Word:        word,
+			Translation: sql.NullString{String: "n. 释义", Valid: true},
+			Tags:        sql.NullString{String: tags, Valid: tags != ""},
+		}
+	}
+	records := []wordRecord{
+		tagged("abandon", "cet4 cet6 ky"),
+		tagged("apple", "zk gk cet4"),
+		tagged("zymurgy", ""),
+		tagged("obscure", "blog"),
+	}
+
+	levels, err := parseCEFRMap("")
+	if err != nil {
+		t.Fatalf("default map: %v", err)
+	}
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "cefr.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	if err := insertBatchEnt(ctx, client, records, nil, levels); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	words := usecase.NewWordUsecase(repository.NewWordRepository(client))
+	for text, want := range map[string]entity.CEFRLevel{"abandon": entity.CEFRB1, "apple": entity.CEFRA2, "zymurgy": "", "obscure": ""} {
+		w, err := words.Lookup(ctx, text, entity.LanguageEnglish, entity.LookupPreferLemma)
+		if err != nil {
+			t.Fatalf("lookup %s: %v", text, err)
+		}
+		if w.CEFR != want {
+			t.Fatalf("%s cefr = %q, want %q", text, w.CEFR, want)
+		}
+	}
+
+	// A custom mapping overrides the defaults on re-import.
+	levels, err = parseCEFRMap("cet4=b2, zk=")
+	if err != nil {
+		t.Fatalf("custom map: %v", err)
+	}
+	if err := insertBatchEnt(ctx, client, records, nil, levels); err != nil {
+		t.Fatalf("re-import: %v", err)
+	}
+	for text, want := range map[string]entity.CEFRLevel{"abandon": entity.CEFRB2, "apple": entity.CEFRB1} {
+		w, err := words.Lookup(ctx, text, entity.LanguageEnglish, entity.LookupPreferLemma)
+		if err != nil {
+			t.Fatalf("lookup %s: %v", text, err)
+		}
+		if w.CEFR != want {
+			t.Fatalf("%s cefr after re-import = %q, want %q", text, w.CEFR, want)
+		}
+	}
+
+	for _, spec := range []string{"cet4", "cet4=D1", "=B1"} {
+		if _, err := parseCEFRMap(spec); err == nil {
+			t.Fatalf("parseCEFRMap(%q) succeeded, want an error", spec)
 		}
 	}
 }
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/eslsoft/vocnet/internal/adapter/mapping"
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	ports "github.com/eslsoft/vocnet/internal/repository"
+)
+
+var exportWordsCmd = &cobra.Command{
+	Use:   "export-words",
+	Short: "按标签导出词典子集为 NDJSON（每行一个 dict.v1.Word，可用 import-words 导入）",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		tags, _ := cmd.Flags().GetStringSlice("tags")
+		lang, _ := cmd.Flags().GetString("language")
+		outputPath, _ := cmd.Flags().GetString("output")
+		if len(tags) == 0 {
+			return fmt.Errorf("请通过 --tags 指定至少一个标签")
+		}
+		language := entity.ParseLanguage(lang)
+		if language == entity.LanguageUnspecified {
+			return fmt.Errorf("不支持的语言: %q", lang)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("加载配置失败: %w", err)
+		}
+		entClient, cleanup, err := newEntClient(cfg)
+		if err != nil {
+			return fmt.Errorf("创建 ent 客户端失败: %w", err)
+		}
+		defer cleanup()
+
+		writer := cmd.OutOrStdout()
+		if outputPath != "-" {
+			file, createErr := os.Create(filepath.Clean(outputPath))
+			if createErr != nil {
+				return fmt.Errorf("创建输出文件失败: %w", createErr)
+			}
+			defer func() {
+				if cerr := file.Close(); cerr != nil && err == nil {
+					err = cerr
+				}
+			}()
+			writer = file
+		}
+
+		count, err := exportWords(cmd.Context(), repository.NewWordRepository(entClient), tags, language, writer)
+		if err != nil {
+			return fmt.Errorf("导出词条失败: %w", err)
+		}
+		if outputPath != "-" {
+			cmd.Printf("导出完成: %d 个词条 -> %s\n", count, outputPath)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportWordsCmd)
+	exportWordsCmd.Flags().StringSlice("tags", nil, "导出包含任一标签的词条（如 toefl,cet4），逗号分隔或重复指定")
+	exportWordsCmd.Flags().String("language", string(entity.LanguageEnglish), "词条语言代码")
+	exportWordsCmd.Flags().StringP("output", "o", "-", "输出文件路径，使用 - 表示标准输出")
+}
+
+// exportWords writes every word carrying any of tags as one dict.v1.Word JSON object per line.
+// Database-assigned fields (id, source, timestamps) are dropped so the file imports into any instance.
+func exportWords(ctx context.Context, words ports.WordRepository, tags []string, language entity.Language, w io.Writer) (int, error) {
+	bw := bufio.NewWriter(w)
+	marshal := protojson.MarshalOptions{UseProtoNames: true}
+	count := 0
+	err := words.StreamByTags(ctx, tags, language, func(word *entity.Word) error {
+		pb := mapping.ToPbWord(word)
+		pb.Id, pb.Source, pb.CreatedAt, pb.UpdatedAt = 0, "", nil, nil
+		line, err := marshal.Marshal(pb)
+		if err != nil {
+			return fmt.Errorf("encode %q: %w", word.Text, err)
+		}
+		if _, err := bw.Write(append(line, '\n')); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		return count, err
+	}
+	return count, bw.Flush()
+}
@@ -26,11 +26,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/infrastructure/objectstore"
 	"github.com/eslsoft/vocnet/internal/usecase/backup"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -47,7 +50,10 @@ var exportCmd = &cobra.Command{
 	Use:   "export",
 	Short: "导出数据库内容为 NDJSON 备份",
 	RunE: func(cmd *cobra.Command, args []string) (err error) {
-		ctx := cmd.Context()
+		// An interrupted export stops on a record boundary and reports the failure, so an upload
+		// is aborted rather than replacing the object with a truncated backup.
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		defer stop()
 
 		cfg, err := config.Load()
 		if err != nil {
@@ -58,7 +64,15 @@ var exportCmd = &cobra.Command{
 		gzipEnabled := viper.GetBool(exportGzipKey)
 		tableList := tablesFromConfig(exportTablesKey)
 		batchSize := viper.GetInt(exportBatchKey)
+		schemaOnly, _ := cmd.Flags().GetBool("schema-only")
+		userID, _ := cmd.Flags().GetInt64("user")
+		flushBytes, _ := cmd.Flags().GetInt("flush-bytes")
+		flushRows, _ := cmd.Flags().GetInt("flush-rows")
+		continueOnError, _ := cmd.Flags().GetBool("continue-on-error")
 
+		if outputPath == "" && schemaOnly {
+			outputPath = "-"
+		}
 		if outputPath == "" {
 			outputPath = defaultExportFilename(gzipEnabled)
 		}
@@ -79,6 +93,7 @@ var exportCmd = &cobra.Command{
 			driver,
 			dsn,
 			backup.WithBatchSize(batchSize),
+			backup.WithDeniedTables(cfg.Backup.DenyTables),
 		)
 		if err != nil {
 			return fmt.Errorf("创建备份服务失败: %w", err)
@@ -89,7 +104,21 @@ var exportCmd = &cobra.Command{
 			closeFns []func() error
 		)
 
-		if outputPath != "-" {
+		switch {
+		case objectstore.IsURL(outputPath):
+			upload, openErr := createObject(ctx, cfg, outputPath)
+			if openErr != nil {
+				return openErr
+			}
+			writer = upload
+			// Only a finished export completes the upload; a failed one aborts it so the object is kept.
+			closeFns = append(closeFns, func() error {
+				if err != nil {
+					return upload.Abort()
+				}
+				return upload.Close()
+			})
+		case outputPath != "-":
 			if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
 				return fmt.Errorf("创建输出目录失败: %w", err)
 			}
@@ -115,13 +144,37 @@ var exportCmd = &cobra.Command{
 			}
 		}()
 
+		if schemaOnly {
+			if err := service.ExportSchema(writer); err != nil {
+				return fmt.Errorf("导出表结构失败: %w", err)
+			}
+			cmd.Println("表结构导出完成")
+			return nil
+		}
+
 		progress := newCLIProgress(cmd.ErrOrStderr())
-		exportOpts := []backup.ExportOption{backup.WithProgressReporter(progress)}
+		exportOpts := []backup.ExportOption{
+			backup.WithProgressReporter(progress),
+			backup.WithFlushEvery(flushBytes, flushRows),
+		}
 		if len(tableList) > 0 {
 			exportOpts = append(exportOpts, backup.WithTables(tableList))
 		}
+		if continueOnError {
+			exportOpts = append(exportOpts,
+				backup.WithContinueOnError(),
+				backup.WithTableErrorHandler(func(table string, err error) {
+					cmd.PrintErrf("警告: 跳过无法读取的表 %s: %v\n", table, err)
+				}),
+			)
+		}
 
-		if err := service.Export(ctx, writer, exportOpts...); err != nil {
+		if userID > 0 {
+			err = service.ExportUser(ctx, writer, userID, exportOpts...)
+		} else {
+			err = service.Export(ctx, writer, exportOpts...)
+		}
+		if err != nil {
 			return fmt.Errorf("导出备份失败: %w", err)
 		}
 
@@ -137,10 +190,15 @@ var exportCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(exportCmd)
 
-	exportCmd.Flags().StringP("output", "o", "", "备份输出文件路径，使用 - 表示标准输出")
+	exportCmd.Flags().StringP("output", "o", "", "备份输出文件路径，使用 - 表示标准输出，s3://bucket/key 表示上传到对象存储 (BACKUP_S3_*)")
 	exportCmd.Flags().Bool("gzip", false, "使用 gzip 压缩输出")
 	exportCmd.Flags().StringSlice("tables", nil, "仅导出指定表，逗号分隔或重复指定")
 	exportCmd.Flags().Int("batch-size", 0, "导出批处理大小 (默认 512)")
+	exportCmd.Flags().Int("flush-bytes", 0, "缓冲的记录达到该字节数时写出 (默认 1 MiB)")
+	exportCmd.Flags().Int("flush-rows", 0, "缓冲的记录达到该条数时写出 (默认 1000)")
+	exportCmd.Flags().Bool("continue-on-error", false, "跳过读取失败的表并继续导出其余表，失败原因记录在 meta 记录的 errors 字段 (默认任一表失败即中止)")
+	exportCmd.Flags().Int64("user", 0, "仅导出该用户的学习数据（生词及其熟练度、复习计划），可通过 import --user 恢复到任意用户")
+	exportCmd.Flags().Bool("schema-only", false, "仅以 JSON 导出当前程序内置的表结构与 schema 哈希，不连接数据库 (默认输出到标准输出)")
 
 	bindExportConfig()
 }
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	commonv1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
+	"github.com/spf13/cobra"
+)
+
+// importSentencesCmd attaches curated example sentences from a JSONL corpus to dictionary words.
+var importSentencesCmd = &cobra.Command{
+	Use:   "import-sentences",
+	Short: "从 JSONL 文件批量导入例句到词条",
+	Long:  "读取每行一个 {word, language, text, source, source_ref} 的 JSONL 文件，按词条合并例句（按文本+来源去重）。数据库中不存在的词条会被跳过并计数。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		input, _ := cmd.Flags().GetString("input")
+		language, _ := cmd.Flags().GetString("language")
+		return importSentences(cmd.Context(), input, entity.ParseLanguage(language))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importSentencesCmd)
+	importSentencesCmd.Flags().StringP("input", "i", "", "例句 JSONL 文件路径 (使用 - 表示标准输入)")
+	importSentencesCmd.Flags().String("language", string(entity.LanguageEnglish), "记录未指定 language 时使用的语言代码")
+	_ = importSentencesCmd.MarkFlagRequired("input")
+}
+
+// sentenceRecord is a single line of the sentence corpus file.
+type sentenceRecord struct {
+	Word      string          `json:"word"`
+	Language  string          `json:"language"`
+	Text      string          `json:"text"`
+	Source    json.RawMessage `json:"source"`
+	SourceRef string          `json:"source_ref"`
+}
+
+type sentenceGroupKey struct {
+	word     string
+	language entity.Language
+}
+
+type sentenceGroup struct {
+	key       sentenceGroupKey
+	sentences []entity.Sentence
+}
+
+func importSentences(ctx context.Context, input string, defaultLang entity.Language) error {
+	if defaultLang == entity.LanguageUnspecified {
+		return fmt.Errorf("不支持的语言代码")
+	}
+
+	var reader io.Reader
+	if input == "-" {
+		reader = os.Stdin
+	} else {
+		f, err := os.Open(input)
+		if err != nil {
+			return fmt.Errorf("打开例句文件失败: %w", err)
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	groups, total, err := readSentenceGroups(reader, defaultLang)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+	entClient, cleanup, err := newEntClient(cfg)
+	if err != nil {
+		return fmt.Errorf("连接目标数据库失败: %w", err)
+	}
+	defer cleanup()
+
+	words := usecase.NewWordUsecase(repository.NewWordRepository(entClient))
+
+	matched, skipped := 0, 0
+	for _, g := range groups {
+		w, err := words.Lookup(ctx, g.key.word, g.key.language, entity.LookupPreferExact)
+		if errors.Is(err, entity.ErrVocNotFound) {
+			skipped += len(g.sentences)
+			log.Printf("警告: 词条 %q (%s) 不存在, 跳过 %d 条例句", g.key.word, g.key.language, len(g.sentences))
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("查询词条 %q 失败: %w", g.key.word, err)
+		}
+		if err := words.AppendSentences(ctx, w.ID, g.sentences); err != nil {
+			return fmt.Errorf("写入词条 %q 例句失败: %w", g.key.word, err)
+		}
+		matched += len(g.sentences)
+	}
+
+	log.Printf("读取 %d 条例句, 处理 %d 条, 因词条不存在跳过 %d 条", total, matched, skipped)
+	return nil
+}
+
+// readSentenceGroups parses the JSONL input and groups sentences by word/language, preserving file order.
+func readSentenceGroups(r io.Reader, defaultLang entity.Language) ([]*sentenceGroup, int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	index := map[sentenceGroupKey]*sentenceGroup{}
+	var groups []*sentenceGroup
+	total := 0
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var rec sentenceRecord
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			return nil, 0, fmt.Errorf("第 %d 行解析失败: %w", lineNo, err)
+		}
+		word := strings.TrimSpace(rec.Word)
+		text := strings.TrimSpace(rec.Text)
+		if word == "" || text == "" {
+			return nil, 0, fmt.Errorf("第 %d 行缺少 word 或 text", lineNo)
+		}
+		source, err := parseSentenceSource(rec.Source)
+		if err != nil {
+			return nil, 0, fmt.Errorf("第 %d 行: %w", lineNo, err)
+		}
+		lang := defaultLang
+		if strings.TrimSpace(rec.Language) != "" {
+			lang = entity.ParseLanguage(rec.Language)
+			if lang == entity.LanguageUnspecified {
+				return nil, 0, fmt.Errorf("第 %d 行: 不支持的语言代码 %q", lineNo, rec.Language)
+			}
+		}
+
+		key := sentenceGroupKey{word: word, language: lang}
+		g, ok := index[key]
+		if !ok {
+			g = &sentenceGroup{key: key}
+			index[key] = g
+			groups = append(groups, g)
+		}
+		g.sentences = append(g.sentences, entity.Sentence{
+			Text:      text,
+			Source:    source,
+			SourceRef: strings.TrimSpace(rec.SourceRef),
+		})
+		total++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("读取例句文件失败: %w", err)
+	}
+	return groups, total, nil
+}
+
+// parseSentenceSource accepts a numeric SourceType or its name (e.g. "book", "SOURCE_TYPE_BOOK").
+func parseSentenceSource(raw json.RawMessage) (int32, error) {
+	value := strings.TrimSpace(string(raw))
+	if value == "" || value == "null" {
+		return int32(commonv1.SourceType_SOURCE_TYPE_UNSPECIFIED), nil
+	}
+
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if n, err := strconv.ParseInt(name, 10, 32); err == nil {
+			return validSourceType(int32(n))
+		}
+		if !strings.HasPrefix(name, "SOURCE_TYPE_") {
+			name = "SOURCE_TYPE_" + name
+		}
+		if v, ok := commonv1.SourceType_value[name]; ok {
+			return v, nil
+		}
+		return 0, fmt.Errorf("未知的 source %q", name)
+	}
+
+	n, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("无效的 source %s", value)
+	}
+	return validSourceType(int32(n))
+}
+
+func validSourceType(v int32) (int32, error) {
+	if _, ok := commonv1.SourceType_name[v]; !ok {
+		return 0, fmt.Errorf("未知的 source %d", v)
+	}
+	return v, nil
+}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/eslsoft/vocnet/internal/adapter/mapping"
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+)
+
+var importWordsCmd = &cobra.Command{
+	Use:   "import-words",
+	Short: "导入 export-words 生成的 NDJSON 词条",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		inputPath, _ := cmd.Flags().GetString("input")
+		onConflict, _ := cmd.Flags().GetString("on-conflict")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if inputPath == "" {
+			return fmt.Errorf("请通过 --input 指定词条文件或使用 - 表示标准输入")
+		}
+		var overwrite bool
+		switch onConflict {
+		case "skip":
+		case "update":
+			overwrite = true
+		default:
+			return fmt.Errorf("无效的 --on-conflict 取值 %q（可选 skip|update）", onConflict)
+		}
+
+		reader := cmd.InOrStdin()
+		if inputPath != "-" {
+			file, openErr := os.Open(filepath.Clean(inputPath))
+			if openErr != nil {
+				return fmt.Errorf("打开词条文件失败: %w", openErr)
+			}
+			defer file.Close()
+			reader = file
+		}
+		words, err := decodeWordsNDJSON(reader)
+		if err != nil {
+			return err
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("加载配置失败: %w", err)
+		}
+		entClient, cleanup, err := newEntClient(cfg)
+		if err != nil {
+			return fmt.Errorf("创建 ent 客户端失败: %w", err)
+		}
+		defer cleanup()
+		if err := entClient.Schema.Create(cmd.Context()); err != nil {
+			return fmt.Errorf("执行数据库迁移失败: %w", err)
+		}
+
+		stats, err := importWords(cmd.Context(), entClient, words, overwrite, dryRun)
+		if err != nil {
+			return err
+		}
+		prefix := "导入完成"
+		if dryRun {
+			prefix = "试运行完成（未写入数据库）"
+		}
+		cmd.Printf("%s: 新增 %d，更新 %d，跳过 %d\n", prefix, stats.Imported, stats.Updated, stats.Skipped)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importWordsCmd)
+	importWordsCmd.Flags().StringP("input", "i", "", "词条文件路径（NDJSON，每行一个 dict.v1.Word），使用 - 表示标准输入")
+	importWordsCmd.Flags().String("on-conflict", "skip", "已存在相同 (language, text, word_type) 的词条时: skip 保留原词条，update 覆盖")
+	importWordsCmd.Flags().Bool("dry-run", false, "只统计将新增/更新/跳过的词条，不写入数据库")
+}
+
+type importWordsStats struct {
+	Imported int
+	Updated  int
+	Skipped  int
+}
+
+// importWords upserts words through the usecase inside one transaction, lemmas before the forms
+// that reference them. The transaction is rolled back on error or when dryRun is set, so a dry
+// run reports exactly what a real import would do. Transactions aborted by postgres
+// serialization failures or deadlocks are retried from the start.
+func importWords(ctx context.Context, client *entdb.Client, words []*entity.Word, overwrite, dryRun bool) (stats importWordsStats, err error) {
+	err = database.RetryTransient(ctx, "import words", func(ctx context.Context) (err error) {
+		stats, err = importWordsTx(ctx, client, words, overwrite, dryRun)
+		return err
+	})
+	return stats, err
+}
+
+func importWordsTx(ctx context.Context, client *entdb.Client, words []*entity.Word, overwrite, dryRun bool) (stats importWordsStats, err error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("开启事务失败: %w", err)
+	}
+	defer func() {
+		if err != nil || dryRun {
+			_ = tx.Rollback()
+		}
+	}()
+
+	ordered := append([]*entity.Word(nil), words...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return isSeedLemma(ordered[i]) && !isSeedLemma(ordered[j])
+	})
+
+	uc := usecase.NewWordUsecase(repository.NewWordRepository(tx.Client()))
+	for _, word := range ordered {
+		_, outcome, upsertErr := uc.Upsert(ctx, word, overwrite)
+		if upsertErr != nil {
+			return stats, fmt.Errorf("导入词条 %q 失败: %w", word.Text, upsertErr)
+		}
+		switch outcome {
+		case entity.UpsertCreated:
+			stats.Imported++
+		case entity.UpsertUpdated:
+			stats.Updated++
+		case entity.UpsertSkipped:
+			stats.Skipped++
+		}
+	}
+	if dryRun {
+		return stats, nil
+	}
+	if err = tx.Commit(); err != nil {
+		return stats, fmt.Errorf("提交事务失败: %w", err)
+	}
+	return stats, nil
+}
+
+// decodeWordsNDJSON reads one dict.v1.Word JSON object per line, as written by export-words.
+func decodeWordsNDJSON(r io.Reader) ([]*entity.Word, error) {
+	dec := json.NewDecoder(r)
+	var words []*entity.Word
+	for line := 1; ; line++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return words, nil
+			}
+			return nil, fmt.Errorf("读取第 %d 条词条失败: %w", line, err)
+		}
+		var pb dictv1.Word
+		if err := protojson.Unmarshal(raw, &pb); err != nil {
+			return nil, fmt.Errorf("第 %d 条词条格式错误: %w", line, err)
+		}
+		words = append(words, mapping.FromPbWord(&pb))
+	}
+}
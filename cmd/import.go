@@ -26,10 +26,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
 	"github.com/eslsoft/vocnet/internal/infrastructure/config"
-	"github.com/eslsoft/vocnet/internal/infrastructure/database"
+	"github.com/eslsoft/vocnet/internal/infrastructure/objectstore"
 	"github.com/eslsoft/vocnet/internal/usecase/backup"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -40,6 +39,8 @@ const (
 	importGzipKey   = "backup.import.gzip"
 	importTablesKey = "backup.import.tables"
 	importBatchKey  = "backup.import.batch_size"
+	importVerifyKey = "backup.import.verify_counts"
+	importMaxRecKey = "backup.import.max_record_bytes"
 )
 
 var importCmd = &cobra.Command{
@@ -53,7 +54,7 @@ var importCmd = &cobra.Command{
 			return fmt.Errorf("加载配置失败: %w", err)
 		}
 
-		entClient, cleanup, err := database.NewEntClient(cfg)
+		entClient, cleanup, err := newEntClient(cfg)
 		if err != nil {
 			return fmt.Errorf("创建 ent 客户端失败: %w", err)
 		}
@@ -67,13 +68,11 @@ var importCmd = &cobra.Command{
 		gzipEnabled := viper.GetBool(importGzipKey)
 		tableList := tablesFromConfig(importTablesKey)
 		batchSize := viper.GetInt(importBatchKey)
+		userID, _ := cmd.Flags().GetInt64("user")
 
 		if inputPath == "" {
 			return fmt.Errorf("请通过 --input 指定备份文件或使用 - 表示标准输入")
 		}
-		if !gzipEnabled && inputPath != "-" && strings.HasSuffix(strings.ToLower(inputPath), ".gz") {
-			gzipEnabled = true
-		}
 
 		driver, err := cfg.DatabaseDriver()
 		if err != nil {
@@ -88,6 +87,7 @@ var importCmd = &cobra.Command{
 			driver,
 			dsn,
 			backup.WithBatchSize(batchSize),
+			backup.WithDeniedTables(cfg.Backup.DenyTables),
 		)
 		if err != nil {
 			return fmt.Errorf("创建备份服务失败: %w", err)
@@ -97,8 +97,23 @@ var importCmd = &cobra.Command{
 			reader  = cmd.InOrStdin()
 			closers []func() error
 		)
+		defer func() {
+			for _, closer := range closers {
+				if cerr := closer(); cerr != nil && err == nil {
+					err = cerr
+				}
+			}
+		}()
 
-		if inputPath != "-" {
+		switch {
+		case objectstore.IsURL(inputPath):
+			body, openErr := openObject(ctx, cfg, inputPath)
+			if openErr != nil {
+				return openErr
+			}
+			reader = body
+			closers = append(closers, body.Close)
+		case inputPath != "-":
 			file, openErr := os.Open(filepath.Clean(inputPath))
 			if openErr != nil {
 				return fmt.Errorf("打开备份文件失败: %w", openErr)
@@ -107,6 +122,8 @@ var importCmd = &cobra.Command{
 			closers = append(closers, file.Close)
 		}
 
+		// --gzip forces gzip; otherwise compression is detected from the leading magic bytes,
+		// so piped backups work without the flag.
 		if gzipEnabled {
 			gzr, gzErr := gzip.NewReader(reader)
 			if gzErr != nil {
@@ -114,22 +131,37 @@ var importCmd = &cobra.Command{
 			}
 			reader = gzr
 			closers = append([]func() error{gzr.Close}, closers...)
-		}
-
-		defer func() {
-			for _, closer := range closers {
-				if cerr := closer(); cerr != nil && err == nil {
-					err = cerr
-				}
+		} else {
+			decoded, closeDecoded, decErr := decompressBackup(reader)
+			if decErr != nil {
+				return decErr
 			}
-		}()
+			reader = decoded
+			closers = append([]func() error{func() error { closeDecoded(); return nil }}, closers...)
+		}
 
-		var importOpts []backup.ImportOption
+		importOpts := []backup.ImportOption{
+			backup.WithCountMismatchHandler(func(m backup.CountMismatch) {
+				cmd.PrintErrf("警告: 行数校验不一致 %s\n", m)
+			}),
+			backup.WithImportWarningHandler(func(w backup.ImportWarning) {
+				cmd.PrintErrf("警告: %s\n", w)
+			}),
+		}
 		if len(tableList) > 0 {
 			importOpts = append(importOpts, backup.WithImportTables(tableList))
 		}
+		if viper.GetBool(importVerifyKey) {
+			importOpts = append(importOpts, backup.WithVerifyCounts())
+		}
+		importOpts = append(importOpts, backup.WithMaxRecordBytes(viper.GetInt(importMaxRecKey)))
 
-		if err := service.Import(ctx, reader, importOpts...); err != nil {
+		if userID > 0 {
+			err = service.ImportUser(ctx, reader, userID, importOpts...)
+		} else {
+			err = service.Import(ctx, reader, importOpts...)
+		}
+		if err != nil {
 			return fmt.Errorf("导入备份失败: %w", err)
 		}
 
@@ -145,10 +177,13 @@ var importCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(importCmd)
 
-	importCmd.Flags().StringP("input", "i", "", "备份文件路径，使用 - 表示标准输入")
-	importCmd.Flags().Bool("gzip", false, "输入为 gzip 压缩格式")
+	importCmd.Flags().StringP("input", "i", "", "备份文件路径，使用 - 表示标准输入，s3://bucket/key 表示从对象存储下载 (BACKUP_S3_*)")
+	importCmd.Flags().Bool("gzip", false, "强制按 gzip 解压输入 (默认根据文件内容自动识别 gzip/zstd)")
 	importCmd.Flags().StringSlice("tables", nil, "仅导入指定表，逗号分隔或重复指定")
 	importCmd.Flags().Int("batch-size", 0, "导入批处理大小 (默认 512)")
+	importCmd.Flags().Bool("verify-counts", false, "导入后行数与备份不一致时返回错误 (默认仅警告)")
+	importCmd.Flags().Int64("user", 0, "将 export --user 导出的单用户备份恢复到该用户 (生成新 ID，保留熟练度与复习计划)")
+	importCmd.Flags().Int("max-record-bytes", 0, "单条备份记录（一行）的最大字节数，超出即中止导入 (默认 16 MiB)")
 
 	bindImportConfig()
 }
@@ -158,4 +193,6 @@ func bindImportConfig() {
 	bindFlagToViper(importGzipKey, importCmd.Flags().Lookup("gzip"))
 	bindFlagToViper(importTablesKey, importCmd.Flags().Lookup("tables"))
 	bindFlagToViper(importBatchKey, importCmd.Flags().Lookup("batch-size"))
+	bindFlagToViper(importVerifyKey, importCmd.Flags().Lookup("verify-counts"))
+	bindFlagToViper(importMaxRecKey, importCmd.Flags().Lookup("max-record-bytes"))
 }
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/usecase/backup"
+)
+
+func TestImportCmdDetectsGzipStdin(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	srcDSN := "file:" + filepath.Join(dir, "src.db") + "?_fk=1"
+	src := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { src.Close() })
+	src.Word.Create().SetText("apple").SetLanguage(entity.LanguageEnglish.Code()).SetWordType(string(entity.WordTypeLemma)).SaveX(ctx)
+
+	svc, err := backup.NewService(dialect.SQLite, srcDSN)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	var archive bytes.Buffer
+	gz := gzip.NewWriter(&archive)
+	if err := svc.Export(ctx, gz); err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip: %v", err)
+	}
+
+	dstPath := filepath.Join(dir, "dst.db")
+	t.Setenv("DATABASE_DSN", "file:"+dstPath)
+	rootCmd.SetArgs([]string{"import", "--input", "-"})
+	rootCmd.SetIn(&archive)
+	rootCmd.SetOut(io.Discard)
+	t.Cleanup(func() {
+		rootCmd.SetArgs(nil)
+		rootCmd.SetIn(nil)
+		rootCmd.SetOut(nil)
+	})
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		t.Fatalf("import without --gzip: %v", err)
+	}
+
+	dst := enttest.Open(t, dialect.SQLite, "file:"+dstPath+"?_fk=1")
+	t.Cleanup(func() { dst.Close() })
+	if n := dst.Word.Query().Where(word.Text("apple")).CountX(ctx); n != 1 {
+		t.Fatalf("imported apple rows = %d, want 1", n)
+	}
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/usecase"
+)
+
+func TestExportImportWordsRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	lemma := "abandon"
+
+	src := enttest.Open(t, dialect.SQLite, "file:export_words_src?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { src.Close() })
+	srcWords := usecase.NewWordUsecase(repository.NewWordRepository(src))
+	for _, w := range []*entity.Word{
+		{Text: "abandon", Categories: []string{"toefl"}, Definitions: []entity.WordDefinition{{Pos: "v.", Text: "放弃", Language: entity.LanguageChinese}}},
+		{Text: "abandoned", WordType: entity.WordTypePast, Lemma: &lemma, Categories: []string{"toefl"}},
+		{Text: "cat", Categories: []string{"zk"}},
+	} {
+		if _, err := srcWords.Create(ctx, w); err != nil {
+			t.Fatalf("seed %s: %v", w.Text, err)
+		}
+	}
+
+	var file bytes.Buffer
+	count, err := exportWords(ctx, repository.NewWordRepository(src), []string{"toefl"}, entity.LanguageEnglish, &file)
+	if err != nil {
+		t.Fatalf("exportWords: %v", err)
+	}
+	if count != 2 || strings.Count(file.String(), "\n") != 2 || strings.Contains(file.String(), `"id"`) {
+		t.Fatalf("unexpected export (%d words): %s", count, file.String())
+	}
+	exported := file.String()
+	load := func() []*entity.Word {
+		t.Helper()
+		words, err := decodeWordsNDJSON(strings.NewReader(exported))
+		if err != nil {
+			t.Fatalf("decodeWordsNDJSON: %v", err)
+		}
+		return words
+	}
+
+	dst := enttest.Open(t, dialect.SQLite, "file:export_words_dst?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { dst.Close() })
+	dstWords := usecase.NewWordUsecase(repository.NewWordRepository(dst))
+
+	// A dry run reports the inserts but writes nothing.
+	stats, err := importWords(ctx, dst, load(), false, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if stats != (importWordsStats{Imported: 2}) {
+		t.Fatalf("dry run stats = %+v", stats)
+	}
+	if n := dst.Word.Query().CountX(ctx); n != 0 {
+		t.Fatalf("dry run wrote %d rows", n)
+	}
+
+	stats, err = importWords(ctx, dst, load(), false, false)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if stats != (importWordsStats{Imported: 2}) {
+		t.Fatalf("import stats = %+v", stats)
+	}
+	got, err := dstWords.Lookup(ctx, "abandon", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup abandon: %v", err)
+	}
+	if len(got.Definitions) != 1 || got.Definitions[0].Text != "放弃" || len(got.Forms) != 1 || got.Forms[0].Text != "abandoned" {
+		t.Fatalf("unexpected imported lemma: %+v", got)
+	}
+	if _, err := dstWords.Lookup(ctx, "cat", entity.LanguageEnglish, entity.LookupPreferLemma); err == nil {
+		t.Fatal("untagged word must not be exported")
+	}
+
+	// Local edits survive a skip re-import and are replaced by an update re-import.
+	got.Definitions = []entity.WordDefinition{{Text: "local edit", Language: entity.LanguageChinese}}
+	if _, err := dstWords.Update(ctx, got, entity.WordFieldDefinitions); err != nil {
+		t.Fatalf("local edit: %v", err)
+	}
+	stats, err = importWords(ctx, dst, load(), false, false)
+	if err != nil {
+		t.Fatalf("skip re-import: %v", err)
+	}
+	if stats != (importWordsStats{Skipped: 2}) {
+		t.Fatalf("skip stats = %+v", stats)
+	}
+	if w, _ := dstWords.Lookup(ctx, "abandon", entity.LanguageEnglish, entity.LookupPreferLemma); w.Definitions[0].Text != "local edit" {
+		t.Fatalf("skip overwrote the local edit: %+v", w.Definitions)
+	}
+	stats, err = importWords(ctx, dst, load(), true, false)
+	if err != nil {
+		t.Fatalf("update re-import: %v", err)
+	}
+	if stats != (importWordsStats{Updated: 2}) {
+		t.Fatalf("update stats = %+v", stats)
+	}
+	if w, _ := dstWords.Lookup(ctx, "abandon", entity.LanguageEnglish, entity.LookupPreferLemma); w.Definitions[0].Text != "放弃" {
+		t.Fatalf("update kept the local edit: %+v", w.Definitions)
+	}
+	if n := dst.Word.Query().CountX(ctx); n != 2 {
+		t.Fatalf("expected 2 rows after re-imports, got %d", n)
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// mergeDupesCmd folds a user's learned lexemes that only differ by case into a single row.
+var mergeDupesCmd = &cobra.Command{
+	Use:   "merge-dupes",
+	Short: "合并用户生词本中仅大小写不同的重复词条",
+	Long:  "按规范化词形与语言分组用户的生词，保留最早创建的记录：熟练度逐项取最大值，查询次数求和，标签/例句/关联词取并集，并在同一事务中删除其余重复记录。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		userID, _ := cmd.Flags().GetInt64("user-id")
+		return mergeDupes(cmd.Context(), userID)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mergeDupesCmd)
+	mergeDupesCmd.Flags().Int64("user-id", 1000, "需要合并重复生词的用户 ID")
+}
+
+func mergeDupes(ctx context.Context, userID int64) error {
+	if userID <= 0 {
+		return fmt.Errorf("无效的用户 ID: %d", userID)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+	entClient, cleanup, err := newEntClient(cfg)
+	if err != nil {
+		return fmt.Errorf("连接目标数据库失败: %w", err)
+	}
+	defer cleanup()
+
+	lexemes := usecase.NewLearnedLexemeUsecase(repository.NewLearnedLexemeRepository(entClient))
+	merged, err := lexemes.MergeDuplicates(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("合并重复生词失败: %w", err)
+	}
+
+	log.Printf("用户 %d: 合并并删除 %d 条重复生词", userID, merged)
+	return nil
+}
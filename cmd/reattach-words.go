@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	"github.com/spf13/cobra"
+)
+
+// reattachWordsCmd repairs learned lexemes whose dictionary link is missing or points at a deleted word.
+var reattachWordsCmd = &cobra.Command{
+	Use:   "reattach-words",
+	Short: "重新关联生词与词典词条，清理失效的关联",
+	Long:  "分批扫描 word_id 为空或指向已删除词条的生词，按规范化词形与语言重新匹配词典词条；仍无法匹配的失效关联将被清空。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("加载配置失败: %w", err)
+		}
+		entClient, cleanup, err := newEntClient(cfg)
+		if err != nil {
+			return fmt.Errorf("连接目标数据库失败: %w", err)
+		}
+		defer cleanup()
+
+		lexemes := usecase.NewLearnedLexemeUsecase(repository.NewLearnedLexemeRepository(entClient))
+		stats, err := lexemes.ReattachDictionaryWords(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("重新关联生词失败: %w", err)
+		}
+		log.Printf("扫描 %d 条未关联生词, 重新关联 %d 条, 清理失效关联 %d 条", stats.Scanned, stats.Linked, stats.Cleared)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reattachWordsCmd)
+}
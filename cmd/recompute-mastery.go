@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
+)
+
+// recomputeMasteryCmd rewrites mastery_overall from the per-skill scores for every learned lexeme.
+var recomputeMasteryCmd = &cobra.Command{
+	Use:   "recompute-mastery",
+	Short: "根据各项熟练度重新计算全部生词的总体熟练度",
+	Long:  "分批扫描 learned_lexemes，使用 MasteryBreakdown.Recompute 由听、读、拼写、发音四项分数重新计算 mastery_overall，每批在独立事务中写入。四项均为 0 的记录保留原值（通常为用户自评等级）。使用 --dry-run 仅统计将要修改的行数。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		batch, _ := cmd.Flags().GetInt("batch")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("加载配置失败: %w", err)
+		}
+		entClient, cleanup, err := newEntClient(cfg)
+		if err != nil {
+			return fmt.Errorf("连接目标数据库失败: %w", err)
+		}
+		defer cleanup()
+
+		scanned, changed, err := recomputeMastery(cmd.Context(), entClient, batch, dryRun)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			log.Printf("扫描 %d 条, 需要更新 %d 条 (dry-run, 未写入)", scanned, changed)
+		} else {
+			log.Printf("扫描 %d 条, 已更新 %d 条", scanned, changed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(recomputeMasteryCmd)
+	recomputeMasteryCmd.Flags().Int("batch", defaultBatchSize, "每个事务处理的行数")
+	recomputeMasteryCmd.Flags().Bool("dry-run", false, "仅统计将要修改的行数，不写入数据库")
+}
+
+// recomputeMastery walks learned lexemes by id and corrects drifted mastery_overall values, one
+// transaction per batch. It returns the number of rows scanned and the number that (would) change.
+func recomputeMastery(ctx context.Context, client *entdb.Client, batchSize int, dryRun bool) (scanned, changed int, err error) {
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	lastID := 0
+	for {
+		rows, err := client.LearnedLexeme.Query().
+			Where(learnedlexeme.IDGT(lastID)).
+			Order(learnedlexeme.ByID()).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return scanned, changed, fmt.Errorf("读取生词失败: %w", err)
+		}
+		if len(rows) == 0 {
+			return scanned, changed, nil
+		}
+		lastID = rows[len(rows)-1].ID
+		scanned += len(rows)
+
+		updates := make(map[int]int32)
+		for _, row := range rows {
+			mastery := entity.MasteryBreakdown{
+				Listen:    int32(row.MasteryListen),
+				Read:      int32(row.MasteryRead),
+				Spell:     int32(row.MasterySpell),
+				Pronounce: int32(row.MasteryPronounce),
+			}
+			if !mastery.HasComponents() {
+				continue
+			}
+			mastery.Recompute()
+			if mastery.Overall != row.MasteryOverall {
+				updates[row.ID] = mastery.Overall
+			}
+		}
+		changed += len(updates)
+		if dryRun || len(updates) == 0 {
+			continue
+		}
+		if err := applyMasteryUpdates(ctx, client, updates); err != nil {
+			return scanned, changed, err
+		}
+	}
+}
+
+func applyMasteryUpdates(ctx context.Context, client *entdb.Client, updates map[int]int32) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("开启事务失败: %w", err)
+	}
+	for id, overall := range updates {
+		if err := tx.LearnedLexeme.UpdateOneID(id).SetMasteryOverall(overall).Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("更新生词 %d 失败: %w", id, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("提交事务失败: %w", err)
+	}
+	return nil
+}
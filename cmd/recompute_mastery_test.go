@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+)
+
+func TestRecomputeMastery(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:recompute_mastery?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	seed := []struct {
+		term                           string
+		listen, read, spell, pronounce int16
+		overall                        int32
+		want                           int32
+	}{
+		{term: "drifted", listen: 4, read: 4, spell: 2, pronounce: 2, overall: 150, want: 300},
+		{term: "correct", listen: 5, read: 5, spell: 5, pronounce: 5, overall: 500, want: 500},
+		{term: "clamped", listen: 9, read: 5, spell: 5, pronounce: 5, overall: 0, want: 500},
+		{term: "self-assessed", overall: 3, want: 3},
+	}
+	ids := make([]int, len(seed))
+	for i, s := range seed {
+		row, err := client.LearnedLexeme.Create().
+			SetUserID(1).
+			SetTerm(s.term).
+			SetMasteryListen(s.listen).
+			SetMasteryRead(s.read).
+			SetMasterySpell(s.spell).
+			SetMasteryPronounce(s.pronounce).
+			SetMasteryOverall(s.overall).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("seed %s: %v", s.term, err)
+		}
+		ids[i] = row.ID
+	}
+
+	scanned, changed, err := recomputeMastery(ctx, client, 2, true)
+	if err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if scanned != 4 || changed != 2 {
+		t.Fatalf("dry run scanned %d changed %d, want 4 and 2", scanned, changed)
+	}
+	if row := client.LearnedLexeme.GetX(ctx, ids[0]); row.MasteryOverall != 150 {
+		t.Fatalf("dry run wrote mastery_overall = %d", row.MasteryOverall)
+	}
+
+	if _, changed, err = recomputeMastery(ctx, client, 2, false); err != nil || changed != 2 {
+		t.Fatalf("recompute: changed %d, err %v", changed, err)
+	}
+	for i, s := range seed {
+		if got := client.LearnedLexeme.GetX(ctx, ids[i]).MasteryOverall; got != s.want {
+			t.Errorf("%s: mastery_overall = %d, want %d", s.term, got, s.want)
+		}
+	}
+
+	if _, changed, err = recomputeMastery(ctx, client, 2, false); err != nil || changed != 0 {
+		t.Fatalf("second run: changed %d, err %v", changed, err)
+	}
+}
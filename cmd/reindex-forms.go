@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/usecase/inflection"
+	"github.com/spf13/cobra"
+)
+
+// reindexFormsCmd re-derives word_type/lemma links for existing words from ECDICT exchange data.
+var reindexFormsCmd = &cobra.Command{
+	Use:   "reindex-forms",
+	Short: "根据 ECDICT 变形数据重建词形与原形的关联",
+	Long:  "扫描指定语言的全部词条，使用 ECDICT exchange 字段重新推导 word_type 与 lemma，并分批更新。使用 --dry-run 仅输出将要修改的内容。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		url, _ := cmd.Flags().GetString("url")
+		cacheDir, _ := cmd.Flags().GetString("cache-dir")
+		checksum, _ := cmd.Flags().GetString("sha256")
+		language, _ := cmd.Flags().GetString("language")
+		batch, _ := cmd.Flags().GetInt("batch")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		return reindexForms(cmd.Context(), url, cacheDir, checksum, entity.ParseLanguage(language), batch, dryRun)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(reindexFormsCmd)
+	reindexFormsCmd.Flags().String("url", ecDictURL, "ECDICT 下载地址")
+	reindexFormsCmd.Flags().String("cache-dir", "", "ECDICT 缓存目录 (默认: 用户缓存目录/vocnet)")
+	reindexFormsCmd.Flags().String("sha256", "", "ECDICT 压缩包的期望 SHA-256")
+	reindexFormsCmd.Flags().String("language", string(entity.LanguageEnglish), "需要重建的语言代码 (ECDICT 变形数据仅支持 en)")
+	reindexFormsCmd.Flags().Int("batch", defaultBatchSize, "批量更新大小")
+	reindexFormsCmd.Flags().Bool("dry-run", false, "仅打印将要修改的词条，不写入数据库")
+}
+
+// formChange is a pending word_type/lemma correction for a single row.
+type formChange struct {
+	ID       int
+	Text     string
+	WordType entity.WordType
+	Lemma    *string
+}
+
+func reindexForms(ctx context.Context, url, cacheDirFlag, checksum string, language entity.Language, batchSize int, dryRun bool) error {
+	if language == entity.LanguageUnspecified {
+		return fmt.Errorf("不支持的语言代码")
+	}
+	// ECDICT exchange data describes English inflections only.
+	if language != entity.LanguageEnglish {
+		return fmt.Errorf("ECDICT 变形数据仅适用于英语, 不支持语言 %s", language.Code())
+	}
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("加载配置失败: %w", err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "ecdict-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	sqlitePath, err := fetchECDICT(ctx, url, cacheDirFlag, false, checksum, tmpDir)
+	if err != nil {
+		return err
+	}
+	entries, err := loadExchangeEntries(ctx, sqlitePath)
+	if err != nil {
+		return err
+	}
+	rels := inflection.BuildMap(entries)
+
+	entClient, cleanup, err := newEntClient(cfg)
+	if err != nil {
+		return fmt.Errorf("连接目标数据库失败: %w", err)
+	}
+	defer cleanup()
+
+	scanned, changed := 0, 0
+	lastID := 0
+	for {
+		rows, err := entClient.Word.Query().
+			Where(word.LanguageEQ(language.Code()), word.IDGT(lastID)).
+			Order(word.ByID()).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("读取词条失败: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		lastID = rows[len(rows)-1].ID
+		scanned += len(rows)
+
+		changes := diffForms(rows, rels)
+		changed += len(changes)
+		for _, c := range changes {
+			if dryRun {
+				log.Printf("[dry-run] %s: word_type=%s lemma=%s", c.Text, c.WordType, displayLemma(c.Lemma))
+			}
+		}
+		if !dryRun {
+			if err := applyFormChanges(ctx, entClient, language, changes); err != nil {
+				return err
+			}
+		}
+	}
+
+	if dryRun {
+		log.Printf("扫描 %d 条, 需要更新 %d 条 (dry-run, 未写入)", scanned, changed)
+	} else {
+		log.Printf("扫描 %d 条, 已更新 %d 条", scanned, changed)
+	}
+	return nil
+}
+
+// loadExchangeEntries reads the word/exchange columns from the extracted ECDICT database.
+func loadExchangeEntries(ctx context.Context, sqlitePath string) ([]inflection.Entry, error) {
+	sqldb, err := sql.Open("sqlite3", sqlitePath)
+	if err != nil {
+		return nil, err
+	}
+	defer sqldb.Close()
+
+	rows, err := sqldb.QueryContext(ctx, `SELECT word, exchange FROM stardict WHERE exchange IS NOT NULL AND exchange <> ''`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []inflection.Entry
+	for rows.Next() {
+		var (
+			w        string
+			exchange sql.NullString
+		)
+		if err := rows.Scan(&w, &exchange); err != nil {
+			return nil, err
+		}
+		w = strings.TrimSpace(w)
+		if w == "" || !isSingleWordFor(entity.LanguageEnglish, w) {
+			continue
+		}
+		entries = append(entries, inflection.Entry{Word: w, Exchange: nullStringVal(exchange)})
+	}
+	return entries, rows.Err()
+}
+
+// diffForms returns rows whose stored word_type/lemma differ from the derived relationship.
+// Only words that ECDICT lists as inflections are touched, and never manually curated ones.
+func diffForms(rows []*entdb.Word, rels map[string]inflection.Relation) []formChange {
+	var changes []formChange
+	for _, row := range rows {
+		if _, ok := rels[strings.ToLower(row.Text)]; !ok || row.Source == string(entity.WordSourceManual) {
+			continue
+		}
+		wordType, lemma := inflection.Resolve(row.Text, rels)
+		if wordType == entity.WordType(row.WordType) && equalLemma(lemma, row.Lemma) {
+			continue
+		}
+		changes = append(changes, formChange{ID: row.ID, Text: row.Text, WordType: wordType, Lemma: lemma})
+	}
+	return changes
+}
+
+func applyFormChanges(ctx context.Context, client *entdb.Client, language entity.Language, changes []formChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	builders := make([]*entdb.WordCreate, 0, len(changes))
+	for _, c := range changes {
+		builders = append(builders, client.Word.Create().
+			SetText(c.Text).
+			SetLanguage(language.Code()).
+			SetWordType(string(c.WordType)).
+			SetNillableLemma(c.Lemma))
+	}
+	// Rows curated by hand since the scan are left alone, like db-init re-imports do.
+	err := client.Word.CreateBulk(builders...).
+		OnConflict(
+			entsql.ConflictColumns(word.FieldLanguage, word.FieldText),
+			entsql.UpdateWhere(entsql.NEQ(word.FieldSource, string(entity.WordSourceManual))),
+		).
+		Update(func(u *entdb.WordUpsert) {
+			u.UpdateWordType()
+			u.UpdateLemma()
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("更新词形关联失败: %w", err)
+	}
+	return nil
+}
+
+func equalLemma(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func displayLemma(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
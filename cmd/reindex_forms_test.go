@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/usecase/inflection"
+)
+
+func Test_reindexFormsRejectsNonEnglish(t *testing.T) {
+	err := reindexForms(context.Background(), "", "", "", entity.LanguageGerman, 0, true)
+	if err == nil || !strings.Contains(err.Error(), "仅适用于英语") {
+		t.Fatalf("expected non-English language to be rejected, got %v", err)
+	}
+}
+
+func Test_reindexFormsKeepsManualRows(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "reindex.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	for text, source := range map[string]entity.WordSource{"went": entity.WordSourceECDICT, "ran": entity.WordSourceManual} {
+		client.Word.Create().SetText(text).SetLanguage("en").SetSource(string(source)).ExecX(ctx)
+	}
+	rels := inflection.BuildMap([]inflection.Entry{
+		{Word: "go", Exchange: "p:went"},
+		{Word: "run", Exchange: "p:ran"},
+	})
+
+	rows := client.Word.Query().Order(word.ByID()).AllX(ctx)
+	changes := diffForms(rows, rels)
+	if len(changes) != 1 || changes[0].Text != "went" {
+		t.Fatalf("changes = %+v, want only went", changes)
+	}
+	// A row curated after the scan is protected by the upsert itself.
+	run := "run"
+	changes = append(changes, formChange{Text: "ran", WordType: entity.WordTypePast, Lemma: &run})
+	if err := applyFormChanges(ctx, client, entity.LanguageEnglish, changes); err != nil {
+		t.Fatalf("applyFormChanges: %v", err)
+	}
+
+	went := client.Word.Query().Where(word.TextEQ("went")).OnlyX(ctx)
+	if went.WordType != string(entity.WordTypePast) || went.Lemma == nil || *went.Lemma != "go" {
+		t.Fatalf("went = %s/%v, want past of go", went.WordType, went.Lemma)
+	}
+	ran := client.Word.Query().Where(word.TextEQ("ran")).OnlyX(ctx)
+	if ran.WordType != string(entity.WordTypeLemma) || ran.Lemma != nil {
+		t.Fatalf("manual ran was rewritten to %s/%v", ran.WordType, ran.Lemma)
+	}
+}
@@ -27,9 +27,14 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
 )
 
-var cfgFile string
+var (
+	cfgFile string
+	appEnv  string
+)
 
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
@@ -63,6 +68,7 @@ func init() {
 	// will be global for your application.
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.vocnet.yaml)")
+	rootCmd.PersistentFlags().StringVar(&appEnv, "env", "", "configuration profile layered over .env from config/{env}.env (overrides $APP_ENV)")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -71,6 +77,10 @@ func init() {
 
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
+	if appEnv != "" {
+		cobra.CheckErr(os.Setenv(config.ProfileEnv, appEnv))
+	}
+
 	if cfgFile != "" {
 		// Use config file from the flag.
 		viper.SetConfigFile(cfgFile)
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/eslsoft/vocnet/internal/adapter/mapping"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+)
+
+// loadSeedFile reads a JSON or YAML list of words. Entries use the API field names of dict.v1.Word
+// (text, language, word_type, lemma, definitions, ...), so a ListWords response can be reused as seed.
+func loadSeedFile(path string) ([]*entity.Word, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取种子文件失败: %w", err)
+	}
+
+	var items []json.RawMessage
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		var doc []any
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, fmt.Errorf("解析 YAML 种子文件失败: %w", err)
+		}
+		for i, item := range doc {
+			encoded, err := json.Marshal(item)
+			if err != nil {
+				return nil, fmt.Errorf("种子第 %d 条无法转换为 JSON: %w", i+1, err)
+			}
+			items = append(items, encoded)
+		}
+	default:
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, fmt.Errorf("解析 JSON 种子文件失败: %w", err)
+		}
+	}
+
+	words := make([]*entity.Word, 0, len(items))
+	for i, item := range items {
+		var pb dictv1.Word
+		if err := protojson.Unmarshal(item, &pb); err != nil {
+			return nil, fmt.Errorf("种子第 %d 条格式错误: %w", i+1, err)
+		}
+		words = append(words, mapping.FromPbWord(&pb))
+	}
+	return words, nil
+}
+
+// seedWords creates words through the usecase so normalization and validation apply.
+// Lemmas are inserted before the forms that reference them; entries that already exist are skipped.
+func seedWords(ctx context.Context, uc usecase.WordUsecase, words []*entity.Word) (created, skipped int, err error) {
+	ordered := append([]*entity.Word(nil), words...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return isSeedLemma(ordered[i]) && !isSeedLemma(ordered[j])
+	})
+
+	for _, word := range ordered {
+		word.ID = 0
+		if _, err := uc.Create(ctx, word); err != nil {
+			if errors.Is(err, entity.ErrDuplicateWord) {
+				skipped++
+				continue
+			}
+			return created, skipped, fmt.Errorf("写入种子词 %q 失败: %w", word.Text, err)
+		}
+		created++
+	}
+	return created, skipped, nil
+}
+
+func isSeedLemma(w *entity.Word) bool {
+	return w.WordType == "" || w.WordType == entity.WordTypeLemma
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/usecase"
+)
+
+func TestSeedFileLoadsWords(t *testing.T) {
+	seeds := map[string]string{
+		"seed.json": `[
+  {"text": "ran", "language": "LANGUAGE_ENGLISH", "word_type": "past", "lemma": "run"},
+  {"text": "run", "language": "LANGUAGE_ENGLISH", "definitions": [{"pos": "v.", "text": "跑", "language": "LANGUAGE_CHINESE"}]}
+]`,
+		"seed.yaml": `
+- text: ran
+  language: LANGUAGE_ENGLISH
+  word_type: past
+  lemma: run
+- text: run
+  language: LANGUAGE_ENGLISH
+  definitions:
+    - pos: v.
+      text: 跑
+      language: LANGUAGE_CHINESE
+`,
+	}
+
+	for name, content := range seeds {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			path := filepath.Join(t.TempDir(), name)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatalf("write seed: %v", err)
+			}
+
+			client := enttest.Open(t, dialect.SQLite, "file:"+name+"?mode=memory&cache=shared&_fk=1")
+			t.Cleanup(func() { client.Close() })
+			uc := usecase.NewWordUsecase(repository.NewWordRepository(client))
+
+			words, err := loadSeedFile(path)
+			if err != nil {
+				t.Fatalf("loadSeedFile: %v", err)
+			}
+			created, skipped, err := seedWords(ctx, uc, words)
+			if err != nil {
+				t.Fatalf("seedWords: %v", err)
+			}
+			if created != 2 || skipped != 0 {
+				t.Fatalf("created=%d skipped=%d, want 2/0", created, skipped)
+			}
+
+			run, err := uc.Lookup(ctx, "run", entity.LanguageEnglish, entity.LookupPreferLemma)
+			if err != nil {
+				t.Fatalf("lookup run: %v", err)
+			}
+			if run.WordType != entity.WordTypeLemma || len(run.Definitions) != 1 || run.Definitions[0].Text != "跑" {
+				t.Fatalf("unexpected lemma: %+v", run)
+			}
+			ran, err := uc.Lookup(ctx, "ran", entity.LanguageEnglish, entity.LookupPreferExact)
+			if err != nil {
+				t.Fatalf("lookup ran: %v", err)
+			}
+			if ran.WordType != entity.WordTypePast || ran.Lemma == nil || *ran.Lemma != "run" {
+				t.Fatalf("unexpected form: %+v", ran)
+			}
+
+			// Seeding again is a no-op.
+			words, err = loadSeedFile(path)
+			if err != nil {
+				t.Fatalf("reload seed: %v", err)
+			}
+			created, skipped, err = seedWords(ctx, uc, words)
+			if err != nil {
+				t.Fatalf("reseed: %v", err)
+			}
+			if created != 0 || skipped != 2 {
+				t.Fatalf("reseed created=%d skipped=%d, want 0/2", created, skipped)
+			}
+		})
+	}
+}
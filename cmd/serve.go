@@ -26,12 +26,14 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/eslsoft/vocnet/internal/app"
+	"github.com/eslsoft/vocnet/internal/entity"
 )
 
 // serveCmd represents the serve command
@@ -39,7 +41,10 @@ var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Start gRPC + HTTP gateway server",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		container, cleanup, err := app.Initialize()
+		// Allow SIGINT/SIGTERM to abort while waiting for the database at startup.
+		initCtx, stopInit := signal.NotifyContext(cmd.Context(), syscall.SIGINT, syscall.SIGTERM)
+		container, cleanup, err := app.Initialize(initCtx)
+		stopInit()
 		if err != nil {
 			return fmt.Errorf("init container: %w", err)
 		}
@@ -47,6 +52,14 @@ var serveCmd = &cobra.Command{
 
 		logger := container.Logger
 
+		if err := seedOnStart(cmd, container); err != nil {
+			return err
+		}
+
+		jobCtx, stopJobs := context.WithCancel(cmd.Context())
+		defer stopJobs()
+		go refreshDueCounts(jobCtx, container)
+
 		// Build server
 		srv := container.Server
 
@@ -74,8 +87,59 @@ var serveCmd = &cobra.Command{
 	},
 }
 
+// seedOnStart loads --seed-file and --seed-word entries before the server starts accepting traffic.
+func seedOnStart(cmd *cobra.Command, container *app.Container) error {
+	seedFile, _ := cmd.Flags().GetString("seed-file")
+	seedWord, _ := cmd.Flags().GetString("seed-word")
+
+	var words []*entity.Word
+	if seedFile != "" {
+		loaded, err := loadSeedFile(seedFile)
+		if err != nil {
+			return err
+		}
+		words = append(words, loaded...)
+	}
+	if text := strings.TrimSpace(seedWord); text != "" {
+		words = append(words, &entity.Word{Text: text, Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma})
+	}
+	if len(words) == 0 {
+		return nil
+	}
+
+	created, skipped, err := seedWords(cmd.Context(), container.WordUsecase, words)
+	if err != nil {
+		return fmt.Errorf("seed words: %w", err)
+	}
+	container.Logger.Infof("seeded words: created=%d skipped=%d", created, skipped)
+	return nil
+}
+
+// refreshDueCounts recomputes the cached due review counts of polling users every cache interval
+// until ctx ends, so their "due today" badges keep hitting the cache.
+func refreshDueCounts(ctx context.Context, container *app.Container) {
+	interval := container.Config.Learning.DueCountCacheInterval
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := container.LearnedLexemeUsecase.RefreshDueCounts(ctx); err != nil {
+				container.Logger.WithError(err).Warn("refresh due counts")
+			}
+		}
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().String("seed-file", "", "启动前导入的种子词条文件（JSON/YAML，字段同 dict.v1.Word），已存在的词条会跳过")
+	serveCmd.Flags().String("seed-word", "", "启动前写入的单个英文原形词（已存在则跳过）")
 
 	// Here you will define your flags and configuration settings.
 
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/spf13/cobra"
+
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
+)
+
+// statsCmd prints row counts and the database size without touching the schema.
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "查看词条、生词数量与数据库大小",
+	Long:  "按语言统计词条数，按每个用户的生词数量分档统计用户数与生词数，并显示数据库占用空间。仅执行只读聚合查询，不会迁移或修改数据库。",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := config.Load()
+		if err != nil {
+			return fmt.Errorf("加载配置失败: %w", err)
+		}
+		driver, err := cfg.DatabaseDriver()
+		if err != nil {
+			return fmt.Errorf("确定数据库驱动失败: %w", err)
+		}
+		dsn, err := cfg.DatabaseURL()
+		if err != nil {
+			return fmt.Errorf("确定数据库连接失败: %w", err)
+		}
+		drv, err := entsql.Open(driver, dsn)
+		if err != nil {
+			return fmt.Errorf("连接数据库失败: %w", err)
+		}
+		client := entdb.NewClient(entdb.Driver(drv))
+		defer client.Close()
+
+		stats, err := collectStats(cmd.Context(), client, drv)
+		if err != nil {
+			return err
+		}
+		return printStats(cmd.OutOrStdout(), stats)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+}
+
+// lexemeBuckets group users by how many learned lexemes they have; each bucket holds users with
+// at least min lexemes and fewer than the next bucket's min.
+var lexemeBuckets = []struct {
+	label string
+	min   int64
+}{
+	{"1-9", 1},
+	{"10-99", 10},
+	{"100-999", 100},
+	{"1000+", 1000},
+}
+
+type lexemeBucket struct {
+	Label   string
+	Users   int64
+	Lexemes int64
+}
+
+type dbStats struct {
+	Languages []entity.LanguageCount
+	Buckets   []lexemeBucket
+	// SizeBytes is the on-disk size of the database, or -1 when the driver cannot report it.
+	SizeBytes int64
+}
+
+// collectStats runs the aggregate queries behind the stats command.
+func collectStats(ctx context.Context, client *entdb.Client, drv dialect.Driver) (dbStats, error) {
+	var stats dbStats
+	languages, err := repository.NewWordRepository(client).LanguageStats(ctx)
+	if err != nil {
+		return stats, fmt.Errorf("统计词条失败: %w", err)
+	}
+	stats.Languages = languages
+
+	var perUser []struct {
+		UserID int64 `json:"user_id"`
+		Count  int64 `json:"count"`
+	}
+	err = client.LearnedLexeme.Query().
+		GroupBy(learnedlexeme.FieldUserID).
+		Aggregate(entdb.Count()).
+		Scan(ctx, &perUser)
+	if err != nil {
+		return stats, fmt.Errorf("统计生词失败: %w", err)
+	}
+	stats.Buckets = make([]lexemeBucket, len(lexemeBuckets))
+	for i, b := range lexemeBuckets {
+		stats.Buckets[i].Label = b.label
+	}
+	for _, row := range perUser {
+		for i := len(lexemeBuckets) - 1; i >= 0; i-- {
+			if row.Count >= lexemeBuckets[i].min {
+				stats.Buckets[i].Users++
+				stats.Buckets[i].Lexemes += row.Count
+				break
+			}
+		}
+	}
+
+	if stats.SizeBytes, err = databaseSize(ctx, drv); err != nil {
+		return stats, fmt.Errorf("读取数据库大小失败: %w", err)
+	}
+	return stats, nil
+}
+
+// databaseSize asks the database for its allocated size in bytes.
+func databaseSize(ctx context.Context, drv dialect.Driver) (int64, error) {
+	var query string
+	switch drv.Dialect() {
+	case dialect.SQLite:
+		query = "SELECT page_count * page_size FROM pragma_page_count(), pragma_page_size()"
+	case dialect.Postgres:
+		query = "SELECT pg_database_size(current_database())"
+	default:
+		return -1, nil
+	}
+	var rows entsql.Rows
+	if err := drv.Query(ctx, query, []any{}, &rows); err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	var size int64
+	if rows.Next() {
+		if err := rows.Scan(&size); err != nil {
+			return 0, err
+		}
+	}
+	return size, rows.Err()
+}
+
+func printStats(w io.Writer, stats dbStats) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	// English labels like backup-info; tabwriter cannot align double-width CJK text.
+	fmt.Fprintln(tw, "LANGUAGE\tWORDS")
+	var words int64
+	for _, lc := range stats.Languages {
+		words += lc.Count
+		fmt.Fprintf(tw, "%s\t%d\n", lc.Language.Code(), lc.Count)
+	}
+	fmt.Fprintf(tw, "total\t%d\n", words)
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "LEXEMES PER USER\tUSERS\tLEXEMES")
+	var users, lexemes int64
+	for _, b := range stats.Buckets {
+		users += b.Users
+		lexemes += b.Lexemes
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", b.Label, b.Users, b.Lexemes)
+	}
+	fmt.Fprintf(tw, "total\t%d\t%d\n", users, lexemes)
+
+	fmt.Fprintln(tw)
+	if stats.SizeBytes >= 0 {
+		fmt.Fprintf(tw, "db_size\t%s\n", formatBytes(stats.SizeBytes))
+	} else {
+		fmt.Fprintln(tw, "db_size\tunknown")
+	}
+	return tw.Flush()
+}
+
+// formatBytes renders n with a binary unit, e.g. 1536 as "1.5 KiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+)
+
+func TestStats(t *testing.T) {
+	ctx := context.Background()
+	drv, err := entsql.Open(dialect.SQLite, "file:stats?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	client := enttest.NewClient(t, enttest.WithOptions(entdb.Driver(drv)))
+	t.Cleanup(func() { client.Close() })
+
+	for _, w := range []struct {
+		text     string
+		language entity.Language
+	}{
+		{"apple", entity.LanguageEnglish},
+		{"pear", entity.LanguageEnglish},
+		{"plum", entity.LanguageEnglish},
+		{"pomme", entity.LanguageFrench},
+	} {
+		client.Word.Create().SetText(w.text).SetLanguage(w.language.Code()).SetWordType(string(entity.WordTypeLemma)).SaveX(ctx)
+	}
+	// User 1 has 3 lexemes, user 2 has 12.
+	for userID, n := range map[int64]int{1: 3, 2: 12} {
+		for i := range n {
+			client.LearnedLexeme.Create().SetUserID(userID).SetTerm(strings.Repeat("a", i+1)).SaveX(ctx)
+		}
+	}
+
+	stats, err := collectStats(ctx, client, drv)
+	if err != nil {
+		t.Fatalf("collect stats: %v", err)
+	}
+	if stats.SizeBytes <= 0 {
+		t.Fatalf("db size = %d, want a positive size", stats.SizeBytes)
+	}
+	var out strings.Builder
+	if err := printStats(&out, stats); err != nil {
+		t.Fatalf("print stats: %v", err)
+	}
+	fields := make(map[string][]string)
+	section := ""
+	for _, line := range strings.Split(out.String(), "\n") {
+		f := strings.Fields(line)
+		switch {
+		case len(f) == 0:
+		case f[0] == "LANGUAGE" || f[0] == "LEXEMES":
+			section = f[0]
+		default:
+			fields[section+" "+f[0]] = f[1:]
+		}
+	}
+	for key, want := range map[string]string{
+		"LANGUAGE en":     "3",
+		"LANGUAGE fr":     "1",
+		"LANGUAGE total":  "4",
+		"LEXEMES 1-9":     "1 3",
+		"LEXEMES 10-99":   "1 12",
+		"LEXEMES 100-999": "0 0",
+		"LEXEMES total":   "2 15",
+		"LEXEMES db_size": "",
+	} {
+		got, ok := fields[key]
+		if !ok || (want != "" && strings.Join(got, " ") != want) {
+			t.Errorf("%s = %v, want %q\n%s", key, got, want, out.String())
+		}
+	}
+}
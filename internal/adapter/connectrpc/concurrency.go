@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/sync/semaphore"
+)
+
+// ConcurrencyInterceptor bounds how many handlers, and so how much database work, run at once.
+// A call waits up to wait for a free slot and then fails with ResourceExhausted; a zero or
+// negative wait fails at once when the server is saturated. Streaming calls hold their slot
+// until the stream ends. A zero or negative limit disables the bound.
+func ConcurrencyInterceptor(limit int64, wait time.Duration) connect.Interceptor {
+	c := concurrencyInterceptor{wait: wait}
+	if limit > 0 {
+		c.sem = semaphore.NewWeighted(limit)
+	}
+	return c
+}
+
+type concurrencyInterceptor struct {
+	sem  *semaphore.Weighted
+	wait time.Duration
+}
+
+func (c concurrencyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if c.sem == nil || req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		if err := c.acquire(ctx, req.Spec().Procedure); err != nil {
+			return nil, err
+		}
+		defer c.sem.Release(1)
+		return next(ctx, req)
+	}
+}
+
+func (concurrencyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (c concurrencyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if c.sem == nil {
+			return next(ctx, conn)
+		}
+		if err := c.acquire(ctx, conn.Spec().Procedure); err != nil {
+			return err
+		}
+		defer c.sem.Release(1)
+		return next(ctx, conn)
+	}
+}
+
+func (c concurrencyInterceptor) acquire(ctx context.Context, procedure string) error {
+	if c.wait <= 0 {
+		if c.sem.TryAcquire(1) {
+			return nil
+		}
+		return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("%s: server is at its concurrent request limit", procedure))
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, c.wait)
+	defer cancel()
+	if err := c.sem.Acquire(waitCtx, 1); err != nil {
+		// The caller's own cancellation or deadline is reported as such, not as saturation.
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			return connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("%s: no free request slot within %s", procedure, c.wait))
+		}
+		return err
+	}
+	return nil
+}
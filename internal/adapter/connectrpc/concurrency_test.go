@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
+)
+
+// blockingWordUsecase holds every Lookup until release is closed, counting calls in flight.
+type blockingWordUsecase struct {
+	usecase.WordUsecase
+	release  chan struct{}
+	started  chan struct{}
+	inFlight atomic.Int32
+	peak     atomic.Int32
+}
+
+func (b *blockingWordUsecase) Lookup(ctx context.Context, lemma string, language entity.Language, _ entity.LookupPreference, _ ...int32) (*entity.Word, error) {
+	n := b.inFlight.Add(1)
+	defer b.inFlight.Add(-1)
+	for {
+		peak := b.peak.Load()
+		if n <= peak || b.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	b.started <- struct{}{}
+	select {
+	case <-b.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &entity.Word{ID: 1, Text: lemma, Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma}, nil
+}
+
+func TestConcurrencyInterceptor(t *testing.T) {
+	newClient := func(uc usecase.WordUsecase, limit int64, wait time.Duration) dictv1connect.WordServiceClient {
+		mux := http.NewServeMux()
+		mux.Handle(dictv1connect.NewWordServiceHandler(
+			NewWordServiceServer(uc),
+			connect.WithInterceptors(ConcurrencyInterceptor(limit, wait), ErrorInterceptor()),
+		))
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+		return dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+	}
+	lookup := func(rpc dictv1connect.WordServiceClient) error {
+		_, err := rpc.LookupWord(context.Background(), connect.NewRequest(&dictv1.LookupWordRequest{Word: "busy"}))
+		return err
+	}
+
+	for _, wait := range []time.Duration{0, 20 * time.Millisecond} {
+		uc := &blockingWordUsecase{release: make(chan struct{}), started: make(chan struct{}, 8)}
+		rpc := newClient(uc, 2, wait)
+
+		done := make(chan error, 2)
+		for i := 0; i < 2; i++ {
+			go func() { done <- lookup(rpc) }()
+		}
+		<-uc.started
+		<-uc.started
+
+		if code := connect.CodeOf(lookup(rpc)); code != connect.CodeResourceExhausted {
+			t.Fatalf("wait %s: code = %v, want ResourceExhausted while saturated", wait, code)
+		}
+
+		close(uc.release)
+		for i := 0; i < 2; i++ {
+			if err := <-done; err != nil {
+				t.Fatalf("wait %s: blocked call failed: %v", wait, err)
+			}
+		}
+		if err := lookup(rpc); err != nil {
+			t.Fatalf("wait %s: call after release failed: %v", wait, err)
+		}
+		if peak := uc.peak.Load(); peak != 2 {
+			t.Fatalf("wait %s: peak concurrency = %d, want 2", wait, peak)
+		}
+	}
+}
+
+func TestConcurrencyInterceptorWaitsForSlot(t *testing.T) {
+	uc := &blockingWordUsecase{release: make(chan struct{}), started: make(chan struct{}, 8)}
+	mux := http.NewServeMux()
+	mux.Handle(dictv1connect.NewWordServiceHandler(
+		NewWordServiceServer(uc),
+		connect.WithInterceptors(ConcurrencyInterceptor(1, time.Second), ErrorInterceptor()),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	rpc := dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+
+	done := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := rpc.LookupWord(context.Background(), connect.NewRequest(&dictv1.LookupWordRequest{Word: "queued"}))
+			done <- err
+		}()
+	}
+	<-uc.started
+	select {
+	case <-uc.started:
+		t.Fatal("second call ran while the only slot was held")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(uc.release)
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Fatalf("queued call failed: %v", err)
+		}
+	}
+	if peak := uc.peak.Load(); peak != 1 {
+		t.Fatalf("peak concurrency = %d, want 1", peak)
+	}
+}
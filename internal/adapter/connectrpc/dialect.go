@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+// DialectHeader names the phonetic dialect the client prefers, e.g. "en-GB" or an alias like "uk".
+const DialectHeader = "X-Vocnet-Dialect"
+
+// DialectInterceptor stores the dialect from X-Vocnet-Dialect on the request context, where the
+// word usecase picks it up through entity.DialectFromContext. Unknown dialects are ignored.
+func DialectInterceptor() connect.Interceptor {
+	return dialectInterceptor{}
+}
+
+type dialectInterceptor struct{}
+
+func (dialectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		return next(withRequestDialect(ctx, req.Header()), req)
+	}
+}
+
+func (dialectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (dialectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(withRequestDialect(ctx, conn.RequestHeader()), conn)
+	}
+}
+
+func withRequestDialect(ctx context.Context, header http.Header) context.Context {
+	dialect, err := entity.ParseDialect(header.Get(DialectHeader))
+	if err != nil || dialect == "" {
+		return ctx
+	}
+	return entity.WithDialect(ctx, dialect)
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+// EditorHeader names the caller credited in the word audit trail and as created_by on collected
+// lexemes. The gateway in front of the service is expected to set it from the authenticated
+// principal and strip client-supplied values.
+const EditorHeader = "X-Vocnet-Editor"
+
+// maxEditorLength bounds what an audit row stores for the editor.
+const maxEditorLength = 128
+
+// EditorInterceptor stores the X-Vocnet-Editor identity on the request context, where the word
+// usecase picks it up through entity.EditorFromContext.
+func EditorInterceptor() connect.Interceptor {
+	return editorInterceptor{}
+}
+
+type editorInterceptor struct{}
+
+func (editorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		return next(withRequestEditor(ctx, req.Header()), req)
+	}
+}
+
+func (editorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (editorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(withRequestEditor(ctx, conn.RequestHeader()), conn)
+	}
+}
+
+func withRequestEditor(ctx context.Context, header http.Header) context.Context {
+	editor := strings.TrimSpace(header.Get(EditorHeader))
+	if editor == "" || entity.CheckText(editor, maxEditorLength) != nil {
+		return ctx
+	}
+	return entity.WithEditor(ctx, editor)
+}
@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/adapter/mapping"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorInterceptor converts domain errors and gRPC status errors returned by handlers into
+// connect errors so clients receive the intended code instead of CodeUnknown.
+func ErrorInterceptor() connect.Interceptor {
+	return errorInterceptor{}
+}
+
+type errorInterceptor struct{}
+
+func (errorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		resp, err := next(ctx, req)
+		if req.Spec().IsClient {
+			return resp, err
+		}
+		return resp, toConnectError(err)
+	}
+}
+
+func (errorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (errorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return toConnectError(next(ctx, conn))
+	}
+}
+
+func toConnectError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	// Handlers validate input with status.Error; gRPC and Connect share numeric code values.
+	if st, ok := status.FromError(err); ok {
+		return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	}
+	st, _ := status.FromError(mapping.ToPbError(err))
+	return connect.NewError(connect.Code(st.Code()), err)
+}
@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+// LanguageHeader lets clients pin the preferred language explicitly; it wins over Accept-Language.
+const LanguageHeader = "X-Vocnet-Language"
+
+type languageContextKey struct{}
+
+// WithLanguage returns a copy of ctx carrying the client's preferred language.
+func WithLanguage(ctx context.Context, lang entity.Language) context.Context {
+	return context.WithValue(ctx, languageContextKey{}, lang)
+}
+
+// LanguageFromContext returns the language requested via headers, or LanguageUnspecified when absent.
+func LanguageFromContext(ctx context.Context) entity.Language {
+	lang, _ := ctx.Value(languageContextKey{}).(entity.Language)
+	return lang
+}
+
+// LanguageInterceptor stores the supported language found in X-Vocnet-Language or Accept-Language
+// on the request context. Unsupported values are ignored.
+func LanguageInterceptor() connect.Interceptor {
+	return languageInterceptor{}
+}
+
+type languageInterceptor struct{}
+
+func (languageInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		return next(withRequestLanguage(ctx, req.Header()), req)
+	}
+}
+
+func (languageInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (languageInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(withRequestLanguage(ctx, conn.RequestHeader()), conn)
+	}
+}
+
+func withRequestLanguage(ctx context.Context, header http.Header) context.Context {
+	if lang := languageFromHeader(header); lang != entity.LanguageUnspecified {
+		return WithLanguage(ctx, lang)
+	}
+	return ctx
+}
+
+func languageFromHeader(header http.Header) entity.Language {
+	if lang := parseLanguageTag(header.Get(LanguageHeader)); lang != entity.LanguageUnspecified {
+		return lang
+	}
+	return parseAcceptLanguage(header.Get("Accept-Language"))
+}
+
+// parseAcceptLanguage picks the highest weighted supported language, e.g. "fr-CH, de;q=0.9, *;q=0.5".
+func parseAcceptLanguage(value string) entity.Language {
+	type candidate struct {
+		lang entity.Language
+		q    float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(value, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		q := 1.0
+		if raw, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		lang := parseLanguageTag(tag)
+		if lang == entity.LanguageUnspecified || q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{lang: lang, q: q})
+	}
+	if len(candidates) == 0 {
+		return entity.LanguageUnspecified
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].lang
+}
+
+// parseLanguageTag maps a BCP 47 tag such as "zh-CN" onto a supported language by its primary subtag.
+func parseLanguageTag(tag string) entity.Language {
+	primary, _, _ := strings.Cut(strings.TrimSpace(tag), "-")
+	primary, _, _ = strings.Cut(primary, "_")
+	lang := entity.ParseLanguage(primary)
+	if lang == entity.LanguageUnspecified || entity.NormalizeLanguage(lang) != lang {
+		return entity.LanguageUnspecified
+	}
+	return lang
+}
+
+// languageOrPreferred keeps an explicit request language and otherwise falls back to the header preference.
+func languageOrPreferred(ctx context.Context, lang entity.Language) entity.Language {
+	if lang != entity.LanguageUnspecified {
+		return lang
+	}
+	return LanguageFromContext(ctx)
+}
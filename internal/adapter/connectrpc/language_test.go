@@ -0,0 +1,99 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect"
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	commonv1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
+)
+
+func TestLookupWordFallsBackToHeaderLanguage(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "lang.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	for _, lang := range []entity.Language{entity.LanguageEnglish, entity.LanguageFrench} {
+		client.Word.Create().SetText("chat").SetNormalized("chat").SetLanguage(lang.Code()).SaveX(ctx)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(dictv1connect.NewWordServiceHandler(
+		NewWordServiceServer(usecase.NewWordUsecase(repository.NewWordRepository(client))),
+		connect.WithInterceptors(LanguageInterceptor()),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	rpc := dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		reqLang commonv1.Language
+		want    commonv1.Language
+	}{
+		{
+			name:    "accept-language header",
+			headers: map[string]string{"Accept-Language": "fr-CH, en;q=0.8"},
+			want:    commonv1.Language_LANGUAGE_FRENCH,
+		},
+		{
+			name:    "vocnet header wins over accept-language",
+			headers: map[string]string{"Accept-Language": "en", LanguageHeader: "fr"},
+			want:    commonv1.Language_LANGUAGE_FRENCH,
+		},
+		{
+			name:    "unknown header value is ignored",
+			headers: map[string]string{LanguageHeader: "klingon", "Accept-Language": "xx-YY"},
+			want:    commonv1.Language_LANGUAGE_ENGLISH,
+		},
+		{
+			name:    "explicit request field wins over header",
+			headers: map[string]string{LanguageHeader: "fr"},
+			reqLang: commonv1.Language_LANGUAGE_ENGLISH,
+			want:    commonv1.Language_LANGUAGE_ENGLISH,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := connect.NewRequest(&dictv1.LookupWordRequest{Word: "chat", Language: tt.reqLang})
+			for k, v := range tt.headers {
+				req.Header().Set(k, v)
+			}
+			resp, err := rpc.LookupWord(ctx, req)
+			if err != nil {
+				t.Fatalf("LookupWord: %v", err)
+			}
+			if got := resp.Msg.GetLanguage(); got != tt.want {
+				t.Fatalf("language = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := map[string]entity.Language{
+		"":                        entity.LanguageUnspecified,
+		"zh-CN":                   entity.LanguageChinese,
+		"xx, de;q=0.4, ja;q=0.9":  entity.LanguageJapanese,
+		"es;q=0, ko;q=0.1":        entity.LanguageKorean,
+		"*;q=0.5, en_US;q=0.3":    entity.LanguageEnglish,
+		"fr;q=abc":                entity.LanguageUnspecified,
+		"tlh, i-klingon;q=0.9, *": entity.LanguageUnspecified,
+	}
+	for header, want := range tests {
+		if got := parseAcceptLanguage(header); got != want {
+			t.Errorf("parseAcceptLanguage(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	commonv1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
 	learningv1 "github.com/eslsoft/vocnet/pkg/api/learning/v1"
 	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+	"github.com/samber/lo"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -22,11 +23,12 @@ var _ learningv1connect.LearningServiceHandler = (*LearningServiceServer)(nil)
 type LearningServiceServer struct {
 	learningv1connect.UnimplementedLearningServiceHandler
 
-	uc usecase.LearnedLexemeUsecase
+	uc    usecase.LearnedLexemeUsecase
+	words usecase.WordUsecase
 }
 
-func NewLearningServiceServer(uc usecase.LearnedLexemeUsecase) *LearningServiceServer {
-	return &LearningServiceServer{uc: uc}
+func NewLearningServiceServer(uc usecase.LearnedLexemeUsecase, words usecase.WordUsecase) *LearningServiceServer {
+	return &LearningServiceServer{uc: uc, words: words}
 }
 
 func (s *LearningServiceServer) CollectLexeme(ctx context.Context, req *connect.Request[learningv1.CollectLexemeRequest]) (*connect.Response[learningv1.LearnedLexeme], error) {
@@ -34,8 +36,14 @@ func (s *LearningServiceServer) CollectLexeme(ctx context.Context, req *connect.
 		return nil, status.Error(codes.InvalidArgument, "lexeme payload required")
 	}
 
-	userID := int64(1000)
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	entityLexeme := mapping.FromPbLearnedLexeme(req.Msg.Lexeme)
+	if req.Msg.GetClearTags() {
+		entityLexeme.Tags = []string{}
+	}
 	result, err := s.uc.CollectLexeme(ctx, userID, entityLexeme)
 	if err != nil {
 		return nil, err
@@ -46,7 +54,10 @@ func (s *LearningServiceServer) CollectLexeme(ctx context.Context, req *connect.
 
 func (s *LearningServiceServer) UncollectLexeme(ctx context.Context, req *connect.Request[commonv1.IDRequest]) (*connect.Response[emptypb.Empty], error) {
 	msg := req.Msg
-	userID := int64(1000)
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if err := s.uc.DeleteLearnedLexeme(ctx, userID, msg.GetId()); err != nil {
 		return nil, err
 	}
@@ -54,10 +65,34 @@ func (s *LearningServiceServer) UncollectLexeme(ctx context.Context, req *connec
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (s *LearningServiceServer) BatchUncollect(ctx context.Context, req *connect.Request[learningv1.BatchUncollectRequest]) (*connect.Response[learningv1.BatchUncollectResponse], error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "request required")
+	}
+	msg := req.Msg
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := s.uc.DeleteByFilter(ctx, userID, &repository.ListLearnedLexemeQuery{
+		FilterOrder: repository.FilterOrder{Filter: msg.GetFilter()},
+		All:         msg.GetAll(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&learningv1.BatchUncollectResponse{Deleted: deleted}), nil
+}
+
 func (s *LearningServiceServer) ListLearnedLexemes(ctx context.Context, req *connect.Request[learningv1.ListLearnedLexemesRequest]) (*connect.Response[learningv1.ListLearnedLexemesResponse], error) {
 	if req == nil || req.Msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "request required")
 	}
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	msg := req.Msg
 	query := &repository.ListLearnedLexemeQuery{
 		Pagination: convertPagination(msg.GetPagination()),
@@ -65,23 +100,20 @@ func (s *LearningServiceServer) ListLearnedLexemes(ctx context.Context, req *con
 			Filter:  msg.GetFilter(),
 			OrderBy: msg.GetOrderBy(),
 		},
-		UserID: int64(1000),
+		UserID: userID,
 	}
 	items, total, err := s.uc.ListLearnedLexemes(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
-	total32, err := safeInt32("total user lexemes", total)
+	pagination, err := makePaginationResponse("total user lexemes", query.Pagination, total)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	resp := &learningv1.ListLearnedLexemesResponse{
-		Pagination: &commonv1.PaginationResponse{
-			Total:  total32,
-			PageNo: query.PageNo,
-		},
+		Pagination: pagination,
 	}
 	for _, item := range items {
 		resp.Lexemes = append(resp.Lexemes, mapping.ToPbLearnedLexeme(&item))
@@ -90,13 +122,34 @@ func (s *LearningServiceServer) ListLearnedLexemes(ctx context.Context, req *con
 	return connect.NewResponse(resp), nil
 }
 
+func (s *LearningServiceServer) CountLearnedLexemes(ctx context.Context, req *connect.Request[learningv1.CountLearnedLexemesRequest]) (*connect.Response[learningv1.CountLearnedLexemesResponse], error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "request required")
+	}
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.uc.CountLearnedLexemes(ctx, &repository.ListLearnedLexemeQuery{
+		FilterOrder: repository.FilterOrder{Filter: req.Msg.GetFilter()},
+		UserID:      userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&learningv1.CountLearnedLexemesResponse{Count: count}), nil
+}
+
 func (s *LearningServiceServer) UpdateMastery(ctx context.Context, req *connect.Request[learningv1.UpdateMasteryRequest]) (*connect.Response[learningv1.LearnedLexeme], error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request required")
 	}
 
 	msg := req.Msg
-	userID := int64(1000)
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
 	result, err := s.uc.UpdateMastery(ctx, userID, msg.GetLexemeId(), mapping.FromPbMastery(msg.GetMastery()), entity.ReviewTiming{}, msg.GetNotes())
 	if err != nil {
 		return nil, err
@@ -104,3 +157,137 @@ func (s *LearningServiceServer) UpdateMastery(ctx context.Context, req *connect.
 
 	return connect.NewResponse(mapping.ToPbLearnedLexeme(result)), nil
 }
+
+func (s *LearningServiceServer) RenameLexeme(ctx context.Context, req *connect.Request[learningv1.RenameLexemeRequest]) (*connect.Response[learningv1.LearnedLexeme], error) {
+	if req.Msg == nil || req.Msg.GetTerm() == "" {
+		return nil, status.Error(codes.InvalidArgument, "term required")
+	}
+
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.uc.RenameTerm(ctx, userID, req.Msg.GetLexemeId(), req.Msg.GetTerm(), req.Msg.GetMerge())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(mapping.ToPbLearnedLexeme(result)), nil
+}
+
+func (s *LearningServiceServer) BatchUpdateMastery(ctx context.Context, req *connect.Request[learningv1.BatchUpdateMasteryRequest]) (*connect.Response[learningv1.BatchUpdateMasteryResponse], error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "request required")
+	}
+
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updates := lo.Map(req.Msg.GetUpdates(), func(upd *learningv1.MasteryUpdate, _ int) usecase.MasteryUpdate {
+		update := usecase.MasteryUpdate{
+			ID:      upd.GetLexemeId(),
+			Mastery: mapping.FromPbMastery(upd.GetMastery()),
+			Notes:   upd.GetNotes(),
+		}
+		if upd.GetReviewedAt() != nil {
+			update.ReviewedAt = upd.GetReviewedAt().AsTime()
+		}
+		return update
+	})
+	results, err := s.uc.UpdateMasteryBatch(ctx, userID, updates, req.Msg.GetAtomic())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &learningv1.BatchUpdateMasteryResponse{
+		Results: make([]*learningv1.MasteryUpdateResult, 0, len(results)),
+		Errors:  mapping.ToPbBatchItemErrors(results),
+	}
+	for i, result := range results {
+		item := &learningv1.MasteryUpdateResult{LexemeId: updates[i].ID}
+		if result.Err == nil {
+			item.Lexeme = mapping.ToPbLearnedLexeme(result.Value)
+		}
+		resp.Results = append(resp.Results, item)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *LearningServiceServer) GetLearnedLexemeByTerm(ctx context.Context, req *connect.Request[learningv1.GetLearnedLexemeByTermRequest]) (*connect.Response[learningv1.LearnedLexeme], error) {
+	if req.Msg == nil || req.Msg.GetTerm() == "" {
+		return nil, status.Error(codes.InvalidArgument, "term required")
+	}
+
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	language := languageOrPreferred(ctx, mapping.FromPbLanguage(req.Msg.GetLanguage()))
+	lexeme, err := s.uc.GetByTerm(ctx, userID, req.Msg.GetTerm(), language)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(mapping.ToPbLearnedLexeme(lexeme)), nil
+}
+
+func (s *LearningServiceServer) LookupWord(ctx context.Context, req *connect.Request[learningv1.LookupWordRequest]) (*connect.Response[learningv1.LookupWordResponse], error) {
+	if req.Msg == nil || req.Msg.GetWord() == "" {
+		return nil, status.Error(codes.InvalidArgument, "text required")
+	}
+
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	language := languageOrPreferred(ctx, mapping.FromPbLanguage(req.Msg.GetLanguage()))
+	prefer := mapping.FromPbLookupPreference(req.Msg.GetPrefer())
+	word, learned, err := s.words.LookupWithUserState(ctx, userID, req.Msg.GetWord(), language, prefer)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &learningv1.LookupWordResponse{Word: mapping.ToPbWord(word)}
+	if learned != nil {
+		resp.LearnedLexeme = mapping.ToPbLearnedLexeme(learned)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *LearningServiceServer) ListLexemesByLemma(ctx context.Context, req *connect.Request[learningv1.ListLexemesByLemmaRequest]) (*connect.Response[learningv1.ListLexemesByLemmaResponse], error) {
+	if req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "request required")
+	}
+
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	language := languageOrPreferred(ctx, mapping.FromPbLanguage(req.Msg.GetLanguage()))
+	groups, err := s.uc.ListGroupedByLemma(ctx, userID, language)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &learningv1.ListLexemesByLemmaResponse{Groups: make([]*learningv1.LemmaGroup, 0, len(groups))}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, &learningv1.LemmaGroup{
+			Lemma: group.Lemma,
+			Lexemes: lo.Map(group.Members, func(l entity.LearnedLexeme, _ int) *learningv1.LearnedLexeme {
+				return mapping.ToPbLearnedLexeme(&l)
+			}),
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (s *LearningServiceServer) GetDueCount(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[learningv1.GetDueCountResponse], error) {
+	userID, err := requestUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.uc.GetDueCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&learningv1.GetDueCountResponse{DueCount: count}), nil
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	learningv1 "github.com/eslsoft/vocnet/pkg/api/learning/v1"
+	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+)
+
+// batchMasteryStub applies every update except those for missing lexeme ids.
+type batchMasteryStub struct {
+	usecase.LearnedLexemeUsecase
+}
+
+func (batchMasteryStub) UpdateMasteryBatch(_ context.Context, userID int64, updates []usecase.MasteryUpdate, _ bool) ([]usecase.MasteryUpdateResult, error) {
+	results := make([]usecase.MasteryUpdateResult, len(updates))
+	for i, upd := range updates {
+		results[i].Index = i
+		if upd.ID == 404 {
+			results[i].Err = entity.ErrLearnedLexemeNotFound
+			continue
+		}
+		results[i].Value = &entity.LearnedLexeme{ID: upd.ID, UserID: userID, Term: "bridge", Mastery: upd.Mastery}
+	}
+	return results, nil
+}
+
+func TestBatchUpdateMasteryReportsFailuresOnce(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle(learningv1connect.NewLearningServiceHandler(
+		NewLearningServiceServer(batchMasteryStub{}, nil),
+		connect.WithInterceptors(UserInterceptor(true, 1000), ErrorInterceptor()),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	rpc := learningv1connect.NewLearningServiceClient(srv.Client(), srv.URL)
+
+	resp, err := rpc.BatchUpdateMastery(context.Background(), connect.NewRequest(&learningv1.BatchUpdateMasteryRequest{
+		Updates: []*learningv1.MasteryUpdate{{LexemeId: 1}, {LexemeId: 404}},
+	}))
+	if err != nil {
+		t.Fatalf("BatchUpdateMastery: %v", err)
+	}
+	results := resp.Msg.GetResults()
+	if len(results) != 2 || results[0].GetLexeme() == nil || results[1].GetLexeme() != nil || results[1].GetLexemeId() != 404 {
+		t.Fatalf("results = %v, want the first update applied and the second without a lexeme", results)
+	}
+	errs := resp.Msg.GetErrors()
+	if len(errs) != 1 || errs[0].GetIndex() != 1 || errs[0].GetCode() != "NotFound" || errs[0].GetMessage() == "" {
+		t.Fatalf("errors = %v, want one NotFound entry for index 1", errs)
+	}
+}
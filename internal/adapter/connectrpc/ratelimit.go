@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// RateLimitInterceptor admits at most perMinute unary calls per minute across all callers,
+// allowing bursts of up to perMinute, and fails the rest with ResourceExhausted. A zero or
+// negative perMinute disables the limit.
+func RateLimitInterceptor(perMinute int) connect.Interceptor {
+	if perMinute <= 0 {
+		return rateLimitInterceptor{}
+	}
+	return rateLimitInterceptor{bucket: &tokenBucket{
+		capacity: float64(perMinute),
+		tokens:   float64(perMinute),
+		interval: time.Minute / time.Duration(perMinute),
+		now:      time.Now,
+	}}
+}
+
+type rateLimitInterceptor struct {
+	bucket *tokenBucket
+}
+
+func (r rateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if r.bucket != nil && !req.Spec().IsClient && !r.bucket.take() {
+			return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("%s: rate limit exceeded", req.Spec().Procedure))
+		}
+		return next(ctx, req)
+	}
+}
+
+func (rateLimitInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (rateLimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
+// tokenBucket refills one token every interval up to capacity.
+type tokenBucket struct {
+	capacity float64
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) take() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens = min(b.capacity, b.tokens+float64(now.Sub(b.last))/float64(b.interval))
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucketRefills(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	bucket := RateLimitInterceptor(2).(rateLimitInterceptor).bucket
+	bucket.now = func() time.Time { return now }
+
+	if !bucket.take() || !bucket.take() {
+		t.Fatal("a full bucket should admit a burst of 2")
+	}
+	if bucket.take() {
+		t.Fatal("an empty bucket should refuse")
+	}
+	now = now.Add(29 * time.Second)
+	if bucket.take() {
+		t.Fatal("no token should be back before 30s at 2 per minute")
+	}
+	now = now.Add(time.Second)
+	if !bucket.take() {
+		t.Fatal("a token should be back after 30s")
+	}
+	now = now.Add(time.Hour)
+	if !bucket.take() || !bucket.take() || bucket.take() {
+		t.Fatal("refill should stop at the burst size")
+	}
+}
@@ -5,20 +5,20 @@ import (
 	commonv1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
 )
 
-const _maxPageSize = 10000
-
 func convertPagination(p *commonv1.PaginationRequest) repository.Pagination {
-	pageNo := p.GetPageNo()
-	if pageNo <= 0 {
-		pageNo = 1
-	}
-	pageSize := p.GetPageSize()
-	if pageSize <= 0 {
-		pageSize = 20
-	}
-	if pageSize > _maxPageSize {
-		pageSize = _maxPageSize
-	}
+	return repository.NewPagination(p.GetPageNo(), p.GetPageSize())
+}
 
-	return repository.Pagination{PageNo: pageNo, PageSize: pageSize}
+// makePaginationResponse derives the page metadata for a list response from the applied pagination.
+func makePaginationResponse(name string, p repository.Pagination, total int64) (*commonv1.PaginationResponse, error) {
+	total32, err := safeInt32(name, total)
+	if err != nil {
+		return nil, err
+	}
+	return &commonv1.PaginationResponse{
+		Total:       total32,
+		PageNo:      p.PageNo,
+		TotalPages:  int32(p.TotalPages(total)), //nolint:gosec // pages <= total, which fits in int32
+		HasNextPage: p.HasNextPage(total),
+	}, nil
 }
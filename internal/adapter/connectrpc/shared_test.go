@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/eslsoft/vocnet/internal/repository"
+)
+
+func TestMakePaginationResponse(t *testing.T) {
+	tests := []struct {
+		name      string
+		page      repository.Pagination
+		total     int64
+		wantPages int32
+		wantNext  bool
+	}{
+		{name: "empty", page: repository.Pagination{PageNo: 1, PageSize: 20}, total: 0, wantPages: 0, wantNext: false},
+		{name: "exact multiple first page", page: repository.Pagination{PageNo: 1, PageSize: 20}, total: 60, wantPages: 3, wantNext: true},
+		{name: "exact multiple last page", page: repository.Pagination{PageNo: 3, PageSize: 20}, total: 60, wantPages: 3, wantNext: false},
+		{name: "remainder middle page", page: repository.Pagination{PageNo: 2, PageSize: 20}, total: 61, wantPages: 4, wantNext: true},
+		{name: "remainder last partial page", page: repository.Pagination{PageNo: 4, PageSize: 20}, total: 61, wantPages: 4, wantNext: false},
+		{name: "single partial page", page: repository.Pagination{PageNo: 1, PageSize: 20}, total: 7, wantPages: 1, wantNext: false},
+		{name: "past the end", page: repository.Pagination{PageNo: 9, PageSize: 20}, total: 61, wantPages: 4, wantNext: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := makePaginationResponse("items", tc.page, tc.total)
+			if err != nil {
+				t.Fatalf("makePaginationResponse: %v", err)
+			}
+			if got.GetTotal() != int32(tc.total) || got.GetPageNo() != tc.page.PageNo {
+				t.Fatalf("total/page_no = %d/%d", got.GetTotal(), got.GetPageNo())
+			}
+			if got.GetTotalPages() != tc.wantPages || got.GetHasNextPage() != tc.wantNext {
+				t.Fatalf("total_pages=%d has_next_page=%v, want %d/%v", got.GetTotalPages(), got.GetHasNextPage(), tc.wantPages, tc.wantNext)
+			}
+		})
+	}
+
+	if _, err := makePaginationResponse("items", repository.Pagination{PageNo: 1, PageSize: 20}, math.MaxInt32+1); err == nil {
+		t.Fatal("expected overflow error")
+	}
+}
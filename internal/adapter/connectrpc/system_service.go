@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	systemv1 "github.com/eslsoft/vocnet/pkg/api/system/v1"
+	"github.com/eslsoft/vocnet/pkg/api/system/v1/systemv1connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ systemv1connect.SystemServiceHandler = (*SystemServiceServer)(nil)
+
+// ServerInfo is the build and runtime information reported by GetServerInfo.
+type ServerInfo struct {
+	Version        string
+	SchemaHash     string
+	DatabaseDriver string
+	StartedAt      time.Time
+}
+
+type SystemServiceServer struct {
+	systemv1connect.UnimplementedSystemServiceHandler
+	info ServerInfo
+	now  func() time.Time
+}
+
+func NewSystemServiceServer(info ServerInfo) *SystemServiceServer {
+	return &SystemServiceServer{info: info, now: time.Now}
+}
+
+// GetServerInfo does not read the request user, so it also answers unauthenticated callers in
+// multi-user mode.
+func (s *SystemServiceServer) GetServerInfo(_ context.Context, _ *connect.Request[systemv1.GetServerInfoRequest]) (*connect.Response[systemv1.ServerInfo], error) {
+	return connect.NewResponse(&systemv1.ServerInfo{
+		Version:        s.info.Version,
+		SchemaHash:     s.info.SchemaHash,
+		DatabaseDriver: s.info.DatabaseDriver,
+		StartedAt:      timestamppb.New(s.info.StartedAt),
+		Uptime:         durationpb.New(s.now().Sub(s.info.StartedAt)),
+	}), nil
+}
@@ -0,0 +1,84 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// TimeoutInterceptor bounds how long a handler may run. The default applies to unary calls;
+// overrides are keyed by full procedure ("/dict.v1.WordService/ListWords") or bare method name
+// ("ListWords") and also apply to streaming calls. A zero or negative timeout disables the limit.
+// The client's own deadline still wins when it is shorter.
+func TimeoutInterceptor(unary time.Duration, overrides map[string]time.Duration) connect.Interceptor {
+	return timeoutInterceptor{unary: unary, overrides: overrides}
+}
+
+type timeoutInterceptor struct {
+	unary     time.Duration
+	overrides map[string]time.Duration
+}
+
+func (t timeoutInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		timeout, ok := t.lookup(req.Spec().Procedure)
+		if !ok {
+			timeout = t.unary
+		}
+		if timeout <= 0 {
+			return next(ctx, req)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		resp, err := next(ctx, req)
+		if deadlineErr := deadlineError(ctx, req.Spec().Procedure, timeout); deadlineErr != nil {
+			return nil, deadlineErr
+		}
+		return resp, err
+	}
+}
+
+func (timeoutInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (t timeoutInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		timeout, ok := t.lookup(conn.Spec().Procedure)
+		if !ok || timeout <= 0 {
+			return next(ctx, conn)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		err := next(ctx, conn)
+		if deadlineErr := deadlineError(ctx, conn.Spec().Procedure, timeout); deadlineErr != nil {
+			return deadlineErr
+		}
+		return err
+	}
+}
+
+func (t timeoutInterceptor) lookup(procedure string) (time.Duration, bool) {
+	if timeout, ok := t.overrides[procedure]; ok {
+		return timeout, true
+	}
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	timeout, ok := t.overrides[method]
+	return timeout, ok
+}
+
+// deadlineError reports DeadlineExceeded once the handler context expired, regardless of how the
+// handler wrapped (or swallowed) the cancellation.
+func deadlineError(ctx context.Context, procedure string, timeout time.Duration) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil
+	}
+	return connect.NewError(connect.CodeDeadlineExceeded, fmt.Errorf("%s exceeded %s", procedure, timeout))
+}
@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
+)
+
+// slowWordUsecase simulates a query that takes delay to finish, optionally ignoring cancellation.
+type slowWordUsecase struct {
+	usecase.WordUsecase
+	delay     time.Duration
+	ignoreCtx bool
+}
+
+func (s slowWordUsecase) Lookup(ctx context.Context, lemma string, language entity.Language, _ entity.LookupPreference, _ ...int32) (*entity.Word, error) {
+	if s.ignoreCtx {
+		time.Sleep(s.delay)
+	} else {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(s.delay):
+		}
+	}
+	return &entity.Word{ID: 1, Text: lemma, Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma}, nil
+}
+
+func TestTimeoutInterceptor(t *testing.T) {
+	newClient := func(uc usecase.WordUsecase, unary time.Duration, overrides map[string]time.Duration) dictv1connect.WordServiceClient {
+		mux := http.NewServeMux()
+		mux.Handle(dictv1connect.NewWordServiceHandler(
+			NewWordServiceServer(uc),
+			connect.WithInterceptors(TimeoutInterceptor(unary, overrides), ErrorInterceptor()),
+		))
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+		return dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+	}
+	lookup := func(rpc dictv1connect.WordServiceClient) error {
+		_, err := rpc.LookupWord(context.Background(), connect.NewRequest(&dictv1.LookupWordRequest{Word: "slow"}))
+		return err
+	}
+
+	tests := []struct {
+		name      string
+		uc        slowWordUsecase
+		unary     time.Duration
+		overrides map[string]time.Duration
+		wantCode  connect.Code // 0 means success
+	}{
+		{name: "deadline fires", uc: slowWordUsecase{delay: time.Second}, unary: 20 * time.Millisecond, wantCode: connect.CodeDeadlineExceeded},
+		{name: "handler ignoring cancellation", uc: slowWordUsecase{delay: 100 * time.Millisecond, ignoreCtx: true}, unary: 20 * time.Millisecond, wantCode: connect.CodeDeadlineExceeded},
+		{name: "within timeout", uc: slowWordUsecase{delay: 5 * time.Millisecond}, unary: time.Second},
+		{name: "disabled", uc: slowWordUsecase{delay: 50 * time.Millisecond}, unary: 0},
+		{
+			name:      "method override extends",
+			uc:        slowWordUsecase{delay: 50 * time.Millisecond},
+			unary:     10 * time.Millisecond,
+			overrides: map[string]time.Duration{"LookupWord": time.Second},
+		},
+		{
+			name:      "procedure override shortens",
+			uc:        slowWordUsecase{delay: time.Second},
+			unary:     time.Minute,
+			overrides: map[string]time.Duration{dictv1connect.WordServiceLookupWordProcedure: 20 * time.Millisecond},
+			wantCode:  connect.CodeDeadlineExceeded,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := lookup(newClient(tc.uc, tc.unary, tc.overrides))
+			if tc.wantCode == 0 {
+				if err != nil {
+					t.Fatalf("LookupWord: %v", err)
+				}
+				return
+			}
+			if code := connect.CodeOf(err); code != tc.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tc.wantCode, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+// UserIDHeader carries the authenticated user id in multi-user mode. The gateway in front of the
+// service is expected to set it from the authenticated principal and strip client-supplied values.
+const UserIDHeader = "X-Vocnet-User-Id"
+
+// UserInterceptor stores the user a request acts for on the request context. In single-user mode
+// every request acts for anonymousUserID; otherwise the user comes from X-Vocnet-User-Id, and
+// requests without a valid one stay unauthenticated.
+func UserInterceptor(singleUser bool, anonymousUserID int64) connect.Interceptor {
+	return userInterceptor{singleUser: singleUser, anonymousUserID: anonymousUserID}
+}
+
+type userInterceptor struct {
+	singleUser      bool
+	anonymousUserID int64
+}
+
+func (i userInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		return next(i.withRequestUser(ctx, req.Header()), req)
+	}
+}
+
+func (userInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i userInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(i.withRequestUser(ctx, conn.RequestHeader()), conn)
+	}
+}
+
+func (i userInterceptor) withRequestUser(ctx context.Context, header http.Header) context.Context {
+	if i.singleUser {
+		return entity.WithUserID(ctx, i.anonymousUserID)
+	}
+	userID, err := strconv.ParseInt(strings.TrimSpace(header.Get(UserIDHeader)), 10, 64)
+	if err != nil || userID <= 0 {
+		return ctx
+	}
+	return entity.WithUserID(ctx, userID)
+}
+
+// requestUserID returns the user stored by UserInterceptor, or entity.ErrUnauthenticated.
+func requestUserID(ctx context.Context) (int64, error) {
+	if userID, ok := entity.UserIDFromContext(ctx); ok {
+		return userID, nil
+	}
+	return 0, entity.ErrUnauthenticated
+}
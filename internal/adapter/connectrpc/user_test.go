@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	learningv1 "github.com/eslsoft/vocnet/pkg/api/learning/v1"
+	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+)
+
+// collectRecorder records which user CollectLexeme ran for; other methods are not used.
+type collectRecorder struct {
+	usecase.LearnedLexemeUsecase
+	userID int64
+}
+
+func (r *collectRecorder) CollectLexeme(_ context.Context, userID int64, lexeme *entity.LearnedLexeme) (*entity.LearnedLexeme, error) {
+	r.userID = userID
+	out := *lexeme
+	out.UserID = userID
+	return &out, nil
+}
+
+func TestCollectLexemeUser(t *testing.T) {
+	tests := []struct {
+		name       string
+		singleUser bool
+		header     string
+		wantUser   int64
+		wantCode   connect.Code
+	}{
+		{name: "single-user uses the configured id", singleUser: true, wantUser: 1000},
+		{name: "single-user ignores the header", singleUser: true, header: "42", wantUser: 1000},
+		{name: "multi-user uses the header", header: "42", wantUser: 42},
+		{name: "multi-user rejects a missing header", wantCode: connect.CodeUnauthenticated},
+		{name: "multi-user rejects an invalid header", header: "-3", wantCode: connect.CodeUnauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lexemes := &collectRecorder{}
+			mux := http.NewServeMux()
+			mux.Handle(learningv1connect.NewLearningServiceHandler(
+				NewLearningServiceServer(lexemes, nil),
+				connect.WithInterceptors(UserInterceptor(tt.singleUser, 1000), ErrorInterceptor()),
+			))
+			srv := httptest.NewServer(mux)
+			t.Cleanup(srv.Close)
+			rpc := learningv1connect.NewLearningServiceClient(srv.Client(), srv.URL)
+
+			req := connect.NewRequest(&learningv1.CollectLexemeRequest{Lexeme: &learningv1.LearnedLexeme{Spec: &learningv1.LearnedLexemeSpec{Term: "apple"}}})
+			if tt.header != "" {
+				req.Header().Set(UserIDHeader, tt.header)
+			}
+			_, err := rpc.CollectLexeme(context.Background(), req)
+			if tt.wantCode != 0 {
+				var connectErr *connect.Error
+				if !errors.As(err, &connectErr) || connectErr.Code() != tt.wantCode {
+					t.Fatalf("CollectLexeme: err = %v, want code %v", err, tt.wantCode)
+				}
+				if lexemes.userID != 0 {
+					t.Fatalf("usecase ran for user %d on a rejected call", lexemes.userID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CollectLexeme: %v", err)
+			}
+			if lexemes.userID != tt.wantUser {
+				t.Fatalf("collected for user %d, want %d", lexemes.userID, tt.wantUser)
+			}
+		})
+	}
+}
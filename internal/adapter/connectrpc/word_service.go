@@ -33,37 +33,71 @@ func (s *WordServiceServer) CreateWord(ctx context.Context, req *connect.Request
 		return nil, status.Error(codes.InvalidArgument, "word payload required")
 	}
 
-	result, err := s.uc.Create(ctx, mapping.FromPbWord(req.Msg.Word))
+	word := mapping.FromPbWord(req.Msg.Word)
+	word.Language = languageOrPreferred(ctx, word.Language)
+	result, err := s.uc.Create(ctx, word)
 	if err != nil {
 		return nil, err
 	}
 	return connect.NewResponse(mapping.ToPbWord(result)), nil
 }
 
-func (s *WordServiceServer) UpdateWord(ctx context.Context, req *connect.Request[dictv1.Word]) (*connect.Response[dictv1.Word], error) {
-	if req.Msg == nil {
+// UpdateWord replaces the fields listed in update_mask, or the whole entry when the mask is empty.
+func (s *WordServiceServer) UpdateWord(ctx context.Context, req *connect.Request[dictv1.UpdateWordRequest]) (*connect.Response[dictv1.Word], error) {
+	if req.Msg == nil || req.Msg.Word == nil {
 		return nil, status.Error(codes.InvalidArgument, "word payload required")
 	}
+	fields, err := mapping.FromPbWordMask(req.Msg.GetUpdateMask())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	result, err := s.uc.Update(ctx, mapping.FromPbWord(req.Msg))
+	// The header language only fills in creates and lookups; an update without a language keeps
+	// the stored one rather than moving the word.
+	word := mapping.FromPbWord(req.Msg.Word)
+	result, err := s.uc.Update(ctx, word, fields...)
 	if err != nil {
 		return nil, err
 	}
 	return connect.NewResponse(mapping.ToPbWord(result)), nil
 }
 
-func (s *WordServiceServer) GetWord(ctx context.Context, req *connect.Request[commonv1.IDRequest]) (*connect.Response[dictv1.Word], error) {
+func (s *WordServiceServer) GetWord(ctx context.Context, req *connect.Request[dictv1.GetWordRequest]) (*connect.Response[dictv1.Word], error) {
 	if req.Msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "id required")
 	}
 
-	result, err := s.uc.Get(ctx, req.Msg.GetId())
+	result, err := s.uc.Get(ctx, req.Msg.GetId(), mapping.FromPbSourceTypes(req.Msg.GetSentenceSources())...)
 	if err != nil {
 		return nil, err
 	}
 	return connect.NewResponse(mapping.ToPbWord(result)), nil
 }
 
+func (s *WordServiceServer) BatchGetWords(ctx context.Context, req *connect.Request[dictv1.BatchGetWordsRequest]) (*connect.Response[dictv1.BatchGetWordsResponse], error) {
+	if req.Msg == nil || len(req.Msg.GetIds()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "ids required")
+	}
+
+	words, err := s.uc.GetByIDs(ctx, req.Msg.GetIds())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(mapping.ToPbBatchGetWordsResponse(req.Msg.GetIds(), words)), nil
+}
+
+func (s *WordServiceServer) GetRelatedWords(ctx context.Context, req *connect.Request[commonv1.IDRequest]) (*connect.Response[dictv1.GetRelatedWordsResponse], error) {
+	if req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "id required")
+	}
+
+	related, err := s.uc.RelatedWords(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(mapping.ToPbRelatedWords(related)), nil
+}
+
 func (s *WordServiceServer) ListWords(ctx context.Context, req *connect.Request[dictv1.ListWordsRequest]) (*connect.Response[dictv1.ListWordsResponse], error) {
 	if req.Msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "request required")
@@ -75,13 +109,14 @@ func (s *WordServiceServer) ListWords(ctx context.Context, req *connect.Request[
 			Filter:  msg.GetFilter(),
 			OrderBy: msg.GetOrderBy(),
 		},
+		IncludeForms: msg.GetIncludeForms(),
 	}
 	items, total, err := s.uc.List(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
-	total32, err := safeInt32("total words", total)
+	pagination, err := makePaginationResponse("total words", query.Pagination, total)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -90,13 +125,26 @@ func (s *WordServiceServer) ListWords(ctx context.Context, req *connect.Request[
 		Words: lo.Map(items, func(item *entity.Word, _ int) *dictv1.Word {
 			return mapping.ToPbWord(item)
 		}),
-		Pagination: &commonv1.PaginationResponse{
-			Total:  total32,
-			PageNo: query.PageNo,
-		},
+		Pagination: pagination,
 	}), nil
 }
 
+// StreamWords streams every word matching the filter; pagination in the request is ignored.
+func (s *WordServiceServer) StreamWords(ctx context.Context, req *connect.Request[dictv1.ListWordsRequest], stream *connect.ServerStream[dictv1.Word]) error {
+	if req.Msg == nil {
+		return status.Error(codes.InvalidArgument, "request required")
+	}
+	query := &repository.ListWordQuery{
+		FilterOrder: repository.FilterOrder{
+			Filter:  req.Msg.GetFilter(),
+			OrderBy: req.Msg.GetOrderBy(),
+		},
+	}
+	return s.uc.Stream(ctx, query, func(word *entity.Word) error {
+		return stream.Send(mapping.ToPbWord(word))
+	})
+}
+
 func (s *WordServiceServer) DeleteWord(ctx context.Context, req *connect.Request[commonv1.IDRequest]) (*connect.Response[emptypb.Empty], error) {
 	if req.Msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "id required")
@@ -107,16 +155,82 @@ func (s *WordServiceServer) DeleteWord(ctx context.Context, req *connect.Request
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
-// LookupWord looks up a word by text and language.
+// LookupWord looks up a word by text and language; an unspecified language falls back to the request headers.
 func (s *WordServiceServer) LookupWord(ctx context.Context, req *connect.Request[dictv1.LookupWordRequest]) (*connect.Response[dictv1.Word], error) {
 	if req.Msg == nil || req.Msg.Word == "" {
 		return nil, status.Error(codes.InvalidArgument, "text required")
 	}
 
-	v, err := s.uc.Lookup(ctx, req.Msg.Word, mapping.FromPbLanguage(req.Msg.Language))
+	language := languageOrPreferred(ctx, mapping.FromPbLanguage(req.Msg.Language))
+	prefer := mapping.FromPbLookupPreference(req.Msg.GetPrefer())
+	v, err := s.uc.Lookup(ctx, req.Msg.Word, language, prefer, mapping.FromPbSourceTypes(req.Msg.GetSentenceSources())...)
 	if err != nil {
 		return nil, err
 	}
+	if v == nil {
+		return nil, status.Error(codes.NotFound, entity.ErrVocNotFound.Error())
+	}
 
 	return connect.NewResponse(mapping.ToPbWord(v)), nil
 }
+
+// ListForms lists a lemma's forms; an unspecified language falls back to the request headers.
+func (s *WordServiceServer) ListForms(ctx context.Context, req *connect.Request[dictv1.ListFormsRequest]) (*connect.Response[dictv1.ListFormsResponse], error) {
+	if req.Msg == nil || req.Msg.GetLemma() == "" {
+		return nil, status.Error(codes.InvalidArgument, "lemma required")
+	}
+
+	language := languageOrPreferred(ctx, mapping.FromPbLanguage(req.Msg.GetLanguage()))
+	forms, err := s.uc.ListForms(ctx, req.Msg.GetLemma(), language, repository.ListFormsQuery{
+		WordTypes: lo.Map(req.Msg.GetWordTypes(), func(t string, _ int) entity.WordType { return entity.WordType(t) }),
+		Limit:     int(req.Msg.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(mapping.ToPbListFormsResponse(forms)), nil
+}
+
+func (s *WordServiceServer) ListWordAudit(ctx context.Context, req *connect.Request[dictv1.ListWordAuditRequest]) (*connect.Response[dictv1.ListWordAuditResponse], error) {
+	if req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "word_id required")
+	}
+
+	audits, err := s.uc.ListWordAudit(ctx, req.Msg.GetWordId(), int(req.Msg.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(mapping.ToPbListWordAuditResponse(audits)), nil
+}
+
+func (s *WordServiceServer) ListLanguages(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[dictv1.ListLanguagesResponse], error) {
+	stats, err := s.uc.LanguageStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(mapping.ToPbListLanguagesResponse(stats)), nil
+}
+
+// Lemmatize resolves each token to its lemma; unknown tokens are echoed back with found=false.
+func (s *WordServiceServer) Lemmatize(ctx context.Context, req *connect.Request[dictv1.LemmatizeRequest]) (*connect.Response[dictv1.LemmatizeResponse], error) {
+	if req.Msg == nil || len(req.Msg.GetTokens()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tokens required")
+	}
+
+	language := languageOrPreferred(ctx, mapping.FromPbLanguage(req.Msg.GetLanguage()))
+	results, err := s.uc.LemmatizeBatch(ctx, req.Msg.GetTokens(), language)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&dictv1.LemmatizeResponse{
+		Results: lo.Map(results, func(r entity.Lemmatization, _ int) *dictv1.LemmatizeResult {
+			return &dictv1.LemmatizeResult{
+				Token:    r.Token,
+				Lemma:    r.Lemma,
+				WordType: string(r.WordType),
+				Found:    r.Found,
+			}
+		}),
+	}), nil
+}
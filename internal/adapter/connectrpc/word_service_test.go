@@ -0,0 +1,225 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect"
+	"github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	repo "github.com/eslsoft/vocnet/internal/repository"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	commonv1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestStreamWordsDrainsAllMatches(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "stream.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	seedWords(t, ctx, client, entity.LanguageEnglish, 1203)
+	seedWords(t, ctx, client, entity.LanguageChinese, 17)
+
+	words := repository.NewWordRepository(client)
+	mux := http.NewServeMux()
+	mux.Handle(dictv1connect.NewWordServiceHandler(NewWordServiceServer(usecase.NewWordUsecase(words))))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	rpc := dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+
+	for _, filter := range []string{"", `word.startsWith("en-word-00")`} {
+		_, total, err := words.List(ctx, &repo.ListWordQuery{FilterOrder: repo.FilterOrder{Filter: filter}})
+		if err != nil {
+			t.Fatalf("count words (%q): %v", filter, err)
+		}
+
+		stream, err := rpc.StreamWords(ctx, connect.NewRequest(&dictv1.ListWordsRequest{Filter: filter}))
+		if err != nil {
+			t.Fatalf("open stream (%q): %v", filter, err)
+		}
+		got := 0
+		seen := map[int64]bool{}
+		for stream.Receive() {
+			id := stream.Msg().GetId()
+			if seen[id] {
+				t.Fatalf("word %d streamed twice", id)
+			}
+			seen[id] = true
+			got++
+		}
+		if err := stream.Err(); err != nil {
+			t.Fatalf("stream (%q): %v", filter, err)
+		}
+		if err := stream.Close(); err != nil {
+			t.Fatalf("close stream (%q): %v", filter, err)
+		}
+		if int64(got) != total {
+			t.Fatalf("streamed %d words for %q, want %d", got, filter, total)
+		}
+	}
+}
+
+func seedWords(t *testing.T, ctx context.Context, client *entdb.Client, lang entity.Language, n int) {
+	t.Helper()
+	builders := make([]*entdb.WordCreate, 0, n)
+	for i := 0; i < n; i++ {
+		text := fmt.Sprintf("%s-word-%04d", lang, i)
+		builders = append(builders, client.Word.Create().
+			SetText(text).
+			SetNormalized(text).
+			SetLanguage(lang.Code()))
+	}
+	for start := 0; start < len(builders); start += 200 {
+		end := min(start+200, len(builders))
+		if err := client.Word.CreateBulk(builders[start:end]...).Exec(ctx); err != nil {
+			t.Fatalf("seed words: %v", err)
+		}
+	}
+}
+
+func TestUpdateWordWithFieldMask(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "mask.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	mux := http.NewServeMux()
+	mux.Handle(dictv1connect.NewWordServiceHandler(NewWordServiceServer(usecase.NewWordUsecase(repository.NewWordRepository(client)))))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	rpc := dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+
+	created, err := rpc.CreateWord(ctx, connect.NewRequest(&dictv1.CreateWordRequest{Word: &dictv1.Word{
+		Text:        "apple",
+		Language:    commonv1.Language_LANGUAGE_ENGLISH,
+		Phonetics:   []*dictv1.Phonetic{{Ipa: "ˈæp.əl", Dialect: "en-US"}},
+		Definitions: []*dictv1.Definition{{Pos: "n.", Text: "a fruit", Language: commonv1.Language_LANGUAGE_ENGLISH}},
+		Categories:  []string{"cet4"},
+		Sentences:   []*dictv1.Sentence{{Text: "An apple a day."}},
+	}}))
+	if err != nil {
+		t.Fatalf("CreateWord: %v", err)
+	}
+	id := created.Msg.GetId()
+
+	update := func(word *dictv1.Word, paths ...string) *dictv1.Word {
+		t.Helper()
+		resp, err := rpc.UpdateWord(ctx, connect.NewRequest(&dictv1.UpdateWordRequest{
+			Word:       word,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: paths},
+		}))
+		if err != nil {
+			t.Fatalf("UpdateWord(%v): %v", paths, err)
+		}
+		return resp.Msg
+	}
+
+	// Only categories are sent; everything else must survive.
+	got := update(&dictv1.Word{Id: id, Categories: []string{"cet4", "fruit"}}, "categories")
+	if !slices.Equal(got.GetCategories(), []string{"cet4", "fruit"}) {
+		t.Fatalf("categories = %v", got.GetCategories())
+	}
+	if got.GetText() != "apple" || got.GetLanguage() != commonv1.Language_LANGUAGE_ENGLISH {
+		t.Fatalf("text/language changed: %q %v", got.GetText(), got.GetLanguage())
+	}
+	if len(got.GetPhonetics()) != 1 || len(got.GetDefinitions()) != 1 || len(got.GetSentences()) != 1 {
+		t.Fatalf("unmasked fields were wiped: %+v", got)
+	}
+
+	// Only definitions are sent; categories from the previous update must survive.
+	got = update(&dictv1.Word{Id: id, Definitions: []*dictv1.Definition{
+		{Pos: "n.", Text: "苹果", Language: commonv1.Language_LANGUAGE_CHINESE},
+	}}, "definitions")
+	if len(got.GetDefinitions()) != 1 || got.GetDefinitions()[0].GetText() != "苹果" {
+		t.Fatalf("definitions = %+v", got.GetDefinitions())
+	}
+	if !slices.Equal(got.GetCategories(), []string{"cet4", "fruit"}) || len(got.GetPhonetics()) != 1 || len(got.GetSentences()) != 1 {
+		t.Fatalf("unmasked fields were wiped: %+v", got)
+	}
+
+	// A masked but empty field is cleared.
+	got = update(&dictv1.Word{Id: id}, "sentences")
+	if len(got.GetSentences()) != 0 || len(got.GetDefinitions()) != 1 {
+		t.Fatalf("expected only sentences cleared: %+v", got)
+	}
+
+	_, err = rpc.UpdateWord(ctx, connect.NewRequest(&dictv1.UpdateWordRequest{
+		Word:       &dictv1.Word{Id: id},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"created_at"}},
+	}))
+	if err == nil {
+		t.Fatal("expected unsupported mask path to be rejected")
+	}
+}
+
+func TestLookupWordUnknownIsNotFound(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "missing.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	mux := http.NewServeMux()
+	mux.Handle(dictv1connect.NewWordServiceHandler(
+		NewWordServiceServer(usecase.NewWordUsecase(repository.NewWordRepository(client))),
+		connect.WithInterceptors(ErrorInterceptor()),
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	rpc := dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+
+	resp, err := rpc.LookupWord(ctx, connect.NewRequest(&dictv1.LookupWordRequest{Word: "zzyzx"}))
+	if err == nil {
+		t.Fatalf("expected NotFound, got word %+v", resp.Msg)
+	}
+	if code := connect.CodeOf(err); code != connect.CodeNotFound {
+		t.Fatalf("code = %v, want NotFound (%v)", code, err)
+	}
+}
+
+func TestLookupWordPreference(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "prefer.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	// The schema keeps one row per (language, text), so a form and its lemma are separate entries.
+	client.Word.Create().SetText("go").SetLanguage("en").SetWordType(string(entity.WordTypeLemma)).ExecX(ctx)
+	client.Word.Create().SetText("went").SetLanguage("en").SetWordType(string(entity.WordTypePast)).SetLemma("go").ExecX(ctx)
+	client.Word.Create().SetText("ate").SetLanguage("en").SetWordType(string(entity.WordTypePast)).SetLemma("eat").ExecX(ctx)
+
+	mux := http.NewServeMux()
+	mux.Handle(dictv1connect.NewWordServiceHandler(NewWordServiceServer(usecase.NewWordUsecase(repository.NewWordRepository(client)))))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	rpc := dictv1connect.NewWordServiceClient(srv.Client(), srv.URL)
+
+	for _, tc := range []struct {
+		word         string
+		prefer       dictv1.LookupPreference
+		want         string
+		fallbackFrom string
+	}{
+		{word: "went", prefer: dictv1.LookupPreference_LOOKUP_PREFERENCE_UNSPECIFIED, want: "go", fallbackFrom: "went"},
+		{word: "went", prefer: dictv1.LookupPreference_LOOKUP_PREFERENCE_LEMMA, want: "go", fallbackFrom: "went"},
+		{word: "went", prefer: dictv1.LookupPreference_LOOKUP_PREFERENCE_EXACT, want: "went"},
+		{word: "ate", prefer: dictv1.LookupPreference_LOOKUP_PREFERENCE_LEMMA, want: "ate"}, // "eat" has no entry
+	} {
+		resp, err := rpc.LookupWord(ctx, connect.NewRequest(&dictv1.LookupWordRequest{Word: tc.word, Prefer: tc.prefer}))
+		if err != nil {
+			t.Fatalf("lookup %q (%v): %v", tc.word, tc.prefer, err)
+		}
+		if got := resp.Msg.GetText(); got != tc.want || resp.Msg.GetFallbackFrom() != tc.fallbackFrom {
+			t.Fatalf("lookup %q (%v) = %q fallback %q, want %q fallback %q",
+				tc.word, tc.prefer, got, resp.Msg.GetFallbackFrom(), tc.want, tc.fallbackFrom)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package mapping
+
+import (
+	"google.golang.org/grpc/status"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	commonv1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
+)
+
+// ToPbBatchItemErrors lists the failed items of a batch in request order.
+func ToPbBatchItemErrors[T any](results []entity.BatchResult[T]) []*commonv1.BatchItemError {
+	var out []*commonv1.BatchItemError
+	for _, r := range results {
+		if r.Err == nil {
+			continue
+		}
+		out = append(out, &commonv1.BatchItemError{
+			Index:   int32(r.Index),
+			Code:    status.Code(ToPbError(r.Err)).String(),
+			Message: r.Err.Error(),
+		})
+	}
+	return out
+}
@@ -7,18 +7,35 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
 )
 
 func ToPbError(err error) error {
+	var orderErr *filterexpr.UnsupportedOrderKeyError
+	var complexErr *filterexpr.FilterTooComplexError
 	switch {
 	case err == nil:
 		return nil
-	case errors.Is(err, entity.ErrInvalidVocText), errors.Is(err, entity.ErrInvalidVocID):
+	case errors.As(err, &orderErr), errors.As(err, &complexErr):
 		return status.Error(codes.InvalidArgument, err.Error())
-	case errors.Is(err, entity.ErrVocNotFound):
+	case errors.Is(err, entity.ErrInvalidVocText), errors.Is(err, entity.ErrInvalidVocID), errors.Is(err, entity.ErrUnscopedDelete),
+		errors.Is(err, entity.ErrWordTooLarge), errors.Is(err, entity.ErrInvalidWordType), errors.Is(err, entity.ErrInvalidDialect),
+		errors.Is(err, entity.ErrInvalidSentenceSource), errors.Is(err, entity.ErrInvalidRelationType),
+		errors.Is(err, entity.ErrInvalidLearnedLexemeText),
+		errors.Is(err, entity.ErrOffsetTooLarge), errors.Is(err, entity.ErrPageSizeTooLarge), errors.Is(err, entity.ErrBatchTooLarge),
+		errors.Is(err, entity.ErrInvalidReviewState), errors.Is(err, entity.ErrInvalidReviewTiming), errors.Is(err, entity.ErrInvalidCreatedBy),
+		errors.Is(err, entity.ErrLanguageRequired), errors.Is(err, entity.ErrInvalidTags), errors.Is(err, entity.ErrInvalidCEFRLevel):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, entity.ErrVocNotFound), errors.Is(err, entity.ErrLearnedLexemeNotFound):
 		return status.Error(codes.NotFound, err.Error())
-	case errors.Is(err, entity.ErrDuplicateWord):
+	case errors.Is(err, entity.ErrDuplicateWord), errors.Is(err, entity.ErrDuplicateLearnedLexeme):
 		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, entity.ErrLemmaNotFound), errors.Is(err, entity.ErrStaleReview), errors.Is(err, entity.ErrWordAuditDisabled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, entity.ErrUnauthenticated):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, entity.ErrWriteConflict), errors.Is(err, entity.ErrBatchAborted):
+		return status.Error(codes.Aborted, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
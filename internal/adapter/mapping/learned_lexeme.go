@@ -21,6 +21,7 @@ func FromPbLearnedLexeme(in *learningv1.LearnedLexeme) *entity.LearnedLexeme {
 			Overall: in.Spec.MasteryLevel,
 		},
 		// Notes:      in.Spec.GetNotes(),
+		Tags: fromPbTags(in.Spec.GetTags()),
 		Sentences: lo.Map(in.Spec.GetSentences(), func(s *dictv1.Sentence, _ int) entity.Sentence {
 			return entity.Sentence{
 				Text:      strings.TrimSpace(s.GetText()),
@@ -31,13 +32,21 @@ func FromPbLearnedLexeme(in *learningv1.LearnedLexeme) *entity.LearnedLexeme {
 		Relations: lo.Map(in.Spec.GetRelations(), func(rel *learningv1.LearnedLexemeRelation, _ int) entity.LearnedLexemeRelation {
 			return entity.LearnedLexemeRelation{
 				Word:         rel.GetWord(),
-				RelationType: int32(rel.GetRelationType()),
+				RelationType: entity.RelationType(rel.GetRelationType()),
 				Note:         rel.GetNote(),
 			}
 		}),
 	}
 }
 
+// fromPbTags canonicalizes tags. A list without any usable tag maps to nil ("leave unchanged").
+func fromPbTags(tags []string) []string {
+	if tags = entity.NormalizeTags(tags); len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
+
 func ToPbLearnedLexeme(in *entity.LearnedLexeme) *learningv1.LearnedLexeme {
 	out := &learningv1.LearnedLexeme{
 		Id: in.ID,
@@ -60,6 +69,7 @@ func ToPbLearnedLexeme(in *entity.LearnedLexeme) *learningv1.LearnedLexeme {
 					UpdatedAt:    timestamppb.New(rel.UpdatedAt),
 				}
 			}),
+			Tags: in.Tags,
 			// Notes: in.Notes,
 		},
 		Status: &learningv1.LearnedLexemeStatus{
@@ -1,8 +1,11 @@
 package mapping
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/eslsoft/vocnet/internal/entity"
@@ -16,7 +19,7 @@ func FromPbWord(in *dictv1.Word) *entity.Word {
 		ID:       in.GetId(),
 		Text:     strings.TrimSpace(in.GetText()),
 		Language: FromPbLanguage(in.GetLanguage()),
-		WordType: strings.TrimSpace(in.GetWordType()),
+		WordType: entity.WordType(strings.TrimSpace(in.GetWordType())),
 		Phonetics: lo.Map(in.GetPhonetics(), func(p *dictv1.Phonetic, _ int) entity.WordPhonetic {
 			return entity.WordPhonetic{
 				IPA:     strings.TrimSpace(p.GetIpa()),
@@ -28,12 +31,13 @@ func FromPbWord(in *dictv1.Word) *entity.Word {
 				Pos:      strings.TrimSpace(def.GetPos()),
 				Text:     strings.TrimSpace(def.GetText()),
 				Language: FromPbLanguage(def.GetLanguage()),
+				Order:    int(def.GetOrder()),
 			}
 		}),
 		Forms: lo.Map(in.GetForms(), func(form *dictv1.WordFormRef, _ int) entity.WordFormRef {
 			return entity.WordFormRef{
 				Text:     strings.TrimSpace(form.GetText()),
-				WordType: strings.TrimSpace(form.GetWordType()),
+				WordType: entity.WordType(strings.TrimSpace(form.GetWordType())),
 			}
 		}),
 		Phrases: lo.Map(in.GetPhrases(), func(phrase *dictv1.Phrase, _ int) entity.Phrase {
@@ -58,7 +62,9 @@ func FromPbWord(in *dictv1.Word) *entity.Word {
 		Relations: lo.Map(in.GetRelations(), func(rel *dictv1.WordRelation, _ int) entity.WordRelation {
 			return entity.WordRelation{
 				Word:         strings.TrimSpace(rel.GetWord()),
-				RelationType: int32(rel.GetRelationType()),
+				RelationType: entity.RelationType(rel.GetRelationType()),
+				Note:         strings.TrimSpace(rel.GetNote()),
+				Strength:     rel.GetStrength(),
 			}
 		}),
 		Categories: in.GetCategories(),
@@ -70,6 +76,25 @@ func FromPbWord(in *dictv1.Word) *entity.Word {
 	return word
 }
 
+// FromPbWordMask converts an update mask into word fields; a nil or empty mask yields nil (all fields).
+func FromPbWordMask(mask *fieldmaskpb.FieldMask) ([]entity.WordField, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	fields := make([]entity.WordField, 0, len(paths))
+	for _, path := range paths {
+		field, ok := entity.ParseWordField(path)
+		if !ok {
+			return nil, fmt.Errorf("unsupported update_mask path %q", path)
+		}
+		if !lo.Contains(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields, nil
+}
+
 func ToPbWord(v *entity.Word) *dictv1.Word {
 	if v == nil {
 		return nil
@@ -78,15 +103,13 @@ func ToPbWord(v *entity.Word) *dictv1.Word {
 		Id:       v.ID,
 		Text:     v.Text,
 		Language: ToPbLanguage(v.Language),
-		WordType: v.WordType,
+		WordType: string(v.WordType),
 		Phonetics: lo.Map(v.Phonetics, func(p entity.WordPhonetic, _ int) *dictv1.Phonetic {
 			return &dictv1.Phonetic{Ipa: p.IPA, Dialect: p.Dialect}
 		}),
-		Definitions: lo.Map(v.Definitions, func(def entity.WordDefinition, _ int) *dictv1.Definition { return ToPbDefinition(def) }),
-		Forms: lo.Map(v.Forms, func(form entity.WordFormRef, _ int) *dictv1.WordFormRef {
-			return &dictv1.WordFormRef{Text: form.Text, WordType: form.WordType}
-		}),
-		Categories: v.Categories,
+		Definitions: lo.Map(entity.SortDefinitions(v.Definitions), func(def entity.WordDefinition, _ int) *dictv1.Definition { return ToPbDefinition(def) }),
+		Forms:       toPbFormRefs(v.Forms),
+		Categories:  v.Categories,
 		Phrases: lo.Map(v.Phrases, func(phrase entity.Phrase, _ int) *dictv1.Phrase {
 			return &dictv1.Phrase{
 				Text:     phrase.Text,
@@ -99,11 +122,13 @@ func ToPbWord(v *entity.Word) *dictv1.Word {
 		Sentences: lo.Map(v.Sentences, func(sent entity.Sentence, _ int) *dictv1.Sentence {
 			return &dictv1.Sentence{Text: sent.Text, Source: commonv1.SourceType(sent.Source), SourceRef: sent.SourceRef}
 		}),
-		Relations: lo.Map(v.Relations, func(rel entity.WordRelation, _ int) *dictv1.WordRelation {
-			return &dictv1.WordRelation{Word: rel.Word, RelationType: commonv1.RelationType(rel.RelationType)}
-		}),
-		CreatedAt: timestamppb.New(v.CreatedAt),
-		UpdatedAt: timestamppb.New(v.UpdatedAt),
+		Relations:    toPbRelations(v.Relations),
+		Source:       string(v.Source),
+		Frequency:    int64(v.Frequency),
+		Cefr:         string(v.CEFR),
+		FallbackFrom: v.FallbackFrom,
+		CreatedAt:    timestamppb.New(v.CreatedAt),
+		UpdatedAt:    timestamppb.New(v.UpdatedAt),
 	}
 
 	if v.Lemma != nil {
@@ -113,6 +138,84 @@ func ToPbWord(v *entity.Word) *dictv1.Word {
 	return pv
 }
 
+// ToPbRelatedWords converts the related-words view of an entry.
+func ToPbRelatedWords(r entity.RelatedWords) *dictv1.GetRelatedWordsResponse {
+	return &dictv1.GetRelatedWordsResponse{
+		Word:      ToPbWord(r.Word),
+		Lemma:     r.Lemma,
+		Forms:     toPbFormRefs(r.Forms),
+		Siblings:  toPbFormRefs(r.Siblings),
+		Relations: toPbRelations(r.Relations),
+	}
+}
+
+// ToPbBatchGetWordsResponse keys the found words by id and reports each requested id without a
+// word as a NotFound item error.
+func ToPbBatchGetWordsResponse(ids []int64, words map[int64]*entity.Word) *dictv1.BatchGetWordsResponse {
+	out := make(map[int64]*dictv1.Word, len(words))
+	for id, w := range words {
+		out[id] = ToPbWord(w)
+	}
+	results := make([]entity.BatchResult[*entity.Word], len(ids))
+	for i, id := range ids {
+		results[i] = entity.BatchResult[*entity.Word]{Index: i, Value: words[id]}
+		if results[i].Value == nil {
+			results[i].Err = fmt.Errorf("%w: id %d", entity.ErrVocNotFound, id)
+		}
+	}
+	return &dictv1.BatchGetWordsResponse{Words: out, Errors: ToPbBatchItemErrors(results)}
+}
+
+// ToPbListFormsResponse wraps the forms of a lemma.
+func ToPbListFormsResponse(forms []entity.WordFormRef) *dictv1.ListFormsResponse {
+	return &dictv1.ListFormsResponse{Forms: toPbFormRefs(forms)}
+}
+
+// ToPbListLanguagesResponse maps per-language entry counts, keeping their order.
+func ToPbListLanguagesResponse(stats []entity.LanguageCount) *dictv1.ListLanguagesResponse {
+	return &dictv1.ListLanguagesResponse{
+		Languages: lo.Map(stats, func(s entity.LanguageCount, _ int) *dictv1.LanguageWordCount {
+			return &dictv1.LanguageWordCount{Language: ToPbLanguage(s.Language), WordCount: s.Count}
+		}),
+	}
+}
+
+// ToPbListWordAuditResponse maps audit rows, keeping their order, with changes sorted by field.
+func ToPbListWordAuditResponse(audits []*entity.WordAudit) *dictv1.ListWordAuditResponse {
+	entries := lo.Map(audits, func(a *entity.WordAudit, _ int) *dictv1.WordAuditEntry {
+		fields := lo.Keys(a.Changes)
+		slices.Sort(fields)
+		return &dictv1.WordAuditEntry{
+			Id:     a.ID,
+			WordId: a.WordID,
+			Editor: a.Editor,
+			Changes: lo.Map(fields, func(field entity.WordField, _ int) *dictv1.WordFieldChange {
+				change := a.Changes[field]
+				return &dictv1.WordFieldChange{Field: string(field), OldJson: string(change.Old), NewJson: string(change.New)}
+			}),
+			CreatedAt: timestamppb.New(a.CreatedAt),
+		}
+	})
+	return &dictv1.ListWordAuditResponse{Entries: entries}
+}
+
+func toPbFormRefs(forms []entity.WordFormRef) []*dictv1.WordFormRef {
+	return lo.Map(forms, func(form entity.WordFormRef, _ int) *dictv1.WordFormRef {
+		return &dictv1.WordFormRef{Text: form.Text, WordType: string(form.WordType)}
+	})
+}
+
+func toPbRelations(relations []entity.WordRelation) []*dictv1.WordRelation {
+	return lo.Map(relations, func(rel entity.WordRelation, _ int) *dictv1.WordRelation {
+		return &dictv1.WordRelation{
+			Word:         rel.Word,
+			RelationType: commonv1.RelationType(rel.RelationType),
+			Note:         rel.Note,
+			Strength:     rel.Strength,
+		}
+	})
+}
+
 func ToPbDefinition(def entity.WordDefinition) *dictv1.Definition {
 	lang := ToPbLanguage(def.Language)
 	if lang == commonv1.Language_LANGUAGE_UNSPECIFIED {
@@ -122,6 +225,7 @@ func ToPbDefinition(def entity.WordDefinition) *dictv1.Definition {
 		Pos:      def.Pos,
 		Text:     def.Text,
 		Language: lang,
+		Order:    int32(def.Order),
 	}
 }
 
@@ -170,3 +274,16 @@ func FromPbLanguage(lang commonv1.Language) entity.Language {
 		return entity.LanguageUnspecified
 	}
 }
+
+// FromPbLookupPreference maps an unspecified or unknown preference to the lemma-first default.
+func FromPbLookupPreference(prefer dictv1.LookupPreference) entity.LookupPreference {
+	if prefer == dictv1.LookupPreference_LOOKUP_PREFERENCE_EXACT {
+		return entity.LookupPreferExact
+	}
+	return entity.LookupPreferLemma
+}
+
+// FromPbSourceTypes converts a sentence source filter; unknown values are kept so the usecase can reject them.
+func FromPbSourceTypes(sources []commonv1.SourceType) []int32 {
+	return lo.Map(sources, func(s commonv1.SourceType, _ int) int32 { return int32(s) })
+}
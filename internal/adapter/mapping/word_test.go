@@ -0,0 +1,94 @@
+package mapping
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+func TestToPbWordOrdersDefinitions(t *testing.T) {
+	tests := []struct {
+		name string
+		defs []entity.WordDefinition
+		want []string
+	}{
+		{
+			name: "explicit order",
+			defs: []entity.WordDefinition{{Text: "c", Order: 3}, {Text: "a", Order: 1}, {Text: "b", Order: 2}},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "legacy rows keep insertion order",
+			defs: []entity.WordDefinition{{Text: "x"}, {Text: "y"}, {Text: "z"}},
+			want: []string{"x", "y", "z"},
+		},
+		{
+			name: "unordered follow ordered and ties are stable",
+			defs: []entity.WordDefinition{{Text: "late"}, {Text: "second", Order: 2}, {Text: "first", Order: 1}, {Text: "tie", Order: 2}},
+			want: []string{"first", "second", "tie", "late"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append([]entity.WordDefinition(nil), tt.defs...)
+			pb := ToPbWord(&entity.Word{Text: "w", Definitions: tt.defs})
+			if len(pb.GetDefinitions()) != len(tt.want) {
+				t.Fatalf("got %d definitions, want %d", len(pb.GetDefinitions()), len(tt.want))
+			}
+			for i, def := range pb.GetDefinitions() {
+				if def.GetText() != tt.want[i] {
+					t.Fatalf("definition %d = %q, want %q", i, def.GetText(), tt.want[i])
+				}
+			}
+			for i := range original {
+				if tt.defs[i] != original[i] {
+					t.Fatalf("ToPbWord reordered the entity in place: %+v", tt.defs)
+				}
+			}
+		})
+	}
+}
+
+func TestWordRelationRoundTrip(t *testing.T) {
+	relations := []entity.WordRelation{
+		{Word: "glad", RelationType: 1, Note: "near-synonym in casual speech", Strength: 80},
+		{Word: "sad", RelationType: 2},
+	}
+	pb := ToPbWord(&entity.Word{Text: "happy", Relations: relations})
+	got := FromPbWord(&dictv1.Word{Text: "happy", Relations: pb.GetRelations()}).Relations
+	if !reflect.DeepEqual(got, relations) {
+		t.Fatalf("round trip = %+v, want %+v", got, relations)
+	}
+
+	var legacy []entity.WordRelation
+	if err := json.Unmarshal([]byte(`[{"word":"glad","relation_type":1}]`), &legacy); err != nil {
+		t.Fatalf("decode legacy relations: %v", err)
+	}
+	if want := []entity.WordRelation{{Word: "glad", RelationType: 1}}; !reflect.DeepEqual(legacy, want) {
+		t.Fatalf("legacy relations = %+v, want %+v", legacy, want)
+	}
+}
+
+func TestToPbBatchGetWordsResponseReportsMissingIDs(t *testing.T) {
+	words := map[int64]*entity.Word{
+		1: {ID: 1, Text: "apple"},
+		3: {ID: 3, Text: "pear"},
+	}
+	resp := ToPbBatchGetWordsResponse([]int64{3, 2, 1, 4}, words)
+
+	if len(resp.GetWords()) != 2 || resp.GetWords()[1].GetText() != "apple" || resp.GetWords()[3].GetText() != "pear" {
+		t.Fatalf("words = %v, want apple and pear", resp.GetWords())
+	}
+	var got []string
+	for _, e := range resp.GetErrors() {
+		got = append(got, fmt.Sprintf("%d:%s", e.GetIndex(), e.GetCode()))
+	}
+	if want := []string{"1:NotFound", "3:NotFound"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("errors = %v, want %v", got, want)
+	}
+}
@@ -2,25 +2,30 @@ package repository
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 	"strings"
+	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database"
 	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
 	entlearnedlexeme "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/predicate"
 	entword "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
 	"github.com/eslsoft/vocnet/internal/repository"
 	"github.com/eslsoft/vocnet/pkg/filterexpr"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
 )
 
 type LearnedLexemeRepository struct {
 	client *entdb.Client
+	// now stamps created_at/updated_at; caller-supplied timestamps are ignored.
+	now func() time.Time
 }
 
 func int32ToInt16(value int32, field string) (int16, error) {
@@ -32,18 +37,23 @@ func int32ToInt16(value int32, field string) (int16, error) {
 
 // NewLearnedLexemeRepository constructs an ent-backed repository.
 func NewLearnedLexemeRepository(client *entdb.Client) repository.LearnedLexemeRepository {
-	return &LearnedLexemeRepository{client: client}
+	return &LearnedLexemeRepository{client: client, now: time.Now}
 }
 
 type listLearnedLexemesParams struct {
-	Keyword       string
-	Lexemes       []string
-	Tags          []string
-	Categories    []string
-	PrimaryKey    string
-	PrimaryDesc   bool
-	SecondaryKey  string
-	SecondaryDesc bool
+	Keyword           string
+	Lexemes           []string
+	Tags              []string
+	Categories        []string
+	MasteryOverallMin *int32
+	MasteryOverallMax *int32
+	ReviewState       entity.ReviewState
+	PrimaryKey        string
+	PrimaryDesc       bool
+	SecondaryKey      string
+	SecondaryDesc     bool
+
+	now time.Time // reference time for review_state == "due"
 }
 
 func (r *LearnedLexemeRepository) Create(ctx context.Context, lexeme *entity.LearnedLexeme) (*entity.LearnedLexeme, error) {
@@ -67,6 +77,7 @@ func (r *LearnedLexemeRepository) Create(ctx context.Context, lexeme *entity.Lea
 	normalizedTerm := entity.NormalizeWordToken(lexeme.Term)
 	languageCode := entity.NormalizeLanguage(lexeme.Language).Code()
 
+	now := r.now()
 	builder := r.client.LearnedLexeme.Create().
 		SetUserID(lexeme.UserID).
 		SetTerm(lexeme.Term).
@@ -83,8 +94,8 @@ func (r *LearnedLexemeRepository) Create(ctx context.Context, lexeme *entity.Lea
 		SetSentences(lexeme.Sentences).
 		SetRelations(lexeme.Relations).
 		SetCreatedBy(lexeme.CreatedBy).
-		SetCreatedAt(lexeme.CreatedAt).
-		SetUpdatedAt(lexeme.UpdatedAt)
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
 
 	if lexeme.Tags != nil {
 		builder.SetTags(append([]string{}, lexeme.Tags...))
@@ -144,11 +155,10 @@ func (r *LearnedLexemeRepository) Update(ctx context.Context, lexeme *entity.Lea
 		SetMasteryOverall(lexeme.Mastery.Overall).
 		SetReviewIntervalDays(lexeme.Review.IntervalDays).
 		SetReviewFailCount(lexeme.Review.FailCount).
-		SetQueryCount(lexeme.QueryCount).
 		SetSentences(lexeme.Sentences).
 		SetRelations(lexeme.Relations).
 		SetCreatedBy(lexeme.CreatedBy).
-		SetUpdatedAt(lexeme.UpdatedAt)
+		SetUpdatedAt(r.now())
 
 	if lexeme.Tags != nil {
 		mutation.SetTags(append([]string{}, lexeme.Tags...))
@@ -186,6 +196,94 @@ func (r *LearnedLexemeRepository) Update(ctx context.Context, lexeme *entity.Lea
 	return mapEntLearnedLexeme(rec), nil
 }
 
+func (r *LearnedLexemeRepository) UpdateMany(ctx context.Context, userID int64, ids []int64, apply func(map[int64]*entity.LearnedLexeme) ([]*entity.LearnedLexeme, error)) ([]*entity.LearnedLexeme, error) {
+	var saved []*entity.LearnedLexeme
+	err := database.RetryTransient(ctx, "update user lexemes", func(ctx context.Context) (err error) {
+		saved, err = r.updateMany(ctx, userID, ids, apply)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+func (r *LearnedLexemeRepository) updateMany(ctx context.Context, userID int64, ids []int64, apply func(map[int64]*entity.LearnedLexeme) ([]*entity.LearnedLexeme, error)) (_ []*entity.LearnedLexeme, err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	recs, err := tx.LearnedLexeme.Query().
+		Where(
+			entlearnedlexeme.UserIDEQ(userID),
+			entlearnedlexeme.IDIn(lo.Map(ids, func(id int64, _ int) int { return int(id) })...),
+			forUpdate,
+		).
+		Order(entdb.Asc(entlearnedlexeme.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("load user lexemes: %w", err)
+	}
+	loaded := make(map[int64]*entity.LearnedLexeme, len(recs))
+	for _, rec := range recs {
+		loaded[int64(rec.ID)] = mapEntLearnedLexeme(rec)
+	}
+	lexemes, err := apply(loaded)
+	if err != nil {
+		return nil, err
+	}
+
+	txRepo := &LearnedLexemeRepository{client: tx.Client(), now: r.now}
+	saved := make([]*entity.LearnedLexeme, 0, len(lexemes))
+	for _, lexeme := range lexemes {
+		if lexeme == nil || lexeme.UserID != userID {
+			return nil, fmt.Errorf("update user lexemes: lexeme must belong to user %d", userID)
+		}
+		rec, err := txRepo.Update(ctx, lexeme)
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, rec)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit update: %w", translateLearnedLexemeError(err))
+	}
+	return saved, nil
+}
+
+// forUpdate locks the selected rows until the transaction ends. SQLite rejects the clause; it
+// serializes writers on the whole database instead.
+func forUpdate(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate()
+	}
+}
+
+// IncrementQueryCount issues UPDATE ... SET query_count = query_count + 1; ent re-reads the column
+// inside the same transaction, so the returned value is the one this call produced.
+func (r *LearnedLexemeRepository) IncrementQueryCount(ctx context.Context, userID, id int64) (int64, error) {
+	rec, err := r.client.LearnedLexeme.UpdateOneID(int(id)).
+		Where(entlearnedlexeme.UserIDEQ(userID)).
+		AddQueryCount(1).
+		SetUpdatedAt(r.now()).
+		Select(entlearnedlexeme.FieldQueryCount).
+		Save(ctx)
+	if err != nil {
+		if entdb.IsNotFound(err) {
+			return 0, entity.ErrLearnedLexemeNotFound
+		}
+		return 0, fmt.Errorf("increment query count: %w", err)
+	}
+	return rec.QueryCount, nil
+}
+
 func (r *LearnedLexemeRepository) GetByID(ctx context.Context, userID, id int64) (*entity.LearnedLexeme, error) {
 	rec, err := r.client.LearnedLexeme.Query().
 		Where(
@@ -222,11 +320,35 @@ func (r *LearnedLexemeRepository) FindByTerm(ctx context.Context, userID int64,
 	return mapEntLearnedLexeme(rec), nil
 }
 
+func (r *LearnedLexemeRepository) FindByNormalizedTerm(ctx context.Context, userID int64, language entity.Language, term string) (*entity.LearnedLexeme, error) {
+	normalized := entity.NormalizeWordToken(term)
+	if normalized == "" {
+		return nil, nil
+	}
+
+	rec, err := r.client.LearnedLexeme.Query().
+		Where(
+			entlearnedlexeme.UserIDEQ(userID),
+			entlearnedlexeme.LanguageEQ(entity.NormalizeLanguage(language).Code()),
+			entlearnedlexeme.NormalizedEQ(normalized),
+		).
+		Order(entlearnedlexeme.ByUpdatedAt(sql.OrderDesc()), entlearnedlexeme.ByID()).
+		First(ctx)
+	if err != nil {
+		if entdb.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find user lexeme by normalized term: %w", err)
+	}
+	return mapEntLearnedLexeme(rec), nil
+}
+
 func (r *LearnedLexemeRepository) List(ctx context.Context, query *repository.ListLearnedLexemeQuery) ([]entity.LearnedLexeme, int64, error) {
 	var params listLearnedLexemesParams
-	if err := filterexpr.Bind(query, &params, listLearnedLexemesSchema); err != nil {
+	if err := filterexpr.Bind(query, &params, listLearnedLexemesSchema.WithLimits(query.Limits)); err != nil {
 		return nil, 0, err
 	}
+	params.now = r.queryTime(query)
 
 	qbuilder := r.client.LearnedLexeme.Query().
 		Where(entlearnedlexeme.UserIDEQ(query.UserID))
@@ -238,14 +360,15 @@ func (r *LearnedLexemeRepository) List(ctx context.Context, query *repository.Li
 		return nil, 0, fmt.Errorf("count user lexemes: %w", err)
 	}
 
-	applyLearnedLexemeOrdering(qbuilder, params)
+	if err := applyLearnedLexemeOrdering(qbuilder, params); err != nil {
+		return nil, 0, err
+	}
 
-	offset := query.Offset()
-	if offset > 0 {
+	if offset := query.Offset(); offset > 0 {
 		qbuilder.Offset(int(offset))
 	}
-	if query.PageSize > 0 {
-		qbuilder.Limit(int(query.PageSize))
+	if limit := query.Limit(); limit > 0 {
+		qbuilder.Limit(limit)
 	}
 
 	rows, err := qbuilder.All(ctx)
@@ -263,6 +386,37 @@ func (r *LearnedLexemeRepository) List(ctx context.Context, query *repository.Li
 	return results, int64(total), nil
 }
 
+func (r *LearnedLexemeRepository) Count(ctx context.Context, query *repository.ListLearnedLexemeQuery) (int64, error) {
+	var params listLearnedLexemesParams
+	if err := filterexpr.Bind(query, &params, listLearnedLexemesSchema.WithLimits(query.Limits)); err != nil {
+		return 0, err
+	}
+	params.now = r.queryTime(query)
+
+	qbuilder := r.client.LearnedLexeme.Query().
+		Where(entlearnedlexeme.UserIDEQ(query.UserID))
+	applyLearnedLexemeFilters(qbuilder, params)
+
+	count, err := qbuilder.Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count user lexemes: %w", err)
+	}
+	return int64(count), nil
+}
+
+func (r *LearnedLexemeRepository) CountDue(ctx context.Context, userID int64, now time.Time) (int64, error) {
+	count, err := r.client.LearnedLexeme.Query().
+		Where(
+			entlearnedlexeme.UserIDEQ(userID),
+			reviewStatePredicate(entity.ReviewStateDue, now),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("count due user lexemes: %w", err)
+	}
+	return int64(count), nil
+}
+
 func (r *LearnedLexemeRepository) Delete(ctx context.Context, userID, id int64) error {
 	affected, err := r.client.LearnedLexeme.Delete().
 		Where(
@@ -279,15 +433,82 @@ func (r *LearnedLexemeRepository) Delete(ctx context.Context, userID, id int64)
 	return nil
 }
 
+func (r *LearnedLexemeRepository) DeleteByFilter(ctx context.Context, query *repository.ListLearnedLexemeQuery) (int64, error) {
+	var params listLearnedLexemesParams
+	if err := filterexpr.Bind(query, &params, listLearnedLexemesSchema.WithLimits(query.Limits)); err != nil {
+		return 0, err
+	}
+	params.now = r.queryTime(query)
+
+	preds := append([]predicate.LearnedLexeme{entlearnedlexeme.UserIDEQ(query.UserID)}, learnedLexemePredicates(params)...)
+	affected, err := r.client.LearnedLexeme.Delete().Where(preds...).Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("delete user lexemes: %w", err)
+	}
+	return int64(affected), nil
+}
+
+func (r *LearnedLexemeRepository) MergeDuplicates(ctx context.Context, userID int64, merges []repository.LearnedLexemeMerge) error {
+	if len(merges) == 0 {
+		return nil
+	}
+	return database.RetryTransient(ctx, "merge user lexemes", func(ctx context.Context) error {
+		return r.mergeDuplicates(ctx, userID, merges)
+	})
+}
+
+func (r *LearnedLexemeRepository) mergeDuplicates(ctx context.Context, userID int64, merges []repository.LearnedLexemeMerge) (err error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	txRepo := &LearnedLexemeRepository{client: tx.Client(), now: r.now}
+	for _, merge := range merges {
+		if merge.Keep == nil || merge.Keep.UserID != userID {
+			return fmt.Errorf("merge user lexemes: keeper must belong to user %d", userID)
+		}
+		// Remove losers first so the keeper never collides with them on unique columns.
+		for _, id := range merge.RemoveIDs {
+			if err = txRepo.Delete(ctx, userID, id); err != nil {
+				return err
+			}
+		}
+		if _, err = txRepo.Update(ctx, merge.Keep); err != nil {
+			return err
+		}
+		// Update leaves query_count alone; the keeper takes the summed count of the merged rows.
+		if err = tx.LearnedLexeme.UpdateOneID(int(merge.Keep.ID)).SetQueryCount(merge.Keep.QueryCount).Exec(ctx); err != nil {
+			return fmt.Errorf("merge user lexemes: set query count: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit merge: %w", translateLearnedLexemeError(err))
+	}
+	return nil
+}
+
 func applyLearnedLexemeFilters(q *entdb.LearnedLexemeQuery, params listLearnedLexemesParams) {
+	q.Where(learnedLexemePredicates(params)...)
+}
+
+// learnedLexemePredicates translates bound filter params into predicates shared by list and delete.
+func learnedLexemePredicates(params listLearnedLexemesParams) []predicate.LearnedLexeme {
+	var preds []predicate.LearnedLexeme
 	if params.Keyword != "" {
-		q.Where(entlearnedlexeme.TermContainsFold(params.Keyword))
+		preds = append(preds, entlearnedlexeme.TermContainsFold(params.Keyword))
 	}
 	if lexemes := uniqueFolded(params.Lexemes); len(lexemes) > 0 {
-		q.Where(entlearnedlexeme.NormalizedIn(lo.Map(lexemes, func(term string, _ int) string { return strings.ToLower(term) })...))
+		preds = append(preds, entlearnedlexeme.NormalizedIn(lo.Map(lexemes, func(term string, _ int) string { return strings.ToLower(term) })...))
 	}
 	if tags := uniqueFolded(params.Tags); len(tags) > 0 {
-		q.Where(func(s *sql.Selector) {
+		preds = append(preds, func(s *sql.Selector) {
 			column := s.C(entlearnedlexeme.FieldTags)
 			for _, tag := range tags {
 				s.Where(sqljson.ValueContains(column, tag))
@@ -295,63 +516,158 @@ func applyLearnedLexemeFilters(q *entdb.LearnedLexemeQuery, params listLearnedLe
 		})
 	}
 	if categories := uniqueFolded(params.Categories); len(categories) > 0 {
-		q.Where(entlearnedlexeme.HasWordWith(func(s *sql.Selector) {
+		preds = append(preds, entlearnedlexeme.HasWordWith(func(s *sql.Selector) {
 			column := s.C(entword.FieldCategories)
 			for _, category := range categories {
 				s.Where(sqljson.ValueContains(column, category))
 			}
 		}))
 	}
+	if params.MasteryOverallMin != nil {
+		preds = append(preds, entlearnedlexeme.MasteryOverallGTE(*params.MasteryOverallMin))
+	}
+	if params.MasteryOverallMax != nil {
+		preds = append(preds, entlearnedlexeme.MasteryOverallLTE(*params.MasteryOverallMax))
+	}
+	if params.ReviewState != "" {
+		preds = append(preds, reviewStatePredicate(params.ReviewState, params.now))
+	}
+	return preds
+}
+
+// reviewStatePredicate maps a review state onto mastery thresholds or the review schedule.
+func reviewStatePredicate(state entity.ReviewState, now time.Time) predicate.LearnedLexeme {
+	switch state {
+	case entity.ReviewStateNew:
+		return entlearnedlexeme.MasteryOverallLTE(entity.ReviewStateNewMaxOverall)
+	case entity.ReviewStateLearning:
+		return entlearnedlexeme.And(
+			entlearnedlexeme.MasteryOverallGT(entity.ReviewStateNewMaxOverall),
+			entlearnedlexeme.MasteryOverallLT(entity.ReviewStateMasteredMinOverall),
+		)
+	case entity.ReviewStateMastered:
+		return entlearnedlexeme.MasteryOverallGTE(entity.ReviewStateMasteredMinOverall)
+	default: // entity.ReviewStateDue; the schema setter rejects anything else
+		return entlearnedlexeme.And(
+			entlearnedlexeme.ReviewNextReviewAtNotNil(),
+			entlearnedlexeme.ReviewNextReviewAtLTE(now),
+		)
+	}
 }
 
-func applyLearnedLexemeOrdering(q *entdb.LearnedLexemeQuery, params listLearnedLexemesParams) {
-	for _, term := range []struct {
-		key  string
-		desc bool
-	}{
+// queryTime is the reference time for time-relative filters.
+func (r *LearnedLexemeRepository) queryTime(query *repository.ListLearnedLexemeQuery) time.Time {
+	if !query.Now.IsZero() {
+		return query.Now
+	}
+	return r.now()
+}
+
+// learnedLexemeOrderFields maps every order key whitelisted in listLearnedLexemesSchema to its
+// ent ordering; TestOrderFieldsCoverSchemas keeps the two in sync.
+var learnedLexemeOrderFields = map[string]func(...sql.OrderTermOption) entlearnedlexeme.OrderOption{
+	"created_at":      entlearnedlexeme.ByCreatedAt,
+	"updated_at":      entlearnedlexeme.ByUpdatedAt,
+	"lexeme":          entlearnedlexeme.ByTerm,
+	"mastery_overall": entlearnedlexeme.ByMasteryOverall,
+	"id":              entlearnedlexeme.ByID,
+}
+
+func applyLearnedLexemeOrdering(q *entdb.LearnedLexemeQuery, params listLearnedLexemesParams) error {
+	for _, term := range []orderTerm{
 		{key: params.PrimaryKey, desc: params.PrimaryDesc},
 		{key: params.SecondaryKey, desc: params.SecondaryDesc},
 	} {
 		if term.key == "" {
 			continue
 		}
-		switch term.key {
-		case "created_at":
-			if term.desc {
-				q.Order(entlearnedlexeme.ByCreatedAt(sql.OrderDesc(), sql.OrderNullsLast()))
-			} else {
-				q.Order(entlearnedlexeme.ByCreatedAt(sql.OrderAsc(), sql.OrderNullsLast()))
-			}
-		case "updated_at":
-			if term.desc {
-				q.Order(entlearnedlexeme.ByUpdatedAt(sql.OrderDesc(), sql.OrderNullsLast()))
-			} else {
-				q.Order(entlearnedlexeme.ByUpdatedAt(sql.OrderAsc(), sql.OrderNullsLast()))
-			}
-		case "word":
-			if term.desc {
-				q.Order(entlearnedlexeme.ByTerm(sql.OrderDesc(), sql.OrderNullsLast()))
-			} else {
-				q.Order(entlearnedlexeme.ByTerm(sql.OrderAsc(), sql.OrderNullsLast()))
-			}
-		case "mastery_overall":
-			if term.desc {
-				q.Order(entlearnedlexeme.ByMasteryOverall(sql.OrderDesc(), sql.OrderNullsLast()))
-			} else {
-				q.Order(entlearnedlexeme.ByMasteryOverall(sql.OrderAsc(), sql.OrderNullsLast()))
-			}
-		case "id":
-			if term.desc {
-				q.Order(entlearnedlexeme.ByID(sql.OrderDesc()))
-			} else {
-				q.Order(entlearnedlexeme.ByID())
-			}
+		by, ok := learnedLexemeOrderFields[term.key]
+		if !ok {
+			return unhandledOrderKey(term.key)
 		}
+		q.Order(by(term.options()...))
 	}
 
 	q.Order(entlearnedlexeme.ByID())
+	return nil
 }
 
+func (r *LearnedLexemeRepository) ReattachDictionaryWords(ctx context.Context, afterID int64, limit int) (stats repository.ReattachStats, lastID int64, err error) {
+	rows, err := r.client.LearnedLexeme.Query().
+		Where(
+			entlearnedlexeme.IDGT(int(afterID)),
+			// HasWord only checks word_id for NULL; HasWordWith also requires the word to exist.
+			entlearnedlexeme.Not(entlearnedlexeme.HasWordWith()),
+		).
+		Order(entlearnedlexeme.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return stats, afterID, fmt.Errorf("list unattached lexemes: %w", err)
+	}
+	if len(rows) == 0 {
+		return stats, afterID, nil
+	}
+	stats.Scanned = len(rows)
+	lastID = int64(rows[len(rows)-1].ID)
+
+	type wordKey struct {
+		language   string
+		normalized string
+	}
+	terms := lo.Uniq(lo.FilterMap(rows, func(row *entdb.LearnedLexeme, _ int) (string, bool) {
+		return row.Normalized, row.Normalized != ""
+	}))
+	matches := make(map[wordKey]int)
+	if len(terms) > 0 {
+		words, err := r.client.Word.Query().
+			Where(entword.NormalizedIn(terms...)).
+			Order(entword.ByID()).
+			Select(entword.FieldID, entword.FieldLanguage, entword.FieldNormalized).
+			All(ctx)
+		if err != nil {
+			return stats, afterID, fmt.Errorf("lookup dictionary words: %w", err)
+		}
+		for _, w := range words {
+			key := wordKey{language: w.Language, normalized: w.Normalized}
+			if _, ok := matches[key]; !ok {
+				matches[key] = w.ID
+			}
+		}
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return stats, afterID, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	for _, row := range rows {
+		wordID, ok := matches[wordKey{language: row.Language, normalized: row.Normalized}]
+		switch {
+		case ok && row.Normalized != "":
+			err = tx.LearnedLexeme.UpdateOneID(row.ID).SetWordID(wordID).Exec(ctx)
+			stats.Linked++
+		case row.WordID != nil:
+			err = tx.LearnedLexeme.UpdateOneID(row.ID).ClearWordID().Exec(ctx)
+			stats.Cleared++
+		}
+		if err != nil {
+			return repository.ReattachStats{}, afterID, fmt.Errorf("reattach lexeme %d: %w", row.ID, err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return repository.ReattachStats{}, afterID, fmt.Errorf("commit reattach: %w", err)
+	}
+	return stats, lastID, nil
+}
+
+// attachDictionaryWord links the lexeme to its dictionary entry. The link is optional enrichment,
+// so a failed lookup only clears it (ReattachDictionaryWords repairs it later); only a cancelled
+// or expired ctx fails the write.
 func (r *LearnedLexemeRepository) attachDictionaryWord(ctx context.Context, mut *entdb.LearnedLexemeMutation, languageCode, normalizedTerm string) error {
 	if mut == nil {
 		return nil
@@ -369,11 +685,15 @@ func (r *LearnedLexemeRepository) attachDictionaryWord(ctx context.Context, mut
 		).
 		First(ctx)
 	if err != nil {
-		if entdb.IsNotFound(err) {
-			mut.ClearWord()
-			return nil
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("lookup dictionary word: %w", ctxErr)
 		}
-		return fmt.Errorf("lookup dictionary word: %w", err)
+		if !entdb.IsNotFound(err) {
+			logrus.WithContext(ctx).WithFields(logrus.Fields{"language": languageCode, "term": normalizedTerm}).
+				WithError(err).Warn("lookup dictionary word failed; saving lexeme without word link")
+		}
+		mut.ClearWord()
+		return nil
 	}
 
 	mut.SetWordID(dictWord.ID)
@@ -432,12 +752,14 @@ func translateLearnedLexemeError(err error) error {
 	if err == nil {
 		return nil
 	}
-	var pgErr *pgconn.PgError
-	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+	if _, ok := database.AsUniqueViolation(err); ok {
 		return entity.ErrDuplicateLearnedLexeme
 	}
 	if entdb.IsNotFound(err) {
 		return entity.ErrLearnedLexemeNotFound
 	}
+	if database.IsTransient(err) {
+		return fmt.Errorf("%w: %w", entity.ErrWriteConflict, err)
+	}
 	return err
 }
@@ -0,0 +1,547 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	entword "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/repository"
+)
+
+func TestLearnedLexemeRepositoryDeleteByFilter(t *testing.T) {
+	dsn := "file:" + filepath.Join(t.TempDir(), "lexemes.db") + "?_fk=1"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+
+	seed := []struct {
+		userID  int64
+		term    string
+		tags    []string
+		overall int32
+	}{
+		{userID: 1, term: "apple", tags: []string{"old"}, overall: 50},
+		{userID: 1, term: "banana", tags: []string{"old"}, overall: 400},
+		{userID: 1, term: "cherry", tags: []string{"new"}, overall: 20},
+		{userID: 2, term: "apple", tags: []string{"old"}, overall: 50},
+	}
+	for _, s := range seed {
+		_, err := repo.Create(ctx, &entity.LearnedLexeme{
+			UserID:    s.userID,
+			Term:      s.term,
+			Language:  entity.LanguageEnglish,
+			Tags:      s.tags,
+			Mastery:   entity.MasteryBreakdown{Overall: s.overall},
+			CreatedBy: "user",
+		})
+		if err != nil {
+			t.Fatalf("seed %q: %v", s.term, err)
+		}
+	}
+
+	deleted, err := repo.DeleteByFilter(ctx, &repository.ListLearnedLexemeQuery{
+		UserID:      1,
+		FilterOrder: repository.FilterOrder{Filter: `tag in ["old"] && mastery_overall <= 100`},
+	})
+	if err != nil {
+		t.Fatalf("DeleteByFilter: %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 deleted, got %d", deleted)
+	}
+
+	assertTerms := func(userID int64, want ...string) {
+		t.Helper()
+		items, _, err := repo.List(ctx, &repository.ListLearnedLexemeQuery{UserID: userID})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		var got []string
+		for _, item := range items {
+			got = append(got, item.Term)
+		}
+		sort.Strings(got)
+		if len(got) != len(want) {
+			t.Fatalf("user %d terms = %v, want %v", userID, got, want)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("user %d terms = %v, want %v", userID, got, want)
+			}
+		}
+	}
+	assertTerms(1, "banana", "cherry")
+	assertTerms(2, "apple")
+
+	if _, err := repo.DeleteByFilter(ctx, &repository.ListLearnedLexemeQuery{
+		UserID:      1,
+		FilterOrder: repository.FilterOrder{Filter: `unknown == "x"`},
+	}); err == nil {
+		t.Fatal("expected invalid filter to be rejected")
+	}
+	assertTerms(1, "banana", "cherry")
+}
+
+func TestLearnedLexemeRepositoryCountMatchesListTotal(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "count.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+	for i, term := range []string{"apple", "apricot", "banana", "cherry", "avocado"} {
+		tags := []string{"fruit"}
+		if i%2 == 0 {
+			tags = append(tags, "tropical")
+		}
+		if _, err := repo.Create(ctx, &entity.LearnedLexeme{
+			UserID:   1,
+			Term:     term,
+			Language: entity.LanguageEnglish,
+			Tags:     tags,
+			Mastery:  entity.MasteryBreakdown{Overall: int32(100 * i)},
+		}); err != nil {
+			t.Fatalf("seed %q: %v", term, err)
+		}
+	}
+	if _, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 2, Term: "apple", Language: entity.LanguageEnglish, Tags: []string{"tropical"}}); err != nil {
+		t.Fatalf("seed other user: %v", err)
+	}
+
+	var mu sync.Mutex
+	var ops []string
+	client.Intercept(entdb.InterceptFunc(func(next entdb.Querier) entdb.Querier {
+		return entdb.QuerierFunc(func(ctx context.Context, q entdb.Query) (entdb.Value, error) {
+			mu.Lock()
+			ops = append(ops, ent.QueryFromContext(ctx).Op)
+			mu.Unlock()
+			return next.Query(ctx, q)
+		})
+	}))
+
+	for _, filter := range []string{``, `tag in ["tropical"]`, `tag in ["tropical"] && mastery_overall >= 200`, `keyword == "ap"`} {
+		query := &repository.ListLearnedLexemeQuery{
+			UserID:      1,
+			Pagination:  repository.Pagination{PageNo: 1, PageSize: 1},
+			FilterOrder: repository.FilterOrder{Filter: filter},
+		}
+		_, total, err := repo.List(ctx, query)
+		if err != nil {
+			t.Fatalf("List(%q): %v", filter, err)
+		}
+
+		mu.Lock()
+		ops = nil
+		mu.Unlock()
+		count, err := repo.Count(ctx, query)
+		if err != nil {
+			t.Fatalf("Count(%q): %v", filter, err)
+		}
+		if count != total {
+			t.Fatalf("Count(%q) = %d, List total = %d", filter, count, total)
+		}
+		mu.Lock()
+		if !slices.Equal(ops, []string{ent.OpQueryCount}) {
+			t.Fatalf("Count(%q) ran queries %v, want only a count", filter, ops)
+		}
+		mu.Unlock()
+	}
+
+	if _, err := repo.Count(ctx, &repository.ListLearnedLexemeQuery{
+		UserID:      1,
+		FilterOrder: repository.FilterOrder{Filter: `unknown == "x"`},
+	}); err == nil {
+		t.Fatal("expected invalid filter to be rejected")
+	}
+}
+
+func TestLearnedLexemeRepositoryStampsTimestamps(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "stamps.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := &LearnedLexemeRepository{client: client, now: steppingClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))}
+
+	created, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 1, Term: "stamp", Language: entity.LanguageEnglish, QueryCount: 1})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.CreatedAt.IsZero() || created.UpdatedAt.IsZero() {
+		t.Fatalf("timestamps not populated: %+v", created)
+	}
+
+	created.QueryCount++
+	created.UpdatedAt = time.Time{}
+	updated, err := repo.Update(ctx, created)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("created_at changed on update: %v -> %v", created.CreatedAt, updated.CreatedAt)
+	}
+	if !updated.UpdatedAt.After(created.CreatedAt) {
+		t.Fatalf("updated_at not advanced: %v -> %v", created.CreatedAt, updated.UpdatedAt)
+	}
+}
+
+func TestReattachDictionaryWords(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reattach.db")
+	client := enttest.Open(t, dialect.SQLite, "file:"+path+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	addWord := func(text string) int {
+		t.Helper()
+		w, err := client.Word.Create().SetText(text).SetNormalized(entity.NormalizeWordToken(text)).SetLanguage("en").Save(ctx)
+		if err != nil {
+			t.Fatalf("seed word %q: %v", text, err)
+		}
+		return w.ID
+	}
+	appleID := addWord("apple")
+	bananaID := addWord("banana")
+
+	repo := NewLearnedLexemeRepository(client)
+	lexemeIDs := make(map[string]int64)
+	for _, term := range []string{"Apple", "banana", "cherry"} {
+		l, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 1, Term: term, Language: entity.LanguageEnglish})
+		if err != nil {
+			t.Fatalf("seed lexeme %q: %v", term, err)
+		}
+		lexemeIDs[term] = l.ID
+	}
+	wordID := func(term string) *int {
+		t.Helper()
+		return client.LearnedLexeme.GetX(ctx, int(lexemeIDs[term])).WordID
+	}
+	if id := wordID("Apple"); id == nil || *id != appleID {
+		t.Fatalf("Apple not linked on create: %v", id)
+	}
+
+	// Delete the word behind the foreign key's back, as a restore without constraints would.
+	raw, err := sql.Open("sqlite3", "file:"+path)
+	if err != nil {
+		t.Fatalf("open raw db: %v", err)
+	}
+	if _, err := raw.ExecContext(ctx, "DELETE FROM "+entword.Table+" WHERE id = ?", appleID); err != nil {
+		t.Fatalf("delete word: %v", err)
+	}
+	raw.Close()
+
+	stats, _, err := repo.ReattachDictionaryWords(ctx, 0, 100)
+	if err != nil {
+		t.Fatalf("reattach: %v", err)
+	}
+	if stats != (repository.ReattachStats{Scanned: 2, Cleared: 1}) {
+		t.Fatalf("stats = %+v, want 2 scanned and 1 cleared", stats)
+	}
+	if id := wordID("Apple"); id != nil {
+		t.Fatalf("stale link kept: %d", *id)
+	}
+
+	cherryID := addWord("cherry")
+	stats, _, err = repo.ReattachDictionaryWords(ctx, 0, 100)
+	if err != nil {
+		t.Fatalf("reattach: %v", err)
+	}
+	if stats != (repository.ReattachStats{Scanned: 2, Linked: 1}) {
+		t.Fatalf("stats = %+v, want 2 scanned and 1 linked", stats)
+	}
+	if id := wordID("cherry"); id == nil || *id != cherryID {
+		t.Fatalf("cherry not linked to new word: %v", id)
+	}
+	if id := wordID("banana"); id == nil || *id != bananaID {
+		t.Fatalf("banana link changed: %v", id)
+	}
+}
+
+func TestLearnedLexemeRepositoryTagSemantics(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "tags.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+
+	created, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 1, Term: "apple", Language: entity.LanguageEnglish})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.Tags == nil || len(created.Tags) != 0 {
+		t.Fatalf("nil tags on create must store an empty list, got %#v", created.Tags)
+	}
+
+	steps := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "set", tags: []string{"fruit", "red"}, want: []string{"fruit", "red"}},
+		{name: "leave unchanged", tags: nil, want: []string{"fruit", "red"}},
+		{name: "replace", tags: []string{"food"}, want: []string{"food"}},
+		{name: "clear", tags: []string{}, want: []string{}},
+		{name: "leave cleared", tags: nil, want: []string{}},
+	}
+	for _, step := range steps {
+		lexeme := *created
+		lexeme.Tags = step.tags
+		if _, err := repo.Update(ctx, &lexeme); err != nil {
+			t.Fatalf("%s: update: %v", step.name, err)
+		}
+		stored, err := repo.GetByID(ctx, 1, created.ID)
+		if err != nil {
+			t.Fatalf("%s: get: %v", step.name, err)
+		}
+		if !slices.Equal(stored.Tags, step.want) {
+			t.Fatalf("%s: tags = %v, want %v", step.name, stored.Tags, step.want)
+		}
+	}
+}
+
+func TestLearnedLexemeRepositoryFiltersByReviewState(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "review.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	seed := []struct {
+		term    string
+		overall int32
+		next    time.Time
+	}{
+		{term: "fresh", overall: 0},
+		{term: "fresh-due", overall: 0, next: now.Add(-time.Minute)},
+		{term: "started", overall: 1},
+		{term: "halfway", overall: 250, next: now.Add(24 * time.Hour)},
+		{term: "almost", overall: entity.ReviewStateMasteredMinOverall - 1, next: now},
+		{term: "known", overall: entity.ReviewStateMasteredMinOverall},
+		{term: "expert", overall: 500, next: now.Add(-48 * time.Hour)},
+	}
+	for _, s := range seed {
+		if _, err := repo.Create(ctx, &entity.LearnedLexeme{
+			UserID:   1,
+			Term:     s.term,
+			Language: entity.LanguageEnglish,
+			Mastery:  entity.MasteryBreakdown{Overall: s.overall},
+			Review:   entity.ReviewTiming{NextReviewAt: s.next},
+		}); err != nil {
+			t.Fatalf("seed %q: %v", s.term, err)
+		}
+	}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `review_state == "new"`, want: []string{"fresh", "fresh-due"}},
+		{filter: `review_state == "learning"`, want: []string{"almost", "halfway", "started"}},
+		{filter: `review_state == "mastered"`, want: []string{"expert", "known"}},
+		{filter: `review_state == "due"`, want: []string{"almost", "expert", "fresh-due"}},
+		{filter: `review_state == "Due" && mastery_overall >= 400`, want: []string{"expert"}},
+	}
+	for _, tc := range tests {
+		items, total, err := repo.List(ctx, &repository.ListLearnedLexemeQuery{
+			UserID:      1,
+			FilterOrder: repository.FilterOrder{Filter: tc.filter},
+			Now:         now,
+		})
+		if err != nil {
+			t.Fatalf("List(%s): %v", tc.filter, err)
+		}
+		got := make([]string, 0, len(items))
+		for _, item := range items {
+			got = append(got, item.Term)
+		}
+		sort.Strings(got)
+		if total != int64(len(tc.want)) || !slices.Equal(got, tc.want) {
+			t.Fatalf("List(%s) = %v (total %d), want %v", tc.filter, got, total, tc.want)
+		}
+	}
+
+	_, _, err := repo.List(ctx, &repository.ListLearnedLexemeQuery{
+		UserID:      1,
+		FilterOrder: repository.FilterOrder{Filter: `review_state == "forgotten"`},
+	})
+	if !errors.Is(err, entity.ErrInvalidReviewState) {
+		t.Fatalf("expected ErrInvalidReviewState, got %v", err)
+	}
+}
+
+func TestUpdateManyLoadsOnlyOwnedLexemes(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "many.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+	mine, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 1, Term: "bridge", Language: entity.LanguageEnglish})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	theirs, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 2, Term: "river", Language: entity.LanguageEnglish})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	saved, err := repo.UpdateMany(ctx, 1, []int64{mine.ID, theirs.ID, 404}, func(loaded map[int64]*entity.LearnedLexeme) ([]*entity.LearnedLexeme, error) {
+		if len(loaded) != 1 || loaded[mine.ID] == nil {
+			return nil, fmt.Errorf("loaded %v, want only lexeme %d", slices.Collect(maps.Keys(loaded)), mine.ID)
+		}
+		lexeme := loaded[mine.ID]
+		lexeme.Mastery.Overall = 300
+		return []*entity.LearnedLexeme{lexeme}, nil
+	})
+	if err != nil {
+		t.Fatalf("UpdateMany: %v", err)
+	}
+	if len(saved) != 1 || saved[0].Mastery.Overall != 300 {
+		t.Fatalf("saved = %+v, want bridge with overall 300", saved)
+	}
+
+	// An error from apply is returned and nothing is written.
+	boom := errors.New("boom")
+	_, err = repo.UpdateMany(ctx, 1, []int64{mine.ID}, func(loaded map[int64]*entity.LearnedLexeme) ([]*entity.LearnedLexeme, error) {
+		loaded[mine.ID].Mastery.Overall = 0
+		return nil, boom
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("UpdateMany error = %v, want boom", err)
+	}
+	stored, err := repo.GetByID(ctx, 1, mine.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if stored.Mastery.Overall != 300 {
+		t.Fatalf("overall = %d, want 300", stored.Mastery.Overall)
+	}
+}
+
+func TestIncrementQueryCountConcurrent(t *testing.T) {
+	// Immediate transactions and a busy timeout let SQLite serialize the parallel writers
+	// instead of failing them with SQLITE_BUSY.
+	dsn := "file:" + filepath.Join(t.TempDir(), "collect.db") + "?_fk=1&_busy_timeout=10000&_txlock=immediate&_journal_mode=WAL"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+	first, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 1, Term: "busy", Language: entity.LanguageEnglish, QueryCount: 1})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	const calls = 20
+	var wg sync.WaitGroup
+	counts := make(chan int64, calls)
+	errs := make(chan error, calls)
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			count, err := repo.IncrementQueryCount(ctx, 1, first.ID)
+			if err != nil {
+				errs <- err
+				return
+			}
+			counts <- count
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	close(counts)
+	for err := range errs {
+		t.Fatalf("parallel increment: %v", err)
+	}
+	// Every increment observes its own value, so none was lost or applied twice.
+	seen := make(map[int64]bool, calls)
+	for count := range counts {
+		if seen[count] {
+			t.Fatalf("query count %d returned twice", count)
+		}
+		seen[count] = true
+	}
+
+	got, err := repo.GetByID(ctx, 1, first.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.QueryCount != calls+1 {
+		t.Fatalf("query count = %d, want %d", got.QueryCount, calls+1)
+	}
+}
+
+func TestFindByNormalizedTermMatchesCaseVariants(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "case.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+	apple, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 1, Term: "apple", Language: entity.LanguageEnglish})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	got, err := repo.FindByNormalizedTerm(ctx, 1, entity.LanguageEnglish, "APPLE")
+	if err != nil {
+		t.Fatalf("find by normalized term: %v", err)
+	}
+	if got == nil || got.ID != apple.ID {
+		t.Fatalf("find APPLE = %+v, want lexeme %d", got, apple.ID)
+	}
+	for _, tc := range []struct {
+		userID   int64
+		language entity.Language
+	}{
+		{userID: 1, language: entity.LanguageFrench},
+		{userID: 2, language: entity.LanguageEnglish},
+	} {
+		got, err := repo.FindByNormalizedTerm(ctx, tc.userID, tc.language, "apple")
+		if err != nil || got != nil {
+			t.Fatalf("find for user %d in %s = %+v, %v; want none", tc.userID, tc.language, got, err)
+		}
+	}
+}
+
+func TestCreateLexemeSurvivesDictionaryLookupFailure(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "lookup.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	client.Word.Create().SetText("apple").SetNormalized("apple").SetLanguage("en").SaveX(ctx)
+	lookupErr := errors.New("dictionary replica unavailable")
+	client.Word.Intercept(entdb.InterceptFunc(func(entdb.Querier) entdb.Querier {
+		return entdb.QuerierFunc(func(context.Context, entdb.Query) (entdb.Value, error) { return nil, lookupErr })
+	}))
+	repo := NewLearnedLexemeRepository(client)
+
+	created, err := repo.Create(ctx, &entity.LearnedLexeme{UserID: 1, Term: "apple", Language: entity.LanguageEnglish, QueryCount: 1})
+	if err != nil {
+		t.Fatalf("create with failing dictionary lookup: %v", err)
+	}
+	if created.WordID != nil {
+		t.Fatalf("word link = %d, want none", *created.WordID)
+	}
+	if _, err := repo.Update(ctx, created); err != nil {
+		t.Fatalf("update with failing dictionary lookup: %v", err)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := repo.Create(cancelled, &entity.LearnedLexeme{UserID: 1, Term: "pear", Language: entity.LanguageEnglish}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("create with cancelled context: err = %v, want context.Canceled", err)
+	}
+}
@@ -1,6 +1,14 @@
 package repository
 
-import "github.com/eslsoft/vocnet/pkg/filterexpr"
+import (
+	"fmt"
+	"reflect"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
+)
 
 var listWordsSchema = filterexpr.ResourceSchema{
 	Filter: map[string]filterexpr.FilterField{
@@ -15,20 +23,46 @@ var listWordsSchema = filterexpr.ResourceSchema{
 				filterexpr.OpIN: "Words",
 			},
 		},
+		// word_type == "past" or word_type in ["past", "pp"].
 		"word_type": {
 			Kind: filterexpr.KindString,
-			Ops:  map[filterexpr.Op]string{filterexpr.OpEQ: "WordType"},
+			Ops: map[filterexpr.Op]string{
+				filterexpr.OpEQ: "WordType",
+				filterexpr.OpIN: "WordTypes",
+			},
+		},
+		// Dictionary tags (e.g. ECDICT's zk/gk/cet4) are stored in the categories column,
+		// so both fields filter the same data; tag is kept for parity with learned lexemes.
+		"tag": {
+			Kind: filterexpr.KindString,
+			Ops:  map[filterexpr.Op]string{filterexpr.OpIN: "Tags"},
+		},
+		"category": {
+			Kind: filterexpr.KindString,
+			Ops:  map[filterexpr.Op]string{filterexpr.OpIN: "Categories"},
+		},
+		// cefr == "B1" or cefr in ["A1", "A2"]; see entity.CEFRLevel.
+		"cefr": {
+			Kind: filterexpr.KindString,
+			Ops: map[filterexpr.Op]string{
+				filterexpr.OpEQ: "CEFRLevels",
+				filterexpr.OpIN: "CEFRLevels",
+			},
+			Setter: setCEFRLevels,
 		},
 	},
+	// Dictionary browsing without an order_by lists entries alphabetically.
 	Order: filterexpr.OrderSchema{
-		DefaultPrimary:     "created_at",
-		DefaultPrimaryDesc: true,
+		DefaultPrimary:     "text",
+		DefaultPrimaryDesc: false,
 		FallbackKey:        "id",
 		FallbackDesc:       false,
 		Fields: map[string]filterexpr.OrderField{
 			"created_at": {Expr: "created_at", Nulls: "last"},
 			"updated_at": {Expr: "updated_at", Nulls: "last"},
 			"text":       {Expr: "text", Nulls: "last"},
+			"frequency":  {Expr: "frequency", Nulls: "last"},
+			"cefr":       {Expr: "cefr", Nulls: "last"},
 			"id":         {Expr: "id", Nulls: "last"},
 		},
 	},
@@ -55,6 +89,19 @@ var listLearnedLexemesSchema = filterexpr.ResourceSchema{
 			Kind: filterexpr.KindString,
 			Ops:  map[filterexpr.Op]string{filterexpr.OpIN: "Categories"},
 		},
+		"mastery_overall": {
+			Kind: filterexpr.KindNumber,
+			Ops: map[filterexpr.Op]string{
+				filterexpr.OpGTE: "MasteryOverallMin",
+				filterexpr.OpLTE: "MasteryOverallMax",
+			},
+		},
+		// review_state == "new" | "learning" | "mastered" | "due"; see entity.ReviewState.
+		"review_state": {
+			Kind:   filterexpr.KindString,
+			Ops:    map[filterexpr.Op]string{filterexpr.OpEQ: "ReviewState"},
+			Setter: setReviewState,
+		},
 	},
 	Order: filterexpr.OrderSchema{
 		DefaultPrimary:     "updated_at",
@@ -70,3 +117,55 @@ var listLearnedLexemesSchema = filterexpr.ResourceSchema{
 		},
 	},
 }
+
+// orderTerm is one resolved order_by key applied through the per-resource order field maps.
+type orderTerm struct {
+	key  string
+	desc bool
+}
+
+func (t orderTerm) options() []sql.OrderTermOption {
+	if t.desc {
+		return []sql.OrderTermOption{sql.OrderDesc(), sql.OrderNullsLast()}
+	}
+	return []sql.OrderTermOption{sql.OrderAsc(), sql.OrderNullsLast()}
+}
+
+// unhandledOrderKey is returned when a key passes the order schema but has no ent ordering,
+// so a drift between the two fails the request instead of silently dropping the sort.
+func unhandledOrderKey(key string) error {
+	return fmt.Errorf("repository: %w", &filterexpr.UnsupportedOrderKeyError{Key: key})
+}
+
+func setReviewState(field reflect.Value, value any) error {
+	raw, _ := value.(string)
+	state, err := entity.ParseReviewState(raw)
+	if err != nil {
+		return err
+	}
+	field.Set(reflect.ValueOf(state))
+	return nil
+}
+
+// setCEFRLevels accepts one level for == and a list for in, canonicalizing each.
+func setCEFRLevels(field reflect.Value, value any) error {
+	var raw []string
+	switch v := value.(type) {
+	case string:
+		raw = []string{v}
+	case []string:
+		raw = v
+	default:
+		return fmt.Errorf("%w: unsupported literal %T", entity.ErrInvalidCEFRLevel, value)
+	}
+	levels := make([]string, 0, len(raw))
+	for _, r := range raw {
+		level, err := entity.ParseCEFRLevel(r)
+		if err != nil {
+			return err
+		}
+		levels = append(levels, string(level))
+	}
+	field.Set(reflect.ValueOf(levels))
+	return nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/repository"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
+)
+
+// TestOrderFieldsCoverSchemas fails when an order key is whitelisted in a schema without an ent
+// ordering behind it, which would otherwise turn that order_by into an error at request time.
+func TestOrderFieldsCoverSchemas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		schema   filterexpr.OrderSchema
+		handlers func(string) bool
+	}{
+		"words": {
+			schema:   listWordsSchema.Order,
+			handlers: func(key string) bool { _, ok := wordOrderFields[key]; return ok },
+		},
+		"learned lexemes": {
+			schema:   listLearnedLexemesSchema.Order,
+			handlers: func(key string) bool { _, ok := learnedLexemeOrderFields[key]; return ok },
+		},
+	} {
+		for key := range tc.schema.Fields {
+			if !tc.handlers(key) {
+				t.Errorf("%s: order key %q is in the schema but has no ordering handler", name, key)
+			}
+		}
+		// The defaults apply to every request without an order_by, so they must resolve too.
+		for _, key := range []string{tc.schema.DefaultPrimary, tc.schema.FallbackKey} {
+			if _, ok := tc.schema.Fields[key]; !ok || !tc.handlers(key) {
+				t.Errorf("%s: default order key %q has no ordering handler", name, key)
+			}
+		}
+	}
+}
+
+func TestListOrdersByEveryLexemeKey(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "order.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+	repo := NewLearnedLexemeRepository(client)
+
+	for i, term := range []string{"cherry", "apple", "banana"} {
+		if _, err := repo.Create(ctx, &entity.LearnedLexeme{
+			UserID: 1, Term: term, Language: entity.LanguageEnglish,
+			Mastery: entity.MasteryBreakdown{Overall: int32(100 * (i + 1))},
+		}); err != nil {
+			t.Fatalf("create %s: %v", term, err)
+		}
+	}
+
+	for orderBy, want := range map[string]string{
+		"lexeme":               "[apple banana cherry]",
+		"lexeme desc":          "[cherry banana apple]",
+		"mastery_overall desc": "[banana apple cherry]",
+		"id":                   "[cherry apple banana]",
+	} {
+		items, _, err := repo.List(ctx, &repository.ListLearnedLexemeQuery{
+			UserID:      1,
+			FilterOrder: repository.FilterOrder{OrderBy: orderBy},
+		})
+		if err != nil {
+			t.Fatalf("%s: list: %v", orderBy, err)
+		}
+		got := make([]string, 0, len(items))
+		for _, item := range items {
+			got = append(got, item.Term)
+		}
+		if fmt.Sprint(got) != want {
+			t.Fatalf("%s: got %v, want %s", orderBy, got, want)
+		}
+	}
+
+	_, _, err := repo.List(ctx, &repository.ListLearnedLexemeQuery{UserID: 1, FilterOrder: repository.FilterOrder{OrderBy: "color"}})
+	var orderErr *filterexpr.UnsupportedOrderKeyError
+	if !errors.As(err, &orderErr) || orderErr.Key != "color" {
+		t.Fatalf("expected UnsupportedOrderKeyError for color, got %v", err)
+	}
+}
@@ -1,35 +1,49 @@
 package repository
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database"
 	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/predicate"
 	entword "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
 	"github.com/eslsoft/vocnet/internal/repository"
 	"github.com/eslsoft/vocnet/pkg/filterexpr"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
 	"github.com/samber/lo"
 )
 
 type wordRepository struct {
 	client *entdb.Client
+	// now stamps created_at/updated_at so timestamps are authoritative server-side.
+	now func() time.Time
 }
 
 // NewWordRepository constructs an ent-backed word repository.
 func NewWordRepository(client *entdb.Client) repository.WordRepository {
-	return &wordRepository{client: client}
+	return &wordRepository{client: client, now: time.Now}
 }
 
 type listWordsParams struct {
 	Language      string
 	Keyword       string
 	WordType      string
+	WordTypes     []string
 	Words         []string
+	Tags          []string
+	Categories    []string
+	CEFRLevels    []string
 	PrimaryKey    string
 	PrimaryDesc   bool
 	SecondaryKey  string
@@ -37,44 +51,80 @@ type listWordsParams struct {
 }
 
 func (r *wordRepository) Create(ctx context.Context, word *entity.Word) (*entity.Word, error) {
+	now := r.now()
 	builder := r.client.Word.Create().
 		SetText(word.Text).
 		SetNormalized(entity.NormalizeWordToken(word.Text)).
 		SetLanguage(entity.NormalizeLanguage(word.Language).Code()).
-		SetWordType(defaultWordType(word.WordType)).
+		SetWordType(string(defaultWordType(word.WordType))).
 		SetNillableLemma(normalizeLemma(word.Lemma)).
 		SetPhonetics(word.Phonetics).
 		SetDefinitions(word.Definitions).
 		SetPhrases(word.Phrases).
 		SetSentences(word.Sentences).
 		SetRelations(word.Relations).
-		SetCategories(word.Categories)
+		SetCategories(word.Categories).
+		SetSource(string(word.Source)).
+		SetFrequency(word.Frequency).
+		SetCefr(string(word.CEFR)).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
 
 	rec, err := builder.Save(ctx)
 	if err != nil {
-		return nil, translateWordError(err)
+		return nil, translateWordError(err, word)
 	}
 
 	return mapEntWord(rec), nil
 }
 
-func (r *wordRepository) Update(ctx context.Context, word *entity.Word) (*entity.Word, error) {
-	mutation := r.client.Word.UpdateOneID(int(word.ID)).
-		SetText(word.Text).
-		SetNormalized(entity.NormalizeWordToken(word.Text)).
-		SetLanguage(entity.NormalizeLanguage(word.Language).Code()).
-		SetWordType(defaultWordType(word.WordType)).
-		SetPhonetics(word.Phonetics).
-		SetDefinitions(word.Definitions).
-		SetPhrases(word.Phrases).
-		SetSentences(word.Sentences).
-		SetRelations(word.Relations).
-		SetCategories(word.Categories)
+func (r *wordRepository) Update(ctx context.Context, word *entity.Word, fields ...entity.WordField) (*entity.Word, error) {
+	return r.update(ctx, r.client.Word, word, fields)
+}
 
-	if lemma := normalizeLemma(word.Lemma); lemma != nil {
-		mutation.SetLemma(*lemma)
-	} else {
-		mutation.ClearLemma()
+// update applies Update through words, which is either the client's or a transaction's word client.
+func (r *wordRepository) update(ctx context.Context, words *entdb.WordClient, word *entity.Word, fields []entity.WordField) (*entity.Word, error) {
+	masked := func(field entity.WordField) bool {
+		return len(fields) == 0 || lo.Contains(fields, field)
+	}
+
+	mutation := words.UpdateOneID(int(word.ID)).SetUpdatedAt(r.now())
+	if masked(entity.WordFieldText) {
+		mutation.SetText(word.Text).SetNormalized(entity.NormalizeWordToken(word.Text))
+	}
+	if masked(entity.WordFieldLanguage) {
+		mutation.SetLanguage(entity.NormalizeLanguage(word.Language).Code())
+	}
+	if masked(entity.WordFieldWordType) {
+		mutation.SetWordType(string(defaultWordType(word.WordType)))
+	}
+	if masked(entity.WordFieldLemma) {
+		if lemma := normalizeLemma(word.Lemma); lemma != nil {
+			mutation.SetLemma(*lemma)
+		} else {
+			mutation.ClearLemma()
+		}
+	}
+	if masked(entity.WordFieldPhonetics) {
+		mutation.SetPhonetics(word.Phonetics)
+	}
+	if masked(entity.WordFieldDefinitions) {
+		mutation.SetDefinitions(word.Definitions)
+	}
+	if masked(entity.WordFieldPhrases) {
+		mutation.SetPhrases(word.Phrases)
+	}
+	if masked(entity.WordFieldSentences) {
+		mutation.SetSentences(word.Sentences)
+	}
+	if masked(entity.WordFieldRelations) {
+		mutation.SetRelations(word.Relations)
+	}
+	if masked(entity.WordFieldCategories) {
+		mutation.SetCategories(word.Categories)
+	}
+	if word.Source != entity.WordSourceUnknown {
+		mutation.SetSource(string(word.Source))
 	}
 
 	rec, err := mutation.Save(ctx)
@@ -82,7 +132,7 @@ func (r *wordRepository) Update(ctx context.Context, word *entity.Word) (*entity
 		if entdb.IsNotFound(err) {
 			return nil, entity.ErrVocNotFound
 		}
-		return nil, translateWordError(err)
+		return nil, translateWordError(err, word)
 	}
 
 	return mapEntWord(rec), nil
@@ -100,27 +150,35 @@ func (r *wordRepository) GetByID(ctx context.Context, id int64) (*entity.Word, e
 	return mapEntWord(rec), nil
 }
 
+func (r *wordRepository) GetByIDs(ctx context.Context, ids []int64) ([]*entity.Word, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	keys := make([]int, len(ids))
+	for i, id := range ids {
+		keys[i] = int(id)
+	}
+	recs, err := r.client.Word.Query().Where(entword.IDIn(keys...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get words: %w", err)
+	}
+	words := make([]*entity.Word, len(recs))
+	for i, rec := range recs {
+		words[i] = mapEntWord(rec)
+	}
+	return words, nil
+}
+
 func (r *wordRepository) Lookup(ctx context.Context, text string, language entity.Language) (*entity.Word, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
 
-	normalizedLang := entity.NormalizeLanguage(language).Code()
 	rec, err := r.client.Word.Query().
 		Where(
 			entword.TextEQ(text),
-			entword.LanguageEQ(normalizedLang),
+			entword.LanguageEQ(entity.NormalizeLanguage(language).Code()),
 		).
-		Order(func(s *sql.Selector) {
-			s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
-				b.WriteString("CASE WHEN ")
-				b.WriteString(s.C(entword.FieldWordType))
-				b.WriteString(" = ")
-				b.Arg(entity.WordTypeLemma)
-				b.WriteString(" THEN 0 ELSE 1 END")
-			}))
-			s.OrderBy(s.C(entword.FieldID))
-		}).
 		First(ctx)
 	if err != nil {
 		if entdb.IsNotFound(err) {
@@ -132,9 +190,46 @@ func (r *wordRepository) Lookup(ctx context.Context, text string, language entit
 	return mapEntWord(rec), nil
 }
 
+func (r *wordRepository) LookupMany(ctx context.Context, texts []string, language entity.Language) ([]*entity.Word, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	recs, err := r.client.Word.Query().
+		Where(
+			entword.TextIn(texts...),
+			entword.LanguageEQ(entity.NormalizeLanguage(language).Code()),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("lookup words: %w", err)
+	}
+	words := make([]*entity.Word, len(recs))
+	for i, rec := range recs {
+		words[i] = mapEntWord(rec)
+	}
+	return words, nil
+}
+
+func (r *wordRepository) GetByKey(ctx context.Context, language entity.Language, text string, wordType entity.WordType) (*entity.Word, error) {
+	rec, err := r.client.Word.Query().
+		Where(
+			entword.LanguageEQ(entity.NormalizeLanguage(language).Code()),
+			entword.TextEQ(text),
+			entword.WordTypeEQ(string(wordType)),
+		).
+		Only(ctx)
+	if err != nil {
+		if entdb.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get word by key: %w", err)
+	}
+	return mapEntWord(rec), nil
+}
+
 func (r *wordRepository) List(ctx context.Context, query *repository.ListWordQuery) ([]*entity.Word, int64, error) {
 	var params listWordsParams
-	if err := filterexpr.Bind(query, &params, listWordsSchema); err != nil {
+	if err := filterexpr.Bind(query, &params, listWordsSchema.WithLimits(query.Limits)); err != nil {
 		return nil, 0, err
 	}
 
@@ -146,14 +241,15 @@ func (r *wordRepository) List(ctx context.Context, query *repository.ListWordQue
 		return nil, 0, fmt.Errorf("count words: %w", err)
 	}
 
-	applyListOrdering(wordsQuery, params)
+	if err := applyListOrdering(wordsQuery, params); err != nil {
+		return nil, 0, err
+	}
 
-	offset := query.Offset()
-	if offset > 0 {
+	if offset := query.Offset(); offset > 0 {
 		wordsQuery.Offset(int(offset))
 	}
-	if query.PageSize > 0 {
-		wordsQuery.Limit(int(query.PageSize))
+	if limit := query.Limit(); limit > 0 {
+		wordsQuery.Limit(limit)
 	}
 
 	rows, err := wordsQuery.All(ctx)
@@ -165,10 +261,157 @@ func (r *wordRepository) List(ctx context.Context, query *repository.ListWordQue
 	for _, row := range rows {
 		results = append(results, mapEntWord(row))
 	}
+	if query != nil && query.IncludeForms {
+		if err := r.attachForms(ctx, results); err != nil {
+			return nil, 0, err
+		}
+	}
 
 	return results, int64(total), nil
 }
 
+// attachForms fills Forms on the lemma rows of words with a single lemma IN (...) query instead of
+// one ListFormsByLemma call per row. Lemmas without forms get an empty slice; forms keep nil.
+func (r *wordRepository) attachForms(ctx context.Context, words []*entity.Word) error {
+	type lemmaKey struct {
+		language string
+		text     string
+	}
+	lemmas := make(map[lemmaKey]*entity.Word)
+	texts := make([]string, 0, len(words))
+	for _, w := range words {
+		if w.WordType != entity.WordTypeLemma {
+			continue
+		}
+		w.Forms = []entity.WordFormRef{}
+		lemmas[lemmaKey{language: w.Language.Code(), text: w.Text}] = w
+		texts = append(texts, w.Text)
+	}
+	if len(texts) == 0 {
+		return nil
+	}
+
+	rows, err := r.client.Word.Query().
+		Where(
+			entword.LemmaIn(lo.Uniq(texts)...),
+			entword.WordTypeNEQ(string(entity.WordTypeLemma)),
+		).
+		Order(entword.ByText()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list forms: %w", err)
+	}
+	for _, row := range rows {
+		if row.Lemma == nil {
+			continue
+		}
+		if w, ok := lemmas[lemmaKey{language: row.Language, text: *row.Lemma}]; ok {
+			w.Forms = append(w.Forms, entity.WordFormRef{Text: row.Text, WordType: entity.WordType(row.WordType)})
+		}
+	}
+	return nil
+}
+
+// iterateBatchSize bounds how many rows Iterate holds in memory at once.
+const iterateBatchSize = 500
+
+func (r *wordRepository) Iterate(ctx context.Context, query *repository.ListWordQuery, fn func(*entity.Word) error) error {
+	var params listWordsParams
+	if err := filterexpr.Bind(query, &params, listWordsSchema.WithLimits(query.Limits)); err != nil {
+		return err
+	}
+
+	// Without an explicit order_by, iterate in id order so keyset paging applies; offsets are only
+	// used when the caller asks for an ordering keyset paging on id cannot reproduce.
+	explicitOrder := strings.TrimSpace(query.GetOrderBy()) != ""
+	keyset := params.Keyword == "" && (!explicitOrder || params.PrimaryKey == "id")
+	desc := explicitOrder && params.PrimaryKey == "id" && params.PrimaryDesc
+
+	var lastID int
+	offset := 0
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		batch := r.client.Word.Query()
+		applyListFilters(batch, params)
+		switch {
+		case keyset && desc:
+			if lastID > 0 {
+				batch.Where(entword.IDLT(lastID))
+			}
+			batch.Order(entword.ByID(sql.OrderDesc()))
+		case keyset:
+			batch.Where(entword.IDGT(lastID))
+			batch.Order(entword.ByID())
+		default:
+			if err := applyListOrdering(batch, params); err != nil {
+				return err
+			}
+			batch.Offset(offset)
+		}
+
+		rows, err := batch.Limit(iterateBatchSize).All(ctx)
+		if err != nil {
+			return fmt.Errorf("iterate words: %w", err)
+		}
+		for _, row := range rows {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(mapEntWord(row)); err != nil {
+				return err
+			}
+		}
+		if len(rows) < iterateBatchSize {
+			return nil
+		}
+		lastID = rows[len(rows)-1].ID
+		offset += len(rows)
+	}
+}
+
+func (r *wordRepository) StreamByTags(ctx context.Context, tags []string, language entity.Language, fn func(*entity.Word) error) error {
+	tags = lo.Map(uniqueFolded(tags), func(tag string, _ int) string { return strings.ToLower(tag) })
+	if len(tags) == 0 {
+		return errors.New("stream words by tags: at least one tag is required")
+	}
+	hasAnyTag := func(s *sql.Selector) {
+		column := s.C(entword.FieldCategories)
+		s.Where(sql.Or(lo.Map(tags, func(tag string, _ int) *sql.Predicate {
+			return sqljson.ValueContains(column, tag)
+		})...))
+	}
+
+	var lastID int
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rows, err := r.client.Word.Query().
+			Where(entword.LanguageEQ(language.CodeOrDefault()), entword.IDGT(lastID), hasAnyTag).
+			Order(entword.ByID()).
+			Limit(iterateBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("stream words by tags: %w", err)
+		}
+		for _, row := range rows {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := fn(mapEntWord(row)); err != nil {
+				return err
+			}
+		}
+		if len(rows) < iterateBatchSize {
+			return nil
+		}
+		lastID = rows[len(rows)-1].ID
+	}
+}
+
 func (r *wordRepository) Delete(ctx context.Context, id int64) error {
 	err := r.client.Word.DeleteOneID(int(id)).Exec(ctx)
 	if err != nil {
@@ -180,104 +423,234 @@ func (r *wordRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-// ListFormsByLemma returns all non-lemma forms (text + voc_type) for a lemma.
-func (r *wordRepository) ListFormsByLemma(ctx context.Context, lemma string, language entity.Language) ([]entity.WordFormRef, error) {
+// ListFormsByLemma returns the non-lemma forms (text + voc_type) for a lemma, optionally
+// restricted to some word types and capped at query.Limit.
+func (r *wordRepository) ListFormsByLemma(ctx context.Context, lemma string, language entity.Language, query repository.ListFormsQuery) ([]entity.WordFormRef, error) {
 	if strings.TrimSpace(lemma) == "" {
 		return []entity.WordFormRef{}, nil
 	}
 
-	rows, err := r.client.Word.Query().
+	q := r.client.Word.Query().
 		Where(
 			entword.LanguageEQ(entity.NormalizeLanguage(language).Code()),
 			entword.LemmaEQ(lemma),
-		).
-		Order(entword.ByText()).
-		All(ctx)
+			entword.WordTypeNEQ(string(entity.WordTypeLemma)),
+		)
+	if len(query.WordTypes) > 0 {
+		q = q.Where(entword.WordTypeIn(lo.Map(query.WordTypes, func(t entity.WordType, _ int) string { return string(t) })...))
+	}
+	if query.Limit > 0 {
+		q = q.Limit(query.Limit)
+	}
+	rows, err := q.Order(entword.ByText()).All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("list forms: %w", err)
 	}
 
 	forms := make([]entity.WordFormRef, 0, len(rows))
 	for _, row := range rows {
-		if row.WordType == entity.WordTypeLemma {
-			continue
-		}
 		forms = append(forms, entity.WordFormRef{
 			Text:     row.Text,
-			WordType: row.WordType,
+			WordType: entity.WordType(row.WordType),
 		})
 	}
 	return forms, nil
 }
 
+// AppendSentences merges sentences into the stored list, deduping by text and source.
+func (r *wordRepository) AppendSentences(ctx context.Context, wordID int64, sentences []entity.Sentence) (err error) {
+	if len(sentences) == 0 {
+		return nil
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rec, err := tx.Word.Get(ctx, int(wordID))
+	if err != nil {
+		if entdb.IsNotFound(err) {
+			return entity.ErrVocNotFound
+		}
+		return fmt.Errorf("get word: %w", err)
+	}
+
+	merged, added := entity.MergeSentences(rec.Sentences, sentences)
+	if added == 0 {
+		return tx.Commit()
+	}
+	if err = tx.Word.UpdateOneID(rec.ID).SetSentences(merged).SetUpdatedAt(r.now()).Exec(ctx); err != nil {
+		return fmt.Errorf("append sentences: %w", translateWordError(err, nil))
+	}
+	return tx.Commit()
+}
+
+func (r *wordRepository) LanguageStats(ctx context.Context) ([]entity.LanguageCount, error) {
+	var rows []struct {
+		Language string `json:"language"`
+		Count    int64  `json:"count"`
+	}
+	err := r.client.Word.Query().
+		GroupBy(entword.FieldLanguage).
+		Aggregate(entdb.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("count words by language: %w", err)
+	}
+	stats := make([]entity.LanguageCount, len(rows))
+	for i, row := range rows {
+		stats[i] = entity.LanguageCount{Language: entity.Language(row.Language), Count: row.Count}
+	}
+	// Ties break by code so the order is stable.
+	slices.SortFunc(stats, func(a, b entity.LanguageCount) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Language, b.Language)
+	})
+	return stats, nil
+}
+
 func applyListFilters(q *entdb.WordQuery, params listWordsParams) {
 	if params.Language == "" {
 		params.Language = entity.LanguageEnglish.CodeOrDefault()
 	}
 	q.Where(entword.LanguageEQ(params.Language))
 	if params.Keyword != "" {
-		q.Where(entword.TextContainsFold(params.Keyword))
+		q.Where(textContainsKeyword(params.Keyword))
 	}
 	if params.WordType != "" {
 		q.Where(entword.WordTypeEQ(params.WordType))
 	}
+	if len(params.WordTypes) > 0 {
+		q.Where(entword.WordTypeIn(params.WordTypes...))
+	}
 	if words := uniqueFolded(params.Words); len(words) > 0 {
 		q.Where(entword.NormalizedIn(lo.Map(words, func(word string, _ int) string { return strings.ToLower(word) })...))
 	}
+	if len(params.CEFRLevels) > 0 {
+		q.Where(entword.CefrIn(params.CEFRLevels...))
+	}
+	if categories := uniqueFolded(append(append([]string{}, params.Tags...), params.Categories...)); len(categories) > 0 {
+		q.Where(func(s *sql.Selector) {
+			column := s.C(entword.FieldCategories)
+			for _, category := range categories {
+				s.Where(sqljson.ValueContains(column, category))
+			}
+		})
+	}
+}
+
+// textContainsKeyword matches words whose text contains keyword, case-insensitively. On postgres
+// it compares LOWER(text) with LIKE, the expression the optional pg_trgm index covers
+// (DB_TRIGRAM_SEARCH); TextContainsFold emits ILIKE there, which that index cannot serve. Other
+// dialects keep TextContainsFold.
+func textContainsKeyword(keyword string) predicate.Word {
+	return func(s *sql.Selector) {
+		if s.Dialect() != dialect.Postgres {
+			entword.TextContainsFold(keyword)(s)
+			return
+		}
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.WriteString("LOWER(").WriteString(s.C(entword.FieldText)).WriteString(") LIKE ")
+			b.Arg("%" + escapeLike(strings.ToLower(keyword)) + "%")
+			b.WriteString(` ESCAPE '\'`)
+		}))
+	}
+}
+
+// wordOrderFields maps every order key whitelisted in listWordsSchema to its ent ordering.
+// TestOrderFieldsCoverSchemas keeps the two in sync so no key silently becomes a no-op.
+var wordOrderFields = map[string]func(...sql.OrderTermOption) entword.OrderOption{
+	"created_at": entword.ByCreatedAt,
+	"updated_at": entword.ByUpdatedAt,
+	"text":       entword.ByText,
+	"frequency":  byFrequency,
+	"cefr":       byCEFR,
+	"id":         entword.ByID,
+}
+
+// byFrequency orders by frequency rank with unranked (zero) words treated as the rarest, so they
+// trail "frequency asc" and lead "frequency desc".
+func byFrequency(opts ...sql.OrderTermOption) entword.OrderOption {
+	desc := sql.NewOrderTermOptions(opts...).Desc
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CASE WHEN ")
+			b.WriteString(s.C(entword.FieldFrequency))
+			b.WriteString(" > 0 THEN 0 ELSE 1 END")
+			if desc {
+				b.WriteString(" DESC")
+			}
+		}))
+		entword.ByFrequency(opts...)(s)
+	}
+}
+
+// byCEFR orders by CEFR level with unleveled words treated as harder than C2, so they trail
+// "cefr asc" and lead "cefr desc" like unranked words do for frequency.
+func byCEFR(opts ...sql.OrderTermOption) entword.OrderOption {
+	desc := sql.NewOrderTermOptions(opts...).Desc
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			b.WriteString("CASE WHEN ")
+			b.WriteString(s.C(entword.FieldCefr))
+			b.WriteString(" <> '' THEN 0 ELSE 1 END")
+			if desc {
+				b.WriteString(" DESC")
+			}
+		}))
+		entword.ByCefr(opts...)(s)
+	}
 }
 
-func applyListOrdering(q *entdb.WordQuery, params listWordsParams) {
+func applyListOrdering(q *entdb.WordQuery, params listWordsParams) error {
 	if params.Keyword != "" {
+		// Rank keyword matches exact, then prefix, then substring, all case-insensitively like the
+		// TextContainsFold filter, so "cat" lists "cat" and "catalog" ahead of "scatter".
+		keyword := strings.ToLower(params.Keyword)
 		q.Order(func(s *sql.Selector) {
 			s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
-				b.WriteString("CASE WHEN ")
+				b.WriteString("CASE WHEN LOWER(")
+				b.WriteString(s.C(entword.FieldText))
+				b.WriteString(") = ")
+				b.Arg(keyword)
+				b.WriteString(" THEN 0 WHEN LOWER(")
 				b.WriteString(s.C(entword.FieldText))
-				b.WriteString(" = ")
-				b.Arg(params.Keyword)
-				b.WriteString(" THEN 0 ELSE 1 END")
+				b.WriteString(") LIKE ")
+				b.Arg(escapeLike(keyword) + "%")
+				b.WriteString(` ESCAPE '\' THEN 1 ELSE 2 END`)
 			}))
 		})
 	}
 
-	for _, term := range []struct {
-		key  string
-		desc bool
-	}{
+	for _, term := range []orderTerm{
 		{key: params.PrimaryKey, desc: params.PrimaryDesc},
 		{key: params.SecondaryKey, desc: params.SecondaryDesc},
 	} {
 		if term.key == "" {
 			continue
 		}
-		switch term.key {
-		case "created_at":
-			if term.desc {
-				q.Order(entword.ByCreatedAt(sql.OrderDesc(), sql.OrderNullsLast()))
-			} else {
-				q.Order(entword.ByCreatedAt(sql.OrderAsc(), sql.OrderNullsLast()))
-			}
-		case "updated_at":
-			if term.desc {
-				q.Order(entword.ByUpdatedAt(sql.OrderDesc(), sql.OrderNullsLast()))
-			} else {
-				q.Order(entword.ByUpdatedAt(sql.OrderAsc(), sql.OrderNullsLast()))
-			}
-		case "text":
-			if term.desc {
-				q.Order(entword.ByText(sql.OrderDesc(), sql.OrderNullsLast()))
-			} else {
-				q.Order(entword.ByText(sql.OrderAsc(), sql.OrderNullsLast()))
-			}
-		case "id":
-			if term.desc {
-				q.Order(entword.ByID(sql.OrderDesc()))
-			} else {
-				q.Order(entword.ByID())
-			}
+		by, ok := wordOrderFields[term.key]
+		if !ok {
+			return unhandledOrderKey(term.key)
 		}
+		q.Order(by(term.options()...))
 	}
 
 	q.Order(entword.ByID())
+	return nil
+}
+
+// escapeLike escapes LIKE wildcards so a keyword matches literally; pair it with ESCAPE '\'.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
 }
 
 func mapEntWord(rec *entdb.Word) *entity.Word {
@@ -288,13 +661,16 @@ func mapEntWord(rec *entdb.Word) *entity.Word {
 		ID:          int64(rec.ID),
 		Text:        rec.Text,
 		Language:    entity.ParseLanguage(rec.Language),
-		WordType:    rec.WordType,
+		WordType:    entity.WordType(rec.WordType),
 		Phonetics:   rec.Phonetics,
 		Definitions: rec.Definitions,
 		Categories:  rec.Categories,
 		Phrases:     rec.Phrases,
 		Sentences:   rec.Sentences,
 		Relations:   rec.Relations,
+		Source:      entity.WordSource(rec.Source),
+		Frequency:   rec.Frequency,
+		CEFR:        entity.CEFRLevel(rec.Cefr),
 		CreatedAt:   rec.CreatedAt,
 		UpdatedAt:   rec.UpdatedAt,
 	}
@@ -316,28 +692,56 @@ func normalizeLemma(lemma *string) *string {
 	return &val
 }
 
-func defaultWordType(vt string) string {
+func defaultWordType(vt entity.WordType) entity.WordType {
 	if vt == "" {
 		return entity.WordTypeLemma
 	}
 	return vt
 }
 
-func translateWordError(err error) error {
+func translateWordError(err error, word *entity.Word) error {
 	if err == nil {
 		return nil
 	}
+	if v, ok := database.AsUniqueViolation(err); ok {
+		return duplicateWordError(v, word)
+	}
 	var pgErr *pgconn.PgError
-	if errors.As(err, &pgErr) {
-		switch pgErr.Code {
-		case "23505":
-			return entity.ErrDuplicateWord
-		case "23503":
-			return entity.ErrVocNotFound
-		}
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+		return entity.ErrVocNotFound
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return entity.ErrVocNotFound
 	}
 	if entdb.IsNotFound(err) {
 		return entity.ErrVocNotFound
 	}
+	if database.IsTransient(err) {
+		return fmt.Errorf("%w: %w", entity.ErrWriteConflict, err)
+	}
 	return err
 }
+
+// duplicateWordError prefers the values reported by the driver and falls back to the attempted word.
+func duplicateWordError(v *database.UniqueViolation, word *entity.Word) error {
+	dup := &entity.DuplicateWordError{}
+	if word != nil {
+		dup.Language = entity.NormalizeLanguage(word.Language)
+		dup.Text = word.Text
+		dup.WordType = defaultWordType(word.WordType)
+	}
+	if lang, ok := v.Values[entword.FieldLanguage]; ok {
+		dup.Language = entity.ParseLanguage(lang)
+	}
+	if text, ok := v.Values[entword.FieldText]; ok {
+		dup.Text = text
+	}
+	if wordType, ok := v.Values[entword.FieldWordType]; ok {
+		dup.WordType = entity.WordType(wordType)
+	}
+	if dup.Text == "" && dup.Language == entity.LanguageUnspecified {
+		return entity.ErrDuplicateWord
+	}
+	return dup
+}
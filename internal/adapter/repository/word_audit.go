@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/vocnet/internal/entity"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	entwordaudit "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
+	"github.com/eslsoft/vocnet/internal/repository"
+)
+
+type wordAuditRepository struct {
+	words *wordRepository
+}
+
+// NewWordAuditRepository constructs an ent-backed word audit repository.
+func NewWordAuditRepository(client *entdb.Client) repository.WordAuditRepository {
+	return &wordAuditRepository{words: &wordRepository{client: client, now: time.Now}}
+}
+
+func (r *wordAuditRepository) UpdateAudited(ctx context.Context, word *entity.Word, editor string, fields ...entity.WordField) (updated *entity.Word, err error) {
+	tx, err := r.words.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rec, err := tx.Word.Get(ctx, int(word.ID))
+	if err != nil {
+		if entdb.IsNotFound(err) {
+			return nil, entity.ErrVocNotFound
+		}
+		return nil, fmt.Errorf("get word: %w", err)
+	}
+	updated, err = r.words.update(ctx, tx.Word, word, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := entity.DiffWords(mapEntWord(rec), updated, fields...)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		err = tx.WordAudit.Create().
+			SetWordID(rec.ID).
+			SetEditor(editor).
+			SetChanges(changes).
+			SetCreatedAt(updated.UpdatedAt).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("record word audit: %w", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit word update: %w", err)
+	}
+	return updated, nil
+}
+
+func (r *wordAuditRepository) ListByWord(ctx context.Context, wordID int64, limit int) ([]*entity.WordAudit, error) {
+	q := r.words.client.WordAudit.Query().
+		Where(entwordaudit.WordID(int(wordID))).
+		Order(entwordaudit.ByID(sql.OrderDesc()))
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	rows, err := q.All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list word audit: %w", err)
+	}
+	audits := make([]*entity.WordAudit, len(rows))
+	for i, row := range rows {
+		audits[i] = &entity.WordAudit{
+			ID:        int64(row.ID),
+			WordID:    int64(row.WordID),
+			Editor:    row.Editor,
+			Changes:   row.Changes,
+			CreatedAt: row.CreatedAt,
+		}
+	}
+	return audits, nil
+}
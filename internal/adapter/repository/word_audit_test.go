@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+)
+
+func TestWordAuditRepositoryUpdateAudited(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "word_audit.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	words := NewWordRepository(client)
+	created, err := words.Create(ctx, &entity.Word{
+		Text:        "run",
+		Language:    entity.LanguageEnglish,
+		WordType:    entity.WordTypeLemma,
+		Definitions: []entity.WordDefinition{{Text: "move fast"}},
+	})
+	if err != nil {
+		t.Fatalf("seed word: %v", err)
+	}
+
+	audits := NewWordAuditRepository(client)
+	edit := *created
+	edit.Definitions = []entity.WordDefinition{{Text: "move quickly on foot"}}
+	edit.Categories = []string{"cet4"}
+	// text is masked but unchanged, so only definitions and categories belong in the diff.
+	fields := []entity.WordField{entity.WordFieldText, entity.WordFieldDefinitions, entity.WordFieldCategories}
+	updated, err := audits.UpdateAudited(ctx, &edit, "curator@example.com", fields...)
+	if err != nil {
+		t.Fatalf("UpdateAudited: %v", err)
+	}
+	if len(updated.Definitions) != 1 || updated.Definitions[0].Text != "move quickly on foot" {
+		t.Fatalf("update not applied: %+v", updated.Definitions)
+	}
+
+	rows := client.WordAudit.Query().AllX(ctx)
+	if len(rows) != 1 {
+		t.Fatalf("audit rows = %d, want 1", len(rows))
+	}
+	row := rows[0]
+	if row.WordID != int(created.ID) || row.Editor != "curator@example.com" {
+		t.Fatalf("audit row word_id=%d editor=%q", row.WordID, row.Editor)
+	}
+	want := map[entity.WordField]entity.FieldChange{
+		entity.WordFieldDefinitions: {Old: []byte(`[{"pos":"","text":"move fast","language":""}]`), New: []byte(`[{"pos":"","text":"move quickly on foot","language":""}]`)},
+		entity.WordFieldCategories:  {Old: []byte(`[]`), New: []byte(`["cet4"]`)},
+	}
+	if len(row.Changes) != len(want) {
+		t.Fatalf("changes = %v, want fields %v", row.Changes, want)
+	}
+	for field, change := range want {
+		got, ok := row.Changes[field]
+		if !ok || string(got.Old) != string(change.Old) || string(got.New) != string(change.New) {
+			t.Fatalf("change %s = %s -> %s, want %s -> %s", field, got.Old, got.New, change.Old, change.New)
+		}
+	}
+
+	// Writing the same values again changes nothing and records nothing.
+	if _, err := audits.UpdateAudited(ctx, &edit, "curator@example.com", fields...); err != nil {
+		t.Fatalf("repeat UpdateAudited: %v", err)
+	}
+	if n := client.WordAudit.Query().CountX(ctx); n != 1 {
+		t.Fatalf("no-op update recorded audit rows: %d", n)
+	}
+
+	edit.Text = "sprint"
+	if _, err := audits.UpdateAudited(ctx, &edit, "", entity.WordFieldText); err != nil {
+		t.Fatalf("rename: %v", err)
+	}
+	listed, err := audits.ListByWord(ctx, created.ID, 1)
+	if err != nil {
+		t.Fatalf("ListByWord: %v", err)
+	}
+	if len(listed) != 1 || listed[0].Editor != "" || string(listed[0].Changes[entity.WordFieldText].New) != `"sprint"` {
+		t.Fatalf("ListByWord newest = %+v", listed)
+	}
+
+	edit.ID = created.ID + 100
+	if _, err := audits.UpdateAudited(ctx, &edit, "curator@example.com"); err != entity.ErrVocNotFound {
+		t.Fatalf("missing word error = %v, want ErrVocNotFound", err)
+	}
+}
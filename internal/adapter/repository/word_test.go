@@ -0,0 +1,635 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/eslsoft/vocnet/internal/entity"
+	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/repository"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/samber/lo"
+)
+
+func TestWordRepositoryCreateDuplicateSQLite(t *testing.T) {
+	dsn := "file:" + filepath.Join(t.TempDir(), "words.db") + "?_fk=1"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+
+	assertDuplicateWord(t, client)
+}
+
+func TestWordRepositoryCreateDuplicatePostgres(t *testing.T) {
+	dsn := os.Getenv("VOCNET_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("VOCNET_TEST_POSTGRES_DSN not set")
+	}
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		t.Skipf("postgres unavailable: %v", err)
+	}
+	db.Close()
+
+	client := enttest.Open(t, dialect.Postgres, dsn)
+	t.Cleanup(func() { client.Close() })
+	t.Cleanup(func() { _, _ = client.Word.Delete().Exec(context.Background()) })
+
+	assertDuplicateWord(t, client)
+}
+
+func assertDuplicateWord(t *testing.T, client *entdb.Client) {
+	t.Helper()
+	ctx := context.Background()
+	repo := NewWordRepository(client)
+
+	if _, err := repo.Create(ctx, &entity.Word{Text: "dupcheck", Language: entity.LanguageEnglish}); err != nil {
+		t.Fatalf("create first word: %v", err)
+	}
+
+	_, err := repo.Create(ctx, &entity.Word{Text: "dupcheck", Language: entity.LanguageEnglish, WordType: "past", Lemma: strPtr("dup")})
+	if !errors.Is(err, entity.ErrDuplicateWord) {
+		t.Fatalf("expected ErrDuplicateWord, got %v", err)
+	}
+	var dup *entity.DuplicateWordError
+	if !errors.As(err, &dup) {
+		t.Fatalf("expected *entity.DuplicateWordError, got %T", err)
+	}
+	if dup.Language != entity.LanguageEnglish || dup.Text != "dupcheck" || dup.WordType != "past" {
+		t.Fatalf("unexpected duplicate details: %+v", dup)
+	}
+}
+
+func strPtr(s string) *string { return &s }
+
+func TestWordRepositoryIterateStopsOnCancel(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "iter.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	builders := make([]*entdb.WordCreate, 0, iterateBatchSize+10)
+	for i := 0; i < iterateBatchSize+10; i++ {
+		builders = append(builders, client.Word.Create().SetText(fmt.Sprintf("w%04d", i)).SetLanguage("en"))
+	}
+	if err := client.Word.CreateBulk(builders...).Exec(ctx); err != nil {
+		t.Fatalf("seed words: %v", err)
+	}
+
+	repo := NewWordRepository(client)
+	visited := 0
+	err := repo.Iterate(ctx, &repository.ListWordQuery{}, func(*entity.Word) error {
+		visited++
+		if visited == 3 {
+			cancel()
+		}
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if visited != 3 {
+		t.Fatalf("expected iteration to stop after 3 words, visited %d", visited)
+	}
+}
+
+func TestWordRepositoryIterateOrdersByText(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "order.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	for _, text := range []string{"pear", "apple", "fig"} {
+		if err := client.Word.Create().SetText(text).SetLanguage("en").Exec(ctx); err != nil {
+			t.Fatalf("seed word: %v", err)
+		}
+	}
+
+	var got []string
+	err := NewWordRepository(client).Iterate(ctx, &repository.ListWordQuery{FilterOrder: repository.FilterOrder{OrderBy: "text desc"}}, func(w *entity.Word) error {
+		got = append(got, w.Text)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("iterate: %v", err)
+	}
+	if fmt.Sprint(got) != "[pear fig apple]" {
+		t.Fatalf("unexpected order: %v", got)
+	}
+}
+
+func TestWordRepositoryListDefaultsToAlphabeticalOrder(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "default-order.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	for _, text := range []string{"pear", "apple", "fig"} {
+		if err := client.Word.Create().SetText(text).SetLanguage("en").Exec(ctx); err != nil {
+			t.Fatalf("seed word: %v", err)
+		}
+	}
+
+	words, _, err := NewWordRepository(client).List(ctx, &repository.ListWordQuery{})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	got := lo.Map(words, func(w *entity.Word, _ int) string { return w.Text })
+	if fmt.Sprint(got) != "[apple fig pear]" {
+		t.Fatalf("expected alphabetical order without order_by, got %v", got)
+	}
+}
+
+func TestWordRepositoryListOrdersByFrequency(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "frequency.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	for text, rank := range map[string]int{"apple": 2600, "the": 1, "zymurgy": 0, "fig": 9000} {
+		if err := client.Word.Create().SetText(text).SetLanguage("en").SetFrequency(rank).Exec(ctx); err != nil {
+			t.Fatalf("seed word: %v", err)
+		}
+	}
+
+	repo := NewWordRepository(client)
+	for orderBy, want := range map[string]string{
+		"frequency asc":  "[the apple fig zymurgy]",
+		"frequency desc": "[zymurgy fig apple the]",
+	} {
+		words, _, err := repo.List(ctx, &repository.ListWordQuery{FilterOrder: repository.FilterOrder{OrderBy: orderBy}})
+		if err != nil {
+			t.Fatalf("list %q: %v", orderBy, err)
+		}
+		got := lo.Map(words, func(w *entity.Word, _ int) string { return w.Text })
+		if fmt.Sprint(got) != want {
+			t.Fatalf("order_by %q = %v, want %s", orderBy, got, want)
+		}
+	}
+}
+
+func TestWordRepositoryListFiltersByCEFR(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "cefr.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	for text, level := range map[string]entity.CEFRLevel{"apple": entity.CEFRA1, "abandon": entity.CEFRB1, "zymurgy": "", "gist": entity.CEFRC1} {
+		if err := client.Word.Create().SetText(text).SetLanguage("en").SetCefr(string(level)).Exec(ctx); err != nil {
+			t.Fatalf("seed word: %v", err)
+		}
+	}
+
+	repo := NewWordRepository(client)
+	for _, tc := range []struct {
+		filter, orderBy, want string
+	}{
+		{`cefr == "b1"`, "", "[abandon]"},
+		{`cefr in ["A1", "C1"]`, "", "[apple gist]"},
+		{"", "cefr asc", "[apple abandon gist zymurgy]"},
+		{"", "cefr desc", "[zymurgy gist abandon apple]"},
+	} {
+		words, _, err := repo.List(ctx, &repository.ListWordQuery{FilterOrder: repository.FilterOrder{Filter: tc.filter, OrderBy: tc.orderBy}})
+		if err != nil {
+			t.Fatalf("list %q %q: %v", tc.filter, tc.orderBy, err)
+		}
+		got := lo.Map(words, func(w *entity.Word, _ int) string { return w.Text })
+		if fmt.Sprint(got) != tc.want {
+			t.Fatalf("filter %q order_by %q = %v, want %s", tc.filter, tc.orderBy, got, tc.want)
+		}
+	}
+
+	_, _, err := repo.List(ctx, &repository.ListWordQuery{FilterOrder: repository.FilterOrder{Filter: `cefr == "D1"`}})
+	if !errors.Is(err, entity.ErrInvalidCEFRLevel) {
+		t.Fatalf("unknown level error = %v, want ErrInvalidCEFRLevel", err)
+	}
+}
+
+func TestWordRepositoryListFiltersByWordTypes(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "types.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	client.Word.Create().SetText("run").SetLanguage("en").ExecX(ctx)
+	for text, wordType := range map[string]entity.WordType{"ran": entity.WordTypePast, "runs": entity.WordType3SG, "running": entity.WordTypeIng} {
+		if err := client.Word.Create().SetText(text).SetLanguage("en").SetWordType(string(wordType)).SetLemma("run").Exec(ctx); err != nil {
+			t.Fatalf("seed word: %v", err)
+		}
+	}
+
+	repo := NewWordRepository(client)
+	for _, tc := range []struct {
+		filter, want string
+	}{
+		{`word_type in ["past", "ing"]`, "[ran running]"},
+		{`word_type == "3sg"`, "[runs]"},
+		{`word_type in ["lemma", "3sg"] && word_type == "lemma"`, "[run]"},
+	} {
+		words, total, err := repo.List(ctx, &repository.ListWordQuery{FilterOrder: repository.FilterOrder{Filter: tc.filter}})
+		if err != nil {
+			t.Fatalf("list %q: %v", tc.filter, err)
+		}
+		got := lo.Map(words, func(w *entity.Word, _ int) string { return w.Text })
+		if fmt.Sprint(got) != tc.want || total != int64(len(got)) {
+			t.Fatalf("filter %q = %v (total %d), want %s", tc.filter, got, total, tc.want)
+		}
+	}
+}
+
+func TestWordRepositoryLanguageStats(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "languages.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	seed := map[string][]string{
+		"en": {"apple", "pear", "fig"},
+		"de": {"Apfel", "Birne"},
+	}
+	for language, texts := range seed {
+		for _, text := range texts {
+			if err := client.Word.Create().SetText(text).SetLanguage(language).Exec(ctx); err != nil {
+				t.Fatalf("seed word: %v", err)
+			}
+		}
+	}
+
+	stats, err := NewWordRepository(client).LanguageStats(ctx)
+	if err != nil {
+		t.Fatalf("language stats: %v", err)
+	}
+	want := []entity.LanguageCount{{Language: "en", Count: 3}, {Language: "de", Count: 2}}
+	if !reflect.DeepEqual(stats, want) {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestWordRepositoryLookupMany(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "lookup-many.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	for _, w := range []struct{ text, language string }{{"run", "en"}, {"ran", "en"}, {"apple", "en"}, {"ran", "de"}} {
+		if err := client.Word.Create().SetText(w.text).SetLanguage(w.language).Exec(ctx); err != nil {
+			t.Fatalf("seed %s: %v", w.text, err)
+		}
+	}
+
+	words, err := NewWordRepository(client).LookupMany(ctx, []string{"ran", "run", "missing"}, entity.LanguageEnglish)
+	if err != nil {
+		t.Fatalf("lookup many: %v", err)
+	}
+	got := lo.Map(words, func(w *entity.Word, _ int) string { return w.Text + "/" + w.Language.Code() })
+	slices.Sort(got)
+	if !slices.Equal(got, []string{"ran/en", "run/en"}) {
+		t.Fatalf("lookup many = %v, want ran/en and run/en", got)
+	}
+}
+
+func TestWordRepositoryListFiltersByTagsAndCategories(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "tags.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	for text, categories := range map[string][]string{
+		"apple":  {"zk", "gk", "cet4"},
+		"banana": {"gk", "cet4"},
+		"cherry": {"cet6"},
+		"date":   {},
+	} {
+		if err := client.Word.Create().SetText(text).SetLanguage("en").SetCategories(categories).Exec(ctx); err != nil {
+			t.Fatalf("seed %s: %v", text, err)
+		}
+	}
+
+	repo := NewWordRepository(client)
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `tag in ["gk"]`, want: []string{"apple", "banana"}},
+		{filter: `tag in ["gk", "zk"]`, want: []string{"apple"}},
+		{filter: `category in ["cet4"]`, want: []string{"apple", "banana"}},
+		{filter: `category in ["cet4", "cet6"]`, want: []string{}},
+		{filter: `tag in ["zk"] && category in ["cet4"]`, want: []string{"apple"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			words, total, err := repo.List(ctx, &repository.ListWordQuery{
+				FilterOrder: repository.FilterOrder{Filter: tt.filter, OrderBy: "text"},
+			})
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			got := make([]string, 0, len(words))
+			for _, w := range words {
+				got = append(got, w.Text)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) || total != int64(len(tt.want)) {
+				t.Fatalf("got %v (total %d), want %v", got, total, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordRepositoryListIncludeForms(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "forms.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	run, cat := "run", "cat"
+	seeds := []struct {
+		text     string
+		language string
+		wordType entity.WordType
+		lemma    *string
+	}{
+		{"run", "en", entity.WordTypeLemma, nil},
+		{"running", "en", entity.WordTypeIng, &run},
+		{"ran", "en", entity.WordTypePast, &run},
+		{"cat", "en", entity.WordTypeLemma, nil},
+		{"run", "fr", entity.WordTypeLemma, nil},
+		{"cats", "fr", entity.WordTypePlural, &cat},
+	}
+	for _, seed := range seeds {
+		if err := client.Word.Create().SetText(seed.text).SetLanguage(seed.language).
+			SetWordType(string(seed.wordType)).SetNillableLemma(seed.lemma).Exec(ctx); err != nil {
+			t.Fatalf("seed %s: %v", seed.text, err)
+		}
+	}
+
+	repo := NewWordRepository(client)
+	list := func(include bool) map[string][]entity.WordFormRef {
+		t.Helper()
+		words, _, err := repo.List(ctx, &repository.ListWordQuery{
+			Pagination:   repository.Pagination{PageNo: 1, PageSize: 10},
+			FilterOrder:  repository.FilterOrder{OrderBy: "text"},
+			IncludeForms: include,
+		})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		forms := make(map[string][]entity.WordFormRef, len(words))
+		for _, w := range words {
+			forms[w.Language.Code()+"/"+w.Text] = w.Forms
+		}
+		return forms
+	}
+
+	forms := list(true)
+	wantRun := []entity.WordFormRef{{Text: "ran", WordType: entity.WordTypePast}, {Text: "running", WordType: entity.WordTypeIng}}
+	if fmt.Sprint(forms["en/run"]) != fmt.Sprint(wantRun) {
+		t.Fatalf("run forms = %v, want %v", forms["en/run"], wantRun)
+	}
+	if forms["en/cat"] == nil || len(forms["en/cat"]) != 0 || len(forms["fr/run"]) != 0 {
+		t.Fatalf("cat forms = %#v, fr run forms = %#v, want empty (the plural is French)", forms["en/cat"], forms["fr/run"])
+	}
+	if forms["en/running"] != nil || forms["en/ran"] != nil || forms["fr/cats"] != nil {
+		t.Fatalf("inflections should carry no forms: %v", forms)
+	}
+
+	for text, f := range list(false) {
+		if f != nil {
+			t.Fatalf("%s: forms loaded without include_forms: %v", text, f)
+		}
+	}
+}
+
+func TestWordRepositoryListFormsByLemma(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "list_forms.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	lemma := "sein"
+	if err := client.Word.Create().SetText(lemma).SetLanguage("de").SetWordType(string(entity.WordTypeLemma)).Exec(ctx); err != nil {
+		t.Fatalf("seed lemma: %v", err)
+	}
+	types := []entity.WordType{entity.WordTypePast, entity.WordTypePP, entity.WordType3SG, entity.WordTypeIng}
+	for i := 0; i < 24; i++ {
+		wordType := types[i%len(types)]
+		text := fmt.Sprintf("form%02d", i)
+		if err := client.Word.Create().SetText(text).SetLanguage("de").SetWordType(string(wordType)).SetLemma(lemma).Exec(ctx); err != nil {
+			t.Fatalf("seed %s: %v", text, err)
+		}
+	}
+	if err := client.Word.Create().SetText("form99").SetLanguage("en").SetWordType(string(entity.WordTypePast)).SetLemma(lemma).Exec(ctx); err != nil {
+		t.Fatalf("seed other language: %v", err)
+	}
+
+	repo := NewWordRepository(client)
+	all, err := repo.ListFormsByLemma(ctx, lemma, entity.LanguageGerman, repository.ListFormsQuery{})
+	if err != nil {
+		t.Fatalf("list all forms: %v", err)
+	}
+	if len(all) != 24 {
+		t.Fatalf("got %d forms, want 24", len(all))
+	}
+
+	past, err := repo.ListFormsByLemma(ctx, lemma, entity.LanguageGerman, repository.ListFormsQuery{WordTypes: []entity.WordType{entity.WordTypePast}})
+	if err != nil {
+		t.Fatalf("list past forms: %v", err)
+	}
+	if len(past) != 6 {
+		t.Fatalf("got %d past forms, want 6: %v", len(past), past)
+	}
+	for _, f := range past {
+		if f.WordType != entity.WordTypePast {
+			t.Fatalf("unexpected form %v in past filter", f)
+		}
+	}
+
+	limited, err := repo.ListFormsByLemma(ctx, lemma, entity.LanguageGerman, repository.ListFormsQuery{
+		WordTypes: []entity.WordType{entity.WordTypePast, entity.WordTypePP},
+		Limit:     5,
+	})
+	if err != nil {
+		t.Fatalf("list limited forms: %v", err)
+	}
+	want := []entity.WordFormRef{
+		{Text: "form00", WordType: entity.WordTypePast},
+		{Text: "form01", WordType: entity.WordTypePP},
+		{Text: "form04", WordType: entity.WordTypePast},
+		{Text: "form05", WordType: entity.WordTypePP},
+		{Text: "form08", WordType: entity.WordTypePast},
+	}
+	if fmt.Sprint(limited) != fmt.Sprint(want) {
+		t.Fatalf("limited forms = %v, want %v", limited, want)
+	}
+}
+
+func TestWordRepositoryListRanksKeywordMatches(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "rank.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	// Seeded so that insertion order and alphabetical order both disagree with the ranking.
+	for _, text := range []string{"scatter", "bobcat", "catalog", "Catch", "cat", "dog"} {
+		if err := client.Word.Create().SetText(text).SetLanguage("en").Exec(ctx); err != nil {
+			t.Fatalf("seed %s: %v", text, err)
+		}
+	}
+
+	repo := NewWordRepository(client)
+	for _, orderBy := range []string{"", "text desc"} {
+		words, _, err := repo.List(ctx, &repository.ListWordQuery{
+			FilterOrder: repository.FilterOrder{Filter: `keyword == "Cat"`, OrderBy: orderBy},
+		})
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		got := make([]string, 0, len(words))
+		for _, w := range words {
+			got = append(got, w.Text)
+		}
+		if len(got) != 5 || got[0] != "cat" {
+			t.Fatalf("order %q: got %v, want the exact match first", orderBy, got)
+		}
+		prefix, substring := got[1:3], got[3:]
+		if !lo.Contains(prefix, "catalog") || !lo.Contains(prefix, "Catch") || !lo.Contains(substring, "scatter") || !lo.Contains(substring, "bobcat") {
+			t.Fatalf("order %q: got %v, want prefix matches before substring matches", orderBy, got)
+		}
+	}
+}
+
+func TestWordRepositoryStreamByTags(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "stream_tags.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	ctx := context.Background()
+
+	seeds := []struct {
+		text       string
+		language   string
+		categories []string
+	}{
+		{"abandon", "en", []string{"cet4", "toefl"}},
+		{"benign", "en", []string{"toefl"}},
+		{"cat", "en", []string{"zk"}},
+		{"dog", "en", []string{"cet4"}},
+		{"eclipse", "en", nil},
+		{"chat", "fr", []string{"toefl"}},
+	}
+	for _, seed := range seeds {
+		if err := client.Word.Create().SetText(seed.text).SetLanguage(seed.language).SetCategories(seed.categories).Exec(ctx); err != nil {
+			t.Fatalf("seed %s: %v", seed.text, err)
+		}
+	}
+
+	repo := NewWordRepository(client)
+	tests := []struct {
+		name     string
+		tags     []string
+		language entity.Language
+		want     []string
+	}{
+		{name: "single tag", tags: []string{"toefl"}, language: entity.LanguageEnglish, want: []string{"abandon", "benign"}},
+		{name: "any of tags", tags: []string{"TOEFL", "cet4"}, language: entity.LanguageEnglish, want: []string{"abandon", "benign", "dog"}},
+		{name: "language scoped", tags: []string{"toefl"}, language: entity.LanguageFrench, want: []string{"chat"}},
+		{name: "no match", tags: []string{"gre"}, language: entity.LanguageEnglish, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			err := repo.StreamByTags(ctx, tt.tags, tt.language, func(w *entity.Word) error {
+				got = append(got, w.Text)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("StreamByTags: %v", err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if err := repo.StreamByTags(ctx, []string{" "}, entity.LanguageEnglish, func(*entity.Word) error { return nil }); err == nil {
+		t.Fatal("expected an error without tags")
+	}
+}
+
+func TestWordRepositoryStampsTimestamps(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "stamps.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+	repo := &wordRepository{client: client, now: steppingClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))}
+
+	created, err := repo.Create(ctx, &entity.Word{
+		Text:      "stamp",
+		Language:  entity.LanguageEnglish,
+		CreatedAt: time.Date(1999, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.CreatedAt.IsZero() || created.UpdatedAt.IsZero() {
+		t.Fatalf("timestamps not populated: %+v", created)
+	}
+	if created.CreatedAt.Year() == 1999 {
+		t.Fatalf("caller-supplied created_at was trusted: %v", created.CreatedAt)
+	}
+
+	created.Categories = []string{"cet4"}
+	updated, err := repo.Update(ctx, created, entity.WordFieldCategories)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if !updated.CreatedAt.Equal(created.CreatedAt) {
+		t.Fatalf("created_at changed on update: %v -> %v", created.CreatedAt, updated.CreatedAt)
+	}
+	if !updated.UpdatedAt.After(created.UpdatedAt) {
+		t.Fatalf("updated_at not advanced: %v -> %v", created.UpdatedAt, updated.UpdatedAt)
+	}
+
+	if err := repo.AppendSentences(ctx, created.ID, []entity.Sentence{{Text: "Stamp it."}}); err != nil {
+		t.Fatalf("append sentences: %v", err)
+	}
+	appended, err := repo.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if !appended.UpdatedAt.After(updated.UpdatedAt) {
+		t.Fatalf("updated_at not advanced by append: %v -> %v", updated.UpdatedAt, appended.UpdatedAt)
+	}
+}
+
+// steppingClock returns a clock that advances by one second on every call.
+func steppingClock(start time.Time) func() time.Time {
+	current := start
+	return func() time.Time {
+		current = current.Add(time.Second)
+		return current
+	}
+}
+
+func TestWordRepositoryListAppliesFilterLimits(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "filter-limits.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+	repo := NewWordRepository(client)
+
+	query := &repository.ListWordQuery{FilterOrder: repository.FilterOrder{
+		Filter: `word.startsWith("a") && word_type == "lemma"`,
+		Limits: filterexpr.FilterLimits{MaxPredicates: 1},
+	}}
+	_, _, err := repo.List(context.Background(), query)
+	var complexErr *filterexpr.FilterTooComplexError
+	if !errors.As(err, &complexErr) {
+		t.Fatalf("list with two predicates and a limit of one: got %v, want FilterTooComplexError", err)
+	}
+
+	query.Limits.MaxPredicates = 2
+	if _, _, err := repo.List(context.Background(), query); err != nil {
+		t.Fatalf("list within the limit: %v", err)
+	}
+}
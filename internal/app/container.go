@@ -1,14 +1,95 @@
 package app
 
 import (
+	"fmt"
+	"time"
+
+	adaptergrpc "github.com/eslsoft/vocnet/internal/adapter/connectrpc"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
 	entdb "github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
 	"github.com/eslsoft/vocnet/internal/infrastructure/server"
+	"github.com/eslsoft/vocnet/internal/repository"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	"github.com/eslsoft/vocnet/internal/usecase/backup"
 	"github.com/sirupsen/logrus"
 )
 
+// Version is the build version reported by GetServerInfo, set at link time with
+// -ldflags "-X github.com/eslsoft/vocnet/internal/app.Version=v1.2.3".
+var Version = "dev"
+
 // Container aggregates the application dependencies produced by Wire.
 type Container struct {
-	Logger    *logrus.Logger
-	Server    *server.Server
-	EntClient *entdb.Client
+	Config               *config.Config
+	Logger               *logrus.Logger
+	Server               *server.Server
+	EntClient            *entdb.Client
+	WordUsecase          usecase.WordUsecase
+	LearnedLexemeUsecase usecase.LearnedLexemeUsecase
+}
+
+// wordUsecaseOptions translates word config into usecase options; audits is only used when
+// word auditing is enabled.
+func wordUsecaseOptions(cfg *config.Config, audits repository.WordAuditRepository, learned repository.LearnedLexemeRepository) []usecase.WordUsecaseOption {
+	opts := []usecase.WordUsecaseOption{usecase.WithLearnedLexemes(learned)}
+	if cfg.Word.AutoCreateLemma {
+		opts = append(opts, usecase.WithAutoCreateLemma())
+	}
+	if cfg.Word.AllowCustomWordTypes {
+		opts = append(opts, usecase.WithCustomWordTypes())
+	}
+	if cfg.Word.StrictDialects {
+		opts = append(opts, usecase.WithStrictDialects())
+	}
+	if cfg.StrictLanguage {
+		opts = append(opts, usecase.WithStrictLanguage())
+	}
+	if cfg.List.MaxOffset > 0 {
+		opts = append(opts, usecase.WithMaxOffset(cfg.List.MaxOffset))
+	}
+	opts = append(opts, usecase.WithFilterLimits(cfg.List.MaxFilterPredicates, cfg.List.MaxFilterDepth))
+	opts = append(opts, usecase.WithMaxTextLength(cfg.Word.MaxTextLength))
+	opts = append(opts, usecase.WithDialectFallback(cfg.Word.DialectFallback...))
+	if cfg.Word.LookupFallback {
+		opts = append(opts, usecase.WithEnglishLookupFallback())
+	}
+	if cfg.Word.Audit {
+		opts = append(opts, usecase.WithWordAudit(audits))
+	}
+	return opts
+}
+
+// learnedLexemeUsecaseOptions translates list, language and text length config into learned lexeme usecase options.
+func learnedLexemeUsecaseOptions(cfg *config.Config, words repository.WordRepository) []usecase.LearnedLexemeUsecaseOption {
+	opts := []usecase.LearnedLexemeUsecaseOption{usecase.WithLearnedLexemeDictionary(words)}
+	if cfg.List.MaxOffset > 0 {
+		opts = append(opts, usecase.WithLearnedLexemeMaxOffset(cfg.List.MaxOffset))
+	}
+	opts = append(opts, usecase.WithLearnedLexemeFilterLimits(cfg.List.MaxFilterPredicates, cfg.List.MaxFilterDepth))
+	if cfg.StrictLanguage {
+		opts = append(opts, usecase.WithLearnedLexemeStrictLanguage())
+	}
+	opts = append(opts, usecase.WithLearnedLexemeMaxTermLength(cfg.Word.MaxTextLength))
+	if name := cfg.Learning.AnonymousCreatedBy; name != "" {
+		opts = append(opts, usecase.WithLearnedLexemeAnonymousCreatedBy(name))
+	}
+	if cfg.Learning.DueCountCacheInterval > 0 {
+		opts = append(opts, usecase.WithDueCountCache(cfg.Learning.DueCountCacheInterval))
+	}
+	return opts
+}
+
+// serverInfo collects the build and schema details GetServerInfo reports; the start time is taken
+// when the container is built.
+func serverInfo(cfg *config.Config) (adaptergrpc.ServerInfo, error) {
+	driver, err := cfg.DatabaseDriver()
+	if err != nil {
+		return adaptergrpc.ServerInfo{}, fmt.Errorf("resolve database driver: %w", err)
+	}
+	return adaptergrpc.ServerInfo{
+		Version:        Version,
+		SchemaHash:     backup.SchemaHash(),
+		DatabaseDriver: driver,
+		StartedAt:      time.Now(),
+	}, nil
 }
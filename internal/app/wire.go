@@ -4,6 +4,8 @@
 package app
 
 import (
+	"context"
+
 	"github.com/google/wire"
 
 	adaptergrpc "github.com/eslsoft/vocnet/internal/adapter/connectrpc"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
 	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+	"github.com/eslsoft/vocnet/pkg/api/system/v1/systemv1connect"
 )
 
 var configSet = wire.NewSet(
@@ -22,33 +25,41 @@ var configSet = wire.NewSet(
 )
 
 var databaseSet = wire.NewSet(
-	database.NewEntClient,
+	database.ConnectEntClient,
 )
 
 var repositorySet = wire.NewSet(
 	repository.NewWordRepository,
+	repository.NewWordAuditRepository,
 	repository.NewLearnedLexemeRepository,
 )
 
 var usecaseSet = wire.NewSet(
+	wordUsecaseOptions,
 	usecase.NewWordUsecase,
+	learnedLexemeUsecaseOptions,
 	usecase.NewLearnedLexemeUsecase,
 )
 
 var serviceSet = wire.NewSet(
 	adaptergrpc.NewWordServiceServer,
 	adaptergrpc.NewLearningServiceServer,
+	serverInfo,
+	adaptergrpc.NewSystemServiceServer,
 	wire.Bind(new(learningv1connect.LearningServiceHandler), new(*adaptergrpc.LearningServiceServer)),
 	wire.Bind(new(dictv1connect.WordServiceHandler), new(*adaptergrpc.WordServiceServer)),
+	wire.Bind(new(systemv1connect.SystemServiceHandler), new(*adaptergrpc.SystemServiceServer)),
 )
 
 var serverSet = wire.NewSet(
+	server.NewLogOutput,
 	server.NewLogger,
 	server.NewServer,
 )
 
 // Initialize builds the application container using Wire.
-func Initialize() (*Container, func(), error) {
+// ctx bounds startup work such as waiting for the database to become reachable.
+func Initialize(ctx context.Context) (*Container, func(), error) {
 	wire.Build(
 		configSet,
 		databaseSet,
@@ -56,7 +67,7 @@ func Initialize() (*Container, func(), error) {
 		usecaseSet,
 		serviceSet,
 		serverSet,
-		wire.Struct(new(Container), "Logger", "Server", "EntClient"),
+		wire.Struct(new(Container), "Config", "Logger", "Server", "EntClient", "WordUsecase", "LearnedLexemeUsecase"),
 	)
 	return nil, nil, nil
 }
@@ -7,6 +7,7 @@
 package app
 
 import (
+	"context"
 	"github.com/eslsoft/vocnet/internal/adapter/connectrpc"
 	"github.com/eslsoft/vocnet/internal/adapter/repository"
 	"github.com/eslsoft/vocnet/internal/infrastructure/config"
@@ -15,38 +16,65 @@ import (
 	"github.com/eslsoft/vocnet/internal/usecase"
 	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
 	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+	"github.com/eslsoft/vocnet/pkg/api/system/v1/systemv1connect"
 	"github.com/google/wire"
 )
 
 // Injectors from wire.go:
 
 // Initialize builds the application container using Wire.
-func Initialize() (*Container, func(), error) {
+// ctx bounds startup work such as waiting for the database to become reachable.
+func Initialize(ctx context.Context) (*Container, func(), error) {
 	configConfig, err := config.Load()
 	if err != nil {
 		return nil, nil, err
 	}
-	logger, err := server.NewLogger(configConfig)
+	writer, cleanup, err := server.NewLogOutput(configConfig)
 	if err != nil {
 		return nil, nil, err
 	}
-	client, cleanup, err := database.NewEntClient(configConfig)
+	logger, err := server.NewLogger(configConfig, writer)
 	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	client, cleanup2, err := database.ConnectEntClient(ctx, configConfig, logger)
+	if err != nil {
+		cleanup()
 		return nil, nil, err
 	}
 	wordRepository := repository.NewWordRepository(client)
-	wordUsecase := usecase.NewWordUsecase(wordRepository)
-	wordServiceServer := grpc.NewWordServiceServer(wordUsecase)
+	wordAuditRepository := repository.NewWordAuditRepository(client)
 	learnedLexemeRepository := repository.NewLearnedLexemeRepository(client)
-	learnedLexemeUsecase := usecase.NewLearnedLexemeUsecase(learnedLexemeRepository)
-	learningServiceServer := grpc.NewLearningServiceServer(learnedLexemeUsecase)
-	serverServer := server.NewServer(configConfig, logger, wordServiceServer, learningServiceServer)
+	v := wordUsecaseOptions(configConfig, wordAuditRepository, learnedLexemeRepository)
+	wordUsecase := usecase.NewWordUsecase(wordRepository, v...)
+	wordServiceServer := grpc.NewWordServiceServer(wordUsecase)
+	v2 := learnedLexemeUsecaseOptions(configConfig, wordRepository)
+	learnedLexemeUsecase := usecase.NewLearnedLexemeUsecase(learnedLexemeRepository, v2...)
+	learningServiceServer := grpc.NewLearningServiceServer(learnedLexemeUsecase, wordUsecase)
+	grpcServerInfo, err := serverInfo(configConfig)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	systemServiceServer := grpc.NewSystemServiceServer(grpcServerInfo)
+	serverServer, err := server.NewServer(configConfig, logger, wordServiceServer, learningServiceServer, systemServiceServer)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
 	container := &Container{
-		Logger:    logger,
-		Server:    serverServer,
-		EntClient: client,
+		Config:               configConfig,
+		Logger:               logger,
+		Server:               serverServer,
+		EntClient:            client,
+		WordUsecase:          wordUsecase,
+		LearnedLexemeUsecase: learnedLexemeUsecase,
 	}
 	return container, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -55,12 +83,14 @@ func Initialize() (*Container, func(), error) {
 
 var configSet = wire.NewSet(config.Load)
 
-var databaseSet = wire.NewSet(database.NewEntClient)
+var databaseSet = wire.NewSet(database.ConnectEntClient)
 
-var repositorySet = wire.NewSet(repository.NewWordRepository, repository.NewLearnedLexemeRepository)
+var repositorySet = wire.NewSet(repository.NewWordRepository, repository.NewWordAuditRepository, repository.NewLearnedLexemeRepository)
 
-var usecaseSet = wire.NewSet(usecase.NewWordUsecase, usecase.NewLearnedLexemeUsecase)
+var usecaseSet = wire.NewSet(
+	wordUsecaseOptions, usecase.NewWordUsecase, learnedLexemeUsecaseOptions, usecase.NewLearnedLexemeUsecase,
+)
 
-var serviceSet = wire.NewSet(grpc.NewWordServiceServer, grpc.NewLearningServiceServer, wire.Bind(new(learningv1connect.LearningServiceHandler), new(*grpc.LearningServiceServer)), wire.Bind(new(dictv1connect.WordServiceHandler), new(*grpc.WordServiceServer)))
+var serviceSet = wire.NewSet(grpc.NewWordServiceServer, grpc.NewLearningServiceServer, serverInfo, grpc.NewSystemServiceServer, wire.Bind(new(learningv1connect.LearningServiceHandler), new(*grpc.LearningServiceServer)), wire.Bind(new(dictv1connect.WordServiceHandler), new(*grpc.WordServiceServer)), wire.Bind(new(systemv1connect.SystemServiceHandler), new(*grpc.SystemServiceServer)))
 
-var serverSet = wire.NewSet(server.NewLogger, server.NewServer)
+var serverSet = wire.NewSet(server.NewLogOutput, server.NewLogger, server.NewServer)
@@ -0,0 +1,25 @@
+package entity
+
+// BatchResult reports the outcome of one item of a batch operation. Index is the item's position
+// in the request; exactly one of Value and Err is meaningful. Err wraps one of the sentinel errors
+// in this package, so callers classify it with errors.Is and transports map it to a status code.
+type BatchResult[T any] struct {
+	Index int
+	Value T
+	Err   error
+}
+
+// OK reports whether the item succeeded.
+func (r BatchResult[T]) OK() bool {
+	return r.Err == nil
+}
+
+// BatchFailed reports whether any item of results failed.
+func BatchFailed[T any](results []BatchResult[T]) bool {
+	for _, r := range results {
+		if r.Err != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CEFRLevel is a Common European Framework of Reference difficulty level. The levels sort
+// lexically from easiest to hardest; CEFRUnknown marks words without a known level.
+type CEFRLevel string
+
+const (
+	CEFRUnknown CEFRLevel = ""
+	CEFRA1      CEFRLevel = "A1"
+	CEFRA2      CEFRLevel = "A2"
+	CEFRB1      CEFRLevel = "B1"
+	CEFRB2      CEFRLevel = "B2"
+	CEFRC1      CEFRLevel = "C1"
+	CEFRC2      CEFRLevel = "C2"
+)
+
+// ParseCEFRLevel accepts a level in any case, e.g. "b1".
+func ParseCEFRLevel(s string) (CEFRLevel, error) {
+	switch level := CEFRLevel(strings.ToUpper(strings.TrimSpace(s))); level {
+	case CEFRA1, CEFRA2, CEFRB1, CEFRB2, CEFRC1, CEFRC2:
+		return level, nil
+	default:
+		return CEFRUnknown, fmt.Errorf("%w: %q (want A1, A2, B1, B2, C1 or C2)", ErrInvalidCEFRLevel, s)
+	}
+}
+
+// CEFRFromTags returns the easiest level that levels maps any of tags to, or CEFRUnknown when
+// no tag has a level. A word listed for both a junior and a senior exam is learned at the
+// junior one, so the lowest level wins.
+func CEFRFromTags(tags []string, levels map[string]CEFRLevel) CEFRLevel {
+	best := CEFRUnknown
+	for _, tag := range tags {
+		level, ok := levels[strings.ToLower(strings.TrimSpace(tag))]
+		if !ok || level == CEFRUnknown {
+			continue
+		}
+		if best == CEFRUnknown || level < best {
+			best = level
+		}
+	}
+	return best
+}
@@ -1,6 +1,9 @@
 package entity
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Domain errors for user entity and related aggregates.
 var (
@@ -12,8 +15,43 @@ var (
 	ErrLearnedLexemeNotFound    = errors.New("user lexeme not found")
 	ErrDuplicateLearnedLexeme   = errors.New("user lexeme already exists")
 	ErrInvalidLearnedLexemeText = errors.New("invalid user lexeme text")
+	ErrUnscopedDelete           = errors.New("refusing to delete without a filter; set all to confirm")
 	ErrVocNotFound              = errors.New("word not found")
 	ErrInvalidVocID             = errors.New("invalid word id")
 	ErrInvalidVocText           = errors.New("invalid word text")
 	ErrDuplicateWord            = errors.New("word already exists")
+	ErrLemmaNotFound            = errors.New("lemma not found")
+	ErrWordTooLarge             = errors.New("word payload too large")
+	ErrInvalidWordType          = errors.New("invalid word type")
+	ErrInvalidDialect           = errors.New("invalid phonetic dialect")
+	ErrInvalidSentenceSource    = errors.New("invalid sentence source")
+	ErrInvalidRelationType      = errors.New("invalid relation type")
+	ErrOffsetTooLarge           = errors.New("page offset too large")
+	ErrPageSizeTooLarge         = errors.New("page size too large")
+	ErrBatchTooLarge            = errors.New("batch too large")
+	ErrBatchAborted             = errors.New("batch aborted because another item failed")
+	ErrStaleReview              = errors.New("review is older than the stored one")
+	ErrInvalidReviewState       = errors.New("invalid review state")
+	ErrInvalidReviewTiming      = errors.New("invalid review timing")
+	ErrInvalidCreatedBy         = errors.New("invalid created_by")
+	ErrLanguageRequired         = errors.New("language required")
+	ErrWriteConflict            = errors.New("write conflicted with a concurrent transaction")
+	ErrWordAuditDisabled        = errors.New("word auditing is disabled")
+	ErrUnauthenticated          = errors.New("authentication required")
+	ErrInvalidTags              = errors.New("invalid tags")
+	ErrInvalidCEFRLevel         = errors.New("invalid CEFR level")
 )
+
+// DuplicateWordError reports which word collided with an existing entry.
+// It matches ErrDuplicateWord via errors.Is.
+type DuplicateWordError struct {
+	Language Language
+	Text     string
+	WordType WordType
+}
+
+func (e *DuplicateWordError) Error() string {
+	return fmt.Sprintf("%s: language=%q text=%q word_type=%q", ErrDuplicateWord, e.Language, e.Text, e.WordType)
+}
+
+func (e *DuplicateWordError) Is(target error) bool { return target == ErrDuplicateWord }
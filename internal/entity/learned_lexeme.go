@@ -1,11 +1,15 @@
 package entity
 
 import (
+	"fmt"
 	"strings"
 	"time"
 )
 
 // LearnedLexeme represents a user's personalised vocabulary entry.
+//
+// Tags distinguishes "not sent" from "clear": nil leaves the stored tags unchanged on update
+// (and stores none on create), while a non-nil empty slice removes them all.
 type LearnedLexeme struct {
 	ID         int64
 	UserID     int64
@@ -33,6 +37,25 @@ type MasteryBreakdown struct {
 	Overall   int32
 }
 
+// masteryMaxLevel is the top of the 0-5 scale used by the per-skill scores.
+const masteryMaxLevel = 5
+
+// HasComponents reports whether any per-skill score is set. Without them Overall is the only
+// signal (for instance a self-assessed level) and cannot be derived.
+func (m MasteryBreakdown) HasComponents() bool {
+	return m.Listen != 0 || m.Read != 0 || m.Spell != 0 || m.Pronounce != 0
+}
+
+// Recompute derives Overall from the per-skill scores: their mean on the 0-5 scale, stored * 100.
+// Components are clamped to 0-5 first so a stray value cannot push Overall out of range.
+func (m *MasteryBreakdown) Recompute() {
+	sum := int32(0)
+	for _, level := range []int32{m.Listen, m.Read, m.Spell, m.Pronounce} {
+		sum += min(max(level, 0), masteryMaxLevel)
+	}
+	m.Overall = sum * 100 / 4
+}
+
 // ReviewTiming represents spaced repetition metadata for a user lexeme.
 type ReviewTiming struct {
 	LastReviewAt time.Time
@@ -41,14 +64,78 @@ type ReviewTiming struct {
 	FailCount    int32
 }
 
+// Reschedule records a review at at. A scheduled next review moves to IntervalDays after at,
+// so it never falls before the review just recorded; an unscheduled one stays unset.
+func (r *ReviewTiming) Reschedule(at time.Time) {
+	r.LastReviewAt = at
+	if !r.NextReviewAt.IsZero() {
+		r.NextReviewAt = at.AddDate(0, 0, int(r.IntervalDays))
+	}
+}
+
+// Validate rejects a next review scheduled before the last one, which would misorder the due
+// queue, and negative counters, with ErrInvalidReviewTiming. Unset timestamps are not compared.
+func (r ReviewTiming) Validate() error {
+	if !r.LastReviewAt.IsZero() && !r.NextReviewAt.IsZero() && r.NextReviewAt.Before(r.LastReviewAt) {
+		return fmt.Errorf("%w: next review %s is before last review %s", ErrInvalidReviewTiming,
+			r.NextReviewAt.Format(time.RFC3339), r.LastReviewAt.Format(time.RFC3339))
+	}
+	if r.IntervalDays < 0 {
+		return fmt.Errorf("%w: interval_days must not be negative, got %d", ErrInvalidReviewTiming, r.IntervalDays)
+	}
+	if r.FailCount < 0 {
+		return fmt.Errorf("%w: fail_count must not be negative, got %d", ErrInvalidReviewTiming, r.FailCount)
+	}
+	return nil
+}
+
+// ReviewState buckets a lexeme for review tabs. New, learning and mastered partition lexemes
+// by MasteryBreakdown.Overall; due is orthogonal and selects lexemes whose next review has come.
+type ReviewState string
+
+const (
+	ReviewStateNew      ReviewState = "new"
+	ReviewStateLearning ReviewState = "learning"
+	ReviewStateMastered ReviewState = "mastered"
+	ReviewStateDue      ReviewState = "due"
+)
+
+// Review state thresholds over MasteryBreakdown.Overall, which stores the 0-5 level * 100.
+const (
+	// ReviewStateNewMaxOverall is the highest overall score still counted as new (nothing learned yet).
+	ReviewStateNewMaxOverall int32 = 0
+	// ReviewStateMasteredMinOverall is the lowest overall score counted as mastered (level 4).
+	ReviewStateMasteredMinOverall int32 = 400
+)
+
+// ParseReviewState validates a review state name (case-insensitive).
+func ParseReviewState(s string) (ReviewState, error) {
+	switch state := ReviewState(strings.ToLower(strings.TrimSpace(s))); state {
+	case ReviewStateNew, ReviewStateLearning, ReviewStateMastered, ReviewStateDue:
+		return state, nil
+	default:
+		return "", fmt.Errorf("%w: %q (want new, learning, mastered or due)", ErrInvalidReviewState, s)
+	}
+}
+
 // LearnedLexemeRelation links a user lexeme to another concept in their vocabulary graph.
 type LearnedLexemeRelation struct {
-	Word         string    `json:"word"`
-	RelationType int32     `json:"relation_type"`
-	Note         string    `json:"note,omitempty"`
-	CreatedBy    string    `json:"created_by"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	Word         string       `json:"word"`
+	RelationType RelationType `json:"relation_type"`
+	Note         string       `json:"note,omitempty"`
+	CreatedBy    string       `json:"created_by"`
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+// CheckLearnedLexemeRelations returns ErrInvalidRelationType for the first relation with an unknown type.
+func CheckLearnedLexemeRelations(relations []LearnedLexemeRelation) error {
+	for i, rel := range relations {
+		if !rel.RelationType.Valid() {
+			return fmt.Errorf("%w: relations[%d] has type %d", ErrInvalidRelationType, i, rel.RelationType)
+		}
+	}
+	return nil
 }
 
 // Normalize ensures defaults & constraints before persistence.
@@ -67,7 +154,4 @@ func (uw *LearnedLexeme) Normalize(now time.Time) {
 	if uw.Relations == nil {
 		uw.Relations = []LearnedLexemeRelation{}
 	}
-	if uw.Tags == nil {
-		uw.Tags = []string{}
-	}
 }
@@ -1,6 +1,12 @@
 package entity
 
-import "strings"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
 
 // Language represents supported language codes using ISO-style abbreviations.
 type Language string
@@ -29,6 +35,12 @@ func (l Language) CodeOrDefault() string {
 	return l.Code()
 }
 
+// LanguageCount is the number of dictionary entries stored for a language.
+type LanguageCount struct {
+	Language Language
+	Count    int64
+}
+
 // NormalizeLanguage ensures the language falls back to a supported value (defaults to English).
 func NormalizeLanguage(lang Language) Language {
 	switch lang {
@@ -39,6 +51,25 @@ func NormalizeLanguage(lang Language) Language {
 	}
 }
 
+// DefaultMaxTextLength is the default limit, in characters, on word text and lexeme terms.
+const DefaultMaxTextLength = 256
+
+// CheckText returns why text cannot be stored as a word or lexeme term: invalid UTF-8, control
+// characters, or more than maxLength characters. A maxLength of 0 or less skips the length check.
+func CheckText(text string, maxLength int) error {
+	if !utf8.ValidString(text) {
+		return errors.New("text is not valid UTF-8")
+	}
+	if i := strings.IndexFunc(text, unicode.IsControl); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(text[i:])
+		return fmt.Errorf("text contains control character %U at byte %d", r, i)
+	}
+	if n := utf8.RuneCountInString(text); maxLength > 0 && n > maxLength {
+		return fmt.Errorf("text is %d characters, limit is %d", n, maxLength)
+	}
+	return nil
+}
+
 func NormalizeWordToken(word string) string {
 	trimmed := strings.TrimSpace(word)
 	if trimmed == "" {
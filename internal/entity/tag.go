@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Limits on the tags of a learned lexeme or the categories of a word.
+const (
+	MaxTags      = 32
+	MaxTagLength = 64
+)
+
+// NormalizeTags returns tags in canonical form: trimmed, lower-cased, without blanks and with
+// duplicates removed, keeping first-seen order. It keeps the nil/empty distinction of
+// LearnedLexeme.Tags: nil stays nil and any other input yields a non-nil slice.
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		out = append(out, tag)
+	}
+	return out
+}
+
+// CheckTags rejects normalized tags that exceed MaxTags or hold a tag longer than MaxTagLength
+// characters.
+func CheckTags(tags []string) error {
+	if len(tags) > MaxTags {
+		return fmt.Errorf("%w: %d tags, limit is %d", ErrInvalidTags, len(tags), MaxTags)
+	}
+	for _, tag := range tags {
+		if n := utf8.RuneCountInString(tag); n > MaxTagLength {
+			return fmt.Errorf("%w: tag %q is %d characters, limit is %d", ErrInvalidTags, tag, n, MaxTagLength)
+		}
+	}
+	return nil
+}
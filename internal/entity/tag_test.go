@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []string
+		want []string
+	}{
+		{name: "nil", in: nil, want: nil},
+		{name: "empty", in: []string{}, want: []string{}},
+		{name: "whitespace", in: []string{"  fruit ", "\tfood\n", "   ", ""}, want: []string{"fruit", "food"}},
+		{name: "case", in: []string{"CET4", "Fruit"}, want: []string{"cet4", "fruit"}},
+		{name: "duplicates keep first position", in: []string{"food", "fruit", " Food", "FRUIT", "cet4"}, want: []string{"food", "fruit", "cet4"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := NormalizeTags(tc.in); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("NormalizeTags(%q) = %#v, want %#v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckTags(t *testing.T) {
+	tags := make([]string, MaxTags+1)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("tag%d", i)
+	}
+	if err := CheckTags(tags[:MaxTags]); err != nil {
+		t.Fatalf("%d tags: %v", MaxTags, err)
+	}
+	if err := CheckTags(tags); !errors.Is(err, ErrInvalidTags) {
+		t.Fatalf("%d tags: got %v, want ErrInvalidTags", len(tags), err)
+	}
+
+	if err := CheckTags([]string{strings.Repeat("词", MaxTagLength)}); err != nil {
+		t.Fatalf("tag of %d characters: %v", MaxTagLength, err)
+	}
+	if err := CheckTags([]string{strings.Repeat("a", MaxTagLength+1)}); !errors.Is(err, ErrInvalidTags) {
+		t.Fatalf("over-long tag: got %v, want ErrInvalidTags", err)
+	}
+}
@@ -1,6 +1,9 @@
 package entity
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // User represents a user entity in the domain
 type User struct {
@@ -21,3 +24,16 @@ func (u *User) Validate() error {
 	}
 	return nil
 }
+
+type userIDKey struct{}
+
+// WithUserID returns a copy of ctx carrying the id of the user a request acts for.
+func WithUserID(ctx context.Context, userID int64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFromContext returns the user id stored by WithUserID; ok is false when absent.
+func UserIDFromContext(ctx context.Context) (userID int64, ok bool) {
+	userID, ok = ctx.Value(userIDKey{}).(int64)
+	return userID, ok
+}
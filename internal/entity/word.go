@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -8,8 +12,8 @@ type Word struct {
 	ID          int64
 	Text        string
 	Language    Language
-	WordType    string  // lemma, past, pp (past participle), ing (present participle), 3sg (third person singular), plural, comparative, superlative, variant, derived, other
-	Lemma       *string // nil if this row itself is the lemma
+	WordType    WordType // lemma, past, pp (past participle), ing (present participle), 3sg (third person singular), plural, comparative, superlative, variant, derived, other
+	Lemma       *string  // nil if this row itself is the lemma
 	Phonetics   []WordPhonetic
 	Definitions []WordDefinition // only populated for lemma rows
 	Categories  []string
@@ -17,6 +21,13 @@ type Word struct {
 	Sentences   []Sentence
 	Forms       []WordFormRef // if this is lemma: other forms; if not lemma: empty
 	Relations   []WordRelation
+	Source      WordSource
+	Frequency   int       // corpus frequency rank from the dictionary import, 1 = most common; 0 when unranked
+	CEFR        CEFRLevel // difficulty derived from the dictionary import's exam tags; empty when unknown
+	// FallbackFrom is the looked-up text when a lookup returned this lemma in its place: a form
+	// entry under LookupPreferLemma ("went" for "go") or a miss found by de-inflecting it ("mice"
+	// for "mouse"); empty for direct matches and outside lookups.
+	FallbackFrom string
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -27,10 +38,141 @@ type WordPhonetic struct {
 	Dialect string `json:"dialect,omitempty"`
 }
 
+// Canonical phonetic dialect codes.
+const (
+	DialectUS = "en-US"
+	DialectGB = "en-GB"
+	DialectAU = "en-AU"
+)
+
+// dialectAliases maps lower-cased dialect spellings onto the canonical codes.
+var dialectAliases = map[string]string{
+	"en-us":    DialectUS,
+	"en_us":    DialectUS,
+	"us":       DialectUS,
+	"usa":      DialectUS,
+	"am":       DialectUS,
+	"american": DialectUS,
+	"en-gb":    DialectGB,
+	"en_gb":    DialectGB,
+	"gb":       DialectGB,
+	"uk":       DialectGB,
+	"en-uk":    DialectGB,
+	"br":       DialectGB,
+	"british":  DialectGB,
+	"en-au":    DialectAU,
+	"en_au":    DialectAU,
+	"au":       DialectAU,
+}
+
+// ParseDialect resolves s (case-insensitive, aliases allowed) to a canonical dialect code.
+// An empty string is valid and means the dialect is unspecified.
+func ParseDialect(s string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	if key == "" {
+		return "", nil
+	}
+	if d, ok := dialectAliases[key]; ok {
+		return d, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidDialect, s)
+}
+
+// NormalizePhonetics trims IPA strings, drops empty entries, canonicalizes dialects and
+// removes duplicate (IPA, dialect) pairs, keeping the first occurrence. Unknown dialects are
+// cleared, or rejected with ErrInvalidDialect when strict is set.
+func (w *Word) NormalizePhonetics(strict bool) error {
+	if len(w.Phonetics) == 0 {
+		return nil
+	}
+	seen := make(map[WordPhonetic]struct{}, len(w.Phonetics))
+	out := make([]WordPhonetic, 0, len(w.Phonetics))
+	for _, p := range w.Phonetics {
+		p.IPA = strings.TrimSpace(p.IPA)
+		if p.IPA == "" {
+			continue
+		}
+		dialect, err := ParseDialect(p.Dialect)
+		if err != nil && strict {
+			return err
+		}
+		p.Dialect = dialect
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		out = append(out, p)
+	}
+	w.Phonetics = out
+	return nil
+}
+
+// DefaultDialectFallback is the phonetic dialect preference used when none is configured.
+var DefaultDialectFallback = []string{DialectUS, DialectGB}
+
+// PreferDialects stably reorders the phonetics so that dialects earlier in chain come first;
+// phonetics of other or unspecified dialects keep their order after them. Nothing is dropped.
+func (w *Word) PreferDialects(chain ...string) {
+	rank := func(p WordPhonetic) int {
+		dialect, _ := ParseDialect(p.Dialect)
+		if i := slices.Index(chain, dialect); i >= 0 && dialect != "" {
+			return i
+		}
+		return len(chain)
+	}
+	slices.SortStableFunc(w.Phonetics, func(a, b WordPhonetic) int { return rank(a) - rank(b) })
+}
+
+type dialectKey struct{}
+
+// WithDialect returns a copy of ctx carrying the client's preferred phonetic dialect.
+func WithDialect(ctx context.Context, dialect string) context.Context {
+	return context.WithValue(ctx, dialectKey{}, dialect)
+}
+
+// DialectFromContext returns the dialect stored by WithDialect, or "" when absent.
+func DialectFromContext(ctx context.Context) string {
+	dialect, _ := ctx.Value(dialectKey{}).(string)
+	return dialect
+}
+
 type WordDefinition struct {
 	Pos      string   `json:"pos"`
 	Text     string   `json:"text"`
 	Language Language `json:"language"`
+	// Order ranks the definition within its word, starting at 1 for the primary one; 0 means unset.
+	Order int `json:"order,omitempty"`
+}
+
+// SortDefinitions returns a copy of defs ordered by Order. Entries without an order follow the
+// ordered ones, and ties keep their given sequence, so legacy rows render in insertion order.
+func SortDefinitions(defs []WordDefinition) []WordDefinition {
+	if len(defs) == 0 {
+		return defs
+	}
+	out := slices.Clone(defs)
+	slices.SortStableFunc(out, func(a, b WordDefinition) int {
+		switch {
+		case a.Order == b.Order:
+			return 0
+		case a.Order == 0:
+			return 1
+		case b.Order == 0:
+			return -1
+		default:
+			return a.Order - b.Order
+		}
+	})
+	return out
+}
+
+// NormalizeDefinitionOrder sorts defs like SortDefinitions and renumbers them 1..n.
+func NormalizeDefinitionOrder(defs []WordDefinition) []WordDefinition {
+	out := SortDefinitions(defs)
+	for i := range out {
+		out[i].Order = i + 1
+	}
+	return out
 }
 
 // Sentence captures a short contextual example recorded by the user.
@@ -40,15 +182,248 @@ type Sentence struct {
 	SourceRef string `json:"source_ref,omitempty"`
 }
 
+// Sentence sources mirror common.v1.SourceType.
+const (
+	SentenceSourceUnspecified int32 = 0
+	SentenceSourceBook        int32 = 1
+	SentenceSourceWeb         int32 = 2
+	SentenceSourceAudio       int32 = 3
+	SentenceSourceVideo       int32 = 4
+	SentenceSourceManual      int32 = 5
+	SentenceSourceOther       int32 = 10
+)
+
+// ValidSentenceSource reports whether source is one of the known sentence sources.
+func ValidSentenceSource(source int32) bool {
+	switch source {
+	case SentenceSourceUnspecified, SentenceSourceBook, SentenceSourceWeb, SentenceSourceAudio,
+		SentenceSourceVideo, SentenceSourceManual, SentenceSourceOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// CheckSentenceSources returns ErrInvalidSentenceSource for the first sentence with an unknown source.
+func CheckSentenceSources(sentences []Sentence) error {
+	for i, s := range sentences {
+		if !ValidSentenceSource(s.Source) {
+			return fmt.Errorf("%w: sentences[%d] has source %d", ErrInvalidSentenceSource, i, s.Source)
+		}
+	}
+	return nil
+}
+
+// MergeSentences appends incoming sentences to existing ones, skipping entries whose
+// trimmed text and source already exist. It returns the merged slice and the number added.
+func MergeSentences(existing, incoming []Sentence) ([]Sentence, int) {
+	type key struct {
+		text   string
+		source int32
+	}
+	seen := make(map[key]struct{}, len(existing)+len(incoming))
+	merged := make([]Sentence, 0, len(existing)+len(incoming))
+	for _, s := range existing {
+		seen[key{text: strings.TrimSpace(s.Text), source: s.Source}] = struct{}{}
+		merged = append(merged, s)
+	}
+
+	added := 0
+	for _, s := range incoming {
+		s.Text = strings.TrimSpace(s.Text)
+		if s.Text == "" {
+			continue
+		}
+		k := key{text: s.Text, source: s.Source}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		merged = append(merged, s)
+		added++
+	}
+	return merged, added
+}
+
 type WordFormRef struct {
-	Text     string `json:"text"`
-	WordType string `json:"word_type"`
+	Text     string   `json:"text"`
+	WordType WordType `json:"word_type"`
 }
 
-const WordTypeLemma = "lemma"
+// WordSource records where a dictionary entry's content came from. Dictionary imports never
+// overwrite entries whose source is WordSourceManual.
+type WordSource string
+
+const (
+	WordSourceUnknown WordSource = ""       // written before provenance was tracked
+	WordSourceECDICT  WordSource = "ecdict" // imported by db-init
+	WordSourceManual  WordSource = "manual" // created or edited through the API
+)
 
-// WordRelation models a connection to another dictionary entry.
+// LookupPreference picks the entry a lookup returns when its text is an inflected form ("went"):
+// the form's own entry or its lemma's. The zero value prefers the lemma.
+type LookupPreference string
+
+const (
+	LookupPreferLemma LookupPreference = "lemma" // the lemma entry, when the dictionary has one
+	LookupPreferExact LookupPreference = "exact" // the inflected form entry, as typed
+)
+
+// WordType classifies a dictionary entry as a lemma or one of its inflected/derived forms.
+type WordType string
+
+const (
+	WordTypeLemma       WordType = "lemma"
+	WordTypePast        WordType = "past"
+	WordTypePP          WordType = "pp"  // past participle
+	WordTypeIng         WordType = "ing" // present participle / gerund
+	WordType3SG         WordType = "3sg" // third person singular present
+	WordTypePlural      WordType = "plural"
+	WordTypeComparative WordType = "comparative"
+	WordTypeSuperlative WordType = "superlative"
+	WordTypeVariant     WordType = "variant"
+	WordTypeDerived     WordType = "derived"
+	WordTypeOther       WordType = "other"
+)
+
+// wordTypeAliases maps common spellings onto the canonical word types.
+var wordTypeAliases = map[string]WordType{
+	"base":                  WordTypeLemma,
+	"past_tense":            WordTypePast,
+	"past_participle":       WordTypePP,
+	"present_participle":    WordTypeIng,
+	"gerund":                WordTypeIng,
+	"third_person_singular": WordType3SG,
+	"3ps":                   WordType3SG,
+	"comparative_degree":    WordTypeComparative,
+	"superlative_degree":    WordTypeSuperlative,
+	"plurals":               WordTypePlural,
+}
+
+// Valid reports whether t is one of the known word types.
+func (t WordType) Valid() bool {
+	switch t {
+	case WordTypeLemma, WordTypePast, WordTypePP, WordTypeIng, WordType3SG, WordTypePlural,
+		WordTypeComparative, WordTypeSuperlative, WordTypeVariant, WordTypeDerived, WordTypeOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseWordType resolves s (case-insensitive, aliases allowed) to a known word type.
+// An empty string resolves to WordTypeLemma.
+func ParseWordType(s string) (WordType, error) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	if key == "" {
+		return WordTypeLemma, nil
+	}
+	key = strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+	if t := WordType(key); t.Valid() {
+		return t, nil
+	}
+	if t, ok := wordTypeAliases[key]; ok {
+		return t, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidWordType, s)
+}
+
+// WordField names an updatable Word attribute; values match the API field names.
+type WordField string
+
+const (
+	WordFieldText        WordField = "text"
+	WordFieldLanguage    WordField = "language"
+	WordFieldWordType    WordField = "word_type"
+	WordFieldLemma       WordField = "lemma"
+	WordFieldPhonetics   WordField = "phonetics"
+	WordFieldDefinitions WordField = "definitions"
+	WordFieldCategories  WordField = "categories"
+	WordFieldPhrases     WordField = "phrases"
+	WordFieldSentences   WordField = "sentences"
+	WordFieldRelations   WordField = "relations"
+)
+
+// ParseWordField validates an update mask path.
+func ParseWordField(path string) (WordField, bool) {
+	switch f := WordField(strings.TrimSpace(path)); f {
+	case WordFieldText, WordFieldLanguage, WordFieldWordType, WordFieldLemma, WordFieldPhonetics,
+		WordFieldDefinitions, WordFieldCategories, WordFieldPhrases, WordFieldSentences, WordFieldRelations:
+		return f, true
+	default:
+		return "", false
+	}
+}
+
+// UpsertOutcome reports what an upsert did with an entry.
+type UpsertOutcome string
+
+const (
+	UpsertCreated UpsertOutcome = "created"
+	UpsertUpdated UpsertOutcome = "updated"
+	UpsertSkipped UpsertOutcome = "skipped" // an entry with the same key exists and was kept
+)
+
+// Lemmatization is the lemma resolved for a single surface token.
+type Lemmatization struct {
+	Token    string
+	Lemma    string
+	WordType WordType // empty when the token is unknown
+	Found    bool
+}
+
+// WordRelation models a connection to another dictionary entry. Note and Strength are curator
+// annotations; relations stored before they existed decode with zero values.
 type WordRelation struct {
-	Word         string `json:"word"`
-	RelationType int32  `json:"relation_type"`
+	Word         string       `json:"word"`
+	RelationType RelationType `json:"relation_type"`
+	Note         string       `json:"note,omitempty"`
+	Strength     int32        `json:"strength,omitempty"`
+}
+
+// RelationType classifies a word or learned lexeme relation; values mirror common.v1.RelationType.
+type RelationType int32
+
+const (
+	RelationTypeUnspecified RelationType = 0
+	RelationTypeSynonym     RelationType = 1
+	RelationTypeAntonym     RelationType = 2
+	RelationTypeHypernym    RelationType = 3
+	RelationTypeHyponym     RelationType = 4
+	RelationTypeAssociation RelationType = 5
+	RelationTypeCauseEffect RelationType = 6
+	RelationTypePartWhole   RelationType = 7
+	RelationTypeMnemonic    RelationType = 10
+	RelationTypeCustom      RelationType = 100
+)
+
+// Valid reports whether t is one of the known relation types.
+func (t RelationType) Valid() bool {
+	switch t {
+	case RelationTypeUnspecified, RelationTypeSynonym, RelationTypeAntonym, RelationTypeHypernym, RelationTypeHyponym,
+		RelationTypeAssociation, RelationTypeCauseEffect, RelationTypePartWhole, RelationTypeMnemonic, RelationTypeCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+// CheckWordRelations returns ErrInvalidRelationType for the first relation with an unknown type.
+func CheckWordRelations(relations []WordRelation) error {
+	for i, rel := range relations {
+		if !rel.RelationType.Valid() {
+			return fmt.Errorf("%w: relations[%d] has type %d", ErrInvalidRelationType, i, rel.RelationType)
+		}
+	}
+	return nil
+}
+
+// RelatedWords gathers the entries connected to a word for "words like this" views.
+// No list repeats an entry, and none contains the word itself.
+type RelatedWords struct {
+	Word      *Word
+	Lemma     string         // lemma text; equals Word.Text for lemma rows
+	Forms     []WordFormRef  // inflected/derived forms, only when Word is a lemma
+	Siblings  []WordFormRef  // the lemma and its other forms, only when Word is a form
+	Relations []WordRelation // stored relations of the word, then of its lemma
 }
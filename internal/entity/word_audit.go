@@ -0,0 +1,101 @@
+package entity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// WordAudit records one audited dictionary edit: who changed which fields of a word, and how.
+type WordAudit struct {
+	ID        int64
+	WordID    int64
+	Editor    string // empty when the request carried no editor identity
+	Changes   map[WordField]FieldChange
+	CreatedAt time.Time
+}
+
+// FieldChange holds the JSON encoding of a field before and after an edit.
+type FieldChange struct {
+	Old json.RawMessage `json:"old"`
+	New json.RawMessage `json:"new"`
+}
+
+// DiffWords returns the fields whose value differs between before and after, limited to fields
+// when any are given. Nil and empty lists compare equal so a round-trip through storage is not
+// reported as a change.
+func DiffWords(before, after *Word, fields ...WordField) (map[WordField]FieldChange, error) {
+	if len(fields) == 0 {
+		fields = []WordField{
+			WordFieldText, WordFieldLanguage, WordFieldWordType, WordFieldLemma, WordFieldPhonetics,
+			WordFieldDefinitions, WordFieldCategories, WordFieldPhrases, WordFieldSentences, WordFieldRelations,
+		}
+	}
+	changes := make(map[WordField]FieldChange)
+	for _, field := range fields {
+		oldValue, err := encodeWordField(before, field)
+		if err != nil {
+			return nil, err
+		}
+		newValue, err := encodeWordField(after, field)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(oldValue, newValue) {
+			changes[field] = FieldChange{Old: oldValue, New: newValue}
+		}
+	}
+	return changes, nil
+}
+
+func encodeWordField(w *Word, field WordField) (json.RawMessage, error) {
+	var value any
+	switch field {
+	case WordFieldText:
+		value = w.Text
+	case WordFieldLanguage:
+		value = w.Language
+	case WordFieldWordType:
+		value = w.WordType
+	case WordFieldLemma:
+		value = w.Lemma
+	case WordFieldPhonetics:
+		value = w.Phonetics
+	case WordFieldDefinitions:
+		value = w.Definitions
+	case WordFieldCategories:
+		value = w.Categories
+	case WordFieldPhrases:
+		value = w.Phrases
+	case WordFieldSentences:
+		value = w.Sentences
+	case WordFieldRelations:
+		value = w.Relations
+	default:
+		return nil, fmt.Errorf("diff word: unknown field %q", field)
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice && v.Len() == 0 {
+		return json.RawMessage("[]"), nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("diff word field %s: %w", field, err)
+	}
+	return data, nil
+}
+
+type editorKey struct{}
+
+// WithEditor returns a copy of ctx carrying the identity credited with dictionary edits.
+func WithEditor(ctx context.Context, editor string) context.Context {
+	return context.WithValue(ctx, editorKey{}, editor)
+}
+
+// EditorFromContext returns the editor stored by WithEditor, or "" when absent.
+func EditorFromContext(ctx context.Context) string {
+	editor, _ := ctx.Value(editorKey{}).(string)
+	return editor
+}
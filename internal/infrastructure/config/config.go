@@ -2,9 +2,15 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
 )
 
 // Config holds all configuration for our application
@@ -12,6 +18,17 @@ type Config struct {
 	Server   ServerConfig   `mapstructure:"server"`
 	Database DatabaseConfig `mapstructure:"database"`
 	Log      LogConfig      `mapstructure:"log"`
+	Word     WordConfig     `mapstructure:"word"`
+	Backup   BackupConfig   `mapstructure:"backup"`
+	List     ListConfig     `mapstructure:"list"`
+	Learning LearningConfig `mapstructure:"learning"`
+	// StrictLanguage rejects requests without a language instead of defaulting them to English.
+	StrictLanguage bool `mapstructure:"strict_language"`
+	// SingleUser serves every request as AnonymousUserID. When false, requests act for the user in
+	// the X-Vocnet-User-Id header set by the gateway, and requests without one are rejected.
+	SingleUser bool `mapstructure:"single_user"`
+	// AnonymousUserID is the user every request acts for in single-user mode.
+	AnonymousUserID int64 `mapstructure:"anonymous_user_id"`
 }
 
 // ServerConfig holds server configuration
@@ -19,30 +36,171 @@ type ServerConfig struct {
 	Host     string `mapstructure:"host"`
 	GRPCPort int    `mapstructure:"grpc_port"`
 	HTTPPort int    `mapstructure:"http_port"`
+	// CompressMinBytes is the smallest response body gzipped for clients that accept it; negative disables.
+	CompressMinBytes int `mapstructure:"compress_min_bytes"`
+	// MaxRequestBytes caps the size of a single (decompressed) RPC request message; 0 disables the limit.
+	MaxRequestBytes int `mapstructure:"max_request_bytes"`
+	// AdminToken enables GET /admin/export for callers sending it as a bearer token; empty disables it.
+	AdminToken string `mapstructure:"admin_token"`
+	// RequestTimeout bounds every unary RPC handler; 0 disables it.
+	RequestTimeout time.Duration `mapstructure:"request_timeout"`
+	// MethodTimeouts overrides RequestTimeout per method as "Method=duration" entries
+	// (e.g. "ListWords=1m", "StreamWords=10m"); streaming methods are only bounded when listed.
+	MethodTimeouts []string `mapstructure:"method_timeouts"`
+	// MaxConcurrentRequests bounds how many RPC handlers (and their database queries) run at
+	// once; 0 disables the limit.
+	MaxConcurrentRequests int64 `mapstructure:"max_concurrent_requests"`
+	// ConcurrencyWait is how long a request waits for a free slot before failing with
+	// ResourceExhausted; 0 fails at once.
+	ConcurrencyWait time.Duration `mapstructure:"concurrency_wait"`
+	// InfoRateLimit caps GetServerInfo calls per minute across all callers; 0 disables the limit.
+	InfoRateLimit int `mapstructure:"info_rate_limit"`
+	// CORSAllowedOrigins lists origins allowed to call the HTTP API; "*" allows any origin
+	// unless CORSAllowCredentials is set.
+	CORSAllowedOrigins []string `mapstructure:"cors_allowed_origins"`
+	// CORSAllowOriginRegex additionally allows origins fully matching any of these expressions.
+	CORSAllowOriginRegex []string `mapstructure:"cors_allow_origin_regex"`
+	// CORSAllowCredentials lets browsers send cookies and auth headers cross-origin.
+	CORSAllowCredentials bool `mapstructure:"cors_allow_credentials"`
+}
+
+// MethodTimeoutMap parses MethodTimeouts keyed by method or full procedure name.
+func (c ServerConfig) MethodTimeoutMap() (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration, len(c.MethodTimeouts))
+	for _, entry := range c.MethodTimeouts {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		method, raw, ok := strings.Cut(entry, "=")
+		method = strings.TrimSpace(method)
+		if !ok || method == "" {
+			return nil, fmt.Errorf("invalid method timeout %q: want Method=duration", entry)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("invalid method timeout %q: %w", entry, err)
+		}
+		timeouts[method] = timeout
+	}
+	return timeouts, nil
 }
 
 // DatabaseConfig holds database configuration
 type DatabaseConfig struct {
-	DSN    string `mapstructure:"dsn"`
-	LogSQL bool   `mapstructure:"log_sql"`
+	DSN    string       `mapstructure:"dsn"`
+	LogSQL bool         `mapstructure:"log_sql"`
+	SQLite SQLiteConfig `mapstructure:"sqlite"`
+	// ConnectTimeout is how long the server waits for the database at startup.
+	ConnectTimeout time.Duration `mapstructure:"connect_timeout"`
+	// ConnectBackoff is the initial delay between connection attempts; it doubles each retry.
+	ConnectBackoff time.Duration `mapstructure:"connect_backoff"`
+	// TrigramSearch creates a pg_trgm GIN index on lower(words.text) during migration so keyword
+	// search avoids full scans. Postgres only; it needs permission to create the extension.
+	TrigramSearch bool `mapstructure:"trigram_search"`
 
 	driver      string
 	initialized bool
 }
 
+// SQLiteConfig tunes connection pragmas applied to sqlite DSNs.
+// Parameters already present in the DSN always take precedence.
+type SQLiteConfig struct {
+	BusyTimeoutMS int    `mapstructure:"busy_timeout_ms"`
+	JournalMode   string `mapstructure:"journal_mode"`
+	Synchronous   string `mapstructure:"synchronous"`
+}
+
 // LogConfig holds logging configuration
 type LogConfig struct {
 	Level  string `mapstructure:"level"`
 	Format string `mapstructure:"format"`
+	// Output is "stderr", "stdout" or a file path to append to.
+	Output string `mapstructure:"output"`
 }
 
-// Load reads configuration from file and environment variables
-func Load() (*Config, error) {
-	viper.SetConfigName(".env")
-	viper.SetConfigType("env")
-	viper.AddConfigPath(".")
-	viper.AddConfigPath("./config")
+// WordConfig holds dictionary write behaviour.
+type WordConfig struct {
+	// AutoCreateLemma creates a stub lemma entry when a word form references a missing lemma.
+	AutoCreateLemma bool `mapstructure:"auto_create_lemma"`
+	// AllowCustomWordTypes accepts word_type values outside the known set (lemma, past, pp, ...).
+	AllowCustomWordTypes bool `mapstructure:"allow_custom_word_types"`
+	// StrictDialects rejects unknown phonetic dialects instead of clearing them.
+	StrictDialects bool `mapstructure:"strict_dialects"`
+	// MaxTextLength caps word text and learned lexeme terms, in characters; 0 disables the limit.
+	MaxTextLength int `mapstructure:"max_text_length"`
+	// Audit records who changed which fields on every word update, in a word_audit table.
+	Audit bool `mapstructure:"audit"`
+	// DialectFallback orders looked-up phonetics after the dialect the client asked for.
+	DialectFallback []string `mapstructure:"dialect_fallback"`
+	// LookupFallback retries English lookups that miss with the lemmas the text may be a form of.
+	LookupFallback bool `mapstructure:"lookup_fallback"`
+}
+
+// normalize canonicalizes the dialect fallback chain, rejecting unknown dialects.
+func (c *WordConfig) normalize() error {
+	chain := make([]string, 0, len(c.DialectFallback))
+	for _, raw := range c.DialectFallback {
+		dialect, err := entity.ParseDialect(raw)
+		if err != nil {
+			return fmt.Errorf("dialect_fallback: %w", err)
+		}
+		if dialect != "" && !slices.Contains(chain, dialect) {
+			chain = append(chain, dialect)
+		}
+	}
+	c.DialectFallback = chain
+	return nil
+}
+
+// BackupConfig holds backup/restore restrictions.
+type BackupConfig struct {
+	// DenyTables are never exported or imported, even when requested explicitly.
+	DenyTables []string `mapstructure:"deny_tables"`
+	// S3 addresses the object store used by export/import for s3://bucket/key paths.
+	S3 S3Config `mapstructure:"s3"`
+}
+
+// S3Config addresses an S3-compatible object store (AWS S3, MinIO, ...).
+type S3Config struct {
+	// Endpoint is the service base URL, e.g. "http://minio:9000"; empty uses AWS S3 in Region.
+	Endpoint string `mapstructure:"endpoint"`
+	Region   string `mapstructure:"region"`
+	// AccessKeyID and SecretAccessKey sign requests; leave both empty for public buckets.
+	AccessKeyID     string `mapstructure:"access_key_id"`
+	SecretAccessKey string `mapstructure:"secret_access_key"`
+	SessionToken    string `mapstructure:"session_token"`
+	// PathStyle puts the bucket in the URL path instead of the host name, as MinIO expects.
+	PathStyle bool `mapstructure:"path_style"`
+}
+
+// ListConfig bounds list endpoints.
+type ListConfig struct {
+	// MaxOffset is the deepest row offset a page may start at (word and learned lexeme lists); 0 disables it.
+	MaxOffset int64 `mapstructure:"max_offset"`
+	// MaxFilterPredicates caps the ANDed clauses of a list filter; negative disables the limit.
+	MaxFilterPredicates int `mapstructure:"max_filter_predicates"`
+	// MaxFilterDepth caps how deeply a list filter may nest; negative disables the limit.
+	MaxFilterDepth int `mapstructure:"max_filter_depth"`
+}
 
+// LearningConfig holds learned lexeme write behaviour.
+type LearningConfig struct {
+	// AnonymousCreatedBy is recorded as created_by on collects carrying neither an authenticated
+	// identity (X-Vocnet-Editor) nor a client-supplied value.
+	AnonymousCreatedBy string `mapstructure:"anonymous_created_by"`
+	// DueCountCacheInterval is how long per-user due review counts are cached and how often the
+	// server recomputes the counts of polling users; 0 counts live on every request.
+	DueCountCacheInterval time.Duration `mapstructure:"due_count_cache_interval"`
+}
+
+// ProfileEnv names the environment variable selecting a configuration profile (e.g. "dev", "prod").
+const ProfileEnv = "APP_ENV"
+
+// Load reads configuration from file and environment variables. Layers apply in increasing
+// precedence: defaults, the base .env file, the {APP_ENV}.env profile file when APP_ENV is set,
+// then environment variables.
+func Load() (*Config, error) {
 	// Set default values
 	setDefaults()
 
@@ -54,12 +212,18 @@ func Load() (*Config, error) {
 	viper.AutomaticEnv()
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
-	// Read configuration file
-	if err := viper.ReadInConfig(); err != nil {
+	// Read configuration files
+	keys := envFileKeys()
+	if err := mergeEnvFile(".env", keys); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
 			return nil, fmt.Errorf("error reading config file: %w", err)
 		}
 	}
+	if profile := strings.TrimSpace(os.Getenv(ProfileEnv)); profile != "" {
+		if err := mergeEnvFile(profile+".env", keys); err != nil {
+			return nil, fmt.Errorf("error reading %s profile: %w", profile, err)
+		}
+	}
 
 	var config Config
 	if err := viper.Unmarshal(&config); err != nil {
@@ -69,6 +233,12 @@ func Load() (*Config, error) {
 	if err := config.Database.ensureInitialized(); err != nil {
 		return nil, fmt.Errorf("validate database config: %w", err)
 	}
+	if err := config.Word.normalize(); err != nil {
+		return nil, fmt.Errorf("validate word config: %w", err)
+	}
+	if config.SingleUser && config.AnonymousUserID <= 0 {
+		return nil, fmt.Errorf("anonymous_user_id must be positive in single-user mode, got %d", config.AnonymousUserID)
+	}
 
 	return &config, nil
 }
@@ -79,23 +249,86 @@ func setDefaults() {
 	viper.SetDefault("server.host", "localhost")
 	viper.SetDefault("server.grpc_port", 9090)
 	viper.SetDefault("server.http_port", 8080)
+	viper.SetDefault("server.compress_min_bytes", 1024)
+	viper.SetDefault("server.max_request_bytes", 4<<20)
+	viper.SetDefault("server.admin_token", "")
+	viper.SetDefault("server.request_timeout", 30*time.Second)
+	viper.SetDefault("server.method_timeouts", []string{})
+	viper.SetDefault("server.max_concurrent_requests", 0)
+	viper.SetDefault("server.concurrency_wait", time.Second)
+	viper.SetDefault("server.info_rate_limit", 60)
+	viper.SetDefault("server.cors_allowed_origins", []string{"*"})
+	viper.SetDefault("server.cors_allow_origin_regex", []string{})
+	viper.SetDefault("server.cors_allow_credentials", false)
 
 	// Database defaults
 	viper.SetDefault("database.dsn", "file:./data/vocnet.db")
 	viper.SetDefault("database.log_sql", false)
+	viper.SetDefault("database.connect_timeout", 30*time.Second)
+	viper.SetDefault("database.connect_backoff", 500*time.Millisecond)
+	viper.SetDefault("database.trigram_search", false)
+	viper.SetDefault("database.sqlite.busy_timeout_ms", defaultSQLiteBusyTimeoutMS)
+	viper.SetDefault("database.sqlite.journal_mode", defaultSQLiteJournalMode)
+	viper.SetDefault("database.sqlite.synchronous", "")
 
 	// Log defaults
 	viper.SetDefault("log.level", "info")
 	viper.SetDefault("log.format", "json")
+	viper.SetDefault("log.output", "stderr")
+
+	// Word defaults
+	viper.SetDefault("word.auto_create_lemma", false)
+	viper.SetDefault("word.allow_custom_word_types", false)
+	viper.SetDefault("word.strict_dialects", false)
+	viper.SetDefault("word.max_text_length", 256)
+	viper.SetDefault("word.audit", false)
+	viper.SetDefault("word.dialect_fallback", slices.Clone(entity.DefaultDialectFallback))
+	viper.SetDefault("word.lookup_fallback", false)
+
+	// Backup defaults
+	viper.SetDefault("backup.deny_tables", []string{})
+	viper.SetDefault("backup.s3.endpoint", "")
+	viper.SetDefault("backup.s3.region", "us-east-1")
+	viper.SetDefault("backup.s3.access_key_id", "")
+	viper.SetDefault("backup.s3.secret_access_key", "")
+	viper.SetDefault("backup.s3.session_token", "")
+	viper.SetDefault("backup.s3.path_style", false)
+
+	// List defaults
+	viper.SetDefault("list.max_offset", 100000)
+	viper.SetDefault("list.max_filter_predicates", filterexpr.DefaultMaxPredicates)
+	viper.SetDefault("list.max_filter_depth", filterexpr.DefaultMaxDepth)
+
+	// Learning defaults
+	viper.SetDefault("learning.anonymous_created_by", "user")
+	viper.SetDefault("learning.due_count_cache_interval", time.Minute)
+
+	viper.SetDefault("strict_language", false)
+	viper.SetDefault("single_user", true)
+	viper.SetDefault("anonymous_user_id", 1000)
 }
 
-func bindEnvAliases() error {
-	bindings := map[string][]string{
-		"database.dsn":     {"DB_DSN", "DB_URL"},
-		"database.log_sql": {"DB_LOG_SQL"},
-	}
+// envAliases lists extra environment variable names accepted for a config key.
+var envAliases = map[string][]string{
+	"database.dsn":     {"DB_DSN", "DB_URL"},
+	"database.log_sql": {"DB_LOG_SQL"},
+
+	"database.connect_timeout": {"DB_CONNECT_TIMEOUT"},
+	"database.connect_backoff": {"DB_CONNECT_BACKOFF"},
+	"database.trigram_search":  {"DB_TRIGRAM_SEARCH"},
+
+	"database.sqlite.busy_timeout_ms": {"DB_SQLITE_BUSY_TIMEOUT_MS"},
+	"database.sqlite.journal_mode":    {"DB_SQLITE_JOURNAL_MODE"},
+	"database.sqlite.synchronous":     {"DB_SQLITE_SYNCHRONOUS"},
+
+	"backup.s3.region":            {"AWS_REGION"},
+	"backup.s3.access_key_id":     {"AWS_ACCESS_KEY_ID"},
+	"backup.s3.secret_access_key": {"AWS_SECRET_ACCESS_KEY"},
+	"backup.s3.session_token":     {"AWS_SESSION_TOKEN"},
+}
 
-	for key, envs := range bindings {
+func bindEnvAliases() error {
+	for key, envs := range envAliases {
 		if len(envs) == 0 {
 			if err := viper.BindEnv(key); err != nil {
 				return err
@@ -109,6 +342,44 @@ func bindEnvAliases() error {
 	return nil
 }
 
+// envFileKeys maps the lower-cased variable names accepted in .env files (SERVER_HOST, DB_DSN, ...)
+// to their config keys, so files use the same names as the environment.
+func envFileKeys() map[string]string {
+	keys := make(map[string]string)
+	for _, key := range viper.AllKeys() {
+		keys[strings.ReplaceAll(key, ".", "_")] = key
+	}
+	for key, envs := range envAliases {
+		for _, env := range envs {
+			keys[strings.ToLower(env)] = key
+		}
+	}
+	return keys
+}
+
+// mergeEnvFile reads name from the working directory or ./config and merges it over the
+// configuration read so far; it returns viper.ConfigFileNotFoundError when the file is absent.
+func mergeEnvFile(name string, keys map[string]string) error {
+	file := viper.New()
+	file.SetConfigName(name)
+	file.SetConfigType("env")
+	file.AddConfigPath(".")
+	file.AddConfigPath("./config")
+	if err := file.ReadInConfig(); err != nil {
+		return err
+	}
+
+	settings := make(map[string]any)
+	for _, key := range file.AllKeys() {
+		target := key
+		if mapped, ok := keys[key]; ok {
+			target = mapped
+		}
+		settings[target] = file.Get(key)
+	}
+	return viper.MergeConfigMap(settings)
+}
+
 // DatabaseURL returns the configured database DSN.
 func (c *Config) DatabaseURL() (string, error) {
 	return c.Database.databaseURL()
@@ -133,29 +404,75 @@ func (db *DatabaseConfig) databaseURL() (string, error) {
 	return db.DSN, nil
 }
 
+const (
+	defaultSQLiteBusyTimeoutMS = 5000
+	defaultSQLiteJournalMode   = "WAL"
+)
+
+var (
+	sqliteJournalModes = []string{"DELETE", "TRUNCATE", "PERSIST", "MEMORY", "WAL", "OFF"}
+	sqliteSyncModes    = []string{"OFF", "NORMAL", "FULL", "EXTRA"}
+)
+
 func (db *DatabaseConfig) sqliteDSN(dsn string) string {
 	if dsn == "" {
 		dsn = "file:./data/vocnet.db"
 	}
 	if strings.HasPrefix(dsn, "file:") {
-		return ensureSQLiteDSNParams(dsn)
+		return ensureSQLiteDSNParams(dsn, db.SQLite)
 	}
 	if strings.Contains(dsn, "://") {
-		return ensureSQLiteDSNParams(dsn)
+		return ensureSQLiteDSNParams(dsn, db.SQLite)
 	}
-	return ensureSQLiteDSNParams("file:" + dsn)
+	return ensureSQLiteDSNParams("file:"+dsn, db.SQLite)
 }
 
-func ensureSQLiteDSNParams(base string) string {
+// normalize applies defaults and validates the configured pragmas.
+func (c *SQLiteConfig) normalize() error {
+	if c.BusyTimeoutMS < 0 {
+		return fmt.Errorf("sqlite busy_timeout_ms must not be negative, got %d", c.BusyTimeoutMS)
+	}
+	if c.BusyTimeoutMS == 0 {
+		c.BusyTimeoutMS = defaultSQLiteBusyTimeoutMS
+	}
+
+	c.JournalMode = strings.ToUpper(strings.TrimSpace(c.JournalMode))
+	if c.JournalMode == "" {
+		c.JournalMode = defaultSQLiteJournalMode
+	}
+	if !containsString(sqliteJournalModes, c.JournalMode) {
+		return fmt.Errorf("unsupported sqlite journal_mode %q (allowed: %s)", c.JournalMode, strings.Join(sqliteJournalModes, ", "))
+	}
+
+	c.Synchronous = strings.ToUpper(strings.TrimSpace(c.Synchronous))
+	if c.Synchronous != "" && !containsString(sqliteSyncModes, c.Synchronous) {
+		return fmt.Errorf("unsupported sqlite synchronous %q (allowed: %s)", c.Synchronous, strings.Join(sqliteSyncModes, ", "))
+	}
+	return nil
+}
+
+func ensureSQLiteDSNParams(base string, opts SQLiteConfig) string {
+	busyTimeout := opts.BusyTimeoutMS
+	if busyTimeout <= 0 {
+		busyTimeout = defaultSQLiteBusyTimeoutMS
+	}
+	journal := opts.JournalMode
+	if journal == "" {
+		journal = defaultSQLiteJournalMode
+	}
+
 	params := []string{}
 	if !strings.Contains(base, "_fk=") {
 		params = append(params, "_fk=1")
 	}
 	if !strings.Contains(base, "_busy_timeout") {
-		params = append(params, "_busy_timeout=5000")
+		params = append(params, fmt.Sprintf("_busy_timeout=%d", busyTimeout))
 	}
 	if !strings.Contains(base, "_journal") {
-		params = append(params, "_journal=WAL")
+		params = append(params, "_journal="+journal)
+	}
+	if opts.Synchronous != "" && !strings.Contains(base, "_sync") {
+		params = append(params, "_sync="+opts.Synchronous)
 	}
 	if len(params) == 0 {
 		return base
@@ -175,6 +492,15 @@ func ensureSQLiteDSNParams(base string) string {
 	return builder.String()
 }
 
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
 func (db *DatabaseConfig) ensureInitialized() error {
 	if db.initialized {
 		return nil
@@ -190,6 +516,9 @@ func (db *DatabaseConfig) ensureInitialized() error {
 	}
 	switch driver {
 	case "sqlite3":
+		if err := db.SQLite.normalize(); err != nil {
+			return err
+		}
 		dsn = db.sqliteDSN(dsn)
 	case "postgres":
 		// keep DSN as-is for postgres
@@ -17,6 +17,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
 )
 
 // Client is the client that holds all ent builders.
@@ -28,6 +29,8 @@ type Client struct {
 	LearnedLexeme *LearnedLexemeClient
 	// Word is the client for interacting with the Word builders.
 	Word *WordClient
+	// WordAudit is the client for interacting with the WordAudit builders.
+	WordAudit *WordAuditClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -41,6 +44,7 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.LearnedLexeme = NewLearnedLexemeClient(c.config)
 	c.Word = NewWordClient(c.config)
+	c.WordAudit = NewWordAuditClient(c.config)
 }
 
 type (
@@ -135,6 +139,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:        cfg,
 		LearnedLexeme: NewLearnedLexemeClient(cfg),
 		Word:          NewWordClient(cfg),
+		WordAudit:     NewWordAuditClient(cfg),
 	}, nil
 }
 
@@ -156,6 +161,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:        cfg,
 		LearnedLexeme: NewLearnedLexemeClient(cfg),
 		Word:          NewWordClient(cfg),
+		WordAudit:     NewWordAuditClient(cfg),
 	}, nil
 }
 
@@ -186,6 +192,7 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	c.LearnedLexeme.Use(hooks...)
 	c.Word.Use(hooks...)
+	c.WordAudit.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
@@ -193,6 +200,7 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.LearnedLexeme.Intercept(interceptors...)
 	c.Word.Intercept(interceptors...)
+	c.WordAudit.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -202,6 +210,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.LearnedLexeme.mutate(ctx, m)
 	case *WordMutation:
 		return c.Word.mutate(ctx, m)
+	case *WordAuditMutation:
+		return c.WordAudit.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -505,12 +515,145 @@ func (c *WordClient) mutate(ctx context.Context, m *WordMutation) (Value, error)
 	}
 }
 
+// WordAuditClient is a client for the WordAudit schema.
+type WordAuditClient struct {
+	config
+}
+
+// NewWordAuditClient returns a client for the WordAudit from the given config.
+func NewWordAuditClient(c config) *WordAuditClient {
+	return &WordAuditClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wordaudit.Hooks(f(g(h())))`.
+func (c *WordAuditClient) Use(hooks ...Hook) {
+	c.hooks.WordAudit = append(c.hooks.WordAudit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wordaudit.Intercept(f(g(h())))`.
+func (c *WordAuditClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WordAudit = append(c.inters.WordAudit, interceptors...)
+}
+
+// Create returns a builder for creating a WordAudit entity.
+func (c *WordAuditClient) Create() *WordAuditCreate {
+	mutation := newWordAuditMutation(c.config, OpCreate)
+	return &WordAuditCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WordAudit entities.
+func (c *WordAuditClient) CreateBulk(builders ...*WordAuditCreate) *WordAuditCreateBulk {
+	return &WordAuditCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WordAuditClient) MapCreateBulk(slice any, setFunc func(*WordAuditCreate, int)) *WordAuditCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WordAuditCreateBulk{err: fmt.Errorf("calling to WordAuditClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WordAuditCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WordAuditCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WordAudit.
+func (c *WordAuditClient) Update() *WordAuditUpdate {
+	mutation := newWordAuditMutation(c.config, OpUpdate)
+	return &WordAuditUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WordAuditClient) UpdateOne(wa *WordAudit) *WordAuditUpdateOne {
+	mutation := newWordAuditMutation(c.config, OpUpdateOne, withWordAudit(wa))
+	return &WordAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WordAuditClient) UpdateOneID(id int) *WordAuditUpdateOne {
+	mutation := newWordAuditMutation(c.config, OpUpdateOne, withWordAuditID(id))
+	return &WordAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WordAudit.
+func (c *WordAuditClient) Delete() *WordAuditDelete {
+	mutation := newWordAuditMutation(c.config, OpDelete)
+	return &WordAuditDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WordAuditClient) DeleteOne(wa *WordAudit) *WordAuditDeleteOne {
+	return c.DeleteOneID(wa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WordAuditClient) DeleteOneID(id int) *WordAuditDeleteOne {
+	builder := c.Delete().Where(wordaudit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WordAuditDeleteOne{builder}
+}
+
+// Query returns a query builder for WordAudit.
+func (c *WordAuditClient) Query() *WordAuditQuery {
+	return &WordAuditQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWordAudit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WordAudit entity by its id.
+func (c *WordAuditClient) Get(ctx context.Context, id int) (*WordAudit, error) {
+	return c.Query().Where(wordaudit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WordAuditClient) GetX(ctx context.Context, id int) *WordAudit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WordAuditClient) Hooks() []Hook {
+	return c.hooks.WordAudit
+}
+
+// Interceptors returns the client interceptors.
+func (c *WordAuditClient) Interceptors() []Interceptor {
+	return c.inters.WordAudit
+}
+
+func (c *WordAuditClient) mutate(ctx context.Context, m *WordAuditMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WordAuditCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WordAuditUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WordAuditUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WordAuditDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WordAudit mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		LearnedLexeme, Word []ent.Hook
+		LearnedLexeme, Word, WordAudit []ent.Hook
 	}
 	inters struct {
-		LearnedLexeme, Word []ent.Interceptor
+		LearnedLexeme, Word, WordAudit []ent.Interceptor
 	}
 )
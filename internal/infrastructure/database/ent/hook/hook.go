@@ -33,6 +33,18 @@ func (f WordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WordMutation", m)
 }
 
+// The WordAuditFunc type is an adapter to allow the use of ordinary
+// function as WordAudit mutator.
+type WordAuditFunc func(context.Context, *ent.WordAuditMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WordAuditFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WordAuditMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WordAuditMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
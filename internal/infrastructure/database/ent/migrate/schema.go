@@ -75,6 +75,9 @@ var (
 		{Name: "sentences", Type: field.TypeJSON},
 		{Name: "relations", Type: field.TypeJSON},
 		{Name: "categories", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "source", Type: field.TypeString, Default: ""},
+		{Name: "frequency", Type: field.TypeInt, Default: 0},
+		{Name: "cefr", Type: field.TypeString, Default: ""},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -94,12 +97,39 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{WordsColumns[3], WordsColumns[2]},
 			},
+			{
+				Name:    "word_language_cefr",
+				Unique:  false,
+				Columns: []*schema.Column{WordsColumns[3], WordsColumns[14]},
+			},
+		},
+	}
+	// WordAuditColumns holds the columns for the "word_audit" table.
+	WordAuditColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "word_id", Type: field.TypeInt},
+		{Name: "editor", Type: field.TypeString, Default: ""},
+		{Name: "changes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WordAuditTable holds the schema information for the "word_audit" table.
+	WordAuditTable = &schema.Table{
+		Name:       "word_audit",
+		Columns:    WordAuditColumns,
+		PrimaryKey: []*schema.Column{WordAuditColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wordaudit_word_id",
+				Unique:  false,
+				Columns: []*schema.Column{WordAuditColumns[1]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		LearnedWordsTable,
 		WordsTable,
+		WordAuditTable,
 	}
 )
 
@@ -114,4 +144,7 @@ func init() {
 	WordsTable.Annotation.Checks = map[string]string{
 		"chk_words_lemma_ref": "((word_type = 'lemma' AND lemma IS NULL) OR (word_type <> 'lemma' AND lemma IS NOT NULL))",
 	}
+	WordAuditTable.Annotation = &entsql.Annotation{
+		Table: "word_audit",
+	}
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/predicate"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
 )
 
 const (
@@ -28,6 +29,7 @@ const (
 	// Node types.
 	TypeLearnedLexeme = "LearnedLexeme"
 	TypeWord          = "Word"
+	TypeWordAudit     = "WordAudit"
 )
 
 // LearnedLexemeMutation represents an operation that mutates the LearnedLexeme nodes in the graph.
@@ -1994,6 +1996,10 @@ type WordMutation struct {
 	appendrelations        []entity.WordRelation
 	categories             *[]string
 	appendcategories       []string
+	source                 *string
+	frequency              *int
+	addfrequency           *int
+	cefr                   *string
 	created_at             *time.Time
 	updated_at             *time.Time
 	clearedFields          map[string]struct{}
@@ -2602,6 +2608,134 @@ func (m *WordMutation) ResetCategories() {
 	m.appendcategories = nil
 }
 
+// SetSource sets the "source" field.
+func (m *WordMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *WordMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *WordMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetFrequency sets the "frequency" field.
+func (m *WordMutation) SetFrequency(i int) {
+	m.frequency = &i
+	m.addfrequency = nil
+}
+
+// Frequency returns the value of the "frequency" field in the mutation.
+func (m *WordMutation) Frequency() (r int, exists bool) {
+	v := m.frequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrequency returns the old "frequency" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldFrequency(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrequency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrequency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrequency: %w", err)
+	}
+	return oldValue.Frequency, nil
+}
+
+// AddFrequency adds i to the "frequency" field.
+func (m *WordMutation) AddFrequency(i int) {
+	if m.addfrequency != nil {
+		*m.addfrequency += i
+	} else {
+		m.addfrequency = &i
+	}
+}
+
+// AddedFrequency returns the value that was added to the "frequency" field in this mutation.
+func (m *WordMutation) AddedFrequency() (r int, exists bool) {
+	v := m.addfrequency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFrequency resets all changes to the "frequency" field.
+func (m *WordMutation) ResetFrequency() {
+	m.frequency = nil
+	m.addfrequency = nil
+}
+
+// SetCefr sets the "cefr" field.
+func (m *WordMutation) SetCefr(s string) {
+	m.cefr = &s
+}
+
+// Cefr returns the value of the "cefr" field in the mutation.
+func (m *WordMutation) Cefr() (r string, exists bool) {
+	v := m.cefr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCefr returns the old "cefr" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldCefr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCefr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCefr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCefr: %w", err)
+	}
+	return oldValue.Cefr, nil
+}
+
+// ResetCefr resets all changes to the "cefr" field.
+func (m *WordMutation) ResetCefr() {
+	m.cefr = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *WordMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -2762,7 +2896,7 @@ func (m *WordMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *WordMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 16)
 	if m.text != nil {
 		fields = append(fields, word.FieldText)
 	}
@@ -2796,6 +2930,15 @@ func (m *WordMutation) Fields() []string {
 	if m.categories != nil {
 		fields = append(fields, word.FieldCategories)
 	}
+	if m.source != nil {
+		fields = append(fields, word.FieldSource)
+	}
+	if m.frequency != nil {
+		fields = append(fields, word.FieldFrequency)
+	}
+	if m.cefr != nil {
+		fields = append(fields, word.FieldCefr)
+	}
 	if m.created_at != nil {
 		fields = append(fields, word.FieldCreatedAt)
 	}
@@ -2832,6 +2975,12 @@ func (m *WordMutation) Field(name string) (ent.Value, bool) {
 		return m.Relations()
 	case word.FieldCategories:
 		return m.Categories()
+	case word.FieldSource:
+		return m.Source()
+	case word.FieldFrequency:
+		return m.Frequency()
+	case word.FieldCefr:
+		return m.Cefr()
 	case word.FieldCreatedAt:
 		return m.CreatedAt()
 	case word.FieldUpdatedAt:
@@ -2867,6 +3016,12 @@ func (m *WordMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldRelations(ctx)
 	case word.FieldCategories:
 		return m.OldCategories(ctx)
+	case word.FieldSource:
+		return m.OldSource(ctx)
+	case word.FieldFrequency:
+		return m.OldFrequency(ctx)
+	case word.FieldCefr:
+		return m.OldCefr(ctx)
 	case word.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case word.FieldUpdatedAt:
@@ -2957,6 +3112,27 @@ func (m *WordMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCategories(v)
 		return nil
+	case word.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case word.FieldFrequency:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrequency(v)
+		return nil
+	case word.FieldCefr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCefr(v)
+		return nil
 	case word.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -2978,13 +3154,21 @@ func (m *WordMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *WordMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addfrequency != nil {
+		fields = append(fields, word.FieldFrequency)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *WordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case word.FieldFrequency:
+		return m.AddedFrequency()
+	}
 	return nil, false
 }
 
@@ -2993,6 +3177,13 @@ func (m *WordMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *WordMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case word.FieldFrequency:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFrequency(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Word numeric field %s", name)
 }
@@ -3062,6 +3253,15 @@ func (m *WordMutation) ResetField(name string) error {
 	case word.FieldCategories:
 		m.ResetCategories()
 		return nil
+	case word.FieldSource:
+		m.ResetSource()
+		return nil
+	case word.FieldFrequency:
+		m.ResetFrequency()
+		return nil
+	case word.FieldCefr:
+		m.ResetCefr()
+		return nil
 	case word.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -3155,3 +3355,527 @@ func (m *WordMutation) ResetEdge(name string) error {
 	}
 	return fmt.Errorf("unknown Word edge %s", name)
 }
+
+// WordAuditMutation represents an operation that mutates the WordAudit nodes in the graph.
+type WordAuditMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	word_id       *int
+	addword_id    *int
+	editor        *string
+	changes       *map[entity.WordField]entity.FieldChange
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*WordAudit, error)
+	predicates    []predicate.WordAudit
+}
+
+var _ ent.Mutation = (*WordAuditMutation)(nil)
+
+// wordauditOption allows management of the mutation configuration using functional options.
+type wordauditOption func(*WordAuditMutation)
+
+// newWordAuditMutation creates new mutation for the WordAudit entity.
+func newWordAuditMutation(c config, op Op, opts ...wordauditOption) *WordAuditMutation {
+	m := &WordAuditMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWordAudit,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWordAuditID sets the ID field of the mutation.
+func withWordAuditID(id int) wordauditOption {
+	return func(m *WordAuditMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WordAudit
+		)
+		m.oldValue = func(ctx context.Context) (*WordAudit, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WordAudit.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWordAudit sets the old WordAudit of the mutation.
+func withWordAudit(node *WordAudit) wordauditOption {
+	return func(m *WordAuditMutation) {
+		m.oldValue = func(context.Context) (*WordAudit, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WordAuditMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WordAuditMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WordAuditMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WordAuditMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WordAudit.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetWordID sets the "word_id" field.
+func (m *WordAuditMutation) SetWordID(i int) {
+	m.word_id = &i
+	m.addword_id = nil
+}
+
+// WordID returns the value of the "word_id" field in the mutation.
+func (m *WordAuditMutation) WordID() (r int, exists bool) {
+	v := m.word_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWordID returns the old "word_id" field's value of the WordAudit entity.
+// If the WordAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordAuditMutation) OldWordID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWordID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWordID: %w", err)
+	}
+	return oldValue.WordID, nil
+}
+
+// AddWordID adds i to the "word_id" field.
+func (m *WordAuditMutation) AddWordID(i int) {
+	if m.addword_id != nil {
+		*m.addword_id += i
+	} else {
+		m.addword_id = &i
+	}
+}
+
+// AddedWordID returns the value that was added to the "word_id" field in this mutation.
+func (m *WordAuditMutation) AddedWordID() (r int, exists bool) {
+	v := m.addword_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWordID resets all changes to the "word_id" field.
+func (m *WordAuditMutation) ResetWordID() {
+	m.word_id = nil
+	m.addword_id = nil
+}
+
+// SetEditor sets the "editor" field.
+func (m *WordAuditMutation) SetEditor(s string) {
+	m.editor = &s
+}
+
+// Editor returns the value of the "editor" field in the mutation.
+func (m *WordAuditMutation) Editor() (r string, exists bool) {
+	v := m.editor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditor returns the old "editor" field's value of the WordAudit entity.
+// If the WordAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordAuditMutation) OldEditor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditor: %w", err)
+	}
+	return oldValue.Editor, nil
+}
+
+// ResetEditor resets all changes to the "editor" field.
+func (m *WordAuditMutation) ResetEditor() {
+	m.editor = nil
+}
+
+// SetChanges sets the "changes" field.
+func (m *WordAuditMutation) SetChanges(mfc map[entity.WordField]entity.FieldChange) {
+	m.changes = &mfc
+}
+
+// Changes returns the value of the "changes" field in the mutation.
+func (m *WordAuditMutation) Changes() (r map[entity.WordField]entity.FieldChange, exists bool) {
+	v := m.changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChanges returns the old "changes" field's value of the WordAudit entity.
+// If the WordAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordAuditMutation) OldChanges(ctx context.Context) (v map[entity.WordField]entity.FieldChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChanges: %w", err)
+	}
+	return oldValue.Changes, nil
+}
+
+// ResetChanges resets all changes to the "changes" field.
+func (m *WordAuditMutation) ResetChanges() {
+	m.changes = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WordAuditMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WordAuditMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WordAudit entity.
+// If the WordAudit object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordAuditMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WordAuditMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the WordAuditMutation builder.
+func (m *WordAuditMutation) Where(ps ...predicate.WordAudit) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WordAuditMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WordAuditMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WordAudit, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WordAuditMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WordAuditMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WordAudit).
+func (m *WordAuditMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WordAuditMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.word_id != nil {
+		fields = append(fields, wordaudit.FieldWordID)
+	}
+	if m.editor != nil {
+		fields = append(fields, wordaudit.FieldEditor)
+	}
+	if m.changes != nil {
+		fields = append(fields, wordaudit.FieldChanges)
+	}
+	if m.created_at != nil {
+		fields = append(fields, wordaudit.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WordAuditMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wordaudit.FieldWordID:
+		return m.WordID()
+	case wordaudit.FieldEditor:
+		return m.Editor()
+	case wordaudit.FieldChanges:
+		return m.Changes()
+	case wordaudit.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WordAuditMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wordaudit.FieldWordID:
+		return m.OldWordID(ctx)
+	case wordaudit.FieldEditor:
+		return m.OldEditor(ctx)
+	case wordaudit.FieldChanges:
+		return m.OldChanges(ctx)
+	case wordaudit.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WordAudit field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WordAuditMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wordaudit.FieldWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordID(v)
+		return nil
+	case wordaudit.FieldEditor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditor(v)
+		return nil
+	case wordaudit.FieldChanges:
+		v, ok := value.(map[entity.WordField]entity.FieldChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChanges(v)
+		return nil
+	case wordaudit.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WordAudit field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WordAuditMutation) AddedFields() []string {
+	var fields []string
+	if m.addword_id != nil {
+		fields = append(fields, wordaudit.FieldWordID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WordAuditMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wordaudit.FieldWordID:
+		return m.AddedWordID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WordAuditMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case wordaudit.FieldWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWordID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WordAudit numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WordAuditMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WordAuditMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WordAuditMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WordAudit nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WordAuditMutation) ResetField(name string) error {
+	switch name {
+	case wordaudit.FieldWordID:
+		m.ResetWordID()
+		return nil
+	case wordaudit.FieldEditor:
+		m.ResetEditor()
+		return nil
+	case wordaudit.FieldChanges:
+		m.ResetChanges()
+		return nil
+	case wordaudit.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WordAudit field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WordAuditMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WordAuditMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WordAuditMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WordAuditMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WordAuditMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WordAuditMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WordAuditMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WordAudit unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WordAuditMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WordAudit edge %s", name)
+}
@@ -11,3 +11,6 @@ type LearnedLexeme func(*sql.Selector)
 
 // Word is the predicate function for word builders.
 type Word func(*sql.Selector)
+
+// WordAudit is the predicate function for wordaudit builders.
+type WordAudit func(*sql.Selector)
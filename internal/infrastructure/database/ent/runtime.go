@@ -8,6 +8,7 @@ import (
 	"github.com/eslsoft/vocnet/internal/entity"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/entschema"
 )
 
@@ -129,14 +130,36 @@ func init() {
 	wordDescCategories := wordFields[10].Descriptor()
 	// word.DefaultCategories holds the default value on creation for the categories field.
 	word.DefaultCategories = wordDescCategories.Default.([]string)
+	// wordDescSource is the schema descriptor for source field.
+	wordDescSource := wordFields[11].Descriptor()
+	// word.DefaultSource holds the default value on creation for the source field.
+	word.DefaultSource = wordDescSource.Default.(string)
+	// wordDescFrequency is the schema descriptor for frequency field.
+	wordDescFrequency := wordFields[12].Descriptor()
+	// word.DefaultFrequency holds the default value on creation for the frequency field.
+	word.DefaultFrequency = wordDescFrequency.Default.(int)
+	// wordDescCefr is the schema descriptor for cefr field.
+	wordDescCefr := wordFields[13].Descriptor()
+	// word.DefaultCefr holds the default value on creation for the cefr field.
+	word.DefaultCefr = wordDescCefr.Default.(string)
 	// wordDescCreatedAt is the schema descriptor for created_at field.
-	wordDescCreatedAt := wordFields[11].Descriptor()
+	wordDescCreatedAt := wordFields[14].Descriptor()
 	// word.DefaultCreatedAt holds the default value on creation for the created_at field.
 	word.DefaultCreatedAt = wordDescCreatedAt.Default.(func() time.Time)
 	// wordDescUpdatedAt is the schema descriptor for updated_at field.
-	wordDescUpdatedAt := wordFields[12].Descriptor()
+	wordDescUpdatedAt := wordFields[15].Descriptor()
 	// word.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	word.DefaultUpdatedAt = wordDescUpdatedAt.Default.(func() time.Time)
 	// word.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	word.UpdateDefaultUpdatedAt = wordDescUpdatedAt.UpdateDefault.(func() time.Time)
+	wordauditFields := entschema.WordAudit{}.Fields()
+	_ = wordauditFields
+	// wordauditDescEditor is the schema descriptor for editor field.
+	wordauditDescEditor := wordauditFields[1].Descriptor()
+	// wordaudit.DefaultEditor holds the default value on creation for the editor field.
+	wordaudit.DefaultEditor = wordauditDescEditor.Default.(string)
+	// wordauditDescCreatedAt is the schema descriptor for created_at field.
+	wordauditDescCreatedAt := wordauditFields[3].Descriptor()
+	// wordaudit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wordaudit.DefaultCreatedAt = wordauditDescCreatedAt.Default.(func() time.Time)
 }
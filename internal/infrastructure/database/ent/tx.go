@@ -16,6 +16,8 @@ type Tx struct {
 	LearnedLexeme *LearnedLexemeClient
 	// Word is the client for interacting with the Word builders.
 	Word *WordClient
+	// WordAudit is the client for interacting with the WordAudit builders.
+	WordAudit *WordAuditClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,6 +151,7 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.LearnedLexeme = NewLearnedLexemeClient(tx.config)
 	tx.Word = NewWordClient(tx.config)
+	tx.WordAudit = NewWordAuditClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
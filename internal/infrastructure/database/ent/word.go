@@ -41,6 +41,12 @@ type Word struct {
 	Relations []entity.WordRelation `json:"relations,omitempty"`
 	// Categories holds the value of the "categories" field.
 	Categories []string `json:"categories,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Frequency holds the value of the "frequency" field.
+	Frequency int `json:"frequency,omitempty"`
+	// Cefr holds the value of the "cefr" field.
+	Cefr string `json:"cefr,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -76,9 +82,9 @@ func (*Word) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case word.FieldPhonetics, word.FieldDefinitions, word.FieldPhrases, word.FieldSentences, word.FieldRelations, word.FieldCategories:
 			values[i] = new([]byte)
-		case word.FieldID:
+		case word.FieldID, word.FieldFrequency:
 			values[i] = new(sql.NullInt64)
-		case word.FieldText, word.FieldNormalized, word.FieldLanguage, word.FieldWordType, word.FieldLemma:
+		case word.FieldText, word.FieldNormalized, word.FieldLanguage, word.FieldWordType, word.FieldLemma, word.FieldSource, word.FieldCefr:
 			values[i] = new(sql.NullString)
 		case word.FieldCreatedAt, word.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -182,6 +188,24 @@ func (w *Word) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field categories: %w", err)
 				}
 			}
+		case word.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				w.Source = value.String
+			}
+		case word.FieldFrequency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field frequency", values[i])
+			} else if value.Valid {
+				w.Frequency = int(value.Int64)
+			}
+		case word.FieldCefr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cefr", values[i])
+			} else if value.Valid {
+				w.Cefr = value.String
+			}
 		case word.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -270,6 +294,15 @@ func (w *Word) String() string {
 	builder.WriteString("categories=")
 	builder.WriteString(fmt.Sprintf("%v", w.Categories))
 	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(w.Source)
+	builder.WriteString(", ")
+	builder.WriteString("frequency=")
+	builder.WriteString(fmt.Sprintf("%v", w.Frequency))
+	builder.WriteString(", ")
+	builder.WriteString("cefr=")
+	builder.WriteString(w.Cefr)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -80,6 +80,21 @@ func Lemma(v string) predicate.Word {
 	return predicate.Word(sql.FieldEQ(FieldLemma, v))
 }
 
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldSource, v))
+}
+
+// Frequency applies equality check predicate on the "frequency" field. It's identical to FrequencyEQ.
+func Frequency(v int) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldFrequency, v))
+}
+
+// Cefr applies equality check predicate on the "cefr" field. It's identical to CefrEQ.
+func Cefr(v string) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldCefr, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Word {
 	return predicate.Word(sql.FieldEQ(FieldCreatedAt, v))
@@ -425,6 +440,176 @@ func LemmaContainsFold(v string) predicate.Word {
 	return predicate.Word(sql.FieldContainsFold(FieldLemma, v))
 }
 
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Word {
+	return predicate.Word(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Word {
+	return predicate.Word(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Word {
+	return predicate.Word(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Word {
+	return predicate.Word(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Word {
+	return predicate.Word(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Word {
+	return predicate.Word(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Word {
+	return predicate.Word(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Word {
+	return predicate.Word(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Word {
+	return predicate.Word(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Word {
+	return predicate.Word(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Word {
+	return predicate.Word(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Word {
+	return predicate.Word(sql.FieldContainsFold(FieldSource, v))
+}
+
+// FrequencyEQ applies the EQ predicate on the "frequency" field.
+func FrequencyEQ(v int) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldFrequency, v))
+}
+
+// FrequencyNEQ applies the NEQ predicate on the "frequency" field.
+func FrequencyNEQ(v int) predicate.Word {
+	return predicate.Word(sql.FieldNEQ(FieldFrequency, v))
+}
+
+// FrequencyIn applies the In predicate on the "frequency" field.
+func FrequencyIn(vs ...int) predicate.Word {
+	return predicate.Word(sql.FieldIn(FieldFrequency, vs...))
+}
+
+// FrequencyNotIn applies the NotIn predicate on the "frequency" field.
+func FrequencyNotIn(vs ...int) predicate.Word {
+	return predicate.Word(sql.FieldNotIn(FieldFrequency, vs...))
+}
+
+// FrequencyGT applies the GT predicate on the "frequency" field.
+func FrequencyGT(v int) predicate.Word {
+	return predicate.Word(sql.FieldGT(FieldFrequency, v))
+}
+
+// FrequencyGTE applies the GTE predicate on the "frequency" field.
+func FrequencyGTE(v int) predicate.Word {
+	return predicate.Word(sql.FieldGTE(FieldFrequency, v))
+}
+
+// FrequencyLT applies the LT predicate on the "frequency" field.
+func FrequencyLT(v int) predicate.Word {
+	return predicate.Word(sql.FieldLT(FieldFrequency, v))
+}
+
+// FrequencyLTE applies the LTE predicate on the "frequency" field.
+func FrequencyLTE(v int) predicate.Word {
+	return predicate.Word(sql.FieldLTE(FieldFrequency, v))
+}
+
+// CefrEQ applies the EQ predicate on the "cefr" field.
+func CefrEQ(v string) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldCefr, v))
+}
+
+// CefrNEQ applies the NEQ predicate on the "cefr" field.
+func CefrNEQ(v string) predicate.Word {
+	return predicate.Word(sql.FieldNEQ(FieldCefr, v))
+}
+
+// CefrIn applies the In predicate on the "cefr" field.
+func CefrIn(vs ...string) predicate.Word {
+	return predicate.Word(sql.FieldIn(FieldCefr, vs...))
+}
+
+// CefrNotIn applies the NotIn predicate on the "cefr" field.
+func CefrNotIn(vs ...string) predicate.Word {
+	return predicate.Word(sql.FieldNotIn(FieldCefr, vs...))
+}
+
+// CefrGT applies the GT predicate on the "cefr" field.
+func CefrGT(v string) predicate.Word {
+	return predicate.Word(sql.FieldGT(FieldCefr, v))
+}
+
+// CefrGTE applies the GTE predicate on the "cefr" field.
+func CefrGTE(v string) predicate.Word {
+	return predicate.Word(sql.FieldGTE(FieldCefr, v))
+}
+
+// CefrLT applies the LT predicate on the "cefr" field.
+func CefrLT(v string) predicate.Word {
+	return predicate.Word(sql.FieldLT(FieldCefr, v))
+}
+
+// CefrLTE applies the LTE predicate on the "cefr" field.
+func CefrLTE(v string) predicate.Word {
+	return predicate.Word(sql.FieldLTE(FieldCefr, v))
+}
+
+// CefrContains applies the Contains predicate on the "cefr" field.
+func CefrContains(v string) predicate.Word {
+	return predicate.Word(sql.FieldContains(FieldCefr, v))
+}
+
+// CefrHasPrefix applies the HasPrefix predicate on the "cefr" field.
+func CefrHasPrefix(v string) predicate.Word {
+	return predicate.Word(sql.FieldHasPrefix(FieldCefr, v))
+}
+
+// CefrHasSuffix applies the HasSuffix predicate on the "cefr" field.
+func CefrHasSuffix(v string) predicate.Word {
+	return predicate.Word(sql.FieldHasSuffix(FieldCefr, v))
+}
+
+// CefrEqualFold applies the EqualFold predicate on the "cefr" field.
+func CefrEqualFold(v string) predicate.Word {
+	return predicate.Word(sql.FieldEqualFold(FieldCefr, v))
+}
+
+// CefrContainsFold applies the ContainsFold predicate on the "cefr" field.
+func CefrContainsFold(v string) predicate.Word {
+	return predicate.Word(sql.FieldContainsFold(FieldCefr, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Word {
 	return predicate.Word(sql.FieldEQ(FieldCreatedAt, v))
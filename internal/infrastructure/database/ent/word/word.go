@@ -37,6 +37,12 @@ const (
 	FieldRelations = "relations"
 	// FieldCategories holds the string denoting the categories field in the database.
 	FieldCategories = "categories"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldFrequency holds the string denoting the frequency field in the database.
+	FieldFrequency = "frequency"
+	// FieldCefr holds the string denoting the cefr field in the database.
+	FieldCefr = "cefr"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -68,6 +74,9 @@ var Columns = []string{
 	FieldSentences,
 	FieldRelations,
 	FieldCategories,
+	FieldSource,
+	FieldFrequency,
+	FieldCefr,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -103,6 +112,12 @@ var (
 	DefaultRelations []entity.WordRelation
 	// DefaultCategories holds the default value on creation for the "categories" field.
 	DefaultCategories []string
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultFrequency holds the default value on creation for the "frequency" field.
+	DefaultFrequency int
+	// DefaultCefr holds the default value on creation for the "cefr" field.
+	DefaultCefr string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -144,6 +159,21 @@ func ByLemma(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLemma, opts...).ToFunc()
 }
 
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByFrequency orders the results by the frequency field.
+func ByFrequency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrequency, opts...).ToFunc()
+}
+
+// ByCefr orders the results by the cefr field.
+func ByCefr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCefr, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -122,6 +122,48 @@ func (wc *WordCreate) SetCategories(s []string) *WordCreate {
 	return wc
 }
 
+// SetSource sets the "source" field.
+func (wc *WordCreate) SetSource(s string) *WordCreate {
+	wc.mutation.SetSource(s)
+	return wc
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (wc *WordCreate) SetNillableSource(s *string) *WordCreate {
+	if s != nil {
+		wc.SetSource(*s)
+	}
+	return wc
+}
+
+// SetFrequency sets the "frequency" field.
+func (wc *WordCreate) SetFrequency(i int) *WordCreate {
+	wc.mutation.SetFrequency(i)
+	return wc
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (wc *WordCreate) SetNillableFrequency(i *int) *WordCreate {
+	if i != nil {
+		wc.SetFrequency(*i)
+	}
+	return wc
+}
+
+// SetCefr sets the "cefr" field.
+func (wc *WordCreate) SetCefr(s string) *WordCreate {
+	wc.mutation.SetCefr(s)
+	return wc
+}
+
+// SetNillableCefr sets the "cefr" field if the given value is not nil.
+func (wc *WordCreate) SetNillableCefr(s *string) *WordCreate {
+	if s != nil {
+		wc.SetCefr(*s)
+	}
+	return wc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wc *WordCreate) SetCreatedAt(t time.Time) *WordCreate {
 	wc.mutation.SetCreatedAt(t)
@@ -236,6 +278,18 @@ func (wc *WordCreate) defaults() {
 		v := word.DefaultCategories
 		wc.mutation.SetCategories(v)
 	}
+	if _, ok := wc.mutation.Source(); !ok {
+		v := word.DefaultSource
+		wc.mutation.SetSource(v)
+	}
+	if _, ok := wc.mutation.Frequency(); !ok {
+		v := word.DefaultFrequency
+		wc.mutation.SetFrequency(v)
+	}
+	if _, ok := wc.mutation.Cefr(); !ok {
+		v := word.DefaultCefr
+		wc.mutation.SetCefr(v)
+	}
 	if _, ok := wc.mutation.CreatedAt(); !ok {
 		v := word.DefaultCreatedAt()
 		wc.mutation.SetCreatedAt(v)
@@ -283,6 +337,15 @@ func (wc *WordCreate) check() error {
 	if _, ok := wc.mutation.Categories(); !ok {
 		return &ValidationError{Name: "categories", err: errors.New(`ent: missing required field "Word.categories"`)}
 	}
+	if _, ok := wc.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "Word.source"`)}
+	}
+	if _, ok := wc.mutation.Frequency(); !ok {
+		return &ValidationError{Name: "frequency", err: errors.New(`ent: missing required field "Word.frequency"`)}
+	}
+	if _, ok := wc.mutation.Cefr(); !ok {
+		return &ValidationError{Name: "cefr", err: errors.New(`ent: missing required field "Word.cefr"`)}
+	}
 	if _, ok := wc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Word.created_at"`)}
 	}
@@ -360,6 +423,18 @@ func (wc *WordCreate) createSpec() (*Word, *sqlgraph.CreateSpec) {
 		_spec.SetField(word.FieldCategories, field.TypeJSON, value)
 		_node.Categories = value
 	}
+	if value, ok := wc.mutation.Source(); ok {
+		_spec.SetField(word.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := wc.mutation.Frequency(); ok {
+		_spec.SetField(word.FieldFrequency, field.TypeInt, value)
+		_node.Frequency = value
+	}
+	if value, ok := wc.mutation.Cefr(); ok {
+		_spec.SetField(word.FieldCefr, field.TypeString, value)
+		_node.Cefr = value
+	}
 	if value, ok := wc.mutation.CreatedAt(); ok {
 		_spec.SetField(word.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -574,6 +649,48 @@ func (u *WordUpsert) UpdateCategories() *WordUpsert {
 	return u
 }
 
+// SetSource sets the "source" field.
+func (u *WordUpsert) SetSource(v string) *WordUpsert {
+	u.Set(word.FieldSource, v)
+	return u
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *WordUpsert) UpdateSource() *WordUpsert {
+	u.SetExcluded(word.FieldSource)
+	return u
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *WordUpsert) SetFrequency(v int) *WordUpsert {
+	u.Set(word.FieldFrequency, v)
+	return u
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *WordUpsert) UpdateFrequency() *WordUpsert {
+	u.SetExcluded(word.FieldFrequency)
+	return u
+}
+
+// AddFrequency adds v to the "frequency" field.
+func (u *WordUpsert) AddFrequency(v int) *WordUpsert {
+	u.Add(word.FieldFrequency, v)
+	return u
+}
+
+// SetCefr sets the "cefr" field.
+func (u *WordUpsert) SetCefr(v string) *WordUpsert {
+	u.Set(word.FieldCefr, v)
+	return u
+}
+
+// UpdateCefr sets the "cefr" field to the value that was provided on create.
+func (u *WordUpsert) UpdateCefr() *WordUpsert {
+	u.SetExcluded(word.FieldCefr)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *WordUpsert) SetUpdatedAt(v time.Time) *WordUpsert {
 	u.Set(word.FieldUpdatedAt, v)
@@ -792,6 +909,55 @@ func (u *WordUpsertOne) UpdateCategories() *WordUpsertOne {
 	})
 }
 
+// SetSource sets the "source" field.
+func (u *WordUpsertOne) SetSource(v string) *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *WordUpsertOne) UpdateSource() *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *WordUpsertOne) SetFrequency(v int) *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// AddFrequency adds v to the "frequency" field.
+func (u *WordUpsertOne) AddFrequency(v int) *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.AddFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *WordUpsertOne) UpdateFrequency() *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetCefr sets the "cefr" field.
+func (u *WordUpsertOne) SetCefr(v string) *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.SetCefr(v)
+	})
+}
+
+// UpdateCefr sets the "cefr" field to the value that was provided on create.
+func (u *WordUpsertOne) UpdateCefr() *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateCefr()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *WordUpsertOne) SetUpdatedAt(v time.Time) *WordUpsertOne {
 	return u.Update(func(s *WordUpsert) {
@@ -1178,6 +1344,55 @@ func (u *WordUpsertBulk) UpdateCategories() *WordUpsertBulk {
 	})
 }
 
+// SetSource sets the "source" field.
+func (u *WordUpsertBulk) SetSource(v string) *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.SetSource(v)
+	})
+}
+
+// UpdateSource sets the "source" field to the value that was provided on create.
+func (u *WordUpsertBulk) UpdateSource() *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateSource()
+	})
+}
+
+// SetFrequency sets the "frequency" field.
+func (u *WordUpsertBulk) SetFrequency(v int) *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.SetFrequency(v)
+	})
+}
+
+// AddFrequency adds v to the "frequency" field.
+func (u *WordUpsertBulk) AddFrequency(v int) *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.AddFrequency(v)
+	})
+}
+
+// UpdateFrequency sets the "frequency" field to the value that was provided on create.
+func (u *WordUpsertBulk) UpdateFrequency() *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateFrequency()
+	})
+}
+
+// SetCefr sets the "cefr" field.
+func (u *WordUpsertBulk) SetCefr(v string) *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.SetCefr(v)
+	})
+}
+
+// UpdateCefr sets the "cefr" field to the value that was provided on create.
+func (u *WordUpsertBulk) UpdateCefr() *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateCefr()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *WordUpsertBulk) SetUpdatedAt(v time.Time) *WordUpsertBulk {
 	return u.Update(func(s *WordUpsert) {
@@ -179,6 +179,55 @@ func (wu *WordUpdate) AppendCategories(s []string) *WordUpdate {
 	return wu
 }
 
+// SetSource sets the "source" field.
+func (wu *WordUpdate) SetSource(s string) *WordUpdate {
+	wu.mutation.SetSource(s)
+	return wu
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (wu *WordUpdate) SetNillableSource(s *string) *WordUpdate {
+	if s != nil {
+		wu.SetSource(*s)
+	}
+	return wu
+}
+
+// SetFrequency sets the "frequency" field.
+func (wu *WordUpdate) SetFrequency(i int) *WordUpdate {
+	wu.mutation.ResetFrequency()
+	wu.mutation.SetFrequency(i)
+	return wu
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (wu *WordUpdate) SetNillableFrequency(i *int) *WordUpdate {
+	if i != nil {
+		wu.SetFrequency(*i)
+	}
+	return wu
+}
+
+// AddFrequency adds i to the "frequency" field.
+func (wu *WordUpdate) AddFrequency(i int) *WordUpdate {
+	wu.mutation.AddFrequency(i)
+	return wu
+}
+
+// SetCefr sets the "cefr" field.
+func (wu *WordUpdate) SetCefr(s string) *WordUpdate {
+	wu.mutation.SetCefr(s)
+	return wu
+}
+
+// SetNillableCefr sets the "cefr" field if the given value is not nil.
+func (wu *WordUpdate) SetNillableCefr(s *string) *WordUpdate {
+	if s != nil {
+		wu.SetCefr(*s)
+	}
+	return wu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (wu *WordUpdate) SetUpdatedAt(t time.Time) *WordUpdate {
 	wu.mutation.SetUpdatedAt(t)
@@ -350,6 +399,18 @@ func (wu *WordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, word.FieldCategories, value)
 		})
 	}
+	if value, ok := wu.mutation.Source(); ok {
+		_spec.SetField(word.FieldSource, field.TypeString, value)
+	}
+	if value, ok := wu.mutation.Frequency(); ok {
+		_spec.SetField(word.FieldFrequency, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedFrequency(); ok {
+		_spec.AddField(word.FieldFrequency, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.Cefr(); ok {
+		_spec.SetField(word.FieldCefr, field.TypeString, value)
+	}
 	if value, ok := wu.mutation.UpdatedAt(); ok {
 		_spec.SetField(word.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -566,6 +627,55 @@ func (wuo *WordUpdateOne) AppendCategories(s []string) *WordUpdateOne {
 	return wuo
 }
 
+// SetSource sets the "source" field.
+func (wuo *WordUpdateOne) SetSource(s string) *WordUpdateOne {
+	wuo.mutation.SetSource(s)
+	return wuo
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (wuo *WordUpdateOne) SetNillableSource(s *string) *WordUpdateOne {
+	if s != nil {
+		wuo.SetSource(*s)
+	}
+	return wuo
+}
+
+// SetFrequency sets the "frequency" field.
+func (wuo *WordUpdateOne) SetFrequency(i int) *WordUpdateOne {
+	wuo.mutation.ResetFrequency()
+	wuo.mutation.SetFrequency(i)
+	return wuo
+}
+
+// SetNillableFrequency sets the "frequency" field if the given value is not nil.
+func (wuo *WordUpdateOne) SetNillableFrequency(i *int) *WordUpdateOne {
+	if i != nil {
+		wuo.SetFrequency(*i)
+	}
+	return wuo
+}
+
+// AddFrequency adds i to the "frequency" field.
+func (wuo *WordUpdateOne) AddFrequency(i int) *WordUpdateOne {
+	wuo.mutation.AddFrequency(i)
+	return wuo
+}
+
+// SetCefr sets the "cefr" field.
+func (wuo *WordUpdateOne) SetCefr(s string) *WordUpdateOne {
+	wuo.mutation.SetCefr(s)
+	return wuo
+}
+
+// SetNillableCefr sets the "cefr" field if the given value is not nil.
+func (wuo *WordUpdateOne) SetNillableCefr(s *string) *WordUpdateOne {
+	if s != nil {
+		wuo.SetCefr(*s)
+	}
+	return wuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (wuo *WordUpdateOne) SetUpdatedAt(t time.Time) *WordUpdateOne {
 	wuo.mutation.SetUpdatedAt(t)
@@ -767,6 +877,18 @@ func (wuo *WordUpdateOne) sqlSave(ctx context.Context) (_node *Word, err error)
 			sqljson.Append(u, word.FieldCategories, value)
 		})
 	}
+	if value, ok := wuo.mutation.Source(); ok {
+		_spec.SetField(word.FieldSource, field.TypeString, value)
+	}
+	if value, ok := wuo.mutation.Frequency(); ok {
+		_spec.SetField(word.FieldFrequency, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedFrequency(); ok {
+		_spec.AddField(word.FieldFrequency, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.Cefr(); ok {
+		_spec.SetField(word.FieldCefr, field.TypeString, value)
+	}
 	if value, ok := wuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(word.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,145 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
+)
+
+// WordAudit is the model entity for the WordAudit schema.
+type WordAudit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// WordID holds the value of the "word_id" field.
+	WordID int `json:"word_id,omitempty"`
+	// Editor holds the value of the "editor" field.
+	Editor string `json:"editor,omitempty"`
+	// Changes holds the value of the "changes" field.
+	Changes map[entity.WordField]entity.FieldChange `json:"changes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WordAudit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wordaudit.FieldChanges:
+			values[i] = new([]byte)
+		case wordaudit.FieldID, wordaudit.FieldWordID:
+			values[i] = new(sql.NullInt64)
+		case wordaudit.FieldEditor:
+			values[i] = new(sql.NullString)
+		case wordaudit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WordAudit fields.
+func (wa *WordAudit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wordaudit.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wa.ID = int(value.Int64)
+		case wordaudit.FieldWordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_id", values[i])
+			} else if value.Valid {
+				wa.WordID = int(value.Int64)
+			}
+		case wordaudit.FieldEditor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field editor", values[i])
+			} else if value.Valid {
+				wa.Editor = value.String
+			}
+		case wordaudit.FieldChanges:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field changes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wa.Changes); err != nil {
+					return fmt.Errorf("unmarshal field changes: %w", err)
+				}
+			}
+		case wordaudit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wa.CreatedAt = value.Time
+			}
+		default:
+			wa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WordAudit.
+// This includes values selected through modifiers, order, etc.
+func (wa *WordAudit) Value(name string) (ent.Value, error) {
+	return wa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WordAudit.
+// Note that you need to call WordAudit.Unwrap() before calling this method if this WordAudit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wa *WordAudit) Update() *WordAuditUpdateOne {
+	return NewWordAuditClient(wa.config).UpdateOne(wa)
+}
+
+// Unwrap unwraps the WordAudit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wa *WordAudit) Unwrap() *WordAudit {
+	_tx, ok := wa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WordAudit is not a transactional entity")
+	}
+	wa.config.driver = _tx.drv
+	return wa
+}
+
+// String implements the fmt.Stringer.
+func (wa *WordAudit) String() string {
+	var builder strings.Builder
+	builder.WriteString("WordAudit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wa.ID))
+	builder.WriteString("word_id=")
+	builder.WriteString(fmt.Sprintf("%v", wa.WordID))
+	builder.WriteString(", ")
+	builder.WriteString("editor=")
+	builder.WriteString(wa.Editor)
+	builder.WriteString(", ")
+	builder.WriteString("changes=")
+	builder.WriteString(fmt.Sprintf("%v", wa.Changes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wa.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WordAudits is a parsable slice of WordAudit.
+type WordAudits []*WordAudit
@@ -0,0 +1,230 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wordaudit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLTE(FieldID, id))
+}
+
+// WordID applies equality check predicate on the "word_id" field. It's identical to WordIDEQ.
+func WordID(v int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldWordID, v))
+}
+
+// Editor applies equality check predicate on the "editor" field. It's identical to EditorEQ.
+func Editor(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldEditor, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WordIDEQ applies the EQ predicate on the "word_id" field.
+func WordIDEQ(v int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldWordID, v))
+}
+
+// WordIDNEQ applies the NEQ predicate on the "word_id" field.
+func WordIDNEQ(v int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNEQ(FieldWordID, v))
+}
+
+// WordIDIn applies the In predicate on the "word_id" field.
+func WordIDIn(vs ...int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldIn(FieldWordID, vs...))
+}
+
+// WordIDNotIn applies the NotIn predicate on the "word_id" field.
+func WordIDNotIn(vs ...int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNotIn(FieldWordID, vs...))
+}
+
+// WordIDGT applies the GT predicate on the "word_id" field.
+func WordIDGT(v int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGT(FieldWordID, v))
+}
+
+// WordIDGTE applies the GTE predicate on the "word_id" field.
+func WordIDGTE(v int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGTE(FieldWordID, v))
+}
+
+// WordIDLT applies the LT predicate on the "word_id" field.
+func WordIDLT(v int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLT(FieldWordID, v))
+}
+
+// WordIDLTE applies the LTE predicate on the "word_id" field.
+func WordIDLTE(v int) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLTE(FieldWordID, v))
+}
+
+// EditorEQ applies the EQ predicate on the "editor" field.
+func EditorEQ(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldEditor, v))
+}
+
+// EditorNEQ applies the NEQ predicate on the "editor" field.
+func EditorNEQ(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNEQ(FieldEditor, v))
+}
+
+// EditorIn applies the In predicate on the "editor" field.
+func EditorIn(vs ...string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldIn(FieldEditor, vs...))
+}
+
+// EditorNotIn applies the NotIn predicate on the "editor" field.
+func EditorNotIn(vs ...string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNotIn(FieldEditor, vs...))
+}
+
+// EditorGT applies the GT predicate on the "editor" field.
+func EditorGT(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGT(FieldEditor, v))
+}
+
+// EditorGTE applies the GTE predicate on the "editor" field.
+func EditorGTE(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGTE(FieldEditor, v))
+}
+
+// EditorLT applies the LT predicate on the "editor" field.
+func EditorLT(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLT(FieldEditor, v))
+}
+
+// EditorLTE applies the LTE predicate on the "editor" field.
+func EditorLTE(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLTE(FieldEditor, v))
+}
+
+// EditorContains applies the Contains predicate on the "editor" field.
+func EditorContains(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldContains(FieldEditor, v))
+}
+
+// EditorHasPrefix applies the HasPrefix predicate on the "editor" field.
+func EditorHasPrefix(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldHasPrefix(FieldEditor, v))
+}
+
+// EditorHasSuffix applies the HasSuffix predicate on the "editor" field.
+func EditorHasSuffix(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldHasSuffix(FieldEditor, v))
+}
+
+// EditorEqualFold applies the EqualFold predicate on the "editor" field.
+func EditorEqualFold(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEqualFold(FieldEditor, v))
+}
+
+// EditorContainsFold applies the ContainsFold predicate on the "editor" field.
+func EditorContainsFold(v string) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldContainsFold(FieldEditor, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WordAudit {
+	return predicate.WordAudit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WordAudit) predicate.WordAudit {
+	return predicate.WordAudit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WordAudit) predicate.WordAudit {
+	return predicate.WordAudit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WordAudit) predicate.WordAudit {
+	return predicate.WordAudit(sql.NotPredicates(p))
+}
@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wordaudit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the wordaudit type in the database.
+	Label = "word_audit"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWordID holds the string denoting the word_id field in the database.
+	FieldWordID = "word_id"
+	// FieldEditor holds the string denoting the editor field in the database.
+	FieldEditor = "editor"
+	// FieldChanges holds the string denoting the changes field in the database.
+	FieldChanges = "changes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the wordaudit in the database.
+	Table = "word_audit"
+)
+
+// Columns holds all SQL columns for wordaudit fields.
+var Columns = []string{
+	FieldID,
+	FieldWordID,
+	FieldEditor,
+	FieldChanges,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEditor holds the default value on creation for the "editor" field.
+	DefaultEditor string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the WordAudit queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWordID orders the results by the word_id field.
+func ByWordID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWordID, opts...).ToFunc()
+}
+
+// ByEditor orders the results by the editor field.
+func ByEditor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditor, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,637 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
+)
+
+// WordAuditCreate is the builder for creating a WordAudit entity.
+type WordAuditCreate struct {
+	config
+	mutation *WordAuditMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWordID sets the "word_id" field.
+func (wac *WordAuditCreate) SetWordID(i int) *WordAuditCreate {
+	wac.mutation.SetWordID(i)
+	return wac
+}
+
+// SetEditor sets the "editor" field.
+func (wac *WordAuditCreate) SetEditor(s string) *WordAuditCreate {
+	wac.mutation.SetEditor(s)
+	return wac
+}
+
+// SetNillableEditor sets the "editor" field if the given value is not nil.
+func (wac *WordAuditCreate) SetNillableEditor(s *string) *WordAuditCreate {
+	if s != nil {
+		wac.SetEditor(*s)
+	}
+	return wac
+}
+
+// SetChanges sets the "changes" field.
+func (wac *WordAuditCreate) SetChanges(mfc map[entity.WordField]entity.FieldChange) *WordAuditCreate {
+	wac.mutation.SetChanges(mfc)
+	return wac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wac *WordAuditCreate) SetCreatedAt(t time.Time) *WordAuditCreate {
+	wac.mutation.SetCreatedAt(t)
+	return wac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wac *WordAuditCreate) SetNillableCreatedAt(t *time.Time) *WordAuditCreate {
+	if t != nil {
+		wac.SetCreatedAt(*t)
+	}
+	return wac
+}
+
+// Mutation returns the WordAuditMutation object of the builder.
+func (wac *WordAuditCreate) Mutation() *WordAuditMutation {
+	return wac.mutation
+}
+
+// Save creates the WordAudit in the database.
+func (wac *WordAuditCreate) Save(ctx context.Context) (*WordAudit, error) {
+	wac.defaults()
+	return withHooks(ctx, wac.sqlSave, wac.mutation, wac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wac *WordAuditCreate) SaveX(ctx context.Context) *WordAudit {
+	v, err := wac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wac *WordAuditCreate) Exec(ctx context.Context) error {
+	_, err := wac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wac *WordAuditCreate) ExecX(ctx context.Context) {
+	if err := wac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wac *WordAuditCreate) defaults() {
+	if _, ok := wac.mutation.Editor(); !ok {
+		v := wordaudit.DefaultEditor
+		wac.mutation.SetEditor(v)
+	}
+	if _, ok := wac.mutation.CreatedAt(); !ok {
+		v := wordaudit.DefaultCreatedAt()
+		wac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wac *WordAuditCreate) check() error {
+	if _, ok := wac.mutation.WordID(); !ok {
+		return &ValidationError{Name: "word_id", err: errors.New(`ent: missing required field "WordAudit.word_id"`)}
+	}
+	if _, ok := wac.mutation.Editor(); !ok {
+		return &ValidationError{Name: "editor", err: errors.New(`ent: missing required field "WordAudit.editor"`)}
+	}
+	if _, ok := wac.mutation.Changes(); !ok {
+		return &ValidationError{Name: "changes", err: errors.New(`ent: missing required field "WordAudit.changes"`)}
+	}
+	if _, ok := wac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WordAudit.created_at"`)}
+	}
+	return nil
+}
+
+func (wac *WordAuditCreate) sqlSave(ctx context.Context) (*WordAudit, error) {
+	if err := wac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wac.mutation.id = &_node.ID
+	wac.mutation.done = true
+	return _node, nil
+}
+
+func (wac *WordAuditCreate) createSpec() (*WordAudit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WordAudit{config: wac.config}
+		_spec = sqlgraph.NewCreateSpec(wordaudit.Table, sqlgraph.NewFieldSpec(wordaudit.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = wac.conflict
+	if value, ok := wac.mutation.WordID(); ok {
+		_spec.SetField(wordaudit.FieldWordID, field.TypeInt, value)
+		_node.WordID = value
+	}
+	if value, ok := wac.mutation.Editor(); ok {
+		_spec.SetField(wordaudit.FieldEditor, field.TypeString, value)
+		_node.Editor = value
+	}
+	if value, ok := wac.mutation.Changes(); ok {
+		_spec.SetField(wordaudit.FieldChanges, field.TypeJSON, value)
+		_node.Changes = value
+	}
+	if value, ok := wac.mutation.CreatedAt(); ok {
+		_spec.SetField(wordaudit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WordAudit.Create().
+//		SetWordID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WordAuditUpsert) {
+//			SetWordID(v+v).
+//		}).
+//		Exec(ctx)
+func (wac *WordAuditCreate) OnConflict(opts ...sql.ConflictOption) *WordAuditUpsertOne {
+	wac.conflict = opts
+	return &WordAuditUpsertOne{
+		create: wac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WordAudit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wac *WordAuditCreate) OnConflictColumns(columns ...string) *WordAuditUpsertOne {
+	wac.conflict = append(wac.conflict, sql.ConflictColumns(columns...))
+	return &WordAuditUpsertOne{
+		create: wac,
+	}
+}
+
+type (
+	// WordAuditUpsertOne is the builder for "upsert"-ing
+	//  one WordAudit node.
+	WordAuditUpsertOne struct {
+		create *WordAuditCreate
+	}
+
+	// WordAuditUpsert is the "OnConflict" setter.
+	WordAuditUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWordID sets the "word_id" field.
+func (u *WordAuditUpsert) SetWordID(v int) *WordAuditUpsert {
+	u.Set(wordaudit.FieldWordID, v)
+	return u
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *WordAuditUpsert) UpdateWordID() *WordAuditUpsert {
+	u.SetExcluded(wordaudit.FieldWordID)
+	return u
+}
+
+// AddWordID adds v to the "word_id" field.
+func (u *WordAuditUpsert) AddWordID(v int) *WordAuditUpsert {
+	u.Add(wordaudit.FieldWordID, v)
+	return u
+}
+
+// SetEditor sets the "editor" field.
+func (u *WordAuditUpsert) SetEditor(v string) *WordAuditUpsert {
+	u.Set(wordaudit.FieldEditor, v)
+	return u
+}
+
+// UpdateEditor sets the "editor" field to the value that was provided on create.
+func (u *WordAuditUpsert) UpdateEditor() *WordAuditUpsert {
+	u.SetExcluded(wordaudit.FieldEditor)
+	return u
+}
+
+// SetChanges sets the "changes" field.
+func (u *WordAuditUpsert) SetChanges(v map[entity.WordField]entity.FieldChange) *WordAuditUpsert {
+	u.Set(wordaudit.FieldChanges, v)
+	return u
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *WordAuditUpsert) UpdateChanges() *WordAuditUpsert {
+	u.SetExcluded(wordaudit.FieldChanges)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WordAudit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WordAuditUpsertOne) UpdateNewValues() *WordAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wordaudit.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WordAudit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WordAuditUpsertOne) Ignore() *WordAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WordAuditUpsertOne) DoNothing() *WordAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WordAuditCreate.OnConflict
+// documentation for more info.
+func (u *WordAuditUpsertOne) Update(set func(*WordAuditUpsert)) *WordAuditUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WordAuditUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWordID sets the "word_id" field.
+func (u *WordAuditUpsertOne) SetWordID(v int) *WordAuditUpsertOne {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.SetWordID(v)
+	})
+}
+
+// AddWordID adds v to the "word_id" field.
+func (u *WordAuditUpsertOne) AddWordID(v int) *WordAuditUpsertOne {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.AddWordID(v)
+	})
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *WordAuditUpsertOne) UpdateWordID() *WordAuditUpsertOne {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.UpdateWordID()
+	})
+}
+
+// SetEditor sets the "editor" field.
+func (u *WordAuditUpsertOne) SetEditor(v string) *WordAuditUpsertOne {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.SetEditor(v)
+	})
+}
+
+// UpdateEditor sets the "editor" field to the value that was provided on create.
+func (u *WordAuditUpsertOne) UpdateEditor() *WordAuditUpsertOne {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.UpdateEditor()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *WordAuditUpsertOne) SetChanges(v map[entity.WordField]entity.FieldChange) *WordAuditUpsertOne {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *WordAuditUpsertOne) UpdateChanges() *WordAuditUpsertOne {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// Exec executes the query.
+func (u *WordAuditUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WordAuditCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WordAuditUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WordAuditUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WordAuditUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WordAuditCreateBulk is the builder for creating many WordAudit entities in bulk.
+type WordAuditCreateBulk struct {
+	config
+	err      error
+	builders []*WordAuditCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WordAudit entities in the database.
+func (wacb *WordAuditCreateBulk) Save(ctx context.Context) ([]*WordAudit, error) {
+	if wacb.err != nil {
+		return nil, wacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wacb.builders))
+	nodes := make([]*WordAudit, len(wacb.builders))
+	mutators := make([]Mutator, len(wacb.builders))
+	for i := range wacb.builders {
+		func(i int, root context.Context) {
+			builder := wacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WordAuditMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wacb *WordAuditCreateBulk) SaveX(ctx context.Context) []*WordAudit {
+	v, err := wacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wacb *WordAuditCreateBulk) Exec(ctx context.Context) error {
+	_, err := wacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wacb *WordAuditCreateBulk) ExecX(ctx context.Context) {
+	if err := wacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WordAudit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WordAuditUpsert) {
+//			SetWordID(v+v).
+//		}).
+//		Exec(ctx)
+func (wacb *WordAuditCreateBulk) OnConflict(opts ...sql.ConflictOption) *WordAuditUpsertBulk {
+	wacb.conflict = opts
+	return &WordAuditUpsertBulk{
+		create: wacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WordAudit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wacb *WordAuditCreateBulk) OnConflictColumns(columns ...string) *WordAuditUpsertBulk {
+	wacb.conflict = append(wacb.conflict, sql.ConflictColumns(columns...))
+	return &WordAuditUpsertBulk{
+		create: wacb,
+	}
+}
+
+// WordAuditUpsertBulk is the builder for "upsert"-ing
+// a bulk of WordAudit nodes.
+type WordAuditUpsertBulk struct {
+	create *WordAuditCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WordAudit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WordAuditUpsertBulk) UpdateNewValues() *WordAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wordaudit.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WordAudit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WordAuditUpsertBulk) Ignore() *WordAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WordAuditUpsertBulk) DoNothing() *WordAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WordAuditCreateBulk.OnConflict
+// documentation for more info.
+func (u *WordAuditUpsertBulk) Update(set func(*WordAuditUpsert)) *WordAuditUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WordAuditUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWordID sets the "word_id" field.
+func (u *WordAuditUpsertBulk) SetWordID(v int) *WordAuditUpsertBulk {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.SetWordID(v)
+	})
+}
+
+// AddWordID adds v to the "word_id" field.
+func (u *WordAuditUpsertBulk) AddWordID(v int) *WordAuditUpsertBulk {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.AddWordID(v)
+	})
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *WordAuditUpsertBulk) UpdateWordID() *WordAuditUpsertBulk {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.UpdateWordID()
+	})
+}
+
+// SetEditor sets the "editor" field.
+func (u *WordAuditUpsertBulk) SetEditor(v string) *WordAuditUpsertBulk {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.SetEditor(v)
+	})
+}
+
+// UpdateEditor sets the "editor" field to the value that was provided on create.
+func (u *WordAuditUpsertBulk) UpdateEditor() *WordAuditUpsertBulk {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.UpdateEditor()
+	})
+}
+
+// SetChanges sets the "changes" field.
+func (u *WordAuditUpsertBulk) SetChanges(v map[entity.WordField]entity.FieldChange) *WordAuditUpsertBulk {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.SetChanges(v)
+	})
+}
+
+// UpdateChanges sets the "changes" field to the value that was provided on create.
+func (u *WordAuditUpsertBulk) UpdateChanges() *WordAuditUpsertBulk {
+	return u.Update(func(s *WordAuditUpsert) {
+		s.UpdateChanges()
+	})
+}
+
+// Exec executes the query.
+func (u *WordAuditUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WordAuditCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WordAuditCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WordAuditUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/predicate"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
+)
+
+// WordAuditDelete is the builder for deleting a WordAudit entity.
+type WordAuditDelete struct {
+	config
+	hooks    []Hook
+	mutation *WordAuditMutation
+}
+
+// Where appends a list predicates to the WordAuditDelete builder.
+func (wad *WordAuditDelete) Where(ps ...predicate.WordAudit) *WordAuditDelete {
+	wad.mutation.Where(ps...)
+	return wad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wad *WordAuditDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wad.sqlExec, wad.mutation, wad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wad *WordAuditDelete) ExecX(ctx context.Context) int {
+	n, err := wad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wad *WordAuditDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(wordaudit.Table, sqlgraph.NewFieldSpec(wordaudit.FieldID, field.TypeInt))
+	if ps := wad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wad.mutation.done = true
+	return affected, err
+}
+
+// WordAuditDeleteOne is the builder for deleting a single WordAudit entity.
+type WordAuditDeleteOne struct {
+	wad *WordAuditDelete
+}
+
+// Where appends a list predicates to the WordAuditDelete builder.
+func (wado *WordAuditDeleteOne) Where(ps ...predicate.WordAudit) *WordAuditDeleteOne {
+	wado.wad.mutation.Where(ps...)
+	return wado
+}
+
+// Exec executes the deletion query.
+func (wado *WordAuditDeleteOne) Exec(ctx context.Context) error {
+	n, err := wado.wad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{wordaudit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wado *WordAuditDeleteOne) ExecX(ctx context.Context) {
+	if err := wado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
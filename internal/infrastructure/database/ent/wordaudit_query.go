@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/predicate"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
+)
+
+// WordAuditQuery is the builder for querying WordAudit entities.
+type WordAuditQuery struct {
+	config
+	ctx        *QueryContext
+	order      []wordaudit.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WordAudit
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WordAuditQuery builder.
+func (waq *WordAuditQuery) Where(ps ...predicate.WordAudit) *WordAuditQuery {
+	waq.predicates = append(waq.predicates, ps...)
+	return waq
+}
+
+// Limit the number of records to be returned by this query.
+func (waq *WordAuditQuery) Limit(limit int) *WordAuditQuery {
+	waq.ctx.Limit = &limit
+	return waq
+}
+
+// Offset to start from.
+func (waq *WordAuditQuery) Offset(offset int) *WordAuditQuery {
+	waq.ctx.Offset = &offset
+	return waq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (waq *WordAuditQuery) Unique(unique bool) *WordAuditQuery {
+	waq.ctx.Unique = &unique
+	return waq
+}
+
+// Order specifies how the records should be ordered.
+func (waq *WordAuditQuery) Order(o ...wordaudit.OrderOption) *WordAuditQuery {
+	waq.order = append(waq.order, o...)
+	return waq
+}
+
+// First returns the first WordAudit entity from the query.
+// Returns a *NotFoundError when no WordAudit was found.
+func (waq *WordAuditQuery) First(ctx context.Context) (*WordAudit, error) {
+	nodes, err := waq.Limit(1).All(setContextOp(ctx, waq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{wordaudit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (waq *WordAuditQuery) FirstX(ctx context.Context) *WordAudit {
+	node, err := waq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WordAudit ID from the query.
+// Returns a *NotFoundError when no WordAudit ID was found.
+func (waq *WordAuditQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = waq.Limit(1).IDs(setContextOp(ctx, waq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{wordaudit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (waq *WordAuditQuery) FirstIDX(ctx context.Context) int {
+	id, err := waq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WordAudit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WordAudit entity is found.
+// Returns a *NotFoundError when no WordAudit entities are found.
+func (waq *WordAuditQuery) Only(ctx context.Context) (*WordAudit, error) {
+	nodes, err := waq.Limit(2).All(setContextOp(ctx, waq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{wordaudit.Label}
+	default:
+		return nil, &NotSingularError{wordaudit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (waq *WordAuditQuery) OnlyX(ctx context.Context) *WordAudit {
+	node, err := waq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WordAudit ID in the query.
+// Returns a *NotSingularError when more than one WordAudit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (waq *WordAuditQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = waq.Limit(2).IDs(setContextOp(ctx, waq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{wordaudit.Label}
+	default:
+		err = &NotSingularError{wordaudit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (waq *WordAuditQuery) OnlyIDX(ctx context.Context) int {
+	id, err := waq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WordAudits.
+func (waq *WordAuditQuery) All(ctx context.Context) ([]*WordAudit, error) {
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryAll)
+	if err := waq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WordAudit, *WordAuditQuery]()
+	return withInterceptors[[]*WordAudit](ctx, waq, qr, waq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (waq *WordAuditQuery) AllX(ctx context.Context) []*WordAudit {
+	nodes, err := waq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WordAudit IDs.
+func (waq *WordAuditQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if waq.ctx.Unique == nil && waq.path != nil {
+		waq.Unique(true)
+	}
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryIDs)
+	if err = waq.Select(wordaudit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (waq *WordAuditQuery) IDsX(ctx context.Context) []int {
+	ids, err := waq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (waq *WordAuditQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryCount)
+	if err := waq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, waq, querierCount[*WordAuditQuery](), waq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (waq *WordAuditQuery) CountX(ctx context.Context) int {
+	count, err := waq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (waq *WordAuditQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, waq.ctx, ent.OpQueryExist)
+	switch _, err := waq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (waq *WordAuditQuery) ExistX(ctx context.Context) bool {
+	exist, err := waq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WordAuditQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (waq *WordAuditQuery) Clone() *WordAuditQuery {
+	if waq == nil {
+		return nil
+	}
+	return &WordAuditQuery{
+		config:     waq.config,
+		ctx:        waq.ctx.Clone(),
+		order:      append([]wordaudit.OrderOption{}, waq.order...),
+		inters:     append([]Interceptor{}, waq.inters...),
+		predicates: append([]predicate.WordAudit{}, waq.predicates...),
+		// clone intermediate query.
+		sql:  waq.sql.Clone(),
+		path: waq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WordID int `json:"word_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WordAudit.Query().
+//		GroupBy(wordaudit.FieldWordID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (waq *WordAuditQuery) GroupBy(field string, fields ...string) *WordAuditGroupBy {
+	waq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WordAuditGroupBy{build: waq}
+	grbuild.flds = &waq.ctx.Fields
+	grbuild.label = wordaudit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WordID int `json:"word_id,omitempty"`
+//	}
+//
+//	client.WordAudit.Query().
+//		Select(wordaudit.FieldWordID).
+//		Scan(ctx, &v)
+func (waq *WordAuditQuery) Select(fields ...string) *WordAuditSelect {
+	waq.ctx.Fields = append(waq.ctx.Fields, fields...)
+	sbuild := &WordAuditSelect{WordAuditQuery: waq}
+	sbuild.label = wordaudit.Label
+	sbuild.flds, sbuild.scan = &waq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WordAuditSelect configured with the given aggregations.
+func (waq *WordAuditQuery) Aggregate(fns ...AggregateFunc) *WordAuditSelect {
+	return waq.Select().Aggregate(fns...)
+}
+
+func (waq *WordAuditQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range waq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, waq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range waq.ctx.Fields {
+		if !wordaudit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if waq.path != nil {
+		prev, err := waq.path(ctx)
+		if err != nil {
+			return err
+		}
+		waq.sql = prev
+	}
+	return nil
+}
+
+func (waq *WordAuditQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WordAudit, error) {
+	var (
+		nodes = []*WordAudit{}
+		_spec = waq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WordAudit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WordAudit{config: waq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, waq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (waq *WordAuditQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := waq.querySpec()
+	_spec.Node.Columns = waq.ctx.Fields
+	if len(waq.ctx.Fields) > 0 {
+		_spec.Unique = waq.ctx.Unique != nil && *waq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, waq.driver, _spec)
+}
+
+func (waq *WordAuditQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(wordaudit.Table, wordaudit.Columns, sqlgraph.NewFieldSpec(wordaudit.FieldID, field.TypeInt))
+	_spec.From = waq.sql
+	if unique := waq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if waq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := waq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wordaudit.FieldID)
+		for i := range fields {
+			if fields[i] != wordaudit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := waq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := waq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := waq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := waq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (waq *WordAuditQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(waq.driver.Dialect())
+	t1 := builder.Table(wordaudit.Table)
+	columns := waq.ctx.Fields
+	if len(columns) == 0 {
+		columns = wordaudit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if waq.sql != nil {
+		selector = waq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if waq.ctx.Unique != nil && *waq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range waq.predicates {
+		p(selector)
+	}
+	for _, p := range waq.order {
+		p(selector)
+	}
+	if offset := waq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := waq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WordAuditGroupBy is the group-by builder for WordAudit entities.
+type WordAuditGroupBy struct {
+	selector
+	build *WordAuditQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wagb *WordAuditGroupBy) Aggregate(fns ...AggregateFunc) *WordAuditGroupBy {
+	wagb.fns = append(wagb.fns, fns...)
+	return wagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wagb *WordAuditGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wagb.build.ctx, ent.OpQueryGroupBy)
+	if err := wagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WordAuditQuery, *WordAuditGroupBy](ctx, wagb.build, wagb, wagb.build.inters, v)
+}
+
+func (wagb *WordAuditGroupBy) sqlScan(ctx context.Context, root *WordAuditQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wagb.fns))
+	for _, fn := range wagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wagb.flds)+len(wagb.fns))
+		for _, f := range *wagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WordAuditSelect is the builder for selecting fields of WordAudit entities.
+type WordAuditSelect struct {
+	*WordAuditQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (was *WordAuditSelect) Aggregate(fns ...AggregateFunc) *WordAuditSelect {
+	was.fns = append(was.fns, fns...)
+	return was
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (was *WordAuditSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, was.ctx, ent.OpQuerySelect)
+	if err := was.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WordAuditQuery, *WordAuditSelect](ctx, was.WordAuditQuery, was, was.inters, v)
+}
+
+func (was *WordAuditSelect) sqlScan(ctx context.Context, root *WordAuditQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(was.fns))
+	for _, fn := range was.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*was.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := was.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
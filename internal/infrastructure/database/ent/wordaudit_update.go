@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/predicate"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/wordaudit"
+)
+
+// WordAuditUpdate is the builder for updating WordAudit entities.
+type WordAuditUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WordAuditMutation
+}
+
+// Where appends a list predicates to the WordAuditUpdate builder.
+func (wau *WordAuditUpdate) Where(ps ...predicate.WordAudit) *WordAuditUpdate {
+	wau.mutation.Where(ps...)
+	return wau
+}
+
+// SetWordID sets the "word_id" field.
+func (wau *WordAuditUpdate) SetWordID(i int) *WordAuditUpdate {
+	wau.mutation.ResetWordID()
+	wau.mutation.SetWordID(i)
+	return wau
+}
+
+// SetNillableWordID sets the "word_id" field if the given value is not nil.
+func (wau *WordAuditUpdate) SetNillableWordID(i *int) *WordAuditUpdate {
+	if i != nil {
+		wau.SetWordID(*i)
+	}
+	return wau
+}
+
+// AddWordID adds i to the "word_id" field.
+func (wau *WordAuditUpdate) AddWordID(i int) *WordAuditUpdate {
+	wau.mutation.AddWordID(i)
+	return wau
+}
+
+// SetEditor sets the "editor" field.
+func (wau *WordAuditUpdate) SetEditor(s string) *WordAuditUpdate {
+	wau.mutation.SetEditor(s)
+	return wau
+}
+
+// SetNillableEditor sets the "editor" field if the given value is not nil.
+func (wau *WordAuditUpdate) SetNillableEditor(s *string) *WordAuditUpdate {
+	if s != nil {
+		wau.SetEditor(*s)
+	}
+	return wau
+}
+
+// SetChanges sets the "changes" field.
+func (wau *WordAuditUpdate) SetChanges(mfc map[entity.WordField]entity.FieldChange) *WordAuditUpdate {
+	wau.mutation.SetChanges(mfc)
+	return wau
+}
+
+// Mutation returns the WordAuditMutation object of the builder.
+func (wau *WordAuditUpdate) Mutation() *WordAuditMutation {
+	return wau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wau *WordAuditUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wau.sqlSave, wau.mutation, wau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wau *WordAuditUpdate) SaveX(ctx context.Context) int {
+	affected, err := wau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wau *WordAuditUpdate) Exec(ctx context.Context) error {
+	_, err := wau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wau *WordAuditUpdate) ExecX(ctx context.Context) {
+	if err := wau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wau *WordAuditUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wordaudit.Table, wordaudit.Columns, sqlgraph.NewFieldSpec(wordaudit.FieldID, field.TypeInt))
+	if ps := wau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wau.mutation.WordID(); ok {
+		_spec.SetField(wordaudit.FieldWordID, field.TypeInt, value)
+	}
+	if value, ok := wau.mutation.AddedWordID(); ok {
+		_spec.AddField(wordaudit.FieldWordID, field.TypeInt, value)
+	}
+	if value, ok := wau.mutation.Editor(); ok {
+		_spec.SetField(wordaudit.FieldEditor, field.TypeString, value)
+	}
+	if value, ok := wau.mutation.Changes(); ok {
+		_spec.SetField(wordaudit.FieldChanges, field.TypeJSON, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wau.mutation.done = true
+	return n, nil
+}
+
+// WordAuditUpdateOne is the builder for updating a single WordAudit entity.
+type WordAuditUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WordAuditMutation
+}
+
+// SetWordID sets the "word_id" field.
+func (wauo *WordAuditUpdateOne) SetWordID(i int) *WordAuditUpdateOne {
+	wauo.mutation.ResetWordID()
+	wauo.mutation.SetWordID(i)
+	return wauo
+}
+
+// SetNillableWordID sets the "word_id" field if the given value is not nil.
+func (wauo *WordAuditUpdateOne) SetNillableWordID(i *int) *WordAuditUpdateOne {
+	if i != nil {
+		wauo.SetWordID(*i)
+	}
+	return wauo
+}
+
+// AddWordID adds i to the "word_id" field.
+func (wauo *WordAuditUpdateOne) AddWordID(i int) *WordAuditUpdateOne {
+	wauo.mutation.AddWordID(i)
+	return wauo
+}
+
+// SetEditor sets the "editor" field.
+func (wauo *WordAuditUpdateOne) SetEditor(s string) *WordAuditUpdateOne {
+	wauo.mutation.SetEditor(s)
+	return wauo
+}
+
+// SetNillableEditor sets the "editor" field if the given value is not nil.
+func (wauo *WordAuditUpdateOne) SetNillableEditor(s *string) *WordAuditUpdateOne {
+	if s != nil {
+		wauo.SetEditor(*s)
+	}
+	return wauo
+}
+
+// SetChanges sets the "changes" field.
+func (wauo *WordAuditUpdateOne) SetChanges(mfc map[entity.WordField]entity.FieldChange) *WordAuditUpdateOne {
+	wauo.mutation.SetChanges(mfc)
+	return wauo
+}
+
+// Mutation returns the WordAuditMutation object of the builder.
+func (wauo *WordAuditUpdateOne) Mutation() *WordAuditMutation {
+	return wauo.mutation
+}
+
+// Where appends a list predicates to the WordAuditUpdate builder.
+func (wauo *WordAuditUpdateOne) Where(ps ...predicate.WordAudit) *WordAuditUpdateOne {
+	wauo.mutation.Where(ps...)
+	return wauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wauo *WordAuditUpdateOne) Select(field string, fields ...string) *WordAuditUpdateOne {
+	wauo.fields = append([]string{field}, fields...)
+	return wauo
+}
+
+// Save executes the query and returns the updated WordAudit entity.
+func (wauo *WordAuditUpdateOne) Save(ctx context.Context) (*WordAudit, error) {
+	return withHooks(ctx, wauo.sqlSave, wauo.mutation, wauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wauo *WordAuditUpdateOne) SaveX(ctx context.Context) *WordAudit {
+	node, err := wauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wauo *WordAuditUpdateOne) Exec(ctx context.Context) error {
+	_, err := wauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wauo *WordAuditUpdateOne) ExecX(ctx context.Context) {
+	if err := wauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wauo *WordAuditUpdateOne) sqlSave(ctx context.Context) (_node *WordAudit, err error) {
+	_spec := sqlgraph.NewUpdateSpec(wordaudit.Table, wordaudit.Columns, sqlgraph.NewFieldSpec(wordaudit.FieldID, field.TypeInt))
+	id, ok := wauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WordAudit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wordaudit.FieldID)
+		for _, f := range fields {
+			if !wordaudit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wordaudit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wauo.mutation.WordID(); ok {
+		_spec.SetField(wordaudit.FieldWordID, field.TypeInt, value)
+	}
+	if value, ok := wauo.mutation.AddedWordID(); ok {
+		_spec.AddField(wordaudit.FieldWordID, field.TypeInt, value)
+	}
+	if value, ok := wauo.mutation.Editor(); ok {
+		_spec.SetField(wordaudit.FieldEditor, field.TypeString, value)
+	}
+	if value, ok := wauo.mutation.Changes(); ok {
+		_spec.SetField(wordaudit.FieldChanges, field.TypeJSON, value)
+	}
+	_node = &WordAudit{config: wauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordaudit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wauo.mutation.done = true
+	return _node, nil
+}
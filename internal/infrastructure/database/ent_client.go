@@ -2,17 +2,23 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	"github.com/eslsoft/vocnet/internal/infrastructure/config"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+	"github.com/sirupsen/logrus"
 
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 )
 
-// NewEntClient constructs an ent.Client configured for the application's database.
-func NewEntClient(cfg *config.Config) (*ent.Client, func(), error) {
+// NewEntClient constructs an ent.Client configured for the application's database. When
+// cfg.Database.LogSQL is set, statements are logged through logger.
+func NewEntClient(cfg *config.Config, logger *logrus.Logger) (*ent.Client, func(), error) {
 	driver, err := cfg.DatabaseDriver()
 	if err != nil {
 		return nil, nil, fmt.Errorf("determine database driver: %w", err)
@@ -23,15 +29,65 @@ func NewEntClient(cfg *config.Config) (*ent.Client, func(), error) {
 		return nil, nil, fmt.Errorf("determine database dsn: %w", err)
 	}
 
-	client, err := ent.Open(driver, dsn, ent.Debug())
+	drv, err := entsql.Open(driver, dsn)
 	if err != nil {
 		return nil, nil, err
 	}
+	client := ent.NewClient(ent.Driver(withSQLLog(drv, cfg.Database.LogSQL, logger)))
 
-	ctx := context.Background()
+	return migrate(context.Background(), client, drv, cfg)
+}
+
+// ConnectEntClient is like NewEntClient but waits for the database to accept connections,
+// retrying with exponential backoff until cfg.Database.ConnectTimeout elapses or ctx is done.
+// SQL statement logging, when enabled, goes through logger.
+func ConnectEntClient(ctx context.Context, cfg *config.Config, logger *logrus.Logger) (*ent.Client, func(), error) {
+	driver, err := cfg.DatabaseDriver()
+	if err != nil {
+		return nil, nil, fmt.Errorf("determine database driver: %w", err)
+	}
+
+	dsn, err := cfg.DatabaseURL()
+	if err != nil {
+		return nil, nil, fmt.Errorf("determine database dsn: %w", err)
+	}
+
+	var db *sql.DB
+	policy := RetryPolicy{MaxWait: cfg.Database.ConnectTimeout, InitialBackoff: cfg.Database.ConnectBackoff}
+	err = Retry(ctx, policy, func(ctx context.Context) error {
+		conn, err := sql.Open(driver, dsn)
+		if err != nil {
+			return err
+		}
+		if err := conn.PingContext(ctx); err != nil {
+			conn.Close()
+			return err
+		}
+		db = conn
+		return nil
+	}, func(attempt int, wait time.Duration, err error) {
+		logger.Warnf("database not ready (attempt %d): %v; retrying in %s", attempt, err, wait)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect database: %w", err)
+	}
+
+	drv := entsql.OpenDB(driver, db)
+	client := ent.NewClient(ent.Driver(withSQLLog(drv, cfg.Database.LogSQL, logger)))
+	return migrate(ctx, client, drv, cfg)
+}
+
+// migrate applies the ent schema through client, then the optional indexes ent cannot express
+// through drv.
+func migrate(ctx context.Context, client *ent.Client, drv dialect.Driver, cfg *config.Config) (*ent.Client, func(), error) {
 	if err := client.Schema.Create(ctx); err != nil {
 		return nil, func() { client.Close() }, fmt.Errorf("migrate schema: %w", err)
 	}
+	if cfg.Database.TrigramSearch {
+		if err := ensureTrigramIndex(ctx, drv); err != nil {
+			return nil, func() { client.Close() }, err
+		}
+	}
 
-	return client, func() { client.Close() }, err
+	return client, func() { client.Close() }, nil
 }
@@ -47,6 +47,13 @@ func (Word) Fields() []ent.Field {
 		field.JSON("categories", []string{}).
 			Default([]string{}).
 			SchemaType(map[string]string{dialect.Postgres: "jsonb"}),
+		// source is the provenance of the row's content: "ecdict" for imports, "manual" for API edits,
+		// empty for rows written before provenance was tracked.
+		field.String("source").Default(""),
+		// frequency is ECDICT's corpus frequency rank (1 = most common); 0 means the word is unranked.
+		field.Int("frequency").Default(0),
+		// cefr is the CEFR level (A1-C2) derived from ECDICT exam tags; empty when unknown.
+		field.String("cefr").Default(""),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -61,6 +68,7 @@ func (Word) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("language", "text").Unique(),
 		index.Fields("language", "normalized"),
+		index.Fields("language", "cefr"),
 	}
 }
 
@@ -0,0 +1,47 @@
+package entschema
+
+import (
+	"time"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WordAudit holds the schema definition for the word_audit table. Rows reference words by id
+// without a foreign key so the history outlives deleted entries.
+type WordAudit struct {
+	ent.Schema
+}
+
+// Fields of the WordAudit.
+func (WordAudit) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("word_id"),
+		field.String("editor").Default(""),
+		field.JSON("changes", map[entity.WordField]entity.FieldChange{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the WordAudit.
+func (WordAudit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("word_id"),
+	}
+}
+
+// Annotations of the WordAudit.
+func (WordAudit) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "word_audit",
+		},
+	}
+}
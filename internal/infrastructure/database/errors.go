@@ -0,0 +1,120 @@
+package database
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	pgUniqueViolation      = "23505"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+)
+
+// IsTransient reports whether err is a postgres serialization failure or deadlock, which abort
+// the transaction but may succeed when it is retried.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code) == pgSerializationFailure || string(pqErr.Code) == pgDeadlockDetected
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgSerializationFailure || pgErr.Code == pgDeadlockDetected
+	}
+	return false
+}
+
+// UniqueViolation describes a unique constraint failure reported by the database driver.
+type UniqueViolation struct {
+	// Constraint is the index name when the driver reports it (postgres only).
+	Constraint string
+	// Columns lists the columns covered by the violated constraint, without table prefixes.
+	Columns []string
+	// Values holds the conflicting values keyed by column when the driver reports them (postgres only).
+	Values map[string]string
+}
+
+// AsUniqueViolation reports whether err is a unique constraint violation from any supported driver.
+func AsUniqueViolation(err error) (*UniqueViolation, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && string(pqErr.Code) == pgUniqueViolation {
+		return pgUniqueViolationFrom(pqErr.Constraint, pqErr.Detail), true
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return pgUniqueViolationFrom(pgErr.ConstraintName, pgErr.Detail), true
+	}
+	var liteErr sqlite3.Error
+	if errors.As(err, &liteErr) &&
+		(liteErr.ExtendedCode == sqlite3.ErrConstraintUnique || liteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey) {
+		return &UniqueViolation{Columns: parseSQLiteUniqueColumns(liteErr.Error())}, true
+	}
+	return nil, false
+}
+
+// pgUniqueViolationFrom parses details such as `Key (language, text)=(en, run) already exists.`.
+func pgUniqueViolationFrom(constraint, detail string) *UniqueViolation {
+	v := &UniqueViolation{Constraint: constraint}
+
+	rest, ok := strings.CutPrefix(strings.TrimSpace(detail), "Key (")
+	if !ok {
+		return v
+	}
+	cols, rest, ok := strings.Cut(rest, ")=(")
+	if !ok {
+		return v
+	}
+	vals, _, ok := strings.Cut(rest, ") already exists")
+	if !ok {
+		return v
+	}
+
+	v.Columns = splitList(cols)
+	values := splitList(vals)
+	// Values may themselves contain ", "; only trust the split when counts line up.
+	if len(values) == len(v.Columns) {
+		v.Values = make(map[string]string, len(values))
+		for i, col := range v.Columns {
+			v.Values[col] = values[i]
+		}
+	}
+	return v
+}
+
+// parseSQLiteUniqueColumns parses messages such as `UNIQUE constraint failed: words.language, words.text`.
+func parseSQLiteUniqueColumns(msg string) []string {
+	_, list, ok := strings.Cut(msg, "constraint failed:")
+	if !ok {
+		return nil
+	}
+	cols := splitList(list)
+	for i, col := range cols {
+		if idx := strings.LastIndex(col, "."); idx >= 0 {
+			cols[i] = col[idx+1:]
+		}
+	}
+	return cols
+}
+
+func splitList(s string) []string {
+	parts := strings.Split(s, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
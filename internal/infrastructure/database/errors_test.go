@@ -0,0 +1,107 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+func TestAsUniqueViolation(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		ok   bool
+		want *UniqueViolation
+	}{
+		{
+			name: "lib/pq",
+			err: fmt.Errorf("wrapped: %w", &pq.Error{
+				Code:       "23505",
+				Constraint: "word_language_text",
+				Detail:     "Key (language, text)=(en, run) already exists.",
+			}),
+			ok: true,
+			want: &UniqueViolation{
+				Constraint: "word_language_text",
+				Columns:    []string{"language", "text"},
+				Values:     map[string]string{"language": "en", "text": "run"},
+			},
+		},
+		{
+			name: "pgx value containing separator",
+			err: &pgconn.PgError{
+				Code:           "23505",
+				ConstraintName: "word_language_text",
+				Detail:         "Key (language, text)=(en, a, b) already exists.",
+			},
+			ok: true,
+			want: &UniqueViolation{
+				Constraint: "word_language_text",
+				Columns:    []string{"language", "text"},
+			},
+		},
+		{
+			name: "sqlite",
+			err: sqlite3.Error{
+				Code:         sqlite3.ErrConstraint,
+				ExtendedCode: sqlite3.ErrConstraintUnique,
+			},
+			ok:   true,
+			want: &UniqueViolation{},
+		},
+		{
+			name: "other postgres error",
+			err:  &pq.Error{Code: "23503"},
+		},
+		{
+			name: "plain error",
+			err:  errors.New("boom"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := AsUniqueViolation(tt.err)
+			if ok != tt.ok {
+				t.Fatalf("AsUniqueViolation() ok = %v, want %v", ok, tt.ok)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("AsUniqueViolation() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "pgx serialization failure", err: fmt.Errorf("commit: %w", &pgconn.PgError{Code: "40001"}), want: true},
+		{name: "lib/pq deadlock", err: &pq.Error{Code: "40P01"}, want: true},
+		{name: "unique violation", err: &pq.Error{Code: "23505"}},
+		{name: "sqlite busy", err: sqlite3.Error{Code: sqlite3.ErrBusy}},
+		{name: "nil", err: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTransient(tt.err); got != tt.want {
+				t.Fatalf("IsTransient() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSQLiteUniqueColumns(t *testing.T) {
+	got := parseSQLiteUniqueColumns("UNIQUE constraint failed: words.language, words.text")
+	want := []string{"language", "text"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("parseSQLiteUniqueColumns() = %v, want %v", got, want)
+	}
+}
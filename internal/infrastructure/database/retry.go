@@ -0,0 +1,87 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const maxRetryBackoff = 5 * time.Second
+
+// RetryPolicy bounds how long Retry keeps trying.
+type RetryPolicy struct {
+	// MaxWait is the total time budget; zero means a single attempt.
+	MaxWait time.Duration
+	// InitialBackoff is the delay after the first failure; it doubles up to maxRetryBackoff.
+	InitialBackoff time.Duration
+	// MaxAttempts, when positive, caps the number of attempts within the budget.
+	MaxAttempts int
+	// Retryable, when set, limits retries to the errors it accepts; others are returned at once.
+	Retryable func(error) bool
+}
+
+// transientTxPolicy retries transactions aborted by postgres serialization failures or deadlocks.
+var transientTxPolicy = RetryPolicy{
+	MaxWait:        5 * time.Second,
+	InitialBackoff: 50 * time.Millisecond,
+	MaxAttempts:    4,
+	Retryable:      IsTransient,
+}
+
+// Retry calls attempt until it succeeds, the policy's budget is spent, or ctx is done.
+// onRetry, if set, is called before each wait with the failed attempt number, the delay and the error.
+func Retry(ctx context.Context, policy RetryPolicy, attempt func(context.Context) error, onRetry func(attempt int, wait time.Duration, err error)) error {
+	backoff := policy.InitialBackoff
+	if backoff <= 0 {
+		backoff = 100 * time.Millisecond
+	}
+	deadline := time.Now().Add(policy.MaxWait)
+
+	for n := 1; ; n++ {
+		err := attempt(ctx)
+		if err == nil {
+			return nil
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if policy.Retryable != nil && !policy.Retryable(err) {
+			return err
+		}
+		if policy.MaxAttempts > 0 && n >= policy.MaxAttempts {
+			return fmt.Errorf("gave up after %d attempts: %w", n, err)
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return fmt.Errorf("gave up after %d attempts in %s: %w", n, policy.MaxWait, err)
+		}
+		wait := min(backoff, remaining)
+		if onRetry != nil {
+			onRetry(n, wait, err)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, maxRetryBackoff)
+	}
+}
+
+// RetryTransient runs tx, a complete transaction, again with backoff while it fails with an error
+// IsTransient accepts, logging each retry under op. tx must start a fresh transaction every call.
+func RetryTransient(ctx context.Context, op string, tx func(context.Context) error) error {
+	return Retry(ctx, transientTxPolicy, tx, func(attempt int, wait time.Duration, err error) {
+		entry := logrus.WithContext(ctx).WithFields(logrus.Fields{"op": op, "attempt": attempt, "wait": wait.String()})
+		if id := RequestIDFromContext(ctx); id != "" {
+			entry = entry.WithField("request_id", id)
+		}
+		entry.WithError(err).Warn("retrying transaction after transient failure")
+	})
+}
@@ -0,0 +1,136 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+)
+
+// fakeConnector fails the first `failures` attempts and succeeds afterwards.
+type fakeConnector struct {
+	failures int
+	attempts int
+}
+
+func (f *fakeConnector) connect(context.Context) error {
+	f.attempts++
+	if f.attempts <= f.failures {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func TestRetryEventuallyConnects(t *testing.T) {
+	conn := &fakeConnector{failures: 3}
+	var waits []time.Duration
+
+	start := time.Now()
+	err := Retry(context.Background(), RetryPolicy{MaxWait: time.Second, InitialBackoff: 5 * time.Millisecond}, conn.connect,
+		func(attempt int, wait time.Duration, err error) { waits = append(waits, wait) })
+	if err != nil {
+		t.Fatalf("expected to connect, got %v", err)
+	}
+	if conn.attempts != 4 {
+		t.Fatalf("expected 4 attempts, got %d", conn.attempts)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("connected after %s, beyond the 1s budget", elapsed)
+	}
+	want := []time.Duration{5 * time.Millisecond, 10 * time.Millisecond, 20 * time.Millisecond}
+	if len(waits) != len(want) {
+		t.Fatalf("expected %d retries, got %v", len(want), waits)
+	}
+	for i := range want {
+		if waits[i] != want[i] {
+			t.Fatalf("backoff %d = %s, want %s", i, waits[i], want[i])
+		}
+	}
+}
+
+func TestRetryGivesUpAfterBudget(t *testing.T) {
+	conn := &fakeConnector{failures: 1 << 30}
+
+	start := time.Now()
+	err := Retry(context.Background(), RetryPolicy{MaxWait: 50 * time.Millisecond, InitialBackoff: 10 * time.Millisecond}, conn.connect, nil)
+	if err == nil {
+		t.Fatal("expected error once budget is exhausted")
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("gave up after %s, before the 50ms budget", elapsed)
+	}
+	if conn.attempts < 2 {
+		t.Fatalf("expected several attempts, got %d", conn.attempts)
+	}
+}
+
+func TestRetryStopsOnCancel(t *testing.T) {
+	conn := &fakeConnector{failures: 1 << 30}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := Retry(ctx, RetryPolicy{MaxWait: time.Minute, InitialBackoff: time.Second}, conn.connect,
+		func(int, time.Duration, error) { cancel() })
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if conn.attempts != 1 {
+		t.Fatalf("expected a single attempt before cancel, got %d", conn.attempts)
+	}
+}
+
+// flakyTx fails its first `failures` runs with err and succeeds afterwards.
+type flakyTx struct {
+	failures int
+	err      error
+	attempts int
+}
+
+func (f *flakyTx) run(context.Context) error {
+	f.attempts++
+	if f.attempts <= f.failures {
+		return f.err
+	}
+	return nil
+}
+
+func TestRetryTransientRetriesSerializationFailures(t *testing.T) {
+	var logged bytes.Buffer
+	logrus.SetOutput(&logged)
+	t.Cleanup(func() { logrus.SetOutput(os.Stderr) })
+
+	tx := &flakyTx{failures: 2, err: fmt.Errorf("commit: %w", &pgconn.PgError{Code: "40001"})}
+	if err := RetryTransient(context.Background(), "import words", tx.run); err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	if tx.attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", tx.attempts)
+	}
+	if n := strings.Count(logged.String(), "retrying transaction"); n != 2 {
+		t.Fatalf("expected 2 logged retries, got %d: %s", n, logged.String())
+	}
+}
+
+func TestRetryTransientLimits(t *testing.T) {
+	logrus.SetOutput(io.Discard)
+	t.Cleanup(func() { logrus.SetOutput(os.Stderr) })
+
+	other := &flakyTx{failures: 1, err: &pq.Error{Code: "23505"}}
+	if err := RetryTransient(context.Background(), "op", other.run); err == nil || other.attempts != 1 {
+		t.Fatalf("non-transient error should not be retried: attempts %d, err %v", other.attempts, err)
+	}
+
+	deadlock := &flakyTx{failures: 1 << 30, err: &pq.Error{Code: "40P01"}}
+	err := RetryTransient(context.Background(), "op", deadlock.run)
+	if !IsTransient(err) || deadlock.attempts != transientTxPolicy.MaxAttempts {
+		t.Fatalf("expected to give up after %d attempts, got %d: %v", transientTxPolicy.MaxAttempts, deadlock.attempts, err)
+	}
+}
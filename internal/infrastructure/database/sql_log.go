@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/sirupsen/logrus"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID that SQL log lines are tagged with.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID stored by WithRequestID, or "" when absent.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// withSQLLog wraps drv so every statement is logged with its duration when enabled; otherwise
+// drv is returned untouched so disabled logging costs nothing.
+func withSQLLog(drv dialect.Driver, enabled bool, logger *logrus.Logger) dialect.Driver {
+	if !enabled || logger == nil {
+		return drv
+	}
+	return &sqlLogDriver{Driver: drv, logger: logger}
+}
+
+type sqlLogDriver struct {
+	dialect.Driver
+	logger *logrus.Logger
+}
+
+func (d *sqlLogDriver) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Exec(ctx, query, args, v)
+	logStatement(ctx, d.logger, query, args, time.Since(start), err)
+	return err
+}
+
+func (d *sqlLogDriver) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := d.Driver.Query(ctx, query, args, v)
+	logStatement(ctx, d.logger, query, args, time.Since(start), err)
+	return err
+}
+
+func (d *sqlLogDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlLogTx{Tx: tx, logger: d.logger}, nil
+}
+
+// BeginTx keeps ent's Client.BeginTx working, which requires the driver to expose it.
+func (d *sqlLogDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlLogTx{Tx: tx, logger: d.logger}, nil
+}
+
+type sqlLogTx struct {
+	dialect.Tx
+	logger *logrus.Logger
+}
+
+func (t *sqlLogTx) Exec(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Exec(ctx, query, args, v)
+	logStatement(ctx, t.logger, query, args, time.Since(start), err)
+	return err
+}
+
+func (t *sqlLogTx) Query(ctx context.Context, query string, args, v any) error {
+	start := time.Now()
+	err := t.Tx.Query(ctx, query, args, v)
+	logStatement(ctx, t.logger, query, args, time.Since(start), err)
+	return err
+}
+
+func logStatement(ctx context.Context, logger *logrus.Logger, query string, args any, duration time.Duration, err error) {
+	fields := logrus.Fields{
+		"query":    query,
+		"args":     fmt.Sprint(args),
+		"duration": duration.String(),
+	}
+	if id := RequestIDFromContext(ctx); id != "" {
+		fields["request_id"] = id
+	}
+	entry := logger.WithContext(ctx).WithFields(fields)
+	if err != nil {
+		entry.WithError(err).Warn("sql")
+		return
+	}
+	entry.Info("sql")
+}
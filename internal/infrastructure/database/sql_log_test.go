@@ -0,0 +1,64 @@
+package database
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent"
+)
+
+func TestSQLLog(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		var buf bytes.Buffer
+		logger := logrus.New()
+		logger.SetOutput(&buf)
+		logger.SetFormatter(&logrus.JSONFormatter{})
+
+		drv, err := entsql.Open("sqlite3", "file:sql_log?mode=memory&cache=shared&_fk=1")
+		if err != nil {
+			t.Fatalf("open sqlite: %v", err)
+		}
+		client := ent.NewClient(ent.Driver(withSQLLog(drv, enabled, logger)))
+		ctx := WithRequestID(context.Background(), "req-42")
+		if err := client.Schema.Create(ctx); err != nil {
+			t.Fatalf("migrate: %v", err)
+		}
+		buf.Reset()
+
+		if _, err := client.Word.Query().Count(ctx); err != nil {
+			t.Fatalf("count words: %v", err)
+		}
+		tx, err := client.Tx(ctx)
+		if err != nil {
+			t.Fatalf("begin tx: %v", err)
+		}
+		if _, err := tx.Word.Query().Count(ctx); err != nil {
+			t.Fatalf("count words in tx: %v", err)
+		}
+		if err := tx.Commit(); err != nil {
+			t.Fatalf("commit: %v", err)
+		}
+		client.Close()
+
+		logged := buf.String()
+		if !enabled {
+			if logged != "" {
+				t.Fatalf("disabled SQL log wrote output: %s", logged)
+			}
+			continue
+		}
+		if n := strings.Count(logged, `"msg":"sql"`); n != 2 {
+			t.Fatalf("expected 2 logged statements, got %d: %s", n, logged)
+		}
+		for _, want := range []string{"SELECT COUNT(", `"request_id":"req-42"`, `"duration":`} {
+			if !strings.Contains(logged, want) {
+				t.Fatalf("SQL log missing %q: %s", want, logged)
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+)
+
+// TrigramIndexName is the pg_trgm GIN index on lower(words.text) that serves keyword search.
+const TrigramIndexName = "words_text_lower_trgm_idx"
+
+// ensureTrigramIndex installs pg_trgm and indexes lower(words.text) with it, so the
+// LOWER(text) LIKE '%kw%' keyword filter becomes an index scan instead of a sequential scan.
+// Both statements are idempotent; other dialects are left alone.
+func ensureTrigramIndex(ctx context.Context, drv dialect.Driver) error {
+	if drv.Dialect() != dialect.Postgres {
+		return nil
+	}
+	for _, stmt := range []string{
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+		"CREATE INDEX IF NOT EXISTS " + TrigramIndexName + " ON words USING gin (lower(text) gin_trgm_ops)",
+	} {
+		var res sql.Result
+		if err := drv.Exec(ctx, stmt, []any{}, &res); err != nil {
+			return fmt.Errorf("create trigram index: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/sirupsen/logrus"
+)
+
+func TestTrigramIndexPostgres(t *testing.T) {
+	dsn := os.Getenv("VOCNET_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("VOCNET_TEST_POSTGRES_DSN not set")
+	}
+	cfg := &config.Config{Database: config.DatabaseConfig{DSN: dsn, TrigramSearch: true}}
+	// The second migration must be a no-op.
+	for i := 0; i < 2; i++ {
+		_, cleanup, err := NewEntClient(cfg, logrus.New())
+		if err != nil {
+			t.Fatalf("migration %d with trigram search: %v", i+1, err)
+		}
+		cleanup()
+	}
+
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open postgres: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	var def string
+	err = db.QueryRowContext(context.Background(),
+		"SELECT indexdef FROM pg_indexes WHERE tablename = 'words' AND indexname = $1", TrigramIndexName).Scan(&def)
+	if err != nil {
+		t.Fatalf("index %s not found: %v", TrigramIndexName, err)
+	}
+	if !strings.Contains(def, "gin_trgm_ops") || !strings.Contains(strings.ToLower(def), "lower(text)") {
+		t.Fatalf("unexpected index definition: %s", def)
+	}
+}
+
+func TestTrigramIndexSkippedOnSQLite(t *testing.T) {
+	dsn := "file:" + filepath.Join(t.TempDir(), "trigram.db") + "?_fk=1"
+	cfg := &config.Config{Database: config.DatabaseConfig{DSN: dsn, TrigramSearch: true}}
+	_, cleanup, err := NewEntClient(cfg, logrus.New())
+	if err != nil {
+		t.Fatalf("sqlite migration with trigram search enabled: %v", err)
+	}
+	cleanup()
+}
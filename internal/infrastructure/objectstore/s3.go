@@ -0,0 +1,179 @@
+// Package objectstore streams files to and from S3-compatible object stores (AWS S3, MinIO)
+// through the minio-go SDK.
+package objectstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+)
+
+const (
+	urlScheme     = "s3://"
+	defaultRegion = "us-east-1"
+	// partSize is how much of an upload is buffered in memory before it is sent as one part.
+	partSize = 16 << 20
+)
+
+// errAborted stops an upload whose writer was abandoned.
+var errAborted = errors.New("s3: upload aborted")
+
+// Location is an object addressed as s3://bucket/key.
+type Location struct {
+	Bucket string
+	Key    string
+}
+
+func (l Location) String() string { return urlScheme + l.Bucket + "/" + l.Key }
+
+// IsURL reports whether path names an object (s3://...) rather than a local file.
+func IsURL(path string) bool {
+	return strings.HasPrefix(path, urlScheme)
+}
+
+// ParseURL splits s3://bucket/key into its bucket and key.
+func ParseURL(raw string) (Location, error) {
+	rest, ok := strings.CutPrefix(raw, urlScheme)
+	if !ok {
+		return Location{}, fmt.Errorf("object url %q: want s3://bucket/key", raw)
+	}
+	bucket, key, _ := strings.Cut(rest, "/")
+	if bucket == "" || key == "" {
+		return Location{}, fmt.Errorf("object url %q: want s3://bucket/key", raw)
+	}
+	return Location{Bucket: bucket, Key: key}, nil
+}
+
+// Client reads and writes objects of one S3-compatible service.
+type Client struct {
+	api *minio.Client
+}
+
+// NewClient validates cfg; an empty endpoint addresses AWS S3 in cfg.Region.
+func NewClient(cfg config.S3Config) (*Client, error) {
+	if cfg.Region == "" {
+		cfg.Region = defaultRegion
+	}
+	if (cfg.AccessKeyID == "") != (cfg.SecretAccessKey == "") {
+		return nil, errors.New("s3: access key id and secret access key must be set together")
+	}
+	raw := cfg.Endpoint
+	if raw == "" {
+		raw = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	endpoint, err := url.Parse(raw)
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") ||
+		strings.Trim(endpoint.Path, "/") != "" {
+		return nil, fmt.Errorf("s3: invalid endpoint %q", raw)
+	}
+	lookup := minio.BucketLookupDNS
+	if cfg.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+	api, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.AccessKeyID, cfg.SecretAccessKey, cfg.SessionToken),
+		Secure:       endpoint.Scheme == "https",
+		Region:       cfg.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s3: %w", err)
+	}
+	return &Client{api: api}, nil
+}
+
+// Open streams the object at loc. The caller closes the returned reader. A missing object
+// reports an error matching fs.ErrNotExist.
+func (c *Client) Open(ctx context.Context, loc Location) (io.ReadCloser, error) {
+	obj, err := c.api.GetObject(ctx, loc.Bucket, loc.Key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, wrapError("get", loc, err)
+	}
+	// Stat sends the request, so a missing object fails here rather than on the first read.
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		return nil, wrapError("get", loc, err)
+	}
+	return obj, nil
+}
+
+// Create returns a writer that streams to the object at loc as a multipart upload, buffering
+// one part at a time. Close completes the upload; Abort cancels it so the object is left as it was.
+func (c *Client) Create(ctx context.Context, loc Location) (*Upload, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	u := &Upload{pw: pw, cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(u.done)
+		_, err := c.api.PutObject(ctx, loc.Bucket, loc.Key, pr, -1, minio.PutObjectOptions{PartSize: partSize})
+		if err != nil {
+			u.err = wrapError("put", loc, err)
+		}
+		// Unblock writers when the upload stops early.
+		_ = pr.CloseWithError(errAborted)
+	}()
+	return u, nil
+}
+
+// Upload is a pending object write; see Client.Create.
+type Upload struct {
+	pw     *io.PipeWriter
+	cancel context.CancelFunc
+	done   chan struct{}
+	err    error
+	closed bool
+}
+
+// Write hands p to the upload, blocking until the current part has room for it.
+func (u *Upload) Write(p []byte) (int, error) {
+	n, err := u.pw.Write(p)
+	if err != nil {
+		<-u.done
+		if u.err != nil {
+			return n, u.err
+		}
+	}
+	return n, err
+}
+
+// Close sends the last part and completes the upload.
+func (u *Upload) Close() error {
+	if u.closed {
+		return nil
+	}
+	u.closed = true
+	_ = u.pw.Close()
+	<-u.done
+	u.cancel()
+	return u.err
+}
+
+// Abort cancels the upload; parts already sent are discarded by the store.
+func (u *Upload) Abort() error {
+	if u.closed {
+		return nil
+	}
+	u.closed = true
+	_ = u.pw.CloseWithError(errAborted)
+	<-u.done
+	u.cancel()
+	return nil
+}
+
+// wrapError names the operation and object, and maps a missing object onto fs.ErrNotExist.
+func wrapError(op string, loc Location, err error) error {
+	resp := minio.ToErrorResponse(err)
+	if resp.Code == "NoSuchKey" || resp.Code == "NoSuchBucket" {
+		return fmt.Errorf("s3: %s %s: %s: %w", op, loc, resp.Message, fs.ErrNotExist)
+	}
+	return fmt.Errorf("s3: %s %s: %w", op, loc, err)
+}
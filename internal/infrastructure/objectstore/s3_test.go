@@ -0,0 +1,87 @@
+package objectstore_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/objectstore"
+	"github.com/eslsoft/vocnet/internal/infrastructure/objectstore/objectstoretest"
+)
+
+func TestUploadStreamsPartsAndReadsBack(t *testing.T) {
+	ctx := context.Background()
+	store := objectstoretest.NewServer(t)
+	client, err := objectstore.NewClient(store.Config())
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	loc := objectstore.Location{Bucket: "backups", Key: "big/vocnet.jsonl"}
+
+	// Larger than one part, so the upload needs more than one.
+	want := make([]byte, 16<<20+4096)
+	for i := range want {
+		want[i] = byte(rand.IntN(256))
+	}
+	upload, err := client.Create(ctx, loc)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := io.Copy(upload, bytes.NewReader(want)); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := upload.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	body, err := client.Open(ctx, loc)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer body.Close()
+	got, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("read back %d bytes, want the %d uploaded", len(got), len(want))
+	}
+	if n := store.PendingUploads(); n != 0 {
+		t.Fatalf("pending uploads = %d, want 0", n)
+	}
+}
+
+func TestAbortLeavesNoObject(t *testing.T) {
+	ctx := context.Background()
+	store := objectstoretest.NewServer(t)
+	client, err := objectstore.NewClient(store.Config())
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+	loc := objectstore.Location{Bucket: "backups", Key: "partial.jsonl"}
+
+	upload, err := client.Create(ctx, loc)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := upload.Write([]byte("half a backup")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := upload.Abort(); err != nil {
+		t.Fatalf("abort: %v", err)
+	}
+	if _, ok := store.Object(loc.Bucket, loc.Key); ok {
+		t.Fatal("aborted upload created the object")
+	}
+	if store.PendingUploads() != 0 || store.Aborted() != 1 {
+		t.Fatalf("pending %d, aborted %d; want the multipart upload aborted", store.PendingUploads(), store.Aborted())
+	}
+
+	if _, err := client.Open(ctx, loc); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("open missing object: %v, want fs.ErrNotExist", err)
+	}
+}
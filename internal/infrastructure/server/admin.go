@@ -0,0 +1,112 @@
+package server
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/eslsoft/vocnet/internal/usecase/backup"
+)
+
+// RoleAdmin is the role claim required by the /admin endpoints.
+const RoleAdmin = "admin"
+
+type rolesKey struct{}
+
+// WithRoles returns a context carrying the caller's role claims.
+func WithRoles(ctx context.Context, roles ...string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// HasRole reports whether the caller's auth context carries role.
+func HasRole(ctx context.Context, role string) bool {
+	roles, _ := ctx.Value(rolesKey{}).([]string)
+	return slices.Contains(roles, role)
+}
+
+// withAdminToken grants RoleAdmin to requests presenting "Authorization: Bearer <token>".
+// It never rejects on its own; handlers decide which roles they require.
+func withAdminToken(h http.Handler, token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if ok && token != "" && subtle.ConstantTimeCompare([]byte(strings.TrimSpace(presented)), []byte(token)) == 1 {
+			r = r.WithContext(WithRoles(r.Context(), RoleAdmin))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// exporter is the part of backup.Service used by the export endpoint.
+type exporter interface {
+	Export(ctx context.Context, w io.Writer, opts ...backup.ExportOption) error
+}
+
+// exportHandler streams a NDJSON backup (GET /admin/export). Query parameters:
+// gzip=true compresses the body as a .jsonl.gz download, tables=a,b limits the exported tables.
+// Rows are written as they are read and the export stops when the client goes away.
+func exportHandler(svc exporter, logger *logrus.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !HasRole(r.Context(), RoleAdmin) {
+			http.Error(w, "admin role required", http.StatusForbidden)
+			return
+		}
+
+		query := r.URL.Query()
+		gzipped := query.Get("gzip") == "1" || strings.EqualFold(query.Get("gzip"), "true")
+		var opts []backup.ExportOption
+		if tables := splitTables(query["tables"]); len(tables) > 0 {
+			opts = append(opts, backup.WithTables(tables))
+		}
+
+		filename := fmt.Sprintf("vocnet-backup-%s.jsonl", time.Now().UTC().Format("20060102-150405"))
+		var out io.Writer = w
+		var gz *gzip.Writer
+		if gzipped {
+			filename += ".gz"
+			w.Header().Set("Content-Type", "application/gzip")
+			gz = gzip.NewWriter(w)
+			out = gz
+		} else {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+		}
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.Header().Set("Cache-Control", "no-store")
+
+		if err := svc.Export(r.Context(), out, opts...); err != nil {
+			logger.WithError(err).Warn("admin export aborted")
+			// Headers are already sent; drop the connection so the client sees a truncated download
+			// instead of a seemingly complete backup.
+			panic(http.ErrAbortHandler)
+		}
+		if gz != nil {
+			if err := gz.Close(); err != nil {
+				logger.WithError(err).Warn("admin export: close gzip stream")
+			}
+		}
+	})
+}
+
+func splitTables(values []string) []string {
+	var tables []string
+	for _, value := range values {
+		for _, name := range strings.Split(value, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				tables = append(tables, name)
+			}
+		}
+	}
+	return tables
+}
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/sirupsen/logrus"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+	"github.com/eslsoft/vocnet/pkg/api/system/v1/systemv1connect"
+)
+
+func TestAdminExportRequiresAdminRole(t *testing.T) {
+	dsn := "file:" + filepath.Join(t.TempDir(), "admin.db") + "?_fk=1"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	client.Word.Create().SetText("apple").SetNormalized("apple").SetLanguage("en").SaveX(context.Background())
+	client.Close()
+
+	cfg := &config.Config{
+		Server:   config.ServerConfig{CompressMinBytes: -1, AdminToken: "s3cret"},
+		Database: config.DatabaseConfig{DSN: dsn},
+		Log:      config.LogConfig{Level: "error", Output: "stderr"},
+	}
+	srv, err := NewServer(cfg, logrus.New(), &countingWordService{}, learningv1connect.UnimplementedLearningServiceHandler{}, systemv1connect.UnimplementedSystemServiceHandler{})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	ts := httptest.NewServer(srv.httpServer.Handler)
+	t.Cleanup(ts.Close)
+
+	get := func(path, token string) *http.Response {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, ts.URL+path, nil)
+		if err != nil {
+			t.Fatalf("build request: %v", err)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		t.Cleanup(func() { resp.Body.Close() })
+		return resp
+	}
+
+	for _, token := range []string{"", "wrong"} {
+		if resp := get("/admin/export", token); resp.StatusCode != http.StatusForbidden {
+			t.Fatalf("token %q: status = %d, want 403", token, resp.StatusCode)
+		}
+	}
+
+	resp := get("/admin/export?tables=words", "s3cret")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Fatalf("content-type = %q", ct)
+	}
+	assertBackupHasWord(t, resp.Body, "apple")
+
+	resp = get("/admin/export?gzip=true", "s3cret")
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/gzip" {
+		t.Fatalf("gzip export: status=%d content-type=%q", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatalf("gzip reader: %v", err)
+	}
+	assertBackupHasWord(t, gz, "apple")
+}
+
+func TestAdminExportDisabledWithoutToken(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.ServerConfig{CompressMinBytes: -1},
+		Log:    config.LogConfig{Level: "error", Output: "stderr"},
+	}
+	srv, err := NewServer(cfg, logrus.New(), &countingWordService{}, learningv1connect.UnimplementedLearningServiceHandler{}, systemv1connect.UnimplementedSystemServiceHandler{})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	rec := httptest.NewRecorder()
+	srv.httpServer.Handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/export", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("status = %d, want 404", rec.Code)
+	}
+}
+
+func assertBackupHasWord(t *testing.T, r io.Reader, text string) {
+	t.Helper()
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	for scanner.Scan() {
+		var rec struct {
+			Type    string          `json:"type"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+			t.Fatalf("decode line %q: %v", scanner.Text(), err)
+		}
+		var row map[string]any
+		if rec.Type == "words" && json.Unmarshal(rec.Payload, &row) == nil && row["text"] == text {
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read backup: %v", err)
+	}
+	t.Fatalf("backup does not contain word %q", text)
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithCompression(t *testing.T) {
+	jsonBody := func(n int) string {
+		items := make([]string, n)
+		for i := range items {
+			items[i] = "word"
+		}
+		b, _ := json.Marshal(map[string][]string{"words": items})
+		return string(b)
+	}
+	large, small := jsonBody(500), jsonBody(2)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, large[:100])
+		_, _ = io.WriteString(w, large[100:])
+	})
+	mux.HandleFunc("/small", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, small)
+	})
+	mux.HandleFunc("/png", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		_, _ = io.WriteString(w, large)
+	})
+	srv := httptest.NewServer(withCompression(mux, 1024))
+	t.Cleanup(srv.Close)
+
+	get := func(t *testing.T, path, acceptEncoding string) (*http.Response, string) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		// Setting Accept-Encoding explicitly disables the client's transparent decompression.
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+		resp, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatalf("GET %s: %v", path, err)
+		}
+		defer resp.Body.Close()
+
+		var body io.Reader = resp.Body
+		if resp.Header.Get("Content-Encoding") == "gzip" {
+			zr, err := gzip.NewReader(resp.Body)
+			if err != nil {
+				t.Fatalf("gzip reader: %v", err)
+			}
+			body = zr
+		}
+		b, err := io.ReadAll(body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		return resp, string(b)
+	}
+
+	t.Run("large json is gzipped", func(t *testing.T) {
+		resp, body := get(t, "/large", "br, gzip;q=0.8")
+		if got := resp.Header.Get("Content-Encoding"); got != "gzip" {
+			t.Fatalf("Content-Encoding = %q, want gzip", got)
+		}
+		if !strings.Contains(resp.Header.Get("Vary"), "Accept-Encoding") {
+			t.Fatalf("Vary = %q, want Accept-Encoding", resp.Header.Get("Vary"))
+		}
+		if resp.Header.Get("Content-Type") != "application/json" {
+			t.Fatalf("Content-Type = %q", resp.Header.Get("Content-Type"))
+		}
+		if body != large {
+			t.Fatal("decompressed body does not match")
+		}
+	})
+
+	t.Run("small json is not gzipped", func(t *testing.T) {
+		resp, body := get(t, "/small", "gzip")
+		if got := resp.Header.Get("Content-Encoding"); got != "" {
+			t.Fatalf("Content-Encoding = %q, want none", got)
+		}
+		if !strings.Contains(resp.Header.Get("Vary"), "Accept-Encoding") {
+			t.Fatalf("Vary = %q, want Accept-Encoding", resp.Header.Get("Vary"))
+		}
+		if body != small {
+			t.Fatalf("body = %q, want %q", body, small)
+		}
+	})
+
+	t.Run("gzip not accepted", func(t *testing.T) {
+		resp, body := get(t, "/large", "gzip;q=0, identity")
+		if got := resp.Header.Get("Content-Encoding"); got != "" {
+			t.Fatalf("Content-Encoding = %q, want none", got)
+		}
+		if body != large {
+			t.Fatal("body does not match")
+		}
+	})
+
+	t.Run("already compressed content type is skipped", func(t *testing.T) {
+		resp, _ := get(t, "/png", "gzip")
+		if got := resp.Header.Get("Content-Encoding"); got != "" {
+			t.Fatalf("Content-Encoding = %q, want none", got)
+		}
+	})
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	connectcors "connectrpc.com/cors"
+	"github.com/rs/cors"
+	"github.com/sirupsen/logrus"
+
+	adaptergrpc "github.com/eslsoft/vocnet/internal/adapter/connectrpc"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+)
+
+// withCORS applies the configured cross-origin policy. Origins are allowed when they appear in
+// CORSAllowedOrigins or fully match one of CORSAllowOriginRegex. With CORSAllowCredentials set
+// the "*" entry is ignored, so a credentialed response only ever echoes an explicitly trusted origin.
+func withCORS(h http.Handler, cfg config.ServerConfig, logger *logrus.Logger) (http.Handler, error) {
+	patterns := make([]*regexp.Regexp, 0, len(cfg.CORSAllowOriginRegex))
+	for _, expr := range cfg.CORSAllowOriginRegex {
+		if expr == "" {
+			continue
+		}
+		// Anchor the whole expression so "example\.com" cannot match "example.com.evil.io".
+		re, err := regexp.Compile(`^(?:` + expr + `)$`)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CORS origin regex %q: %w", expr, err)
+		}
+		patterns = append(patterns, re)
+	}
+
+	allowAll := false
+	origins := make(map[string]struct{}, len(cfg.CORSAllowedOrigins))
+	for _, origin := range cfg.CORSAllowedOrigins {
+		switch origin {
+		case "":
+		case "*":
+			allowAll = true
+		default:
+			origins[origin] = struct{}{}
+		}
+	}
+	if allowAll && cfg.CORSAllowCredentials {
+		logger.Warn("CORS wildcard origin ignored because credentials are allowed; list trusted origins explicitly")
+		allowAll = false
+	}
+
+	opts := cors.Options{
+		AllowedMethods:   connectcors.AllowedMethods(),
+		AllowedHeaders:   append(connectcors.AllowedHeaders(), adaptergrpc.LanguageHeader),
+		ExposedHeaders:   connectcors.ExposedHeaders(),
+		AllowCredentials: cfg.CORSAllowCredentials,
+	}
+	if allowAll {
+		opts.AllowedOrigins = []string{"*"}
+	} else {
+		opts.AllowOriginFunc = func(origin string) bool {
+			if _, ok := origins[origin]; ok {
+				return true
+			}
+			for _, re := range patterns {
+				if re.MatchString(origin) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return cors.New(opts).Handler(h), nil
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+)
+
+func newCORSHandler(t *testing.T, cfg config.ServerConfig) http.Handler {
+	t.Helper()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	h, err := withCORS(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), cfg, logger)
+	if err != nil {
+		t.Fatalf("withCORS: %v", err)
+	}
+	return h
+}
+
+func corsResponse(h http.Handler, origin string) http.Header {
+	req := httptest.NewRequest(http.MethodPost, "/dict.v1.WordService/GetWord", nil)
+	req.Header.Set("Origin", origin)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec.Header()
+}
+
+func TestWithCORS(t *testing.T) {
+	tests := []struct {
+		name            string
+		cfg             config.ServerConfig
+		origin          string
+		wantOrigin      string
+		wantCredentials bool
+	}{
+		{
+			name:       "wildcard",
+			cfg:        config.ServerConfig{CORSAllowedOrigins: []string{"*"}},
+			origin:     "https://any.example",
+			wantOrigin: "*",
+		},
+		{
+			name:       "regex match",
+			cfg:        config.ServerConfig{CORSAllowOriginRegex: []string{`https://[a-z]+\.vocnet\.dev`}},
+			origin:     "https://app.vocnet.dev",
+			wantOrigin: "https://app.vocnet.dev",
+		},
+		{
+			name:   "regex is anchored",
+			cfg:    config.ServerConfig{CORSAllowOriginRegex: []string{`https://[a-z]+\.vocnet\.dev`}},
+			origin: "https://app.vocnet.dev.evil.io",
+		},
+		{
+			name:   "regex miss",
+			cfg:    config.ServerConfig{CORSAllowOriginRegex: []string{`https://[a-z]+\.vocnet\.dev`}},
+			origin: "http://app.vocnet.dev",
+		},
+		{
+			name: "credentialed explicit origin",
+			cfg: config.ServerConfig{
+				CORSAllowedOrigins:   []string{"https://app.vocnet.dev"},
+				CORSAllowCredentials: true,
+			},
+			origin:          "https://app.vocnet.dev",
+			wantOrigin:      "https://app.vocnet.dev",
+			wantCredentials: true,
+		},
+		{
+			name: "credentialed regex origin",
+			cfg: config.ServerConfig{
+				CORSAllowOriginRegex: []string{`https://[a-z]+\.vocnet\.dev`},
+				CORSAllowCredentials: true,
+			},
+			origin:          "https://beta.vocnet.dev",
+			wantOrigin:      "https://beta.vocnet.dev",
+			wantCredentials: true,
+		},
+		{
+			name: "wildcard ignored with credentials",
+			cfg: config.ServerConfig{
+				CORSAllowedOrigins:   []string{"*"},
+				CORSAllowCredentials: true,
+			},
+			origin: "https://evil.example",
+		},
+		{
+			name: "wildcard ignored with credentials keeps explicit origins",
+			cfg: config.ServerConfig{
+				CORSAllowedOrigins:   []string{"*", "https://app.vocnet.dev"},
+				CORSAllowCredentials: true,
+			},
+			origin:          "https://app.vocnet.dev",
+			wantOrigin:      "https://app.vocnet.dev",
+			wantCredentials: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := corsResponse(newCORSHandler(t, tt.cfg), tt.origin)
+			if got := header.Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Fatalf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if got := header.Get("Access-Control-Allow-Credentials") == "true"; got != tt.wantCredentials {
+				t.Fatalf("Access-Control-Allow-Credentials = %v, want %v", got, tt.wantCredentials)
+			}
+		})
+	}
+}
+
+func TestWithCORSPreflightWithCredentials(t *testing.T) {
+	h := newCORSHandler(t, config.ServerConfig{
+		CORSAllowedOrigins:   []string{"*"},
+		CORSAllowOriginRegex: []string{`https://[a-z]+\.vocnet\.dev`},
+		CORSAllowCredentials: true,
+	})
+	preflight := func(origin string) http.Header {
+		req := httptest.NewRequest(http.MethodOptions, "/dict.v1.WordService/GetWord", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec.Header()
+	}
+	if got := preflight("https://app.vocnet.dev").Get("Access-Control-Allow-Origin"); got != "https://app.vocnet.dev" {
+		t.Fatalf("trusted preflight origin = %q", got)
+	}
+	if got := preflight("https://evil.example").Get("Access-Control-Allow-Origin"); got != "" {
+		t.Fatalf("untrusted preflight must not be allowed, got %q", got)
+	}
+}
+
+func TestWithCORSRejectsInvalidRegex(t *testing.T) {
+	_, err := withCORS(http.NotFoundHandler(), config.ServerConfig{CORSAllowOriginRegex: []string{"("}}, logrus.New())
+	if err == nil {
+		t.Fatal("expected error for invalid origin regex")
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"os"
@@ -12,21 +13,34 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/sirupsen/logrus"
 )
 
 // InterceptorLogger adapts slog logger to interceptor logger.
 // This code is simple enough to be copied and not imported.
-func InterceptorLogger() logging.Logger {
-	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+func InterceptorLogger(w io.Writer, cfg config.LogConfig) (logging.Logger, error) {
+	logger, err := newSlogLogger(w, cfg)
+	if err != nil {
+		return nil, err
+	}
 	return logging.LoggerFunc(func(ctx context.Context, lvl logging.Level, msg string, fields ...any) {
 		logger.Log(ctx, slog.Level(lvl), msg, fields...)
-	})
+	}), nil
+}
+
+// Logger returns a unary interceptor that writes one request log line per call to w using the
+// configured level and format.
+func Logger(w io.Writer, cfg config.LogConfig) (connect.UnaryInterceptorFunc, error) {
+	logger, err := newSlogLogger(w, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return requestLogger(logger), nil
 }
 
-func Logger() connect.UnaryInterceptorFunc {
-	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+func requestLogger(logger *slog.Logger) connect.UnaryInterceptorFunc {
 	return func(next connect.UnaryFunc) connect.UnaryFunc {
 		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
 			start := time.Now()
@@ -44,6 +58,51 @@ func Logger() connect.UnaryInterceptorFunc {
 	}
 }
 
+func newSlogLogger(w io.Writer, cfg config.LogConfig) (*slog.Logger, error) {
+	level, err := parseSlogLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	opts := &slog.HandlerOptions{Level: level}
+	if strings.EqualFold(cfg.Format, "text") {
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	}
+	return slog.New(slog.NewJSONHandler(w, opts)), nil
+}
+
+// parseSlogLevel maps logrus-style level names onto slog levels.
+func parseSlogLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "trace", "debug":
+		return slog.LevelDebug, nil
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error", "fatal", "panic":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("parse log level: unknown level %q", level)
+	}
+}
+
+// NewLogOutput resolves the configured log destination, shared by the application and request
+// loggers. File paths are opened once in append mode and closed by the returned cleanup.
+func NewLogOutput(cfg *config.Config) (io.Writer, func(), error) {
+	dest := strings.TrimSpace(cfg.Log.Output)
+	switch strings.ToLower(dest) {
+	case "", "stderr":
+		return os.Stderr, func() {}, nil
+	case "stdout":
+		return os.Stdout, func() {}, nil
+	}
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open log output: %w", err)
+	}
+	return f, func() { _ = f.Close() }, nil
+}
+
 func determineLogLevel(code connect.Code, err error) slog.Level {
 	if err == nil {
 		return slog.LevelInfo
@@ -157,8 +216,19 @@ func contentLength(header http.Header) int {
 	return -1
 }
 
-// NewLogger builds a configured logrus logger from application config.
-func NewLogger(cfg *config.Config) (*logrus.Logger, error) {
+// withRequestID copies the X-Request-Id header onto the request context so SQL log lines can be
+// correlated with the request log.
+func withRequestID(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := strings.TrimSpace(r.Header.Get("X-Request-Id")); id != "" {
+			r = r.WithContext(database.WithRequestID(r.Context(), id))
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// NewLogger builds a configured logrus logger from application config, writing to out.
+func NewLogger(cfg *config.Config, out io.Writer) (*logrus.Logger, error) {
 	logger := logrus.New()
 	level, err := logrus.ParseLevel(cfg.Log.Level)
 	if err != nil {
@@ -167,6 +237,9 @@ func NewLogger(cfg *config.Config) (*logrus.Logger, error) {
 	logger.SetLevel(level)
 	if cfg.Log.Format == "text" {
 		logger.SetFormatter(&logrus.TextFormatter{FullTimestamp: true})
+	} else {
+		logger.SetFormatter(&logrus.JSONFormatter{})
 	}
+	logger.SetOutput(out)
 	return logger, nil
 }
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestRequestLoggerJSONAndLevelFiltering(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := newSlogLogger(&buf, config.LogConfig{Level: "warn", Format: "json"})
+	if err != nil {
+		t.Fatalf("newSlogLogger: %v", err)
+	}
+	interceptor := requestLogger(logger)
+
+	ok := interceptor(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	})
+	failing := interceptor(func(context.Context, connect.AnyRequest) (connect.AnyResponse, error) {
+		return nil, connect.NewError(connect.CodeInternal, context.DeadlineExceeded)
+	})
+
+	if _, err := ok(context.Background(), connect.NewRequest(&emptypb.Empty{})); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("info request log should be filtered at warn level, got %q", buf.String())
+	}
+
+	if _, err := failing(context.Background(), connect.NewRequest(&emptypb.Empty{})); err == nil {
+		t.Fatal("expected error")
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one log line, got %d: %q", len(lines), buf.String())
+	}
+	var entry map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &entry); err != nil {
+		t.Fatalf("log line is not JSON: %v (%q)", err, lines[0])
+	}
+	if entry["level"] != "ERROR" || entry["msg"] != "request completed" || entry["status"] != "internal" {
+		t.Fatalf("unexpected log entry: %v", entry)
+	}
+}
+
+func TestParseSlogLevel(t *testing.T) {
+	for level, want := range map[string]string{
+		"debug": "DEBUG", "trace": "DEBUG", "": "INFO", "INFO": "INFO",
+		"warning": "WARN", "error": "ERROR", "fatal": "ERROR",
+	} {
+		got, err := parseSlogLevel(level)
+		if err != nil {
+			t.Fatalf("parseSlogLevel(%q): %v", level, err)
+		}
+		if got.String() != want {
+			t.Fatalf("parseSlogLevel(%q) = %s, want %s", level, got, want)
+		}
+	}
+	if _, err := parseSlogLevel("loud"); err == nil {
+		t.Fatal("expected error for unknown level")
+	}
+}
+
+func TestNewSlogLoggerTextFormat(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := newSlogLogger(&buf, config.LogConfig{Level: "debug", Format: "text"})
+	if err != nil {
+		t.Fatalf("newSlogLogger: %v", err)
+	}
+	logger.Debug("hello", "k", "v")
+	if out := buf.String(); !strings.Contains(out, "level=DEBUG") || !strings.Contains(out, "k=v") {
+		t.Fatalf("unexpected text output: %q", out)
+	}
+}
+
+func TestNewLogOutputFileClosedByCleanup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+	out, cleanup, err := NewLogOutput(&config.Config{Log: config.LogConfig{Output: path}})
+	if err != nil {
+		t.Fatalf("NewLogOutput: %v", err)
+	}
+	logger, err := NewLogger(&config.Config{Log: config.LogConfig{Level: "info", Format: "json"}}, out)
+	if err != nil {
+		t.Fatalf("NewLogger: %v", err)
+	}
+	logger.Info("first")
+	cleanup()
+
+	if _, err := out.Write([]byte("late\n")); err == nil {
+		t.Fatal("log file still open after cleanup")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"msg":"first"`) {
+		t.Fatalf("log file = %q, want the first entry", data)
+	}
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/eslsoft/vocnet/internal/adapter/mapping"
+	"github.com/eslsoft/vocnet/internal/entity"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
+)
+
+// lookupPath serves word lookups as plain JSON for clients that do not speak Connect.
+const lookupPath = "/v1/words/lookup"
+
+// lookupHandler answers GET /v1/words/lookup?word=apple&lang=en by replaying it as a Connect JSON
+// call to WordService.LookupWord on next, so it passes through the same interceptors as every
+// other RPC. The word is encoded like the Connect JSON codec; prefer=exact returns the form entry
+// rather than its lemma. Missing or unknown parameters are 400, an unknown word is 404, and other
+// errors carry the status and {"code","message"} body of the Connect error.
+func lookupHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		query := r.URL.Query()
+		req := &dictv1.LookupWordRequest{Word: strings.TrimSpace(query.Get("word"))}
+		if req.Word == "" {
+			http.Error(w, "word parameter required", http.StatusBadRequest)
+			return
+		}
+		if code := query.Get("lang"); code != "" {
+			language := entity.ParseLanguage(code)
+			if language == entity.LanguageUnspecified {
+				http.Error(w, "unsupported lang "+code, http.StatusBadRequest)
+				return
+			}
+			req.Language = mapping.ToPbLanguage(language)
+		}
+		switch p := entity.LookupPreference(query.Get("prefer")); p {
+		case "", entity.LookupPreferLemma:
+		case entity.LookupPreferExact:
+			req.Prefer = dictv1.LookupPreference_LOOKUP_PREFERENCE_EXACT
+		default:
+			http.Error(w, "unsupported prefer "+string(p)+", want exact or lemma", http.StatusBadRequest)
+			return
+		}
+
+		body, err := protojson.Marshal(req)
+		if err != nil {
+			http.Error(w, "encode request", http.StatusInternalServerError)
+			return
+		}
+		call := r.Clone(r.Context())
+		call.Method = http.MethodPost
+		call.URL.Path = dictv1connect.WordServiceLookupWordProcedure
+		call.URL.RawPath = ""
+		call.URL.RawQuery = ""
+		call.RequestURI = ""
+		call.Body = io.NopCloser(bytes.NewReader(body))
+		call.ContentLength = int64(len(body))
+		call.Header.Set("Content-Type", "application/json")
+		call.Header.Set("Content-Length", strconv.Itoa(len(body)))
+		call.Header.Set("Connect-Protocol-Version", "1")
+		// Compression, if any, is applied once by the outer middleware.
+		call.Header.Del("Accept-Encoding")
+		next.ServeHTTP(w, call)
+	})
+}
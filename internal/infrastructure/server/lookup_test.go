@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	adaptergrpc "github.com/eslsoft/vocnet/internal/adapter/connectrpc"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/usecase"
+	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+	"github.com/eslsoft/vocnet/pkg/api/system/v1/systemv1connect"
+)
+
+// lookupWords serves Lookup from a fixed map and records the dialect the interceptors put on the
+// context; the other WordUsecase methods are not used.
+type lookupWords struct {
+	usecase.WordUsecase
+	words   map[string]*entity.Word
+	dialect *string
+}
+
+func (l lookupWords) Lookup(ctx context.Context, text string, language entity.Language, _ entity.LookupPreference, _ ...int32) (*entity.Word, error) {
+	*l.dialect = entity.DialectFromContext(ctx)
+	if w, ok := l.words[text]; ok && (language == entity.LanguageUnspecified || language == w.Language) {
+		return w, nil
+	}
+	return nil, fmt.Errorf("%w: %q", entity.ErrVocNotFound, text)
+}
+
+func TestLookupHandler(t *testing.T) {
+	var dialect string
+	words := lookupWords{words: map[string]*entity.Word{
+		"apple": {ID: 7, Text: "apple", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+	}, dialect: &dialect}
+	cfg := &config.Config{
+		Server: config.ServerConfig{CompressMinBytes: -1},
+		Log:    config.LogConfig{Level: "error", Output: "stderr"},
+	}
+	srv, err := NewServer(cfg, logrus.New(), adaptergrpc.NewWordServiceServer(words),
+		learningv1connect.UnimplementedLearningServiceHandler{}, systemv1connect.UnimplementedSystemServiceHandler{})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	ts := httptest.NewServer(srv.httpServer.Handler)
+	t.Cleanup(ts.Close)
+
+	get := func(query string) (*http.Response, []byte) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodGet, ts.URL+lookupPath+query, nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		req.Header.Set(adaptergrpc.DialectHeader, "uk")
+		resp, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatalf("GET %s: %v", query, err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("read body: %v", err)
+		}
+		return resp, body
+	}
+
+	resp, body := get("?word=apple&lang=en")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200 (%s)", resp.StatusCode, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("content type = %q", ct)
+	}
+	var got struct {
+		ID   string `json:"id"`
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal(body, &got); err != nil {
+		t.Fatalf("decode %s: %v", body, err)
+	}
+	if got.Text != "apple" || got.ID != "7" {
+		t.Fatalf("unexpected word %s", body)
+	}
+	// The dialect interceptor ran, as it does for the Connect route.
+	if dialect != "en-GB" {
+		t.Fatalf("dialect on lookup context = %q, want en-GB", dialect)
+	}
+
+	for query, want := range map[string]int{
+		"?word=pear&lang=en":       http.StatusNotFound,
+		"?lang=en":                 http.StatusBadRequest,
+		"?word=apple&lang=xx":      http.StatusBadRequest,
+		"?word=apple&prefer=exact": http.StatusOK,
+		"?word=apple&prefer=stem":  http.StatusBadRequest,
+	} {
+		if resp, body := get(query); resp.StatusCode != want {
+			t.Fatalf("%s: status = %d, want %d (%s)", query, resp.StatusCode, want, body)
+		}
+	}
+}
@@ -7,16 +7,17 @@ import (
 	"net/http"
 	"time"
 
-	connectcors "connectrpc.com/cors"
-	"github.com/rs/cors"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 
 	"connectrpc.com/connect"
+	adaptergrpc "github.com/eslsoft/vocnet/internal/adapter/connectrpc"
 	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/usecase/backup"
 	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
 	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+	"github.com/eslsoft/vocnet/pkg/api/system/v1/systemv1connect"
 	"github.com/sirupsen/logrus"
 )
 
@@ -29,22 +30,56 @@ type Server struct {
 }
 
 // NewServer creates a new server instance from pre-wired dependencies.
-func NewServer(cfg *config.Config, logger *logrus.Logger, wordSvc dictv1connect.WordServiceHandler, learningSvc learningv1connect.LearningServiceHandler) *Server {
-	interceptors := connect.WithInterceptors(Logger())
+func NewServer(cfg *config.Config, logger *logrus.Logger, wordSvc dictv1connect.WordServiceHandler, learningSvc learningv1connect.LearningServiceHandler, systemSvc systemv1connect.SystemServiceHandler) (*Server, error) {
+	requestLog, err := Logger(logger.Out, cfg.Log)
+	if err != nil {
+		return nil, fmt.Errorf("build request logger: %w", err)
+	}
+	methodTimeouts, err := cfg.Server.MethodTimeoutMap()
+	if err != nil {
+		return nil, err
+	}
+	// The request logger sits outside the timeout and error interceptors so it records the mapped status code.
+	opts := []connect.HandlerOption{
+		connect.WithInterceptors(
+			adaptergrpc.LanguageInterceptor(),
+			adaptergrpc.EditorInterceptor(),
+			adaptergrpc.UserInterceptor(cfg.SingleUser, cfg.AnonymousUserID),
+			adaptergrpc.DialectInterceptor(),
+			requestLog,
+			adaptergrpc.TimeoutInterceptor(cfg.Server.RequestTimeout, methodTimeouts),
+			adaptergrpc.ConcurrencyInterceptor(cfg.Server.MaxConcurrentRequests, cfg.Server.ConcurrencyWait),
+			adaptergrpc.ErrorInterceptor(),
+		),
+	}
+	if cfg.Server.MaxRequestBytes > 0 {
+		opts = append(opts, connect.WithReadMaxBytes(cfg.Server.MaxRequestBytes))
+	}
 
 	mux := http.NewServeMux()
-	mux.Handle(dictv1connect.NewWordServiceHandler(wordSvc, interceptors))
-	mux.Handle(learningv1connect.NewLearningServiceHandler(learningSvc, interceptors))
+	mux.Handle(dictv1connect.NewWordServiceHandler(wordSvc, opts...))
+	mux.Handle(learningv1connect.NewLearningServiceHandler(learningSvc, opts...))
+	// Server info needs no user, so it is rate limited instead.
+	mux.Handle(systemv1connect.NewSystemServiceHandler(systemSvc,
+		append(opts, connect.WithInterceptors(adaptergrpc.RateLimitInterceptor(cfg.Server.InfoRateLimit)))...))
+	mux.Handle(lookupPath, lookupHandler(mux))
+	if err := mountAdmin(mux, cfg, logger); err != nil {
+		return nil, err
+	}
+	handler, err := withCORS(withCompression(withRequestID(mux), cfg.Server.CompressMinBytes), cfg.Server, logger)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Server{
 		config: cfg,
 		httpServer: &http.Server{
 			Addr:              fmt.Sprintf(":%d", cfg.Server.HTTPPort),
-			Handler:           h2c.NewHandler(withCORS(mux), &http2.Server{}),
+			Handler:           h2c.NewHandler(handler, &http2.Server{}),
 			ReadHeaderTimeout: 5 * time.Second,
 		},
 		logger: logger,
-	}
+	}, nil
 }
 
 // StartGRPC starts the gRPC server
@@ -90,12 +125,23 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	return nil
 }
 
-func withCORS(h http.Handler) http.Handler {
-	middleware := cors.New(cors.Options{
-		AllowedOrigins: []string{"*"},
-		AllowedMethods: connectcors.AllowedMethods(),
-		AllowedHeaders: connectcors.AllowedHeaders(),
-		ExposedHeaders: connectcors.ExposedHeaders(),
-	})
-	return middleware.Handler(h)
+// mountAdmin registers the admin endpoints when an admin token is configured.
+func mountAdmin(mux *http.ServeMux, cfg *config.Config, logger *logrus.Logger) error {
+	if cfg.Server.AdminToken == "" {
+		return nil
+	}
+	driver, err := cfg.DatabaseDriver()
+	if err != nil {
+		return fmt.Errorf("resolve database driver: %w", err)
+	}
+	dsn, err := cfg.DatabaseURL()
+	if err != nil {
+		return fmt.Errorf("resolve database dsn: %w", err)
+	}
+	svc, err := backup.NewService(driver, dsn, backup.WithDeniedTables(cfg.Backup.DenyTables))
+	if err != nil {
+		return fmt.Errorf("build backup service: %w", err)
+	}
+	mux.Handle("/admin/export", withAdminToken(exportHandler(svc, logger), cfg.Server.AdminToken))
+	return nil
 }
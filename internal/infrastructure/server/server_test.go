@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	adaptergrpc "github.com/eslsoft/vocnet/internal/adapter/connectrpc"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/config"
+	"github.com/eslsoft/vocnet/internal/usecase/backup"
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
+	"github.com/eslsoft/vocnet/pkg/api/dict/v1/dictv1connect"
+	"github.com/eslsoft/vocnet/pkg/api/learning/v1/learningv1connect"
+	systemv1 "github.com/eslsoft/vocnet/pkg/api/system/v1"
+	"github.com/eslsoft/vocnet/pkg/api/system/v1/systemv1connect"
+	"github.com/sirupsen/logrus"
+)
+
+type countingWordService struct {
+	dictv1connect.UnimplementedWordServiceHandler
+	calls int
+	err   error
+}
+
+func (s *countingWordService) CreateWord(context.Context, *connect.Request[dictv1.CreateWordRequest]) (*connect.Response[dictv1.Word], error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return connect.NewResponse(&dictv1.Word{}), nil
+}
+
+func newTestServer(t *testing.T, maxRequestBytes int, wordSvc dictv1connect.WordServiceHandler) dictv1connect.WordServiceClient {
+	t.Helper()
+	cfg := &config.Config{
+		Server: config.ServerConfig{MaxRequestBytes: maxRequestBytes, CompressMinBytes: -1},
+		Log:    config.LogConfig{Level: "error", Output: "stderr"},
+	}
+	srv, err := NewServer(cfg, logrus.New(), wordSvc, learningv1connect.UnimplementedLearningServiceHandler{}, systemv1connect.UnimplementedSystemServiceHandler{})
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	ts := httptest.NewServer(srv.httpServer.Handler)
+	t.Cleanup(ts.Close)
+	return dictv1connect.NewWordServiceClient(ts.Client(), ts.URL)
+}
+
+func TestServerRejectsOversizedRequests(t *testing.T) {
+	svc := &countingWordService{}
+	client := newTestServer(t, 1024, svc)
+
+	big := &dictv1.Word{Text: "big", Sentences: []*dictv1.Sentence{{Text: strings.Repeat("x", 4096)}}}
+	_, err := client.CreateWord(context.Background(), connect.NewRequest(&dictv1.CreateWordRequest{Word: big}))
+	if got := connect.CodeOf(err); got != connect.CodeResourceExhausted {
+		t.Fatalf("expected ResourceExhausted, got %v (%v)", got, err)
+	}
+	if svc.calls != 0 {
+		t.Fatalf("handler must not run for oversized requests, got %d calls", svc.calls)
+	}
+
+	if _, err := client.CreateWord(context.Background(), connect.NewRequest(&dictv1.CreateWordRequest{Word: &dictv1.Word{Text: "ok"}})); err != nil {
+		t.Fatalf("small request failed: %v", err)
+	}
+	if svc.calls != 1 {
+		t.Fatalf("expected handler to run once, got %d", svc.calls)
+	}
+}
+
+func TestServerMapsDomainErrorsToCodes(t *testing.T) {
+	svc := &countingWordService{err: fmt.Errorf("%w: 501 sentences exceeds limit of 500", entity.ErrWordTooLarge)}
+	client := newTestServer(t, 0, svc)
+
+	_, err := client.CreateWord(context.Background(), connect.NewRequest(&dictv1.CreateWordRequest{Word: &dictv1.Word{Text: "x"}}))
+	if got := connect.CodeOf(err); got != connect.CodeInvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v (%v)", got, err)
+	}
+}
+
+func TestServerInfo(t *testing.T) {
+	cfg := &config.Config{
+		Server: config.ServerConfig{CompressMinBytes: -1, InfoRateLimit: 2},
+		Log:    config.LogConfig{Level: "error", Output: "stderr"},
+	}
+	started := time.Now().Add(-time.Hour)
+	systemSvc := adaptergrpc.NewSystemServiceServer(adaptergrpc.ServerInfo{
+		Version:        "v1.2.3",
+		SchemaHash:     backup.SchemaHash(),
+		DatabaseDriver: "sqlite3",
+		StartedAt:      started,
+	})
+	srv, err := NewServer(cfg, logrus.New(), &countingWordService{}, learningv1connect.UnimplementedLearningServiceHandler{}, systemSvc)
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	ts := httptest.NewServer(srv.httpServer.Handler)
+	t.Cleanup(ts.Close)
+	client := systemv1connect.NewSystemServiceClient(ts.Client(), ts.URL)
+
+	// The config is multi-user and no user header is sent: server info needs no user.
+	resp, err := client.GetServerInfo(context.Background(), connect.NewRequest(&systemv1.GetServerInfoRequest{}))
+	if err != nil {
+		t.Fatalf("GetServerInfo: %v", err)
+	}
+	info := resp.Msg
+	if info.GetVersion() != "v1.2.3" || info.GetDatabaseDriver() != "sqlite3" || len(info.GetSchemaHash()) == 0 {
+		t.Fatalf("unexpected server info %v", info)
+	}
+	if !info.GetStartedAt().AsTime().Equal(started) || info.GetUptime().AsDuration() < time.Hour {
+		t.Fatalf("started_at %v uptime %v, want %v and at least 1h", info.GetStartedAt().AsTime(), info.GetUptime().AsDuration(), started)
+	}
+
+	if _, err := client.GetServerInfo(context.Background(), connect.NewRequest(&systemv1.GetServerInfoRequest{})); err != nil {
+		t.Fatalf("second call within the limit: %v", err)
+	}
+	_, err = client.GetServerInfo(context.Background(), connect.NewRequest(&systemv1.GetServerInfoRequest{}))
+	if got := connect.CodeOf(err); got != connect.CodeResourceExhausted {
+		t.Fatalf("third call: expected ResourceExhausted, got %v (%v)", got, err)
+	}
+}
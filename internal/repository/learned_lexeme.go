@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/eslsoft/vocnet/internal/entity"
 )
@@ -12,14 +13,59 @@ type ListLearnedLexemeQuery struct {
 	FilterOrder
 
 	UserID int64
+	// All confirms that an empty filter may match every row in destructive operations.
+	All bool
+	// Now is the reference time for time-relative filters such as review_state == "due";
+	// zero means the current time.
+	Now time.Time
 }
 
 // LearnedLexemeRepository abstracts persistence for user lexemes to keep usecases storage agnostic.
 type LearnedLexemeRepository interface {
 	Create(ctx context.Context, lexeme *entity.LearnedLexeme) (*entity.LearnedLexeme, error)
+	// Update writes every field except QueryCount, which only changes through IncrementQueryCount
+	// so concurrent collects cannot overwrite each other's increments.
 	Update(ctx context.Context, lexeme *entity.LearnedLexeme) (*entity.LearnedLexeme, error)
+	// UpdateMany loads the lexemes of userID with ids, locked until the transaction ends where the
+	// database supports it, and passes them to apply keyed by id; ids the user does not own are
+	// absent. The lexemes apply returns are written with Update in the same transaction and returned
+	// in that order, so concurrent batches cannot overwrite each other. apply may run again when a
+	// transient failure retries the transaction; any error rolls back the whole batch.
+	UpdateMany(ctx context.Context, userID int64, ids []int64, apply func(map[int64]*entity.LearnedLexeme) ([]*entity.LearnedLexeme, error)) ([]*entity.LearnedLexeme, error)
+	// IncrementQueryCount atomically adds one to the lexeme's query count and returns the new value.
+	IncrementQueryCount(ctx context.Context, userID, id int64) (int64, error)
 	GetByID(ctx context.Context, userID, id int64) (*entity.LearnedLexeme, error)
+	// FindByTerm returns (nil, nil) when the user has not collected term yet.
 	FindByTerm(ctx context.Context, userID int64, term string) (*entity.LearnedLexeme, error)
+	// FindByNormalizedTerm matches term case-insensitively within language, preferring the most
+	// recently updated lexeme when case variants exist; (nil, nil) when none is collected.
+	FindByNormalizedTerm(ctx context.Context, userID int64, language entity.Language, term string) (*entity.LearnedLexeme, error)
 	List(ctx context.Context, filter *ListLearnedLexemeQuery) ([]entity.LearnedLexeme, int64, error)
+	// Count counts the lexemes of query.UserID matching query.Filter without loading them; the
+	// query's pagination and ordering are ignored.
+	Count(ctx context.Context, query *ListLearnedLexemeQuery) (int64, error)
+	// CountDue counts the user's lexemes whose next review is at or before now.
+	CountDue(ctx context.Context, userID int64, now time.Time) (int64, error)
 	Delete(ctx context.Context, userID, id int64) error
+	// DeleteByFilter removes every lexeme of query.UserID matching query.Filter and returns the count.
+	DeleteByFilter(ctx context.Context, query *ListLearnedLexemeQuery) (int64, error)
+	// MergeDuplicates applies all merges atomically: each Keep row is updated and its RemoveIDs are deleted.
+	MergeDuplicates(ctx context.Context, userID int64, merges []LearnedLexemeMerge) error
+	// ReattachDictionaryWords re-resolves, by normalized term and language, the dictionary word of
+	// up to limit lexemes after afterID (in id order) whose word_id is empty or points at a missing
+	// word. It returns what changed and the last id scanned; a zero Scanned means no rows remain.
+	ReattachDictionaryWords(ctx context.Context, afterID int64, limit int) (stats ReattachStats, lastID int64, err error)
+}
+
+// ReattachStats counts the work done by ReattachDictionaryWords.
+type ReattachStats struct {
+	Scanned int // lexemes without a live dictionary link
+	Linked  int // lexemes now pointing at a dictionary word
+	Cleared int // dangling links removed because no dictionary word matches
+}
+
+// LearnedLexemeMerge folds duplicate rows into Keep and removes the rows listed in RemoveIDs.
+type LearnedLexemeMerge struct {
+	Keep      *entity.LearnedLexeme
+	RemoveIDs []int64
 }
@@ -1,16 +1,63 @@
 package repository
 
-// Pagination holds pagination parameters for listing entities.
+import "github.com/eslsoft/vocnet/pkg/filterexpr"
+
+// Page size bounds applied by NewPagination.
+const (
+	DefaultPageSize = int32(20)
+	MaxPageSize     = int32(10000)
+)
+
+// Pagination holds pagination parameters for listing entities. A PageSize <= 0 means unpaged.
 type Pagination struct {
 	PageNo   int32
 	PageSize int32
 }
 
-func (p *Pagination) Offset() int32 { return (p.PageNo - 1) * p.PageSize }
+// NewPagination clamps client-supplied paging: page numbers start at 1, and page sizes default to
+// DefaultPageSize and are capped at MaxPageSize.
+func NewPagination(pageNo, pageSize int32) Pagination {
+	if pageNo <= 0 {
+		pageNo = 1
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return Pagination{PageNo: pageNo, PageSize: min(pageSize, MaxPageSize)}
+}
+
+// Offset returns how many rows precede the page; 0 for the first page and for unpaged or
+// out-of-range values. It is an int64 because (PageNo-1)*PageSize can overflow int32.
+func (p Pagination) Offset() int64 {
+	if p.PageNo <= 1 || p.PageSize <= 0 {
+		return 0
+	}
+	return int64(p.PageNo-1) * int64(p.PageSize)
+}
+
+// Limit returns the number of rows on the page, or 0 when unpaged.
+func (p Pagination) Limit() int {
+	return int(max(p.PageSize, 0))
+}
+
+// TotalPages returns how many pages total rows fill, or 0 when unpaged.
+func (p Pagination) TotalPages(total int64) int64 {
+	if p.PageSize <= 0 || total <= 0 {
+		return 0
+	}
+	return (total-1)/int64(p.PageSize) + 1
+}
+
+// HasNextPage reports whether rows remain after the page.
+func (p Pagination) HasNextPage(total int64) bool {
+	return p.PageSize > 0 && p.Offset()+int64(p.PageSize) < total
+}
 
 type FilterOrder struct {
 	Filter  string
 	OrderBy string
+	// Limits bounds how complex Filter may be; zero fields use the filterexpr defaults.
+	Limits filterexpr.FilterLimits
 }
 
 func (fo *FilterOrder) GetFilter() string { return fo.Filter }
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewPagination(t *testing.T) {
+	tests := []struct {
+		name             string
+		pageNo, pageSize int32
+		want             Pagination
+	}{
+		{name: "defaults", want: Pagination{PageNo: 1, PageSize: DefaultPageSize}},
+		{name: "negative", pageNo: -3, pageSize: -5, want: Pagination{PageNo: 1, PageSize: DefaultPageSize}},
+		{name: "kept", pageNo: 4, pageSize: 50, want: Pagination{PageNo: 4, PageSize: 50}},
+		{name: "at max size", pageNo: 2, pageSize: MaxPageSize, want: Pagination{PageNo: 2, PageSize: MaxPageSize}},
+		{name: "capped size", pageNo: 2, pageSize: math.MaxInt32, want: Pagination{PageNo: 2, PageSize: MaxPageSize}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := NewPagination(tc.pageNo, tc.pageSize); got != tc.want {
+				t.Fatalf("NewPagination(%d, %d) = %+v, want %+v", tc.pageNo, tc.pageSize, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPaginationMath(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       Pagination
+		total      int64
+		wantOffset int64
+		wantLimit  int
+		wantPages  int64
+		wantNext   bool
+	}{
+		{name: "unpaged", page: Pagination{}, total: 50, wantOffset: 0, wantLimit: 0, wantPages: 0, wantNext: false},
+		{name: "negative size", page: Pagination{PageNo: 3, PageSize: -1}, total: 50, wantOffset: 0, wantLimit: 0, wantPages: 0, wantNext: false},
+		{name: "zero page", page: Pagination{PageNo: 0, PageSize: 20}, total: 50, wantOffset: 0, wantLimit: 20, wantPages: 3, wantNext: true},
+		{name: "negative page", page: Pagination{PageNo: -2, PageSize: 20}, total: 50, wantOffset: 0, wantLimit: 20, wantPages: 3, wantNext: true},
+		{name: "first page", page: Pagination{PageNo: 1, PageSize: 20}, total: 50, wantOffset: 0, wantLimit: 20, wantPages: 3, wantNext: true},
+		{name: "last partial page", page: Pagination{PageNo: 3, PageSize: 20}, total: 50, wantOffset: 40, wantLimit: 20, wantPages: 3, wantNext: false},
+		{name: "exact multiple", page: Pagination{PageNo: 2, PageSize: 25}, total: 50, wantOffset: 25, wantLimit: 25, wantPages: 2, wantNext: false},
+		{name: "past the end", page: Pagination{PageNo: 9, PageSize: 20}, total: 50, wantOffset: 160, wantLimit: 20, wantPages: 3, wantNext: false},
+		{name: "empty", page: Pagination{PageNo: 1, PageSize: 20}, total: 0, wantOffset: 0, wantLimit: 20, wantPages: 0, wantNext: false},
+		{
+			name:       "large page overflows int32",
+			page:       Pagination{PageNo: math.MaxInt32, PageSize: MaxPageSize},
+			total:      math.MaxInt64,
+			wantOffset: int64(math.MaxInt32-1) * int64(MaxPageSize),
+			wantLimit:  int(MaxPageSize),
+			wantPages:  math.MaxInt64/int64(MaxPageSize) + 1,
+			wantNext:   true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.page.Offset(); got != tc.wantOffset {
+				t.Errorf("Offset() = %d, want %d", got, tc.wantOffset)
+			}
+			if got := tc.page.Limit(); got != tc.wantLimit {
+				t.Errorf("Limit() = %d, want %d", got, tc.wantLimit)
+			}
+			if got := tc.page.TotalPages(tc.total); got != tc.wantPages {
+				t.Errorf("TotalPages(%d) = %d, want %d", tc.total, got, tc.wantPages)
+			}
+			if got := tc.page.HasNextPage(tc.total); got != tc.wantNext {
+				t.Errorf("HasNextPage(%d) = %v, want %v", tc.total, got, tc.wantNext)
+			}
+		})
+	}
+}
@@ -9,15 +9,58 @@ import (
 type ListWordQuery struct {
 	Pagination
 	FilterOrder
+
+	// IncludeForms attaches each listed lemma's forms, loaded in one batched query.
+	IncludeForms bool
+}
+
+// ListFormsQuery narrows ListFormsByLemma; the zero value returns every form.
+type ListFormsQuery struct {
+	// WordTypes keeps only forms of these types; empty keeps all of them.
+	WordTypes []entity.WordType
+	// Limit caps the number of forms returned; 0 means no limit.
+	Limit int
 }
 
 // WordRepository defines data access for word entries.
 type WordRepository interface {
 	Create(ctx context.Context, word *entity.Word) (*entity.Word, error)
-	Update(ctx context.Context, word *entity.Word) (*entity.Word, error)
+	// Update writes only the given fields of word, or every updatable field when none are given.
+	Update(ctx context.Context, word *entity.Word, fields ...entity.WordField) (*entity.Word, error)
+	// GetByID returns entity.ErrVocNotFound when no row has the id.
 	GetByID(ctx context.Context, id int64) (*entity.Word, error)
+	// GetByIDs returns the words whose id is in ids, in no particular order; missing ids are skipped.
+	GetByIDs(ctx context.Context, ids []int64) ([]*entity.Word, error)
+	// Lookup returns the entry for text, which (language, text) keeps unique, or (nil, nil) when
+	// the dictionary has none, so callers can probe for existence without matching on errors.
 	Lookup(ctx context.Context, text string, language entity.Language) (*entity.Word, error)
+	// LookupMany returns the entries of language whose text is in texts, in no particular order;
+	// texts without an entry are skipped.
+	LookupMany(ctx context.Context, texts []string, language entity.Language) ([]*entity.Word, error)
+	// GetByKey returns the entry with exactly this (language, text, word_type) unique key, or
+	// (nil, nil) when there is none.
+	GetByKey(ctx context.Context, language entity.Language, text string, wordType entity.WordType) (*entity.Word, error)
 	List(ctx context.Context, filter *ListWordQuery) ([]*entity.Word, int64, error)
+	// Iterate calls fn for every word matching the query, fetching rows in bounded batches.
+	// Pagination is ignored and rows come in id order unless OrderBy is set.
+	// Iteration stops at the first error returned by fn or when ctx is done.
+	Iterate(ctx context.Context, filter *ListWordQuery, fn func(*entity.Word) error) error
+	// StreamByTags calls fn, in id order, for every word in language whose categories contain any
+	// of tags. Tags are lower-cased like imported categories; at least one is required.
+	StreamByTags(ctx context.Context, tags []string, language entity.Language, fn func(*entity.Word) error) error
 	Delete(ctx context.Context, id int64) error
-	ListFormsByLemma(ctx context.Context, lemma string, language entity.Language) ([]entity.WordFormRef, error)
+	// ListFormsByLemma returns the non-lemma forms of lemma ordered by text.
+	ListFormsByLemma(ctx context.Context, lemma string, language entity.Language, query ListFormsQuery) ([]entity.WordFormRef, error)
+	AppendSentences(ctx context.Context, wordID int64, sentences []entity.Sentence) error
+	// LanguageStats counts the entries of every language that has any, largest count first.
+	LanguageStats(ctx context.Context) ([]entity.LanguageCount, error)
+}
+
+// WordAuditRepository keeps the per-field history of dictionary edits.
+type WordAuditRepository interface {
+	// UpdateAudited applies WordRepository.Update and, in the same transaction, records the
+	// fields that actually changed under editor. Updates that change nothing record no row.
+	UpdateAudited(ctx context.Context, word *entity.Word, editor string, fields ...entity.WordField) (*entity.Word, error)
+	// ListByWord returns up to limit audit rows of a word, newest first.
+	ListByWord(ctx context.Context, wordID int64, limit int) ([]*entity.WordAudit, error)
 }
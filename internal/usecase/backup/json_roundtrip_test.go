@@ -0,0 +1,132 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	entword "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+)
+
+// TestServiceRoundTripPreservesJSONColumns guards the JSON column path: values are exported as raw
+// JSON and re-encoded on import, so unicode, int64 precision and nesting must survive untouched.
+func TestServiceRoundTripPreservesJSONColumns(t *testing.T) {
+	requireSQLite(t)
+	ctx := context.Background()
+
+	columns := map[string]string{
+		entword.FieldRelations: `[{"word":"苹果 🍎","relation_type":9007199254740993,` +
+			`"meta":{"ids":[-9223372036854775808,9223372036854775807],"weights":[0.1,1.5e-7,-0.0],"nested":[[{"k":"é\u0000\t"}],[]]}}]`,
+		entword.FieldSentences: `[{"text":"Tom & Jerry <3 \"quotes\" \\ back\\slash","source":2,"source_ref":"日本語 — ✓",` +
+			`"extra":{"empty":{},"null":null,"bool":true}}]`,
+		entword.FieldDefinitions: `[{"pos":"n.","text":"fruit","language":"zh","senses":[{"n":1},{"n":12345678901234567}]}]`,
+	}
+
+	srcDSN := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	srcClient := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { srcClient.Close() })
+	w := srcClient.Word.Create().SetText("apple").SetNormalized("apple").SetLanguage("en").SaveX(ctx)
+
+	// Write the JSON directly so values outside the entity types (e.g. > 2^53) reach the column.
+	srcDB := openRawDB(t, srcDSN)
+	for column, value := range columns {
+		if _, err := srcDB.ExecContext(ctx, "UPDATE words SET "+column+" = ? WHERE id = ?", value, w.ID); err != nil {
+			t.Fatalf("seed %s: %v", column, err)
+		}
+	}
+
+	exporter, err := NewService("sqlite3", srcDSN)
+	if err != nil {
+		t.Fatalf("new exporter: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := exporter.Export(ctx, &buf, WithTables([]string{"words"})); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	dstDSN := "file:" + filepath.Join(t.TempDir(), "dst.db") + "?_fk=1&cache=shared"
+	dstClient := enttest.Open(t, dialect.SQLite, dstDSN)
+	t.Cleanup(func() { dstClient.Close() })
+	importer, err := NewService("sqlite3", dstDSN)
+	if err != nil {
+		t.Fatalf("new importer: %v", err)
+	}
+	if err := importer.Import(ctx, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+
+	dstDB := openRawDB(t, dstDSN)
+	for column, want := range columns {
+		var got []byte
+		if err := dstDB.QueryRowContext(ctx, "SELECT "+column+" FROM words WHERE id = ?", w.ID).Scan(&got); err != nil {
+			t.Fatalf("read %s: %v", column, err)
+		}
+		if canonicalJSON(t, got) != canonicalJSON(t, []byte(want)) {
+			t.Fatalf("%s changed after round trip:\nwant %s\ngot  %s", column, want, got)
+		}
+	}
+}
+
+func openRawDB(t *testing.T, dsn string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("open %s: %v", dsn, err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+// canonicalJSON re-encodes data with sorted keys, keeping number literals verbatim.
+func canonicalJSON(t *testing.T, data []byte) string {
+	t.Helper()
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		t.Fatalf("decode %s: %v", data, err)
+	}
+	var out bytes.Buffer
+	enc := json.NewEncoder(&out)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	return out.String()
+}
+
+func TestConvertJSONValue(t *testing.T) {
+	col := &schema.Column{Name: "relations", Type: field.TypeJSON}
+
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "decoded document keeps int64 precision", value: []any{map[string]any{"id": json.Number("9007199254740993")}}, want: `[{"id":9007199254740993}]`},
+		{name: "legacy string-encoded document", value: `[{"word":"苹果"}]`, want: `[{"word":"苹果"}]`},
+		{name: "plain string stays a JSON string", value: "hello", want: `"hello"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := convertJSONValue(col, tt.value)
+			if err != nil {
+				t.Fatalf("convertJSONValue: %v", err)
+			}
+			raw, ok := got.(json.RawMessage)
+			if !ok {
+				t.Fatalf("expected json.RawMessage, got %T", got)
+			}
+			if string(raw) != tt.want {
+				t.Fatalf("got %s, want %s", raw, tt.want)
+			}
+		})
+	}
+}
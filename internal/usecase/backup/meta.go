@@ -0,0 +1,67 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// ErrMissingMeta is returned when a backup does not start with a meta record.
+var ErrMissingMeta = errors.New("backup: missing meta record")
+
+// Meta describes a backup as recorded in its leading meta record.
+type Meta struct {
+	Version       int            `json:"version"`
+	MinorVersion  int            `json:"minor_version"`
+	ExportedAt    time.Time      `json:"exported_at"`
+	EntSchemaHash string         `json:"ent_schema_hash"`
+	Tables        []string       `json:"tables"`
+	RowCounts     map[string]int `json:"row_counts"`
+	// Errors maps each table an export skipped (see WithContinueOnError) to why it failed.
+	Errors map[string]string `json:"errors,omitempty"`
+	// UserID is the exported user of a per-user archive (see Service.ExportUser); zero otherwise.
+	UserID int64 `json:"user_id,omitempty"`
+}
+
+// ReadMeta decodes the meta record from the first non-empty NDJSON line of r without touching
+// the database or reading the rest of the backup. r must already be decompressed.
+func ReadMeta(r io.Reader) (Meta, error) {
+	sc := newRecordScanner(r, DefaultMaxRecordBytes)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		if line := bytes.TrimSpace(sc.Bytes()); len(line) > 0 {
+			return decodeMeta(line)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return Meta{}, scanError(err, lineNo+1, DefaultMaxRecordBytes)
+	}
+	return Meta{}, ErrMissingMeta
+}
+
+func decodeMeta(line []byte) (Meta, error) {
+	var rec rawRecord
+	if err := json.Unmarshal(line, &rec); err != nil {
+		return Meta{}, fmt.Errorf("decode record: %w", err)
+	}
+	if rec.Type != "meta" {
+		return Meta{}, fmt.Errorf("%w: first record has type %q", ErrMissingMeta, rec.Type)
+	}
+	meta := Meta{
+		Version:       rec.Version,
+		MinorVersion:  rec.MinorVersion,
+		EntSchemaHash: rec.EntSchemaHash,
+		Tables:        rec.Tables,
+		RowCounts:     rec.RowCounts,
+		Errors:        rec.Errors,
+		UserID:        rec.UserID,
+	}
+	if rec.ExportedAt != nil {
+		meta.ExportedAt = *rec.ExportedAt
+	}
+	return meta, nil
+}
@@ -0,0 +1,71 @@
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// replayReader hands out a reader over the same input for every attempt of a retried import.
+// Seekable input is rewound in place. Anything else is copied to a temporary file as it is read,
+// so a later attempt replays what the failed one consumed and then continues with the rest.
+type replayReader struct {
+	src     io.Reader
+	spool   *os.File
+	started bool
+}
+
+func newReplayReader(r io.Reader) *replayReader {
+	return &replayReader{src: r}
+}
+
+// next returns the reader for the next attempt, positioned at the start of the input.
+func (rr *replayReader) next() (io.Reader, error) {
+	seeker, seekable := rr.src.(io.Seeker)
+	if !rr.started {
+		rr.started = true
+		if seekable {
+			return rr.src, nil
+		}
+		return io.TeeReader(rr.src, rr), nil
+	}
+	if seekable {
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("rewind backup input: %w", err)
+		}
+		return rr.src, nil
+	}
+	if rr.spool == nil {
+		return io.TeeReader(rr.src, rr), nil
+	}
+	size, err := rr.spool.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, fmt.Errorf("rewind backup input: %w", err)
+	}
+	return io.MultiReader(io.NewSectionReader(rr.spool, 0, size), io.TeeReader(rr.src, rr)), nil
+}
+
+// Write appends input read from src to the spool file, creating it on first use.
+func (rr *replayReader) Write(p []byte) (int, error) {
+	if rr.spool == nil {
+		f, err := os.CreateTemp("", "vocnet-import-*")
+		if err != nil {
+			return 0, fmt.Errorf("spool backup input: %w", err)
+		}
+		rr.spool = f
+	}
+	return rr.spool.Write(p)
+}
+
+// Close removes the spool file, if any.
+func (rr *replayReader) Close() error {
+	if rr.spool == nil {
+		return nil
+	}
+	name := rr.spool.Name()
+	err := rr.spool.Close()
+	if rmErr := os.Remove(name); err == nil {
+		err = rmErr
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+package backup
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReplayReaderReplaysConsumedInput(t *testing.T) {
+	const input = "meta\nrow 1\nrow 2\nrow 3\n"
+	// A bare io.Reader hides the Seek method of strings.Reader, like a pipe or gzip stream.
+	rr := newReplayReader(struct{ io.Reader }{strings.NewReader(input)})
+
+	first, err := rr.next()
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+	partial := make([]byte, 10)
+	if _, err := io.ReadFull(first, partial); err != nil {
+		t.Fatalf("read first attempt: %v", err)
+	}
+
+	for attempt := 2; attempt <= 3; attempt++ {
+		r, err := rr.next()
+		if err != nil {
+			t.Fatalf("next: %v", err)
+		}
+		got, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("read attempt %d: %v", attempt, err)
+		}
+		if string(got) != input {
+			t.Fatalf("attempt %d read %q, want %q", attempt, got, input)
+		}
+	}
+
+	spool := rr.spool.Name()
+	if err := rr.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	if _, err := os.Stat(spool); !os.IsNotExist(err) {
+		t.Fatalf("spool %s still exists: %v", spool, err)
+	}
+}
+
+func TestReplayReaderRewindsSeekableInput(t *testing.T) {
+	rr := newReplayReader(strings.NewReader("meta\n"))
+	for attempt := 1; attempt <= 2; attempt++ {
+		r, err := rr.next()
+		if err != nil {
+			t.Fatalf("next: %v", err)
+		}
+		if got, _ := io.ReadAll(r); string(got) != "meta\n" {
+			t.Fatalf("attempt %d read %q", attempt, got)
+		}
+	}
+	if rr.spool != nil {
+		t.Fatalf("seekable input was spooled to %s", rr.spool.Name())
+	}
+}
@@ -0,0 +1,56 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	entword "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+)
+
+func TestExportSchema(t *testing.T) {
+	// The DSN is never opened: exporting the schema must not need a database.
+	svc, err := NewService("postgres", "postgres://unreachable.invalid/vocnet", WithDeniedTables([]string{entword.Table}))
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := svc.ExportSchema(&buf); err != nil {
+		t.Fatalf("ExportSchema: %v", err)
+	}
+
+	var dump schemaDump
+	if err := json.Unmarshal(buf.Bytes(), &dump); err != nil {
+		t.Fatalf("decode schema: %v\n%s", err, buf.String())
+	}
+	if want := computeSchemaHash(svc.tables); dump.EntSchemaHash != want {
+		t.Fatalf("ent_schema_hash = %q, want %q", dump.EntSchemaHash, want)
+	}
+	if len(dump.Tables) != len(svc.tables) {
+		t.Fatalf("dumped %d tables, want %d", len(dump.Tables), len(svc.tables))
+	}
+
+	var word *schemaTable
+	for i := range dump.Tables {
+		if i > 0 && dump.Tables[i-1].Name >= dump.Tables[i].Name {
+			t.Fatalf("tables not sorted: %q before %q", dump.Tables[i-1].Name, dump.Tables[i].Name)
+		}
+		if dump.Tables[i].Name == entword.Table {
+			word = &dump.Tables[i]
+		}
+	}
+	if word == nil {
+		t.Fatalf("denied table %s missing from schema dump", entword.Table)
+	}
+	if len(word.PrimaryKey) != 1 || word.PrimaryKey[0] != entword.FieldID {
+		t.Fatalf("%s primary key = %v", entword.Table, word.PrimaryKey)
+	}
+	if len(word.Columns) == 0 || len(word.Indexes) == 0 {
+		t.Fatalf("%s dumped without columns or indexes: %+v", entword.Table, word)
+	}
+
+	var again bytes.Buffer
+	if err := svc.ExportSchema(&again); err != nil || again.String() != buf.String() {
+		t.Fatalf("schema dump is not deterministic (err %v)", err)
+	}
+}
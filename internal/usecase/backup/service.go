@@ -19,18 +19,42 @@ import (
 
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database"
 	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/migrate"
 	_ "github.com/lib/pq"           // ensure postgres driver available
 	_ "github.com/mattn/go-sqlite3" // ensure sqlite driver available
 )
 
+// Backups carry a major and a minor format version. Minor bumps are additive (new tables, new
+// columns, new record types), so any binary imports backups of its own major version whatever the
+// minor: records of unknown tables and unknown columns are skipped and reported as warnings, and
+// columns missing from an older backup fall back to their defaults. A major bump marks an
+// incompatible change and is rejected. Older binaries only check the major version.
 const (
-	defaultBatchSize = 512
-	formatVersion    = 1
+	defaultBatchSize   = 512
+	formatVersion      = 1
+	formatMinorVersion = 0
 )
 
 var errNoTablesSelected = errors.New("backup: no tables selected")
 
+// ErrTableDenied is returned when a caller explicitly requests a table on the deny-list.
+var ErrTableDenied = errors.New("backup: table is denied")
+
+// ErrRecordTooLarge is returned when a backup line exceeds the per-record size cap.
+var ErrRecordTooLarge = errors.New("backup: record too large")
+
+// DefaultMaxRecordBytes caps a single NDJSON record unless WithMaxRecordBytes overrides it. Lines
+// are read whole, so the cap bounds the memory one record can claim.
+const DefaultMaxRecordBytes = 16 << 20
+
+// Exports hand buffered records to the destination once DefaultFlushBytes or DefaultFlushRows
+// accumulate, unless WithFlushEvery overrides either threshold.
+const (
+	DefaultFlushBytes = 1 << 20
+	DefaultFlushRows  = 1000
+)
+
 type ProgressReporter interface {
 	StartTable(table string, total int)
 	Increment(table string, delta int)
@@ -43,6 +67,9 @@ func (noopProgress) StartTable(string, int) {}
 func (noopProgress) Increment(string, int)  {}
 func (noopProgress) FinishTable(string)     {}
 
+// Service exports and imports backups of one database. It holds no open resources: every call
+// opens its own database handle and closes it before returning, so a long-lived Service, such as
+// the one behind the admin export endpoint, needs no Close.
 type Service struct {
 	driver     string
 	dsn        string
@@ -50,10 +77,26 @@ type Service struct {
 	tables     []*schema.Table
 	tableIndex map[string]*schema.Table
 	schemaHash string
+	denied     map[string]struct{}
 }
 
 type Option func(*Service)
 
+// WithDeniedTables configures tables that are never exported or imported. They are dropped from
+// the default "all tables" selection and requesting one explicitly fails with ErrTableDenied.
+func WithDeniedTables(tables []string) Option {
+	return func(s *Service) {
+		for _, name := range tables {
+			if n := strings.TrimSpace(strings.ToLower(name)); n != "" {
+				if s.denied == nil {
+					s.denied = make(map[string]struct{})
+				}
+				s.denied[n] = struct{}{}
+			}
+		}
+	}
+}
+
 func WithBatchSize(size int) Option {
 	return func(s *Service) {
 		if size > 0 {
@@ -99,8 +142,21 @@ func NewService(driver, dsn string, opts ...Option) (*Service, error) {
 type ExportOption func(*exportConfig)
 
 type exportConfig struct {
-	tables   []string
-	reporter ProgressReporter
+	tables     []string
+	exclude    []string
+	reporter   ProgressReporter
+	flushBytes int
+	flushRows  int
+	continueOn bool
+	onError    func(table string, err error)
+}
+
+func newExportConfig(opts ...ExportOption) exportConfig {
+	cfg := exportConfig{flushBytes: DefaultFlushBytes, flushRows: DefaultFlushRows}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
 }
 
 // WithTables restricts export to the provided table names (snake_case as in DB).
@@ -113,6 +169,14 @@ func WithTables(tables []string) ExportOption {
 	}
 }
 
+// WithExcludeTables leaves the given tables out of this export, whether they were requested
+// explicitly or selected by default.
+func WithExcludeTables(tables []string) ExportOption {
+	return func(cfg *exportConfig) {
+		cfg.exclude = append(cfg.exclude, tables...)
+	}
+}
+
 // WithProgressReporter registers a reporter that receives progress callbacks during export.
 func WithProgressReporter(reporter ProgressReporter) ExportOption {
 	return func(cfg *exportConfig) {
@@ -120,14 +184,51 @@ func WithProgressReporter(reporter ProgressReporter) ExportOption {
 	}
 }
 
+// WithFlushEvery writes buffered records to the destination whenever bytes bytes or rows records
+// have accumulated, whichever comes first; values <= 0 keep the defaults. Records are only ever
+// written whole, so an export cut short ends on a record boundary.
+func WithFlushEvery(bytes, rows int) ExportOption {
+	return func(cfg *exportConfig) {
+		if bytes > 0 {
+			cfg.flushBytes = bytes
+		}
+		if rows > 0 {
+			cfg.flushRows = rows
+		}
+	}
+}
+
+// WithContinueOnError makes Export and ExportUser skip a table it cannot read instead of aborting the whole
+// backup. Every table is checked before the meta record is written; failing tables are left out of
+// its tables and row counts and their errors are recorded in its errors field. A table that fails
+// once its rows are being written still aborts the export, as the meta record is already out.
+func WithContinueOnError() ExportOption {
+	return func(cfg *exportConfig) {
+		cfg.continueOn = true
+	}
+}
+
+// WithTableErrorHandler registers a callback invoked for every table WithContinueOnError skips.
+func WithTableErrorHandler(fn func(table string, err error)) ExportOption {
+	return func(cfg *exportConfig) {
+		cfg.onError = fn
+	}
+}
+
 type ImportOption func(*importConfig)
 
 type importConfig struct {
-	tables []string
+	tables       []string
+	verifyCounts bool
+	onMismatch   func(CountMismatch)
+	onWarning    func(ImportWarning)
+	maxRecord    int
+	tableRemap   map[string]string
+	columnRemap  map[string]map[string]string
 }
 
 func newImportConfig(opts ...ImportOption) importConfig {
-	cfg := importConfig{}
+	cfg := importConfig{maxRecord: DefaultMaxRecordBytes}
 	for _, opt := range opts {
 		opt(&cfg)
 	}
@@ -144,24 +245,134 @@ func WithImportTables(tables []string) ImportOption {
 	}
 }
 
+// WithTableRemap imports records of the backup table old into the current table renames[old],
+// so backups taken before a table was renamed can be migrated forward. Targets must be tables of
+// the current schema; WithImportTables and count verification use the current names.
+func WithTableRemap(renames map[string]string) ImportOption {
+	return func(cfg *importConfig) {
+		for from, to := range renames {
+			if cfg.tableRemap == nil {
+				cfg.tableRemap = make(map[string]string)
+			}
+			cfg.tableRemap[strings.TrimSpace(strings.ToLower(from))] = strings.TrimSpace(strings.ToLower(to))
+		}
+	}
+}
+
+// WithColumnRemap imports the backup column old of table (named as in the current schema) into
+// the current column renames[old].
+func WithColumnRemap(table string, renames map[string]string) ImportOption {
+	return func(cfg *importConfig) {
+		table = strings.TrimSpace(strings.ToLower(table))
+		for from, to := range renames {
+			if cfg.columnRemap == nil {
+				cfg.columnRemap = make(map[string]map[string]string)
+			}
+			if cfg.columnRemap[table] == nil {
+				cfg.columnRemap[table] = make(map[string]string)
+			}
+			cfg.columnRemap[table][strings.TrimSpace(strings.ToLower(from))] = strings.TrimSpace(strings.ToLower(to))
+		}
+	}
+}
+
+// WithVerifyCounts makes Import fail with a *CountMismatchError when the imported row counts
+// disagree with the backup's meta record. Without it, mismatches are only reported to the
+// handler registered via WithCountMismatchHandler.
+func WithVerifyCounts() ImportOption {
+	return func(cfg *importConfig) {
+		cfg.verifyCounts = true
+	}
+}
+
+// WithCountMismatchHandler registers a callback invoked for every table whose counts diverge.
+func WithCountMismatchHandler(fn func(CountMismatch)) ImportOption {
+	return func(cfg *importConfig) {
+		cfg.onMismatch = fn
+	}
+}
+
+// WithMaxRecordBytes makes Import fail with ErrRecordTooLarge on any line longer than n bytes;
+// n <= 0 keeps DefaultMaxRecordBytes.
+func WithMaxRecordBytes(n int) ImportOption {
+	return func(cfg *importConfig) {
+		if n > 0 {
+			cfg.maxRecord = n
+		}
+	}
+}
+
+// WithImportWarningHandler registers a callback invoked once for every unknown table and every
+// unknown column the backup contains, and when the backup comes from a newer minor format version.
+// Such content is skipped either way.
+func WithImportWarningHandler(fn func(ImportWarning)) ImportOption {
+	return func(cfg *importConfig) {
+		cfg.onWarning = fn
+	}
+}
+
+// ImportWarning describes backup content Import skipped because this binary does not know it.
+type ImportWarning struct {
+	Table   string // table the warning concerns; empty for the backup as a whole
+	Column  string // unknown column of Table; empty when the whole table is unknown
+	Message string
+}
+
+func (w ImportWarning) String() string {
+	return w.Message
+}
+
+// CountMismatch describes a table whose row counts after import disagree with the backup.
+type CountMismatch struct {
+	Table    string
+	Expected int // rows recorded in the backup meta
+	Received int // rows read from the backup stream
+	Before   int // rows present before the import
+	After    int // rows present after the import
+}
+
+func (m CountMismatch) String() string {
+	return fmt.Sprintf("%s: expected %d rows, received %d, table had %d before and %d after import",
+		m.Table, m.Expected, m.Received, m.Before, m.After)
+}
+
+// CountMismatchError is returned by Import when WithVerifyCounts is set and counts diverge.
+type CountMismatchError struct {
+	Mismatches []CountMismatch
+}
+
+func (e *CountMismatchError) Error() string {
+	parts := make([]string, 0, len(e.Mismatches))
+	for _, m := range e.Mismatches {
+		parts = append(parts, m.String())
+	}
+	return "backup: row count verification failed: " + strings.Join(parts, "; ")
+}
+
 type record struct {
-	Type          string         `json:"type"`
-	Version       int            `json:"version,omitempty"`
-	ExportedAt    *time.Time     `json:"exported_at,omitempty"`
-	EntSchemaHash string         `json:"ent_schema_hash,omitempty"`
-	Tables        []string       `json:"tables,omitempty"`
-	RowCounts     map[string]int `json:"row_counts,omitempty"`
-	Payload       any            `json:"payload,omitempty"`
+	Type          string            `json:"type"`
+	Version       int               `json:"version,omitempty"`
+	MinorVersion  int               `json:"minor_version,omitempty"`
+	ExportedAt    *time.Time        `json:"exported_at,omitempty"`
+	EntSchemaHash string            `json:"ent_schema_hash,omitempty"`
+	Tables        []string          `json:"tables,omitempty"`
+	RowCounts     map[string]int    `json:"row_counts,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+	UserID        int64             `json:"user_id,omitempty"`
+	Payload       any               `json:"payload,omitempty"`
 }
 
 type rawRecord struct {
-	Type          string          `json:"type"`
-	Version       int             `json:"version"`
-	ExportedAt    *time.Time      `json:"exported_at"`
-	EntSchemaHash string          `json:"ent_schema_hash"`
-	Tables        []string        `json:"tables"`
-	RowCounts     map[string]int  `json:"row_counts"`
-	Payload       json.RawMessage `json:"payload"`
+	Type          string            `json:"type"`
+	Version       int               `json:"version"`
+	MinorVersion  int               `json:"minor_version"`
+	ExportedAt    *time.Time        `json:"exported_at"`
+	EntSchemaHash string            `json:"ent_schema_hash"`
+	Tables        []string          `json:"tables"`
+	RowCounts     map[string]int    `json:"row_counts"`
+	Errors        map[string]string `json:"errors"`
+	UserID        int64             `json:"user_id"`
+	Payload       json.RawMessage   `json:"payload"`
 }
 
 type sequenceKey struct {
@@ -171,12 +382,12 @@ type sequenceKey struct {
 
 type sequenceStats map[sequenceKey]int64
 
+// Export writes the meta record followed by every row of the selected tables. When ctx is
+// cancelled the export stops between records: the records already produced are flushed, so the
+// output holds the meta record and whole rows up to the cut, and ctx.Err() is returned.
 func (s *Service) Export(ctx context.Context, w io.Writer, opts ...ExportOption) error {
-	cfg := exportConfig{}
-	for _, opt := range opts {
-		opt(&cfg)
-	}
-	tables, err := s.selectTables(cfg.tables)
+	cfg := newExportConfig(opts...)
+	tables, err := s.selectTables(cfg.tables, cfg.exclude...)
 	if err != nil {
 		return err
 	}
@@ -191,35 +402,33 @@ func (s *Service) Export(ctx context.Context, w io.Writer, opts ...ExportOption)
 	}
 	defer db.Close()
 
-	counts := make(map[string]int, len(tables))
-	for _, tbl := range tables {
-		count, err := s.countTableRows(ctx, db, tbl.Name)
-		if err != nil {
-			return fmt.Errorf("count table %s: %w", tbl.Name, err)
-		}
-		counts[tbl.Name] = count
+	tables, counts, failed, err := s.checkExportTables(ctx, db, tables, "", nil, cfg)
+	if err != nil {
+		return err
 	}
 
-	writer := bufio.NewWriter(w)
+	writer := newRecordWriter(w, cfg)
 	defer writer.Flush()
 
 	now := time.Now().UTC()
 	meta := record{
 		Type:          "meta",
 		Version:       formatVersion,
+		MinorVersion:  formatMinorVersion,
 		ExportedAt:    &now,
 		EntSchemaHash: s.schemaHash,
 		Tables:        tableNames(tables),
 		RowCounts:     counts,
+		Errors:        failed,
 	}
-	if err := writeRecord(writer, meta); err != nil {
+	if err := writer.Write(meta); err != nil {
 		return err
 	}
 
 	for _, tbl := range tables {
 		total := counts[tbl.Name]
 		reporter.StartTable(tbl.Name, total)
-		if err := s.exportTable(ctx, db, tbl, reporter, writer); err != nil {
+		if err := s.exportTable(ctx, db, tbl, "", nil, reporter, writer); err != nil {
 			return err
 		}
 		reporter.FinishTable(tbl.Name)
@@ -229,41 +438,125 @@ func (s *Service) Export(ctx context.Context, w io.Writer, opts ...ExportOption)
 
 func (s *Service) Import(ctx context.Context, r io.Reader, opts ...ImportOption) error {
 	cfg := newImportConfig(opts...)
-	_, tableFilter, err := s.resolveImportTables(cfg.tables)
+	tables, tableFilter, err := s.resolveImportTables(cfg.tables)
 	if err != nil {
 		return err
 	}
+	return s.importTables(ctx, r, cfg, tables, tableFilter, 0)
+}
 
+// importTables imports the records of tables from r in one transaction, retried from the start of
+// r when it fails transiently; a non-zero userID imports a per-user archive into that user (see
+// ImportUser).
+func (s *Service) importTables(ctx context.Context, r io.Reader, cfg importConfig, tables []*schema.Table, tableFilter map[string]*schema.Table, userID int64) error {
+	if err := s.validateRemaps(cfg); err != nil {
+		return err
+	}
 	db, err := s.openDB(ctx)
 	if err != nil {
 		return err
 	}
 	defer db.Close()
 
+	before := make(map[string]int, len(tables))
+	for _, tbl := range tables {
+		count, err := s.countTableRows(ctx, db, tbl.Name)
+		if err != nil {
+			return fmt.Errorf("count table %s: %w", tbl.Name, err)
+		}
+		before[tbl.Name] = count
+	}
+
+	input := newReplayReader(r)
+	defer input.Close()
+	var (
+		imp  *importRun
+		meta rawRecord
+	)
+	err = database.RetryTransient(ctx, "import backup", func(ctx context.Context) error {
+		records, err := input.next()
+		if err != nil {
+			return err
+		}
+		imp, meta, err = s.importOnce(ctx, db, records, cfg, tables, tableFilter, userID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.syncSequences(ctx, db, imp.stats); err != nil {
+		return err
+	}
+	return s.verifyImportCounts(ctx, db, cfg, tables, meta, before, imp.received)
+}
+
+// importOnce runs one attempt of an import: it reads every record of r into a single transaction
+// and commits it.
+func (s *Service) importOnce(ctx context.Context, db *sql.DB, r io.Reader, cfg importConfig, tables []*schema.Table, tableFilter map[string]*schema.Table, userID int64) (*importRun, rawRecord, error) {
 	tx, err := db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
+		return nil, rawRecord{}, fmt.Errorf("begin transaction: %w", err)
 	}
 	commit := false
 	defer rollbackUnlessCommitted(tx, &commit)
 
-	br := bufio.NewReader(r)
-	stats := make(sequenceStats)
-	meta, err := s.consumeImportRecords(ctx, br, tx, tableFilter, stats)
+	imp := &importRun{
+		tx:          tx,
+		tableFilter: tableFilter,
+		stats:       make(sequenceStats),
+		received:    make(map[string]int, len(tables)),
+		warn:        newWarningReporter(cfg.onWarning),
+		userID:      userID,
+		tableRemap:  cfg.tableRemap,
+		columnRemap: cfg.columnRemap,
+	}
+	meta, err := s.consumeImportRecords(ctx, newRecordScanner(r, cfg.maxRecord), cfg.maxRecord, imp)
 	if err != nil {
-		return err
-	}
-	if err := validateImportMeta(meta); err != nil {
-		return err
+		return nil, rawRecord{}, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("commit import: %w", err)
+		return nil, rawRecord{}, fmt.Errorf("commit import: %w", err)
 	}
 	commit = true
+	return imp, meta, nil
+}
 
-	if err := s.syncSequences(ctx, db, stats); err != nil {
-		return err
+// verifyImportCounts compares destination row counts against the backup meta. Rows are upserted,
+// so a table must end up with at least the exported rows and at most those plus what it held before;
+// for an empty destination that means an exact match.
+func (s *Service) verifyImportCounts(ctx context.Context, db *sql.DB, cfg importConfig, tables []*schema.Table, meta rawRecord, before, received map[string]int) error {
+	var mismatches []CountMismatch
+	for _, tbl := range tables {
+		expected, ok := meta.RowCounts[tbl.Name]
+		if !ok {
+			// Table was not part of the export.
+			continue
+		}
+		after, err := s.countTableRows(ctx, db, tbl.Name)
+		if err != nil {
+			return fmt.Errorf("count table %s: %w", tbl.Name, err)
+		}
+		m := CountMismatch{
+			Table:    tbl.Name,
+			Expected: expected,
+			Received: received[tbl.Name],
+			Before:   before[tbl.Name],
+			After:    after,
+		}
+		if m.Received != m.Expected || m.After < m.Expected || m.After > m.Before+m.Expected {
+			mismatches = append(mismatches, m)
+		}
+	}
+
+	for _, m := range mismatches {
+		if cfg.onMismatch != nil {
+			cfg.onMismatch(m)
+		}
+	}
+	if cfg.verifyCounts && len(mismatches) > 0 {
+		return &CountMismatchError{Mismatches: mismatches}
 	}
 	return nil
 }
@@ -286,53 +579,158 @@ func rollbackUnlessCommitted(tx *sql.Tx, committed *bool) {
 	}
 }
 
-func (s *Service) consumeImportRecords(ctx context.Context, br *bufio.Reader, tx *sql.Tx, tableFilter map[string]*schema.Table, stats sequenceStats) (rawRecord, error) {
+// importRun carries the state of one Import or ImportUser call across records.
+type importRun struct {
+	tx          *sql.Tx
+	tableFilter map[string]*schema.Table
+	stats       sequenceStats
+	received    map[string]int
+	warn        func(ImportWarning)
+	// userID is the user an ImportUser call restores into; zero for Import.
+	userID      int64
+	tableRemap  map[string]string
+	columnRemap map[string]map[string]string
+}
+
+// validateRemaps checks that table and column renames target the current schema.
+func (s *Service) validateRemaps(cfg importConfig) error {
+	for from, to := range cfg.tableRemap {
+		if _, ok := s.tableIndex[to]; !ok {
+			return fmt.Errorf("backup: table remap %q -> %q: unknown table %q", from, to, to)
+		}
+	}
+	for table, renames := range cfg.columnRemap {
+		tbl, ok := s.tableIndex[table]
+		if !ok {
+			return fmt.Errorf("backup: column remap for unknown table %q", table)
+		}
+		for from, to := range renames {
+			if findColumn(tbl, to) == nil {
+				return fmt.Errorf("backup: column remap %s.%s -> %s: unknown column %q", table, from, to, to)
+			}
+		}
+	}
+	return nil
+}
+
+// tableName returns the current name of the backup table name.
+func (imp *importRun) tableName(name string) string {
+	if to, ok := imp.tableRemap[name]; ok {
+		return to
+	}
+	return name
+}
+
+// remapMeta renames the tables listed in a meta record so verification uses current names.
+func (imp *importRun) remapMeta(meta *rawRecord) {
+	if len(imp.tableRemap) == 0 {
+		return
+	}
+	for i, name := range meta.Tables {
+		meta.Tables[i] = imp.tableName(name)
+	}
+	counts := make(map[string]int, len(meta.RowCounts))
+	for name, count := range meta.RowCounts {
+		counts[imp.tableName(name)] += count
+	}
+	meta.RowCounts = counts
+}
+
+// newWarningReporter wraps fn so each distinct warning is reported once; it never returns nil.
+func newWarningReporter(fn func(ImportWarning)) func(ImportWarning) {
+	seen := make(map[ImportWarning]struct{})
+	return func(w ImportWarning) {
+		if _, dup := seen[w]; dup || fn == nil {
+			return
+		}
+		seen[w] = struct{}{}
+		fn(w)
+	}
+}
+
+// newRecordScanner splits r into NDJSON lines of at most maxBytes bytes, excluding the newline.
+func newRecordScanner(r io.Reader, maxBytes int) *bufio.Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, min(64<<10, maxBytes+1)), maxBytes+1)
+	return sc
+}
+
+// scanError describes why a record scanner stopped; lineNo is the 1-based line being read.
+func scanError(err error, lineNo, maxBytes int) error {
+	if errors.Is(err, bufio.ErrTooLong) {
+		return fmt.Errorf("%w: line %d exceeds %d bytes", ErrRecordTooLarge, lineNo, maxBytes)
+	}
+	return fmt.Errorf("read backup: %w", err)
+}
+
+func (s *Service) consumeImportRecords(ctx context.Context, sc *bufio.Scanner, maxRecord int, imp *importRun) (rawRecord, error) {
 	var (
 		meta     rawRecord
 		metaSeen bool
+		lineNo   int
 	)
 
-	for {
-		line, err := br.ReadBytes('\n')
-		if err != nil && !errors.Is(err, io.EOF) {
-			return rawRecord{}, fmt.Errorf("read backup: %w", err)
-		}
-		line = bytes.TrimSpace(line)
-		if len(line) > 0 {
+	for sc.Scan() {
+		lineNo++
+		if line := bytes.TrimSpace(sc.Bytes()); len(line) > 0 {
 			var rec rawRecord
 			if err := json.Unmarshal(line, &rec); err != nil {
 				return rawRecord{}, fmt.Errorf("decode record: %w", err)
 			}
 			if rec.Type == "meta" {
+				// Check the version before writing any row so an incompatible backup fails fast.
+				if err := validateImportMeta(rec); err != nil {
+					return rawRecord{}, err
+				}
+				switch {
+				case imp.userID == 0 && rec.UserID != 0:
+					return rawRecord{}, ErrUserArchive
+				case imp.userID != 0 && rec.UserID == 0:
+					return rawRecord{}, ErrNotUserArchive
+				}
+				if rec.MinorVersion > formatMinorVersion {
+					imp.warn(ImportWarning{Message: fmt.Sprintf(
+						"backup format %d.%d is newer than %d.%d; content this binary does not know is skipped",
+						rec.Version, rec.MinorVersion, formatVersion, formatMinorVersion)})
+				}
+				imp.remapMeta(&rec)
 				metaSeen = true
 				meta = rec
-			} else if err := s.importDataRecord(ctx, tx, tableFilter, rec, stats); err != nil {
-				return rawRecord{}, err
+			} else {
+				rec.Type = imp.tableName(rec.Type)
+				if err := s.importDataRecord(ctx, imp, rec); err != nil {
+					return rawRecord{}, err
+				}
+				imp.received[rec.Type]++
 			}
 		}
-		if errors.Is(err, io.EOF) {
-			break
-		}
+	}
+	if err := sc.Err(); err != nil {
+		return rawRecord{}, scanError(err, lineNo+1, maxRecord)
 	}
 
 	if !metaSeen {
-		return rawRecord{}, errors.New("backup: missing meta record")
+		return rawRecord{}, ErrMissingMeta
 	}
 	return meta, nil
 }
 
-func (s *Service) importDataRecord(ctx context.Context, tx *sql.Tx, tableFilter map[string]*schema.Table, rec rawRecord, stats sequenceStats) error {
-	tbl, ok := tableFilter[rec.Type]
+func (s *Service) importDataRecord(ctx context.Context, imp *importRun, rec rawRecord) error {
+	tbl, ok := imp.tableFilter[rec.Type]
 	if !ok {
-		// Skip records for tables not requested.
+		// Skip records for tables not requested, and warn about tables this binary does not know.
+		if _, known := s.tableIndex[rec.Type]; !known {
+			imp.warn(ImportWarning{Table: rec.Type, Message: fmt.Sprintf("skipped records of unknown table %q", rec.Type)})
+		}
 		return nil
 	}
 	if len(rec.Payload) == 0 {
 		return fmt.Errorf("backup: missing payload for table %s", rec.Type)
 	}
-	return s.importRow(ctx, tx, tbl, rec.Payload, stats)
+	return s.importRow(ctx, imp, tbl, rec.Payload)
 }
 
+// validateImportMeta accepts backups of the current major format version, whatever their minor.
 func validateImportMeta(meta rawRecord) error {
 	if meta.Version != formatVersion {
 		return fmt.Errorf("backup: unsupported format version %d", meta.Version)
@@ -340,27 +738,36 @@ func validateImportMeta(meta rawRecord) error {
 	return nil
 }
 
-func (s *Service) exportTable(ctx context.Context, db *sql.DB, table *schema.Table, reporter ProgressReporter, w io.Writer) error {
+// exportTable writes the rows of table matching where (all rows when empty), a condition whose
+// placeholders are bound to args.
+func (s *Service) exportTable(ctx context.Context, db *sql.DB, table *schema.Table, where string, args []any, reporter ProgressReporter, w *recordWriter) error {
 	columns := columnNames(table)
 	if len(columns) == 0 {
 		return nil
 	}
 	orderBy := buildOrderByClause(table)
+	if where != "" {
+		where = " WHERE " + where
+	}
 	batch := s.batchSize
 	if batch <= 0 {
 		batch = defaultBatchSize
 	}
 
 	for offset := 0; ; offset += batch {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
 		// #nosec G201 -- table names come from ent schema definitions, not user input.
-		query := fmt.Sprintf("SELECT %s FROM %s%s LIMIT %d OFFSET %d",
+		query := fmt.Sprintf("SELECT %s FROM %s%s%s LIMIT %d OFFSET %d",
 			strings.Join(columns, ", "),
 			table.Name,
+			where,
 			orderBy,
 			batch,
 			offset,
 		)
-		rows, err := db.QueryContext(ctx, query)
+		rows, err := db.QueryContext(ctx, query, args...)
 		if err != nil {
 			return fmt.Errorf("query %s: %w", table.Name, err)
 		}
@@ -381,7 +788,7 @@ func (s *Service) exportTable(ctx context.Context, db *sql.DB, table *schema.Tab
 				rows.Close()
 				return err
 			}
-			if err := writeRecord(w, record{Type: table.Name, Payload: rowMap}); err != nil {
+			if err := w.Write(record{Type: table.Name, Payload: rowMap}); err != nil {
 				rows.Close()
 				return err
 			}
@@ -400,14 +807,24 @@ func (s *Service) exportTable(ctx context.Context, db *sql.DB, table *schema.Tab
 	return nil
 }
 
-func (s *Service) importRow(ctx context.Context, tx *sql.Tx, table *schema.Table, payload json.RawMessage, stats sequenceStats) error {
-	values, err := decodePayload(table, payload)
+func (s *Service) importRow(ctx context.Context, imp *importRun, table *schema.Table, payload json.RawMessage) error {
+	values, unknown, err := decodePayload(table, payload, imp.columnRemap[table.Name])
 	if err != nil {
 		return fmt.Errorf("decode payload for %s: %w", table.Name, err)
 	}
+	for _, name := range unknown {
+		imp.warn(ImportWarning{Table: table.Name, Column: name, Message: fmt.Sprintf("skipped unknown column %s.%s", table.Name, name)})
+	}
 	if len(values) == 0 {
 		return nil
 	}
+	conflictCols := conflictColumns(table)
+	if imp.userID != 0 {
+		if err := s.remapUserRow(ctx, imp, table, values); err != nil {
+			return err
+		}
+		conflictCols = userConflictColumns(table)
+	}
 
 	cols := make([]string, 0, len(values))
 	args := make([]any, 0, len(values))
@@ -448,13 +865,13 @@ func (s *Service) importRow(ctx context.Context, tx *sql.Tx, table *schema.Table
 		strings.Join(placeholder, ", "),
 	)
 
-	upsert, err := buildUpsertClause(s.driver, table, cols)
+	upsert, err := buildUpsertClause(s.driver, conflictCols, cols)
 	if err != nil {
 		return err
 	}
 	query := insert + upsert
 
-	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+	if _, err := imp.tx.ExecContext(ctx, query, args...); err != nil {
 		return fmt.Errorf("insert into %s: %w", table.Name, err)
 	}
 
@@ -462,8 +879,8 @@ func (s *Service) importRow(ctx context.Context, tx *sql.Tx, table *schema.Table
 		if val, ok := values[colName]; ok {
 			if max, ok := tryToInt64(val); ok {
 				key := sequenceKey{Table: table.Name, Column: colName}
-				if max > stats[key] {
-					stats[key] = max
+				if max > imp.stats[key] {
+					imp.stats[key] = max
 				}
 			}
 		}
@@ -471,15 +888,27 @@ func (s *Service) importRow(ctx context.Context, tx *sql.Tx, table *schema.Table
 	return nil
 }
 
-func (s *Service) selectTables(requested []string) ([]*schema.Table, error) {
-	if len(requested) == 0 {
-		// Return tables sorted by name for deterministic order.
-		tbls := make([]*schema.Table, len(s.tables))
-		copy(tbls, s.tables)
-		sort.Slice(tbls, func(i, j int) bool { return tbls[i].Name < tbls[j].Name })
-		return tbls, nil
+// selectTables resolves the requested tables (all tables when none are given) in name order,
+// honoring the service deny-list and the per-call exclusions.
+func (s *Service) selectTables(requested []string, exclude ...string) ([]*schema.Table, error) {
+	excluded := make(map[string]struct{}, len(exclude))
+	for _, name := range exclude {
+		excluded[strings.TrimSpace(strings.ToLower(name))] = struct{}{}
+	}
+	skip := func(name string) bool {
+		_, denied := s.denied[name]
+		_, dropped := excluded[name]
+		return denied || dropped
 	}
+
 	set := make(map[string]struct{}, len(requested))
+	if len(requested) == 0 {
+		for _, tbl := range s.tables {
+			if !skip(tbl.Name) {
+				set[tbl.Name] = struct{}{}
+			}
+		}
+	}
 	for _, name := range requested {
 		n := strings.TrimSpace(strings.ToLower(name))
 		if n == "" {
@@ -488,7 +917,12 @@ func (s *Service) selectTables(requested []string) ([]*schema.Table, error) {
 		if _, ok := s.tableIndex[n]; !ok {
 			return nil, fmt.Errorf("backup: unsupported table %q", name)
 		}
-		set[n] = struct{}{}
+		if _, denied := s.denied[n]; denied {
+			return nil, fmt.Errorf("%w: %q", ErrTableDenied, name)
+		}
+		if !skip(n) {
+			set[n] = struct{}{}
+		}
 	}
 	if len(set) == 0 {
 		return nil, errNoTablesSelected
@@ -499,6 +933,7 @@ func (s *Service) selectTables(requested []string) ([]*schema.Table, error) {
 			tbls = append(tbls, tbl)
 		}
 	}
+	// Sorted by name for deterministic output.
 	sort.Slice(tbls, func(i, j int) bool { return tbls[i].Name < tbls[j].Name })
 	return tbls, nil
 }
@@ -521,6 +956,58 @@ func (s *Service) openDB(ctx context.Context) (*sql.DB, error) {
 	return db, nil
 }
 
+// checkExportTables counts the rows of tables matching where (all rows when empty). By default the
+// first table that cannot be read fails the export; with WithContinueOnError it is left out of the
+// returned tables and counts, and its error is recorded in failed.
+func (s *Service) checkExportTables(ctx context.Context, db *sql.DB, tables []*schema.Table, where string, args []any, cfg exportConfig) ([]*schema.Table, map[string]int, map[string]string, error) {
+	counts := make(map[string]int, len(tables))
+	var failed map[string]string
+	readable := make([]*schema.Table, 0, len(tables))
+	for _, tbl := range tables {
+		count, err := s.checkExportTable(ctx, db, tbl, where, args, cfg.continueOn)
+		if err != nil {
+			if !cfg.continueOn || ctx.Err() != nil {
+				return nil, nil, nil, err
+			}
+			if failed == nil {
+				failed = make(map[string]string)
+			}
+			failed[tbl.Name] = err.Error()
+			if cfg.onError != nil {
+				cfg.onError(tbl.Name, err)
+			}
+			continue
+		}
+		counts[tbl.Name] = count
+		readable = append(readable, tbl)
+	}
+	return readable, counts, failed, nil
+}
+
+// checkExportTable counts the rows of table matching where. With probe it also selects the exported
+// columns without fetching any row, so a table whose rows cannot be read fails before the export starts.
+func (s *Service) checkExportTable(ctx context.Context, db *sql.DB, table *schema.Table, where string, args []any, probe bool) (int, error) {
+	// #nosec G201 -- table names come from ent schema definitions, not user input.
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s", table.Name)
+	if where != "" {
+		query += " WHERE " + where
+	}
+	var count int
+	if err := db.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count table %s: %w", table.Name, err)
+	}
+	if columns := columnNames(table); probe && len(columns) > 0 {
+		// #nosec G201 -- table names come from ent schema definitions, not user input.
+		query := fmt.Sprintf("SELECT %s FROM %s LIMIT 0", strings.Join(columns, ", "), table.Name)
+		rows, err := db.QueryContext(ctx, query)
+		if err != nil {
+			return 0, fmt.Errorf("query %s: %w", table.Name, err)
+		}
+		rows.Close()
+	}
+	return count, nil
+}
+
 func (s *Service) countTableRows(ctx context.Context, db *sql.DB, table string) (int, error) {
 	// #nosec G201 -- table names come from ent schema definitions, not user input.
 	query := fmt.Sprintf("SELECT COUNT(*) FROM %s", table)
@@ -567,6 +1054,12 @@ func convertDBValue(col *schema.Column, value any) (any, error) {
 			return cp, nil
 		}
 		return string(v), nil
+	case string:
+		// sqlite hands back JSON stored with TEXT affinity as a string; keep it as raw JSON
+		// so it is not re-encoded as a quoted string.
+		if col.Type == field.TypeJSON && json.Valid([]byte(v)) {
+			return json.RawMessage(v), nil
+		}
 	case time.Time:
 		return v.UTC().Format(time.RFC3339Nano), nil
 	}
@@ -594,26 +1087,34 @@ func convertDBValue(col *schema.Column, value any) (any, error) {
 	}
 }
 
-func decodePayload(table *schema.Table, payload json.RawMessage) (map[string]any, error) {
+// decodePayload converts a row payload to column values, reading backup columns listed in renames
+// under their new name. Keys that are not columns of table are left out of the values and
+// returned, sorted, as unknown.
+func decodePayload(table *schema.Table, payload json.RawMessage, renames map[string]string) (values map[string]any, unknown []string, err error) {
 	dec := json.NewDecoder(bytes.NewReader(payload))
 	dec.UseNumber()
 	var raw map[string]any
 	if err := dec.Decode(&raw); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	result := make(map[string]any, len(raw))
+	values = make(map[string]any, len(raw))
 	for key, val := range raw {
+		if to, ok := renames[key]; ok {
+			key = to
+		}
 		col := findColumn(table, key)
 		if col == nil {
-			return nil, fmt.Errorf("column %s not found in table %s", key, table.Name)
+			unknown = append(unknown, key)
+			continue
 		}
 		converted, err := convertJSONValue(col, val)
 		if err != nil {
-			return nil, fmt.Errorf("convert %s.%s: %w", table.Name, key, err)
+			return nil, nil, fmt.Errorf("convert %s.%s: %w", table.Name, key, err)
 		}
-		result[key] = converted
+		values[key] = converted
 	}
-	return result, nil
+	sort.Strings(unknown)
+	return values, unknown, nil
 }
 
 func convertJSONValue(col *schema.Column, value any) (any, error) {
@@ -652,6 +1153,10 @@ func convertJSONValue(col *schema.Column, value any) (any, error) {
 		}
 		return base64.StdEncoding.DecodeString(str)
 	case field.TypeJSON:
+		// Older backups exported TEXT-affinity JSON as a quoted string; unwrap those documents.
+		if str, ok := value.(string); ok && isJSONDocument(str) {
+			return json.RawMessage(str), nil
+		}
 		b, err := json.Marshal(value)
 		if err != nil {
 			return nil, err
@@ -662,6 +1167,14 @@ func convertJSONValue(col *schema.Column, value any) (any, error) {
 	}
 }
 
+func isJSONDocument(s string) bool {
+	s = strings.TrimSpace(s)
+	if s == "" || (s[0] != '{' && s[0] != '[') {
+		return false
+	}
+	return json.Valid([]byte(s))
+}
+
 func buildPlaceholders(driver string, count int) []string {
 	switch driver {
 	case "postgres", "postgresql":
@@ -683,8 +1196,7 @@ func buildPlaceholders(driver string, count int) []string {
 	}
 }
 
-func buildUpsertClause(driver string, table *schema.Table, insertCols []string) (string, error) {
-	conflictCols := conflictColumns(table)
+func buildUpsertClause(driver string, conflictCols, insertCols []string) (string, error) {
 	if len(conflictCols) == 0 {
 		return "", nil
 	}
@@ -805,6 +1317,11 @@ func findColumn(table *schema.Table, name string) *schema.Column {
 	return nil
 }
 
+// SchemaHash returns the hash of the current ent schema, as written to ent_schema_hash in backups.
+func SchemaHash() string {
+	return computeSchemaHash(migrate.Tables)
+}
+
 func computeSchemaHash(tables []*schema.Table) string {
 	builder := &strings.Builder{}
 	sortedTables := make([]*schema.Table, len(tables))
@@ -846,6 +1363,43 @@ func computeSchemaHash(tables []*schema.Table) string {
 	return fmt.Sprintf("%x", sum[:])
 }
 
+// recordWriter buffers whole NDJSON records and hands them to the destination only at record
+// boundaries, so an export stopped by cancellation or an error never leaves a partial line.
+type recordWriter struct {
+	w          io.Writer
+	buf        bytes.Buffer
+	rows       int
+	flushBytes int
+	flushRows  int
+}
+
+func newRecordWriter(w io.Writer, cfg exportConfig) *recordWriter {
+	return &recordWriter{w: w, flushBytes: cfg.flushBytes, flushRows: cfg.flushRows}
+}
+
+// Write buffers rec and flushes once either threshold is reached.
+func (rw *recordWriter) Write(rec record) error {
+	if err := writeRecord(&rw.buf, rec); err != nil {
+		return err
+	}
+	rw.rows++
+	if rw.buf.Len() >= rw.flushBytes || rw.rows >= rw.flushRows {
+		return rw.Flush()
+	}
+	return nil
+}
+
+// Flush writes every buffered record to the destination.
+func (rw *recordWriter) Flush() error {
+	if rw.buf.Len() == 0 {
+		return nil
+	}
+	_, err := rw.w.Write(rw.buf.Bytes())
+	rw.buf.Reset()
+	rw.rows = 0
+	return err
+}
+
 func writeRecord(w io.Writer, rec record) error {
 	data, err := json.Marshal(rec)
 	if err != nil {
@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
 	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -120,6 +125,501 @@ func TestServiceExportTablesFilter(t *testing.T) {
 	}
 }
 
+// cancelAfter cancels the export once n rows have been written.
+type cancelAfter struct {
+	noopProgress
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *cancelAfter) Increment(string, int) {
+	if c.n--; c.n == 0 {
+		c.cancel()
+	}
+}
+
+// chunkWriter records every write the export hands to its destination.
+type chunkWriter struct {
+	chunks [][]byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.chunks = append(w.chunks, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func TestServiceExportCancelledEndsOnRecordBoundary(t *testing.T) {
+	requireSQLite(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+	for i := range 20 {
+		client.Word.Create().SetText(strings.Repeat("w", i+1)).SetLanguage("en").SaveX(context.Background())
+	}
+
+	exporter, err := NewService("sqlite3", dsn, WithBatchSize(4))
+	if err != nil {
+		t.Fatalf("new exporter: %v", err)
+	}
+	out := &chunkWriter{}
+	err = exporter.Export(ctx, out,
+		WithTables([]string{"words"}),
+		WithFlushEvery(0, 3),
+		WithProgressReporter(&cancelAfter{n: 6, cancel: cancel}),
+	)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("export error = %v, want context.Canceled", err)
+	}
+
+	var data []byte
+	for i, chunk := range out.chunks {
+		if len(chunk) == 0 || chunk[len(chunk)-1] != '\n' {
+			t.Fatalf("chunk %d does not end on a record boundary: %q", i, chunk)
+		}
+		data = append(data, chunk...)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	for i, line := range lines {
+		var rec record
+		if err := json.Unmarshal([]byte(line), &rec); err != nil {
+			t.Fatalf("line %d is not a whole record: %v\n%s", i, err, line)
+		}
+		if want := map[bool]string{true: "meta", false: "words"}[i == 0]; rec.Type != want {
+			t.Fatalf("line %d type = %q, want %q", i, rec.Type, want)
+		}
+	}
+	// The batch in flight when the context is cancelled finishes; the next one is not started.
+	if rows := len(lines) - 1; rows < 6 || rows >= 20 {
+		t.Fatalf("exported %d rows, want the rows up to the cut", rows)
+	}
+}
+
+func TestServiceSelectTablesDenyList(t *testing.T) {
+	svc, err := NewService("sqlite3", "file:unused.db", WithDeniedTables([]string{" Learned_Words ", "sessions"}))
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		requested []string
+		exclude   []string
+		want      []string
+		wantErr   error
+	}{
+		{name: "default skips denied", want: []string{"word_audit", "words"}},
+		{name: "explicit allowed", requested: []string{"words"}, want: []string{"words"}},
+		{name: "explicit denied", requested: []string{"words", "learned_words"}, wantErr: ErrTableDenied},
+		{name: "exclude empties default", exclude: []string{"words", "word_audit"}, wantErr: errNoTablesSelected},
+		{name: "exclude drops explicit", requested: []string{"words"}, exclude: []string{"WORDS"}, wantErr: errNoTablesSelected},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tables, err := svc.selectTables(tc.requested, tc.exclude...)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("expected %v, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("selectTables: %v", err)
+			}
+			if got := tableNames(tables); !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("tables = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	open, err := NewService("sqlite3", "file:unused.db")
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	tables, err := open.selectTables(nil, "words", "word_audit")
+	if err != nil {
+		t.Fatalf("selectTables: %v", err)
+	}
+	if got := tableNames(tables); !reflect.DeepEqual(got, []string{"learned_words"}) {
+		t.Fatalf("tables = %v, want [learned_words]", got)
+	}
+	tables, err = open.selectTables(nil)
+	if err != nil {
+		t.Fatalf("selectTables: %v", err)
+	}
+	if got := tableNames(tables); !reflect.DeepEqual(got, []string{"learned_words", "word_audit", "words"}) {
+		t.Fatalf("tables = %v, want sorted [learned_words word_audit words]", got)
+	}
+}
+
+func TestServiceExportHonorsDenyList(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	dsn := "file:" + filepath.Join(t.TempDir(), "deny.db") + "?_fk=1&cache=shared"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+	seedData(t, ctx, client)
+
+	svc, err := NewService("sqlite3", dsn, WithDeniedTables([]string{"learned_words"}))
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := svc.Export(ctx, &buf); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if bytes.Contains(buf.Bytes(), []byte(`"type":"learned_words"`)) {
+		t.Fatalf("denied table leaked into default export")
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(`"type":"words"`)) {
+		t.Fatalf("expected words rows in export")
+	}
+
+	buf.Reset()
+	if err := svc.Export(ctx, &buf, WithTables([]string{"learned_words"})); !errors.Is(err, ErrTableDenied) {
+		t.Fatalf("expected ErrTableDenied for explicit request, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("nothing should be written when the selection is rejected, got %d bytes", buf.Len())
+	}
+}
+
+func TestServiceExportContinueOnError(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	dsn := "file:" + filepath.Join(t.TempDir(), "partial.db") + "?_fk=1&cache=shared"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+	words, _ := seedData(t, ctx, client)
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+	// word_audit can no longer be counted; learned_words is counted but its rows cannot be read.
+	for _, stmt := range []string{
+		"DROP TABLE word_audit",
+		"ALTER TABLE learned_words RENAME COLUMN notes TO remarks",
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+
+	svc, err := NewService("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := svc.Export(ctx, &buf); err == nil {
+		t.Fatal("expected the default export to fail fast on the dropped table")
+	}
+
+	buf.Reset()
+	var skipped []string
+	err = svc.Export(ctx, &buf, WithContinueOnError(), WithTableErrorHandler(func(table string, err error) {
+		skipped = append(skipped, table)
+	}))
+	if err != nil {
+		t.Fatalf("export with continue on error: %v", err)
+	}
+	sort.Strings(skipped)
+	if want := []string{"learned_words", "word_audit"}; !reflect.DeepEqual(skipped, want) {
+		t.Fatalf("skipped tables = %v, want %v", skipped, want)
+	}
+
+	meta, err := ReadMeta(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("read meta: %v", err)
+	}
+	if !reflect.DeepEqual(meta.Tables, []string{"words"}) {
+		t.Fatalf("meta tables = %v, want [words]", meta.Tables)
+	}
+	if !reflect.DeepEqual(meta.RowCounts, map[string]int{"words": len(words)}) {
+		t.Fatalf("meta row counts = %v, want words: %d", meta.RowCounts, len(words))
+	}
+	for _, table := range skipped {
+		if meta.Errors[table] == "" {
+			t.Fatalf("meta errors = %v, want an entry for %s", meta.Errors, table)
+		}
+	}
+	if got := bytes.Count(buf.Bytes(), []byte(`"type":"words"`)); got != len(words) {
+		t.Fatalf("exported %d words rows, want %d", got, len(words))
+	}
+}
+
+func TestServiceImportVerifiesRowCounts(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+
+	srcDSN := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	srcClient := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { srcClient.Close() })
+	seedData(t, ctx, srcClient)
+
+	exporter, err := NewService("sqlite3", srcDSN)
+	if err != nil {
+		t.Fatalf("new exporter: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := exporter.Export(ctx, &buf); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	newImporter := func(t *testing.T) *Service {
+		t.Helper()
+		dstDSN := "file:" + filepath.Join(t.TempDir(), "dst.db") + "?_fk=1&cache=shared"
+		dstClient := enttest.Open(t, dialect.SQLite, dstDSN)
+		t.Cleanup(func() { dstClient.Close() })
+		importer, err := NewService("sqlite3", dstDSN)
+		if err != nil {
+			t.Fatalf("new importer: %v", err)
+		}
+		return importer
+	}
+
+	t.Run("complete backup", func(t *testing.T) {
+		if err := newImporter(t).Import(ctx, bytes.NewReader(buf.Bytes()), WithVerifyCounts()); err != nil {
+			t.Fatalf("import with verification failed: %v", err)
+		}
+	})
+
+	// Drop the final data record (the last exported word) to simulate a truncated file.
+	lines := bytes.Split(bytes.TrimRight(buf.Bytes(), "\n"), []byte("\n"))
+	truncated := append(bytes.Join(lines[:len(lines)-1], []byte("\n")), '\n')
+
+	t.Run("truncated backup strict", func(t *testing.T) {
+		err := newImporter(t).Import(ctx, bytes.NewReader(truncated), WithVerifyCounts())
+		var mismatchErr *CountMismatchError
+		if !errors.As(err, &mismatchErr) {
+			t.Fatalf("expected CountMismatchError, got %v", err)
+		}
+		want := []CountMismatch{{Table: "words", Expected: 2, Received: 1, Before: 0, After: 1}}
+		if !reflect.DeepEqual(mismatchErr.Mismatches, want) {
+			t.Fatalf("unexpected mismatches: %+v", mismatchErr.Mismatches)
+		}
+	})
+
+	t.Run("truncated backup warns", func(t *testing.T) {
+		var warned []CountMismatch
+		err := newImporter(t).Import(ctx, bytes.NewReader(truncated), WithCountMismatchHandler(func(m CountMismatch) {
+			warned = append(warned, m)
+		}))
+		if err != nil {
+			t.Fatalf("non-strict import should succeed, got %v", err)
+		}
+		if len(warned) != 1 || warned[0].Table != "words" {
+			t.Fatalf("expected one warning for words, got %+v", warned)
+		}
+	})
+}
+
+func TestServiceImportFormatCompatibility(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+
+	srcDSN := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	srcClient := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { srcClient.Close() })
+	srcWords, _ := seedData(t, ctx, srcClient)
+
+	exporter, err := NewService("sqlite3", srcDSN)
+	if err != nil {
+		t.Fatalf("new exporter: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := exporter.Export(ctx, &buf); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+
+	newImporter := func(t *testing.T) (*Service, *entdb.Client) {
+		t.Helper()
+		dstDSN := "file:" + filepath.Join(t.TempDir(), "dst.db") + "?_fk=1&cache=shared"
+		dstClient := enttest.Open(t, dialect.SQLite, dstDSN)
+		t.Cleanup(func() { dstClient.Close() })
+		importer, err := NewService("sqlite3", dstDSN)
+		if err != nil {
+			t.Fatalf("new importer: %v", err)
+		}
+		return importer, dstClient
+	}
+
+	t.Run("newer minor version", func(t *testing.T) {
+		// A backup from a later 1.x binary: an extra record type and an extra column on every word.
+		future := append([]string(nil), lines...)
+		future[0] = strings.Replace(future[0], `"version":1`, `"version":1,"minor_version":3`, 1)
+		for i, line := range future {
+			if strings.HasPrefix(line, `{"type":"`+entword.Table+`"`) {
+				future[i] = strings.Replace(line, `"payload":{`, `"payload":{"etymology":"from Old English",`, 1)
+			}
+		}
+		future = append(future, `{"type":"word_votes","payload":{"id":1,"word_id":1,"score":5}}`, `{"type":"word_votes","payload":{"id":2,"word_id":1,"score":-1}}`)
+		input := strings.Join(future, "\n") + "\n"
+
+		importer, dstClient := newImporter(t)
+		var warnings []ImportWarning
+		err := importer.Import(ctx, strings.NewReader(input), WithVerifyCounts(), WithImportWarningHandler(func(w ImportWarning) {
+			warnings = append(warnings, w)
+		}))
+		if err != nil {
+			t.Fatalf("import of a newer minor version failed: %v", err)
+		}
+		if got := snapshotWords(t, ctx, dstClient); !reflect.DeepEqual(got, srcWords) {
+			t.Fatalf("imported words = %#v, want %#v", got, srcWords)
+		}
+
+		want := []ImportWarning{
+			{Table: "", Column: ""},
+			{Table: entword.Table, Column: "etymology"},
+			{Table: "word_votes", Column: ""},
+		}
+		if len(warnings) != len(want) {
+			t.Fatalf("warnings = %v, want one per minor version, unknown column and unknown table", warnings)
+		}
+		for i, w := range want {
+			if warnings[i].Table != w.Table || warnings[i].Column != w.Column || warnings[i].Message == "" {
+				t.Fatalf("warning %d = %+v, want table %q column %q", i, warnings[i], w.Table, w.Column)
+			}
+		}
+	})
+
+	t.Run("other major version", func(t *testing.T) {
+		major := append([]string(nil), lines...)
+		major[0] = strings.Replace(major[0], `"version":1`, `"version":2`, 1)
+
+		importer, dstClient := newImporter(t)
+		err := importer.Import(ctx, strings.NewReader(strings.Join(major, "\n")+"\n"))
+		if err == nil || !strings.Contains(err.Error(), "unsupported format version 2") {
+			t.Fatalf("expected an unsupported version error, got %v", err)
+		}
+		if n := dstClient.Word.Query().CountX(ctx); n != 0 {
+			t.Fatalf("rejected backup wrote %d words", n)
+		}
+	})
+}
+
+func TestServiceImportRemapsRenamedTables(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+
+	srcDSN := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	srcClient := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { srcClient.Close() })
+	_, srcLearned := seedData(t, ctx, srcClient)
+
+	exporter, err := NewService("sqlite3", srcDSN)
+	if err != nil {
+		t.Fatalf("new exporter: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := exporter.Export(ctx, &buf); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	// Rewrite the backup as an older binary would have written it: learned lexemes lived in
+	// user_words and the term column was called word.
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	lines[0] = strings.ReplaceAll(lines[0], `"`+entlearnedlexeme.Table+`"`, `"user_words"`)
+	for i, line := range lines {
+		if strings.HasPrefix(line, `{"type":"`+entlearnedlexeme.Table+`"`) {
+			line = strings.Replace(line, `"type":"`+entlearnedlexeme.Table+`"`, `"type":"user_words"`, 1)
+			lines[i] = strings.Replace(line, `"term":`, `"word":`, 1)
+		}
+	}
+	legacy := strings.Join(lines, "\n") + "\n"
+
+	dstDSN := "file:" + filepath.Join(t.TempDir(), "dst.db") + "?_fk=1&cache=shared"
+	dstClient := enttest.Open(t, dialect.SQLite, dstDSN)
+	t.Cleanup(func() { dstClient.Close() })
+	importer, err := NewService("sqlite3", dstDSN)
+	if err != nil {
+		t.Fatalf("new importer: %v", err)
+	}
+
+	err = importer.Import(ctx, strings.NewReader(legacy), WithTableRemap(map[string]string{"user_words": "no_such_table"}))
+	if err == nil || !strings.Contains(err.Error(), `unknown table "no_such_table"`) {
+		t.Fatalf("expected an unknown table error, got %v", err)
+	}
+
+	err = importer.Import(ctx, strings.NewReader(legacy), WithVerifyCounts(),
+		WithTableRemap(map[string]string{"user_words": entlearnedlexeme.Table}),
+		WithColumnRemap(entlearnedlexeme.Table, map[string]string{"word": "term"}),
+		WithImportWarningHandler(func(w ImportWarning) {
+			t.Errorf("unexpected warning: %+v", w)
+		}))
+	if err != nil {
+		t.Fatalf("remapped import failed: %v", err)
+	}
+	if got := snapshotLearnedWords(t, ctx, dstClient); !reflect.DeepEqual(got, srcLearned) {
+		t.Fatalf("imported learned words = %#v, want %#v", got, srcLearned)
+	}
+}
+
+// endlessLine yields an unterminated line of 'x' bytes forever.
+type endlessLine struct{}
+
+func (endlessLine) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 'x'
+	}
+	return len(p), nil
+}
+
+func TestServiceImportRejectsOversizedRecords(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	dstDSN := "file:" + filepath.Join(t.TempDir(), "dst.db") + "?_fk=1&cache=shared"
+	dstClient := enttest.Open(t, dialect.SQLite, dstDSN)
+	t.Cleanup(func() { dstClient.Close() })
+	importer, err := NewService("sqlite3", dstDSN)
+	if err != nil {
+		t.Fatalf("new importer: %v", err)
+	}
+
+	meta := `{"type":"meta","version":1,"tables":["words"],"row_counts":{"words":1}}` + "\n"
+	wordRecord := func(category string) string {
+		return `{"type":"words","payload":{"id":1,"text":"run","language":"en","word_type":"lemma","phonetics":[],"definitions":[],` +
+			`"phrases":[],"sentences":[],"relations":[],"categories":["` + category + `"],` +
+			`"created_at":"2025-01-01T00:00:00Z","updated_at":"2025-01-01T00:00:00Z"}}`
+	}
+	const limit = 1 << 10
+
+	// A record of exactly the limit is accepted.
+	padded := wordRecord(strings.Repeat("c", limit-len(wordRecord(""))))
+	if err := importer.Import(ctx, strings.NewReader(meta+padded+"\n"), WithMaxRecordBytes(limit)); err != nil {
+		t.Fatalf("import of a record at the limit: %v", err)
+	}
+
+	// An endless line fails once the cap is reached instead of buffering without bound.
+	err = importer.Import(ctx, io.MultiReader(strings.NewReader(meta), endlessLine{}), WithMaxRecordBytes(limit))
+	if !errors.Is(err, ErrRecordTooLarge) || !strings.Contains(err.Error(), "line 2 exceeds 1024 bytes") {
+		t.Fatalf("expected ErrRecordTooLarge on line 2, got %v", err)
+	}
+
+	// Rows read before an oversized record are rolled back with the rest of the import.
+	dstClient.Word.Delete().ExecX(ctx)
+	err = importer.Import(ctx, strings.NewReader(meta+wordRecord("a")+"\n"+wordRecord(strings.Repeat("y", limit))+"\n"), WithMaxRecordBytes(limit))
+	if !errors.Is(err, ErrRecordTooLarge) {
+		t.Fatalf("expected ErrRecordTooLarge, got %v", err)
+	}
+	if n := dstClient.Word.Query().CountX(ctx); n != 0 {
+		t.Fatalf("failed import left %d words behind", n)
+	}
+}
+
 func seedData(t *testing.T, ctx context.Context, client *entdb.Client) ([]wordSnapshot, []LearnedWordSnapshot) {
 	t.Helper()
 	createdAt := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
@@ -310,3 +810,49 @@ func requireSQLite(t *testing.T) {
 		t.Skipf("skipping sqlite-dependent tests: %v", err)
 	}
 }
+
+func TestReadMeta(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	srcDSN := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	srcClient := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { srcClient.Close() })
+
+	srcWords, srcLearnedWords := seedData(t, ctx, srcClient)
+
+	svc, err := NewService("sqlite3", srcDSN)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := svc.Export(ctx, &buf); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+
+	meta, err := ReadMeta(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadMeta: %v", err)
+	}
+	if meta.Version != formatVersion || meta.EntSchemaHash != svc.schemaHash || meta.ExportedAt.IsZero() {
+		t.Fatalf("unexpected meta header: %+v", meta)
+	}
+	wantTables := tableNames(svc.tables)
+	sort.Strings(wantTables)
+	if !reflect.DeepEqual(meta.Tables, wantTables) {
+		t.Fatalf("tables = %v, want %v", meta.Tables, wantTables)
+	}
+	if meta.RowCounts[entword.Table] != len(srcWords) || meta.RowCounts[entlearnedlexeme.Table] != len(srcLearnedWords) {
+		t.Fatalf("row counts = %v, want %d words and %d learned lexemes", meta.RowCounts, len(srcWords), len(srcLearnedWords))
+	}
+
+	for name, input := range map[string]string{
+		"empty":          "\n\n",
+		"data first":     `{"type":"words","payload":{"id":1}}` + "\n",
+		"no meta at all": "",
+	} {
+		if _, err := ReadMeta(bytes.NewReader([]byte(input))); !errors.Is(err, ErrMissingMeta) {
+			t.Fatalf("%s: expected ErrMissingMeta, got %v", name, err)
+		}
+	}
+}
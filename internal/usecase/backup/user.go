@@ -0,0 +1,175 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// userIDColumn marks the tables holding per-user data, which per-user archives carry.
+const userIDColumn = "user_id"
+
+// ErrUserArchive is returned when Import is given a per-user archive, which ImportUser restores.
+var ErrUserArchive = errors.New("backup: per-user archive; import it with ImportUser")
+
+// ErrNotUserArchive is returned when ImportUser is given a full backup.
+var ErrNotUserArchive = errors.New("backup: not a per-user archive")
+
+// ExportUser writes a per-user archive: the rows of userID in every table with a user_id column,
+// i.e. the learned lexemes with their mastery scores and review schedule (last and next review,
+// interval, fail count), plus any per-user table added later. Shared tables such as words are left
+// out. WithTables narrows the per-user tables further, and WithContinueOnError skips unreadable
+// tables as it does for Export.
+func (s *Service) ExportUser(ctx context.Context, w io.Writer, userID int64, opts ...ExportOption) error {
+	if userID <= 0 {
+		return fmt.Errorf("backup: invalid user id %d", userID)
+	}
+	cfg := newExportConfig(opts...)
+	tables, err := s.selectTables(cfg.tables, cfg.exclude...)
+	if err != nil {
+		return err
+	}
+	if tables = userTables(tables); len(tables) == 0 {
+		return errNoTablesSelected
+	}
+	reporter := cfg.reporter
+	if reporter == nil {
+		reporter = noopProgress{}
+	}
+
+	db, err := s.openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	where := userIDColumn + " = " + buildPlaceholders(s.driver, 1)[0]
+	args := []any{userID}
+	tables, counts, failed, err := s.checkExportTables(ctx, db, tables, where, args, cfg)
+	if err != nil {
+		return err
+	}
+
+	writer := newRecordWriter(w, cfg)
+	defer writer.Flush()
+
+	now := time.Now().UTC()
+	meta := record{
+		Type:          "meta",
+		Version:       formatVersion,
+		MinorVersion:  formatMinorVersion,
+		ExportedAt:    &now,
+		EntSchemaHash: s.schemaHash,
+		Tables:        tableNames(tables),
+		RowCounts:     counts,
+		Errors:        failed,
+		UserID:        userID,
+	}
+	if err := writer.Write(meta); err != nil {
+		return err
+	}
+
+	for _, tbl := range tables {
+		reporter.StartTable(tbl.Name, counts[tbl.Name])
+		if err := s.exportTable(ctx, db, tbl, where, args, reporter, writer); err != nil {
+			return err
+		}
+		reporter.FinishTable(tbl.Name)
+	}
+	return writer.Flush()
+}
+
+// ImportUser restores a per-user archive written by ExportUser into userID, which may differ
+// from the exported user. Rows get new ids, review timing and mastery are kept as exported, and
+// links to shared rows missing from this database (a dictionary word, say) are cleared. Rows
+// colliding with one the user already has (the same term, for lexemes) overwrite it, so
+// importing an archive twice is harmless. WithImportTables narrows the per-user tables.
+func (s *Service) ImportUser(ctx context.Context, r io.Reader, userID int64, opts ...ImportOption) error {
+	if userID <= 0 {
+		return fmt.Errorf("backup: invalid user id %d", userID)
+	}
+	cfg := newImportConfig(opts...)
+	tables, err := s.selectTables(cfg.tables)
+	if err != nil {
+		return err
+	}
+	if tables = userTables(tables); len(tables) == 0 {
+		return errNoTablesSelected
+	}
+	tableFilter := make(map[string]*schema.Table, len(tables))
+	for _, tbl := range tables {
+		tableFilter[tbl.Name] = tbl
+	}
+	return s.importTables(ctx, r, cfg, tables, tableFilter, userID)
+}
+
+func userTables(tables []*schema.Table) []*schema.Table {
+	var out []*schema.Table
+	for _, tbl := range tables {
+		if findColumn(tbl, userIDColumn) != nil {
+			out = append(out, tbl)
+		}
+	}
+	return out
+}
+
+// remapUserRow moves a row of a per-user archive to imp.userID: generated ids are dropped so the
+// database assigns fresh ones, and foreign keys to rows this database lacks are cleared.
+func (s *Service) remapUserRow(ctx context.Context, imp *importRun, table *schema.Table, values map[string]any) error {
+	for _, col := range table.Columns {
+		if col.Increment {
+			delete(values, col.Name)
+		}
+	}
+	values[userIDColumn] = imp.userID
+
+	for _, fk := range table.ForeignKeys {
+		if len(fk.Columns) != 1 || len(fk.RefColumns) != 1 || fk.RefTable == nil {
+			continue
+		}
+		col := fk.Columns[0]
+		val, ok := values[col.Name]
+		if !ok || val == nil {
+			continue
+		}
+		// #nosec G201 -- table and column names come from ent schema definitions, not user input.
+		query := fmt.Sprintf("SELECT 1 FROM %s WHERE %s = %s", fk.RefTable.Name, fk.RefColumns[0].Name, buildPlaceholders(s.driver, 1)[0])
+		var found int
+		err := imp.tx.QueryRowContext(ctx, query, val).Scan(&found)
+		switch {
+		case err == nil:
+		case errors.Is(err, sql.ErrNoRows) && col.Nullable:
+			values[col.Name] = nil
+		case errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("backup: %s.%s references missing %s row %v", table.Name, col.Name, fk.RefTable.Name, val)
+		default:
+			return fmt.Errorf("check %s.%s: %w", table.Name, col.Name, err)
+		}
+	}
+	return nil
+}
+
+// userConflictColumns returns the columns of the first unique index of table that includes
+// user_id, which identify a row within one user once ids are regenerated.
+func userConflictColumns(table *schema.Table) []string {
+	for _, idx := range table.Indexes {
+		if !idx.Unique {
+			continue
+		}
+		cols := make([]string, len(idx.Columns))
+		scoped := false
+		for i, col := range idx.Columns {
+			cols[i] = col.Name
+			scoped = scoped || col.Name == userIDColumn
+		}
+		if scoped {
+			return cols
+		}
+	}
+	return nil
+}
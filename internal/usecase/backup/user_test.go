@@ -0,0 +1,179 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	entlearnedlexeme "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/learnedlexeme"
+)
+
+func TestServiceUserArchiveRoundTrip(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	lastReview := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	nextReview := lastReview.Add(72 * time.Hour)
+
+	srcDSN := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	srcClient := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { srcClient.Close() })
+	apple := srcClient.Word.Create().SetText("apple").SetNormalized("apple").SetLanguage("en").SaveX(ctx)
+	srcClient.LearnedLexeme.Create().SetUserID(42).SetTerm("apple").SetNormalized("apple").SetWordID(apple.ID).
+		SetMasteryListen(3).SetMasteryOverall(250).
+		SetReviewLastReviewAt(lastReview).SetReviewNextReviewAt(nextReview).
+		SetReviewIntervalDays(3).SetReviewFailCount(2).SaveX(ctx)
+	srcClient.LearnedLexeme.Create().SetUserID(42).SetTerm("pear").SetNormalized("pear").
+		SetReviewNextReviewAt(nextReview.Add(24 * time.Hour)).SetReviewIntervalDays(1).SaveX(ctx)
+	srcClient.LearnedLexeme.Create().SetUserID(7).SetTerm("plum").SetNormalized("plum").SaveX(ctx)
+
+	exporter, err := NewService("sqlite3", srcDSN)
+	if err != nil {
+		t.Fatalf("new exporter: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := exporter.ExportUser(ctx, &buf, 42); err != nil {
+		t.Fatalf("export user: %v", err)
+	}
+	meta, err := ReadMeta(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("read meta: %v", err)
+	}
+	if meta.UserID != 42 || meta.RowCounts[entlearnedlexeme.Table] != 2 || len(meta.Tables) != 1 {
+		t.Fatalf("meta = %+v, want the two lexemes of user 42 only", meta)
+	}
+
+	dstDSN := "file:" + filepath.Join(t.TempDir(), "dst.db") + "?_fk=1&cache=shared"
+	dstClient := enttest.Open(t, dialect.SQLite, dstDSN)
+	t.Cleanup(func() { dstClient.Close() })
+	// The destination already has a lexeme with id 1, which the restored rows must not replace.
+	existing := dstClient.LearnedLexeme.Create().SetUserID(5).SetTerm("fig").SetNormalized("fig").SaveX(ctx)
+	importer, err := NewService("sqlite3", dstDSN)
+	if err != nil {
+		t.Fatalf("new importer: %v", err)
+	}
+	for range 2 { // a repeated import overwrites the rows of the first one
+		if err := importer.ImportUser(ctx, bytes.NewReader(buf.Bytes()), 1000, WithVerifyCounts()); err != nil {
+			t.Fatalf("import user: %v", err)
+		}
+	}
+
+	restored := dstClient.LearnedLexeme.Query().Where(entlearnedlexeme.UserID(1000)).Order(entlearnedlexeme.ByTerm()).AllX(ctx)
+	if len(restored) != 2 {
+		t.Fatalf("restored %d lexemes, want 2", len(restored))
+	}
+	got := restored[0]
+	if got.Term != "apple" || got.MasteryListen != 3 || got.MasteryOverall != 250 || got.ReviewIntervalDays != 3 || got.ReviewFailCount != 2 {
+		t.Fatalf("restored apple = %+v, want mastery and review counters kept", got)
+	}
+	if got.ReviewNextReviewAt == nil || !got.ReviewNextReviewAt.Equal(nextReview) || got.ReviewLastReviewAt == nil || !got.ReviewLastReviewAt.Equal(lastReview) {
+		t.Fatalf("restored apple review = %v / %v, want %v / %v", got.ReviewLastReviewAt, got.ReviewNextReviewAt, lastReview, nextReview)
+	}
+	if got.WordID != nil {
+		t.Fatalf("restored apple links word %d, which this database lacks", *got.WordID)
+	}
+	if pear := restored[1]; pear.ReviewNextReviewAt == nil || !pear.ReviewNextReviewAt.Equal(nextReview.Add(24*time.Hour)) {
+		t.Fatalf("restored pear next review = %v", pear.ReviewNextReviewAt)
+	}
+	if fig := dstClient.LearnedLexeme.GetX(ctx, existing.ID); fig.UserID != 5 || fig.Term != "fig" {
+		t.Fatalf("existing lexeme overwritten: %+v", fig)
+	}
+	if n := dstClient.LearnedLexeme.Query().Where(entlearnedlexeme.Term("plum")).CountX(ctx); n != 0 {
+		t.Fatalf("another user's lexeme was exported")
+	}
+
+	// Restoring next to the dictionary keeps the word link.
+	if err := exporter.ImportUser(ctx, bytes.NewReader(buf.Bytes()), 43); err != nil {
+		t.Fatalf("import user into source: %v", err)
+	}
+	linked := srcClient.LearnedLexeme.Query().Where(entlearnedlexeme.UserID(43), entlearnedlexeme.Term("apple")).OnlyX(ctx)
+	if linked.WordID == nil || *linked.WordID != apple.ID {
+		t.Fatalf("word link = %v, want %d", linked.WordID, apple.ID)
+	}
+}
+
+func TestServiceUserArchiveKinds(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	dsn := "file:" + filepath.Join(t.TempDir(), "kinds.db") + "?_fk=1&cache=shared"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+	client.LearnedLexeme.Create().SetUserID(1).SetTerm("apple").SaveX(ctx)
+	svc, err := NewService("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+
+	var full, user bytes.Buffer
+	if err := svc.Export(ctx, &full); err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if err := svc.ExportUser(ctx, &user, 1); err != nil {
+		t.Fatalf("export user: %v", err)
+	}
+	if err := svc.Import(ctx, &user); !errors.Is(err, ErrUserArchive) {
+		t.Fatalf("Import of a user archive: err = %v, want ErrUserArchive", err)
+	}
+	if err := svc.ImportUser(ctx, &full, 2); !errors.Is(err, ErrNotUserArchive) {
+		t.Fatalf("ImportUser of a full backup: err = %v, want ErrNotUserArchive", err)
+	}
+	if n := client.LearnedLexeme.Query().Where(entlearnedlexeme.UserID(2)).CountX(ctx); n != 0 {
+		t.Fatalf("rejected archive wrote %d lexemes", n)
+	}
+}
+
+func TestServiceExportUserContinueOnError(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	dsn := "file:" + filepath.Join(t.TempDir(), "user-partial.db") + "?_fk=1&cache=shared"
+	client := enttest.Open(t, dialect.SQLite, dsn)
+	t.Cleanup(func() { client.Close() })
+	client.LearnedLexeme.Create().SetUserID(42).SetTerm("apple").SetNormalized("apple").SaveX(ctx)
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	defer db.Close()
+	// The lexemes of user 42 can still be counted, but their rows can no longer be read.
+	if _, err := db.ExecContext(ctx, "ALTER TABLE learned_words RENAME COLUMN notes TO remarks"); err != nil {
+		t.Fatalf("rename column: %v", err)
+	}
+
+	svc, err := NewService("sqlite3", dsn)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := svc.ExportUser(ctx, &buf, 42); err == nil {
+		t.Fatal("expected the default user export to fail fast on the unreadable table")
+	}
+
+	buf.Reset()
+	var skipped []string
+	err = svc.ExportUser(ctx, &buf, 42, WithContinueOnError(), WithTableErrorHandler(func(table string, err error) {
+		skipped = append(skipped, table)
+	}))
+	if err != nil {
+		t.Fatalf("user export with continue on error: %v", err)
+	}
+	if len(skipped) != 1 || skipped[0] != entlearnedlexeme.Table {
+		t.Fatalf("skipped tables = %v, want [%s]", skipped, entlearnedlexeme.Table)
+	}
+	meta, err := ReadMeta(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("read meta: %v", err)
+	}
+	if meta.UserID != 42 || len(meta.Tables) != 0 || meta.Errors[entlearnedlexeme.Table] == "" {
+		t.Fatalf("meta = %+v, want user 42 with the skipped table recorded in errors", meta)
+	}
+}
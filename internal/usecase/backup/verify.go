@@ -0,0 +1,173 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/migrate"
+)
+
+// VerifyIssue is a single structural problem found in a backup. Line is 1-based; it is zero for
+// issues that concern the backup as a whole, such as row count mismatches.
+type VerifyIssue struct {
+	Line    int
+	Message string
+}
+
+func (i VerifyIssue) String() string {
+	if i.Line == 0 {
+		return i.Message
+	}
+	return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+}
+
+// VerifyReport summarises an offline backup verification.
+type VerifyReport struct {
+	Meta    Meta
+	Records int            // data records read, excluding the meta record
+	Counts  map[string]int // data records read per table
+	Issues  []VerifyIssue
+	Omitted int // issues found beyond the reporting limit
+}
+
+// OK reports whether the backup passed every check.
+func (r VerifyReport) OK() bool {
+	return len(r.Issues) == 0 && r.Omitted == 0
+}
+
+// Verify checks the structure of a decompressed NDJSON backup without touching a database: every
+// line must decode, the first record must be a meta record with a supported version, data records
+// must target known tables and carry the columns an import needs, and per-table counts must match
+// the meta record. At most maxIssues issues are kept (all of them when maxIssues <= 0). The
+// returned error is reserved for failures reading r, including lines over DefaultMaxRecordBytes
+// (ErrRecordTooLarge); structural problems land in the report.
+func Verify(r io.Reader, maxIssues int) (VerifyReport, error) {
+	tables, err := schema.CopyTables(migrate.Tables)
+	if err != nil {
+		return VerifyReport{}, fmt.Errorf("copy ent schema tables: %w", err)
+	}
+	tableIndex := make(map[string]*schema.Table, len(tables))
+	for _, tbl := range tables {
+		tableIndex[tbl.Name] = tbl
+	}
+
+	report := VerifyReport{Counts: make(map[string]int)}
+	addIssue := func(line int, format string, args ...any) {
+		if maxIssues > 0 && len(report.Issues) >= maxIssues {
+			report.Omitted++
+			return
+		}
+		report.Issues = append(report.Issues, VerifyIssue{Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var (
+		sc       = newRecordScanner(r, DefaultMaxRecordBytes)
+		lineNo   int
+		seen     bool // a non-empty line has been read
+		metaSeen bool
+	)
+	for sc.Scan() {
+		lineNo++
+		if line := bytes.TrimSpace(sc.Bytes()); len(line) > 0 {
+			first := !seen
+			seen = true
+			var rec rawRecord
+			if err := json.Unmarshal(line, &rec); err != nil {
+				addIssue(lineNo, "invalid record: %v", err)
+			} else if rec.Type == "meta" {
+				if !first {
+					addIssue(lineNo, "unexpected meta record; only the first record may be meta")
+				} else {
+					metaSeen = true
+					report.Meta, _ = decodeMeta(line)
+					if err := validateImportMeta(rec); err != nil {
+						addIssue(lineNo, "unsupported format version %d (want %d)", rec.Version, formatVersion)
+					}
+				}
+			} else {
+				if first {
+					addIssue(lineNo, "first record has type %q, want meta", rec.Type)
+				}
+				report.Records++
+				if msg := verifyDataRecord(tableIndex, rec); msg != "" {
+					addIssue(lineNo, "%s", msg)
+				}
+				if _, ok := tableIndex[rec.Type]; ok {
+					report.Counts[rec.Type]++
+				}
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return report, scanError(err, lineNo+1, DefaultMaxRecordBytes)
+	}
+
+	if !seen {
+		addIssue(0, "backup is empty")
+		return report, nil
+	}
+	if metaSeen {
+		verifyRowCounts(report.Meta, report.Counts, addIssue)
+	}
+	return report, nil
+}
+
+// verifyDataRecord mirrors the checks importRow applies before writing a row and returns a
+// description of the first problem, or "" when the record is importable.
+func verifyDataRecord(tableIndex map[string]*schema.Table, rec rawRecord) string {
+	if rec.Type == "" {
+		return "record has no type"
+	}
+	tbl, ok := tableIndex[rec.Type]
+	if !ok {
+		return fmt.Sprintf("unknown table %q", rec.Type)
+	}
+	if len(rec.Payload) == 0 || bytes.Equal(rec.Payload, []byte("null")) {
+		return fmt.Sprintf("missing payload for table %s", tbl.Name)
+	}
+	values, unknown, err := decodePayload(tbl, rec.Payload, nil)
+	if err != nil {
+		return fmt.Sprintf("invalid payload for %s: %v", tbl.Name, err)
+	}
+	// Import skips unknown columns, but a backup that fully matches this binary has none.
+	if len(unknown) > 0 {
+		return fmt.Sprintf("invalid payload for %s: column %s not found in table %s", tbl.Name, unknown[0], tbl.Name)
+	}
+	for _, col := range tbl.Columns {
+		if col.Nullable || col.Default != nil {
+			continue
+		}
+		val, present := values[col.Name]
+		if !present {
+			return fmt.Sprintf("missing required column %s.%s", tbl.Name, col.Name)
+		}
+		if val == nil {
+			if _, ok := defaultValueForColumn(col); !ok {
+				return fmt.Sprintf("null value for required column %s.%s", tbl.Name, col.Name)
+			}
+		}
+	}
+	return ""
+}
+
+func verifyRowCounts(meta Meta, received map[string]int, addIssue func(int, string, ...any)) {
+	names := make([]string, 0, len(meta.RowCounts)+len(received))
+	for name := range meta.RowCounts {
+		names = append(names, name)
+	}
+	for name := range received {
+		if _, ok := meta.RowCounts[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if expected, got := meta.RowCounts[name], received[name]; expected != got {
+			addIssue(0, "%s: meta records %d rows, backup contains %d", name, expected, got)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	entword "github.com/eslsoft/vocnet/internal/infrastructure/database/ent/word"
+
+	"entgo.io/ent/dialect"
+)
+
+func TestVerify(t *testing.T) {
+	requireSQLite(t)
+
+	ctx := context.Background()
+	srcDSN := "file:" + filepath.Join(t.TempDir(), "src.db") + "?_fk=1&cache=shared"
+	srcClient := enttest.Open(t, dialect.SQLite, srcDSN)
+	t.Cleanup(func() { srcClient.Close() })
+
+	srcWords, _ := seedData(t, ctx, srcClient)
+
+	svc, err := NewService("sqlite3", srcDSN)
+	if err != nil {
+		t.Fatalf("new service: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := svc.Export(ctx, &buf); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	valid := buf.String()
+
+	report, err := Verify(strings.NewReader(valid), 0)
+	if err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if !report.OK() {
+		t.Fatalf("valid backup reported issues: %v", report.Issues)
+	}
+	if report.Counts[entword.Table] != len(srcWords) {
+		t.Fatalf("word count = %d, want %d", report.Counts[entword.Table], len(srcWords))
+	}
+
+	lines := strings.Split(strings.TrimSuffix(valid, "\n"), "\n")
+	wordLine := -1
+	for i, line := range lines {
+		if strings.HasPrefix(line, `{"type":"`+entword.Table+`"`) {
+			wordLine = i
+			break
+		}
+	}
+	if wordLine < 0 {
+		t.Fatalf("no word record in backup")
+	}
+	withLine := func(idx int, replacement string) string {
+		out := append([]string(nil), lines...)
+		out[idx] = replacement
+		return strings.Join(out, "\n") + "\n"
+	}
+	dropLine := func(idx int) string {
+		out := append(append([]string(nil), lines[:idx]...), lines[idx+1:]...)
+		return strings.Join(out, "\n") + "\n"
+	}
+
+	cases := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "empty",
+			input: "\n",
+			want:  "backup is empty",
+		},
+		{
+			name:  "invalid json",
+			input: withLine(wordLine, `{"type":`),
+			want:  "invalid record",
+		},
+		{
+			name:  "missing meta",
+			input: dropLine(0),
+			want:  "want meta",
+		},
+		{
+			name:  "unsupported version",
+			input: withLine(0, strings.Replace(lines[0], `"version":1`, `"version":99`, 1)),
+			want:  "unsupported format version 99",
+		},
+		{
+			name:  "unknown table",
+			input: valid + `{"type":"nope","payload":{"id":1}}` + "\n",
+			want:  `unknown table "nope"`,
+		},
+		{
+			name:  "unknown column",
+			input: withLine(wordLine, strings.Replace(lines[wordLine], `"payload":{`, `"payload":{"bogus":1,`, 1)),
+			want:  "column bogus not found",
+		},
+		{
+			name:  "missing required column",
+			input: withLine(wordLine, `{"type":"`+entword.Table+`","payload":{"id":999}}`),
+			want:  "missing required column",
+		},
+		{
+			name:  "row count mismatch",
+			input: dropLine(wordLine),
+			want:  entword.Table + ": meta records",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			report, err := Verify(strings.NewReader(tc.input), 0)
+			if err != nil {
+				t.Fatalf("Verify: %v", err)
+			}
+			if report.OK() {
+				t.Fatalf("expected issues for %s", tc.name)
+			}
+			found := false
+			for _, issue := range report.Issues {
+				if strings.Contains(issue.String(), tc.want) {
+					found = true
+				}
+			}
+			if !found {
+				t.Fatalf("issues %v do not mention %q", report.Issues, tc.want)
+			}
+		})
+	}
+
+	t.Run("line numbers and limit", func(t *testing.T) {
+		input := withLine(wordLine, "not json")
+		input += "garbage\n{\"type\":\"nope\"}\n"
+		report, err := Verify(strings.NewReader(input), 1)
+		if err != nil {
+			t.Fatalf("Verify: %v", err)
+		}
+		if len(report.Issues) != 1 || report.Issues[0].Line != wordLine+1 {
+			t.Fatalf("issues = %v, want a single issue on line %d", report.Issues, wordLine+1)
+		}
+		if report.Omitted == 0 {
+			t.Fatalf("expected omitted issues beyond the limit")
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"sync"
+	"time"
+)
+
+// dueCountCache remembers per-user due review counts for ttl so polled "due today" badges do not
+// run a count query per request. Writes to a user's lexemes invalidate the user's entry, and a
+// count computed before an invalidation is never stored after it. A nil cache stores nothing.
+type dueCountCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[int64]*dueCountEntry
+	// gens holds the generation of users invalidated since the last prune; other users are at
+	// floor. Both only grow, so a stale generation never matches again.
+	gens  map[int64]uint64
+	floor uint64
+	next  uint64
+}
+
+type dueCountEntry struct {
+	count      int64
+	computedAt time.Time
+	read       bool // served since computedAt; unread entries are dropped by prune
+}
+
+func newDueCountCache(ttl time.Duration) *dueCountCache {
+	return &dueCountCache{ttl: ttl, entries: make(map[int64]*dueCountEntry), gens: make(map[int64]uint64)}
+}
+
+// get returns the fresh cached count of userID, if any, and the generation a recomputed count
+// must be stored with.
+func (c *dueCountCache) get(userID int64, now time.Time) (count int64, ok bool, gen uint64) {
+	if c == nil {
+		return 0, false, 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry := c.entries[userID]; entry != nil && now.Sub(entry.computedAt) < c.ttl {
+		entry.read = true
+		return entry.count, true, c.genLocked(userID)
+	}
+	return 0, false, c.genLocked(userID)
+}
+
+// put stores count unless userID was invalidated after gen was read.
+func (c *dueCountCache) put(userID, count int64, now time.Time, gen uint64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.genLocked(userID) != gen {
+		return
+	}
+	c.entries[userID] = &dueCountEntry{count: count, computedAt: now}
+}
+
+func (c *dueCountCache) invalidate(userID int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userID)
+	c.next++
+	c.gens[userID] = c.next
+}
+
+// prune forgets users whose count was not served since it was last computed and returns the
+// remaining users with the generation to store their recomputed counts with.
+func (c *dueCountCache) prune() map[int64]uint64 {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.next++
+	c.floor = c.next
+	c.gens = make(map[int64]uint64)
+	users := make(map[int64]uint64, len(c.entries))
+	for userID, entry := range c.entries {
+		if !entry.read {
+			delete(c.entries, userID)
+			continue
+		}
+		users[userID] = c.floor
+	}
+	return users
+}
+
+func (c *dueCountCache) genLocked(userID int64) uint64 {
+	if gen, ok := c.gens[userID]; ok {
+		return gen
+	}
+	return c.floor
+}
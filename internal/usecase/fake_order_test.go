@@ -0,0 +1,258 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+
+	entrepo "github.com/eslsoft/vocnet/internal/adapter/repository"
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/infrastructure/database/ent/enttest"
+	"github.com/eslsoft/vocnet/internal/repository"
+)
+
+// fakeOrder mirrors how the ent repositories order list results so the fakes page like production:
+// keyword matches ranked exact, prefix, then substring (words only), the primary and secondary
+// order_by keys with the schema defaults filled in, and id ascending as the final tie-breaker.
+type fakeOrder[T any] struct {
+	defaultPrimary     string
+	defaultPrimaryDesc bool
+	fallback           string
+	fallbackDesc       bool
+	fields             map[string]func(a, b T) int
+	id                 func(T) int64
+	text               func(T) string // ranked against the keyword; nil when the repository does not rank
+}
+
+type fakeOrderTerm struct {
+	key  string
+	desc bool
+}
+
+func (o fakeOrder[T]) terms(orderBy string) ([]fakeOrderTerm, error) {
+	var terms []fakeOrderTerm
+	for _, segment := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(segment)
+		if len(parts) == 0 {
+			continue
+		}
+		if _, ok := o.fields[parts[0]]; !ok {
+			return nil, fmt.Errorf("field %q cannot be used for ordering", parts[0])
+		}
+		terms = append(terms, fakeOrderTerm{key: parts[0], desc: len(parts) > 1 && strings.EqualFold(parts[1], "desc")})
+	}
+	if len(terms) == 0 {
+		terms = append(terms, fakeOrderTerm{key: o.defaultPrimary, desc: o.defaultPrimaryDesc})
+	}
+	if len(terms) == 1 && terms[0].key != o.fallback {
+		terms = append(terms, fakeOrderTerm{key: o.fallback, desc: o.fallbackDesc})
+	}
+	return terms, nil
+}
+
+func (o fakeOrder[T]) sort(items []T, orderBy, keyword string) error {
+	terms, err := o.terms(orderBy)
+	if err != nil {
+		return err
+	}
+	keyword = strings.ToLower(keyword)
+	slices.SortStableFunc(items, func(a, b T) int {
+		if o.text != nil && keyword != "" {
+			if c := cmp.Compare(fakeKeywordRank(o.text(a), keyword), fakeKeywordRank(o.text(b), keyword)); c != 0 {
+				return c
+			}
+		}
+		for _, term := range terms {
+			c := o.fields[term.key](a, b)
+			if term.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(o.id(a), o.id(b))
+	})
+	return nil
+}
+
+func fakeKeywordRank(text, keyword string) int {
+	text = strings.ToLower(text)
+	switch {
+	case text == keyword:
+		return 0
+	case strings.HasPrefix(text, keyword):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// fakeWordOrder follows listWordsSchema and applyListOrdering.
+var fakeWordOrder = fakeOrder[*entity.Word]{
+	defaultPrimary: "text",
+	fallback:       "id",
+	fields: map[string]func(a, b *entity.Word) int{
+		"created_at": func(a, b *entity.Word) int { return a.CreatedAt.Compare(b.CreatedAt) },
+		"updated_at": func(a, b *entity.Word) int { return a.UpdatedAt.Compare(b.UpdatedAt) },
+		"text":       func(a, b *entity.Word) int { return strings.Compare(a.Text, b.Text) },
+		"frequency": func(a, b *entity.Word) int {
+			// Unranked words sort as the rarest, like byFrequency.
+			rank := func(w *entity.Word) int {
+				if w.Frequency > 0 {
+					return w.Frequency
+				}
+				return math.MaxInt
+			}
+			return cmp.Compare(rank(a), rank(b))
+		},
+		"cefr": func(a, b *entity.Word) int {
+			// Unleveled words sort as the hardest, like byCEFR.
+			level := func(w *entity.Word) string {
+				if w.CEFR != entity.CEFRUnknown {
+					return string(w.CEFR)
+				}
+				return "~"
+			}
+			return strings.Compare(level(a), level(b))
+		},
+		"id": func(a, b *entity.Word) int { return cmp.Compare(a.ID, b.ID) },
+	},
+	id:   func(w *entity.Word) int64 { return w.ID },
+	text: func(w *entity.Word) string { return w.Text },
+}
+
+// fakeLearnedLexemeOrder follows listLearnedLexemesSchema and applyLearnedLexemeOrdering.
+var fakeLearnedLexemeOrder = fakeOrder[*entity.LearnedLexeme]{
+	defaultPrimary:     "updated_at",
+	defaultPrimaryDesc: true,
+	fallback:           "id",
+	fields: map[string]func(a, b *entity.LearnedLexeme) int{
+		"created_at":      func(a, b *entity.LearnedLexeme) int { return a.CreatedAt.Compare(b.CreatedAt) },
+		"updated_at":      func(a, b *entity.LearnedLexeme) int { return a.UpdatedAt.Compare(b.UpdatedAt) },
+		"lexeme":          func(a, b *entity.LearnedLexeme) int { return strings.Compare(a.Term, b.Term) },
+		"mastery_overall": func(a, b *entity.LearnedLexeme) int { return cmp.Compare(a.Mastery.Overall, b.Mastery.Overall) },
+		"id":              func(a, b *entity.LearnedLexeme) int { return cmp.Compare(a.ID, b.ID) },
+	},
+	id: func(l *entity.LearnedLexeme) int64 { return l.ID },
+}
+
+// TestFakeReposOrderLikeEnt lists the same rows through the ent repositories and the fakes and
+// expects identical orders, so usecase tests cannot pass on an ordering production does not have.
+func TestFakeReposOrderLikeEnt(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:"+filepath.Join(t.TempDir(), "fake-order.db")+"?_fk=1")
+	t.Cleanup(func() { client.Close() })
+
+	base := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	for i, seed := range []struct {
+		text      string
+		frequency int
+		cefr      entity.CEFRLevel
+		age       int
+	}{
+		{"cat", 900, entity.CEFRA1, 2}, {"Catalog", 0, "", 1}, {"scatter", 4000, entity.CEFRB2, 1},
+		{"apple", 300, entity.CEFRA1, 0}, {"cab", 0, "", 2}, {"dog", 900, entity.CEFRA2, 0},
+	} {
+		at := base.Add(time.Duration(seed.age) * time.Hour)
+		err := client.Word.Create().SetText(seed.text).SetLanguage("en").SetFrequency(seed.frequency).SetCefr(string(seed.cefr)).
+			SetCreatedAt(at).SetUpdatedAt(at.Add(time.Duration(i%2) * time.Minute)).Exec(ctx)
+		if err != nil {
+			t.Fatalf("seed word %s: %v", seed.text, err)
+		}
+		err = client.LearnedLexeme.Create().SetUserID(1).SetTerm(seed.text).SetMasteryOverall(int32(seed.frequency % 7)).
+			SetCreatedAt(at).SetUpdatedAt(at.Add(time.Duration(i%2) * time.Minute)).Exec(ctx)
+		if err != nil {
+			t.Fatalf("seed lexeme %s: %v", seed.text, err)
+		}
+	}
+
+	realWords := entrepo.NewWordRepository(client)
+	seededWords, _, err := realWords.List(ctx, &repository.ListWordQuery{})
+	if err != nil || len(seededWords) != 6 {
+		t.Fatalf("list seeded words: %d words, %v", len(seededWords), err)
+	}
+	fakeWords := &mockVocRepo{words: map[string]*entity.Word{}}
+	for _, w := range seededWords {
+		fakeWords.words[w.Text] = w
+	}
+
+	for _, q := range []repository.FilterOrder{
+		{},
+		{OrderBy: "created_at desc"},
+		{OrderBy: "updated_at, text desc"},
+		{OrderBy: "frequency asc"},
+		{OrderBy: "frequency desc"},
+		{OrderBy: "cefr asc"},
+		{OrderBy: "cefr desc"},
+		{OrderBy: "id desc"},
+		{Filter: `keyword == "cat"`},
+		{Filter: `keyword == "cat"`, OrderBy: "created_at desc"},
+	} {
+		want, _, err := realWords.List(ctx, &repository.ListWordQuery{FilterOrder: q})
+		if err != nil {
+			t.Fatalf("ent words %+v: %v", q, err)
+		}
+		got, _, err := fakeWords.List(ctx, &repository.ListWordQuery{FilterOrder: q})
+		if err != nil {
+			t.Fatalf("fake words %+v: %v", q, err)
+		}
+		if fmt.Sprint(wordTexts(got)) != fmt.Sprint(wordTexts(want)) {
+			t.Fatalf("words %+v: fake order %v, ent order %v", q, wordTexts(got), wordTexts(want))
+		}
+	}
+
+	realLexemes := entrepo.NewLearnedLexemeRepository(client)
+	seededLexemes, _, err := realLexemes.List(ctx, &repository.ListLearnedLexemeQuery{UserID: 1})
+	if err != nil || len(seededLexemes) != 6 {
+		t.Fatalf("list seeded lexemes: %d lexemes, %v", len(seededLexemes), err)
+	}
+	fakeLexemes := newFakeLearnedLexemeRepo()
+	for i := range seededLexemes {
+		fakeLexemes.items[seededLexemes[i].ID] = &seededLexemes[i]
+	}
+
+	for _, q := range []repository.FilterOrder{
+		{},
+		{OrderBy: "created_at"},
+		{OrderBy: "mastery_overall desc, lexeme"},
+		{OrderBy: "lexeme desc"},
+		{OrderBy: "id desc"},
+	} {
+		want, _, err := realLexemes.List(ctx, &repository.ListLearnedLexemeQuery{FilterOrder: q, UserID: 1})
+		if err != nil {
+			t.Fatalf("ent lexemes %+v: %v", q, err)
+		}
+		got, _, err := fakeLexemes.List(ctx, &repository.ListLearnedLexemeQuery{FilterOrder: q, UserID: 1})
+		if err != nil {
+			t.Fatalf("fake lexemes %+v: %v", q, err)
+		}
+		if fmt.Sprint(lexemeTerms(got)) != fmt.Sprint(lexemeTerms(want)) {
+			t.Fatalf("lexemes %+v: fake order %v, ent order %v", q, lexemeTerms(got), lexemeTerms(want))
+		}
+	}
+}
+
+func wordTexts(words []*entity.Word) []string {
+	texts := make([]string, 0, len(words))
+	for _, w := range words {
+		texts = append(texts, w.Text)
+	}
+	return texts
+}
+
+func lexemeTerms(lexemes []entity.LearnedLexeme) []string {
+	terms := make([]string, 0, len(lexemes))
+	for _, l := range lexemes {
+		terms = append(terms, l.Term)
+	}
+	return terms
+}
@@ -0,0 +1,137 @@
+package inflection
+
+import (
+	"strings"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+// englishIrregular lists common English forms that suffix rules cannot undo.
+var englishIrregular = map[string]Relation{
+	"men":       {Lemma: "man", Type: entity.WordTypePlural},
+	"women":     {Lemma: "woman", Type: entity.WordTypePlural},
+	"children":  {Lemma: "child", Type: entity.WordTypePlural},
+	"people":    {Lemma: "person", Type: entity.WordTypePlural},
+	"mice":      {Lemma: "mouse", Type: entity.WordTypePlural},
+	"lice":      {Lemma: "louse", Type: entity.WordTypePlural},
+	"geese":     {Lemma: "goose", Type: entity.WordTypePlural},
+	"feet":      {Lemma: "foot", Type: entity.WordTypePlural},
+	"teeth":     {Lemma: "tooth", Type: entity.WordTypePlural},
+	"oxen":      {Lemma: "ox", Type: entity.WordTypePlural},
+	"dice":      {Lemma: "die", Type: entity.WordTypePlural},
+	"indices":   {Lemma: "index", Type: entity.WordTypePlural},
+	"criteria":  {Lemma: "criterion", Type: entity.WordTypePlural},
+	"phenomena": {Lemma: "phenomenon", Type: entity.WordTypePlural},
+	"analyses":  {Lemma: "analysis", Type: entity.WordTypePlural},
+	"crises":    {Lemma: "crisis", Type: entity.WordTypePlural},
+	"went":      {Lemma: "go", Type: entity.WordTypePast},
+	"gone":      {Lemma: "go", Type: entity.WordTypePP},
+	"was":       {Lemma: "be", Type: entity.WordTypePast},
+	"were":      {Lemma: "be", Type: entity.WordTypePast},
+	"been":      {Lemma: "be", Type: entity.WordTypePP},
+	"had":       {Lemma: "have", Type: entity.WordTypePast},
+	"did":       {Lemma: "do", Type: entity.WordTypePast},
+	"done":      {Lemma: "do", Type: entity.WordTypePP},
+	"better":    {Lemma: "good", Type: entity.WordTypeComparative},
+	"best":      {Lemma: "good", Type: entity.WordTypeSuperlative},
+	"worse":     {Lemma: "bad", Type: entity.WordTypeComparative},
+	"worst":     {Lemma: "bad", Type: entity.WordTypeSuperlative},
+}
+
+// EnglishCandidates guesses the lemmas an English word form may come from, most likely first:
+// the irregular form table, then suffix rules for plurals, third person singular, past tense and
+// participles ("cities" gives "city", "stopped" gives "stop"). Guesses are not checked against a
+// dictionary, so callers look each one up. The word itself is never returned.
+func EnglishCandidates(word string) []Relation {
+	w := strings.ToLower(strings.TrimSpace(word))
+	var out []Relation
+	seen := map[string]struct{}{w: {}}
+	add := func(lemma string, typ entity.WordType) {
+		if len(lemma) < 2 {
+			return
+		}
+		if _, ok := seen[lemma]; ok {
+			return
+		}
+		seen[lemma] = struct{}{}
+		out = append(out, Relation{Lemma: lemma, Type: typ})
+	}
+
+	if rel, ok := englishIrregular[w]; ok {
+		add(rel.Lemma, rel.Type)
+	}
+	switch {
+	case strings.HasSuffix(w, "ies"):
+		add(strings.TrimSuffix(w, "ies")+"y", entity.WordTypePlural)
+	case strings.HasSuffix(w, "ves"):
+		stem := strings.TrimSuffix(w, "ves")
+		add(stem+"f", entity.WordTypePlural)
+		add(stem+"fe", entity.WordTypePlural)
+		add(strings.TrimSuffix(w, "s"), entity.WordTypePlural)
+	case strings.HasSuffix(w, "es"):
+		stem := strings.TrimSuffix(w, "es")
+		if hasAnySuffix(stem, "s", "x", "z", "ch", "sh", "o") {
+			add(stem, entity.WordTypePlural)
+		}
+		add(strings.TrimSuffix(w, "s"), entity.WordTypePlural)
+	case strings.HasSuffix(w, "s") && !hasAnySuffix(w, "ss", "us", "is"):
+		add(strings.TrimSuffix(w, "s"), entity.WordTypePlural)
+	case strings.HasSuffix(w, "ied"):
+		add(strings.TrimSuffix(w, "ied")+"y", entity.WordTypePast)
+	case strings.HasSuffix(w, "ed"):
+		addVerbStems(add, strings.TrimSuffix(w, "ed"), entity.WordTypePast)
+	case strings.HasSuffix(w, "ing"):
+		addVerbStems(add, strings.TrimSuffix(w, "ing"), entity.WordTypeIng)
+	}
+	return out
+}
+
+// addVerbStems adds the lemmas a stem left by removing -ed or -ing may come from: an undoubled
+// consonant first ("stopp" gives "stop"), then the stem with a silent e restored ahead of the bare
+// stem when it ends consonant-vowel-consonant ("hop" gives "hope" before "hop").
+func addVerbStems(add func(string, entity.WordType), stem string, typ entity.WordType) {
+	if undoubled := undouble(stem); undoubled != stem {
+		add(undoubled, typ)
+	}
+	if endsCVC(stem) {
+		add(stem+"e", typ)
+		add(stem, typ)
+		return
+	}
+	add(stem, typ)
+	add(stem+"e", typ)
+}
+
+func isVowel(b byte) bool {
+	return strings.IndexByte("aeiou", b) >= 0
+}
+
+// endsCVC reports whether s ends consonant-vowel-consonant, where the last consonant is not w, x
+// or y, as in "hop" but not "stay" or "hoop".
+func endsCVC(s string) bool {
+	n := len(s)
+	if n < 3 {
+		return false
+	}
+	last := s[n-1]
+	return !isVowel(s[n-3]) && isVowel(s[n-2]) && !isVowel(last) && strings.IndexByte("wxy", last) < 0
+}
+
+func hasAnySuffix(s string, suffixes ...string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// undouble drops a doubled final consonant, as in "stopp" from "stopped"; other stems are
+// returned unchanged.
+func undouble(stem string) string {
+	n := len(stem)
+	if n < 3 || stem[n-1] != stem[n-2] || strings.ContainsRune("aeiouls", rune(stem[n-1])) {
+		return stem
+	}
+	return stem[:n-1]
+}
@@ -0,0 +1,42 @@
+package inflection
+
+import (
+	"testing"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+func TestEnglishCandidates(t *testing.T) {
+	cases := []struct {
+		word     string
+		wantType entity.WordType
+		want     string // expected first candidate; empty for none
+	}{
+		{word: "Mice", wantType: entity.WordTypePlural, want: "mouse"},
+		{word: "apples", wantType: entity.WordTypePlural, want: "apple"},
+		{word: "cities", wantType: entity.WordTypePlural, want: "city"},
+		{word: "boxes", wantType: entity.WordTypePlural, want: "box"},
+		{word: "wolves", wantType: entity.WordTypePlural, want: "wolf"},
+		{word: "stopped", wantType: entity.WordTypePast, want: "stop"},
+		{word: "hoped", wantType: entity.WordTypePast, want: "hope"},
+		{word: "wanted", wantType: entity.WordTypePast, want: "want"},
+		{word: "running", wantType: entity.WordTypeIng, want: "run"},
+		{word: "went", wantType: entity.WordTypePast, want: "go"},
+		{word: "glass", want: ""},
+		{word: "bus", want: ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.word, func(t *testing.T) {
+			got := EnglishCandidates(tc.word)
+			if tc.want == "" {
+				if len(got) != 0 {
+					t.Fatalf("want no candidates, got %+v", got)
+				}
+				return
+			}
+			if len(got) == 0 || got[0].Lemma != tc.want || got[0].Type != tc.wantType {
+				t.Fatalf("want first candidate %s (%s), got %+v", tc.want, tc.wantType, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Package inflection derives inflection→lemma relationships from ECDICT exchange data.
+package inflection
+
+import (
+	"strings"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+// Pair is a single exchange entry: the inflected surface form and its normalized word type.
+type Pair struct {
+	Code entity.WordType
+	Word string
+}
+
+// Entry is the minimal ECDICT row needed to resolve relationships.
+type Entry struct {
+	Word     string
+	Exchange string
+}
+
+// Relation points an inflected form at its lemma.
+type Relation struct {
+	Lemma string
+	Type  entity.WordType
+}
+
+// ParseExchange splits an ECDICT exchange string (e.g. "p:ran/d:run/i:running") into pairs,
+// dropping empty values and duplicates.
+func ParseExchange(s string) []Pair {
+	if s == "" {
+		return nil
+	}
+	parts := strings.Split(s, "/")
+	out := make([]Pair, 0, len(parts))
+	seen := make(map[string]struct{})
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		code := string(entity.WordTypeOther)
+		val := part
+		if left, right, ok := strings.Cut(part, ":"); ok {
+			code = left
+			val = right
+		}
+		val = strings.TrimSpace(val)
+		if val == "" {
+			continue
+		}
+		norm := NormalizeCode(code)
+		key := string(norm) + "|" + strings.ToLower(val)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, Pair{Code: norm, Word: val})
+	}
+	return out
+}
+
+// NormalizeCode maps ECDICT exchange codes to word_type strings.
+// Rule (finalized): Only these three get abbreviations: past participle -> pp, present participle -> ing, third person singular -> 3sg.
+// Others keep readable full words without underscores.
+// Mapping:
+//
+//	p -> past
+//	d -> pp            (past participle)
+//	i -> ing           (present participle / gerund)
+//	3 -> 3sg           (third person singular present)
+//	r -> comparative
+//	t -> superlative
+//	s -> plural
+//	0 -> lemma
+//	1 -> variant
+//
+// Unrecognized codes returned unchanged (may be treated as "other").
+func NormalizeCode(c string) entity.WordType {
+	switch c {
+	case "p":
+		return entity.WordTypePast
+	case "d":
+		return entity.WordTypePP
+	case "i":
+		return entity.WordTypeIng
+	case "3":
+		return entity.WordType3SG
+	case "r":
+		return entity.WordTypeComparative
+	case "t":
+		return entity.WordTypeSuperlative
+	case "s":
+		return entity.WordTypePlural
+	case "0":
+		return entity.WordTypeLemma
+	case "1":
+		return entity.WordTypeVariant
+	default:
+		return entity.WordType(c)
+	}
+}
+
+// BuildMap indexes every inflected form (lower-cased) to the lemma that lists it.
+// The first lemma wins when several entries claim the same form; unrecognized codes are stored as "other".
+func BuildMap(entries []Entry) map[string]Relation {
+	rels := make(map[string]Relation)
+	for _, e := range entries {
+		exchange := strings.TrimSpace(e.Exchange)
+		if exchange == "" {
+			continue
+		}
+		for _, p := range ParseExchange(exchange) {
+			// 忽略 code=lemma (0:root) 这种“指向原形”的反向信息，避免把真正的原形标成别人的变形
+			if p.Code == entity.WordTypeLemma {
+				continue
+			}
+			if !p.Code.Valid() {
+				p.Code = entity.WordTypeOther
+			}
+			lw := strings.ToLower(p.Word)
+			if lw == "" || lw == strings.ToLower(e.Word) {
+				continue
+			}
+			if _, exists := rels[lw]; !exists {
+				rels[lw] = Relation{Lemma: e.Word, Type: p.Code}
+			}
+		}
+	}
+	return rels
+}
+
+// Resolve returns the word_type and lemma a word should carry according to rels.
+// Words without a relation (or pointing at themselves) resolve to a lemma with a nil lemma pointer.
+func Resolve(word string, rels map[string]Relation) (entity.WordType, *string) {
+	rel, ok := rels[strings.ToLower(word)]
+	if !ok || strings.EqualFold(rel.Lemma, word) {
+		return entity.WordTypeLemma, nil
+	}
+	lemma := rel.Lemma
+	return rel.Type, &lemma
+}
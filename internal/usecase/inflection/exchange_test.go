@@ -0,0 +1,64 @@
+package inflection
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+)
+
+func TestParseExchange(t *testing.T) {
+	got := ParseExchange("p:ran/d:run/i:running/3:runs/0:run/p:ran/x:odd/ /s:")
+	want := []Pair{
+		{Code: "past", Word: "ran"},
+		{Code: "pp", Word: "run"},
+		{Code: "ing", Word: "running"},
+		{Code: "3sg", Word: "runs"},
+		{Code: entity.WordTypeLemma, Word: "run"},
+		{Code: "x", Word: "odd"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseExchange mismatch:\nwant %#v\ngot  %#v", want, got)
+	}
+}
+
+func TestBuildMapAndResolve(t *testing.T) {
+	rels := BuildMap([]Entry{
+		{Word: "run", Exchange: "p:ran/d:run/i:running/3:runs"},
+		{Word: "ran", Exchange: "0:run"},
+		{Word: "apple", Exchange: "s:apples"},
+		{Word: "apples", Exchange: ""},
+		{Word: "sprint", Exchange: "i:running"},
+		{Word: "odd", Exchange: "x:odds"},
+	})
+
+	cases := []struct {
+		word     string
+		wantType entity.WordType
+		wantLem  string
+	}{
+		{word: "ran", wantType: "past", wantLem: "run"},
+		{word: "Running", wantType: "ing", wantLem: "run"}, // first lemma wins, lookup is case-insensitive
+		{word: "apples", wantType: "plural", wantLem: "apple"},
+		{word: "run", wantType: entity.WordTypeLemma},                  // self reference is ignored
+		{word: "apple", wantType: entity.WordTypeLemma},                // unknown to the map
+		{word: "odds", wantType: entity.WordTypeOther, wantLem: "odd"}, // unrecognized exchange code
+	}
+	for _, tc := range cases {
+		t.Run(tc.word, func(t *testing.T) {
+			gotType, gotLemma := Resolve(tc.word, rels)
+			if gotType != tc.wantType {
+				t.Fatalf("word_type: want %q got %q", tc.wantType, gotType)
+			}
+			if tc.wantLem == "" {
+				if gotLemma != nil {
+					t.Fatalf("expected nil lemma, got %q", *gotLemma)
+				}
+				return
+			}
+			if gotLemma == nil || *gotLemma != tc.wantLem {
+				t.Fatalf("lemma: want %q got %v", tc.wantLem, gotLemma)
+			}
+		})
+	}
+}
@@ -2,32 +2,172 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/eslsoft/vocnet/internal/entity"
 	"github.com/eslsoft/vocnet/internal/repository"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
+	"github.com/samber/lo"
 )
 
 // LearnedLexemeUsecase encapsulates business logic for managing user vocabulary entries.
 type LearnedLexemeUsecase interface {
 	CollectLexeme(ctx context.Context, userID int64, lexeme *entity.LearnedLexeme) (*entity.LearnedLexeme, error)
+	// GetByTerm returns the user's lexeme whose normalized term matches term in language.
+	GetByTerm(ctx context.Context, userID int64, term string, language entity.Language) (*entity.LearnedLexeme, error)
 	UpdateMastery(ctx context.Context, userID, id int64, mastery entity.MasteryBreakdown, review entity.ReviewTiming, notes string) (*entity.LearnedLexeme, error)
+	// UpdateMasteryBatch applies offline review results in one transaction. Results align with
+	// updates; items that cannot apply carry an error without failing the rest of the batch. When
+	// atomic is set, one failed item aborts the whole batch: nothing is written and the items that
+	// would have applied report entity.ErrBatchAborted. An applied review moves a scheduled next
+	// review to the stored interval after it.
+	UpdateMasteryBatch(ctx context.Context, userID int64, updates []MasteryUpdate, atomic bool) ([]MasteryUpdateResult, error)
+	// RenameTerm changes a lexeme's term, re-linking its dictionary word and keeping its mastery,
+	// review schedule and history. When another lexeme of the user already has newTerm it returns
+	// entity.ErrDuplicateLearnedLexeme, or with merge folds the renamed lexeme into that one and
+	// returns the survivor.
+	RenameTerm(ctx context.Context, userID, id int64, newTerm string, merge bool) (*entity.LearnedLexeme, error)
 	ListLearnedLexemes(ctx context.Context, filter *repository.ListLearnedLexemeQuery) ([]entity.LearnedLexeme, int64, error)
+	// CountLearnedLexemes returns the total ListLearnedLexemes would report for query without
+	// fetching any rows.
+	CountLearnedLexemes(ctx context.Context, query *repository.ListLearnedLexemeQuery) (int64, error)
+	// ListGroupedByLemma buckets the user's lexemes in language under the lemma of their dictionary
+	// word. It requires WithLearnedLexemeDictionary.
+	ListGroupedByLemma(ctx context.Context, userID int64, language entity.Language) ([]LemmaGroup, error)
 	DeleteLearnedLexeme(ctx context.Context, userID, id int64) error
+	DeleteByFilter(ctx context.Context, userID int64, query *repository.ListLearnedLexemeQuery) (deleted int64, err error)
+	MergeDuplicates(ctx context.Context, userID int64) (mergedPairs int, err error)
+	// GetDueCount counts the user's lexemes due for review now. With WithDueCountCache it serves a
+	// cached count while fresh and falls back to a live count otherwise.
+	GetDueCount(ctx context.Context, userID int64) (int64, error)
+	// RefreshDueCounts recomputes the cached counts of users polled since their last computation
+	// and forgets the others, so polling users keep hitting the cache. A no-op without the cache.
+	RefreshDueCounts(ctx context.Context) error
+	// ReattachDictionaryWords links every lexeme without a live dictionary word to the entry
+	// matching its normalized term and language, clearing links that no longer resolve.
+	ReattachDictionaryWords(ctx context.Context) (repository.ReattachStats, error)
+}
+
+// MasteryUpdate is one review result recorded by a client, possibly while offline.
+type MasteryUpdate struct {
+	ID      int64
+	Mastery entity.MasteryBreakdown
+	Notes   string
+	// ReviewedAt is when the review happened on the client. Zero or future values mean now.
+	ReviewedAt time.Time
+}
+
+// MasteryUpdateResult reports the outcome of one MasteryUpdate; Value holds the saved lexeme.
+type MasteryUpdateResult = entity.BatchResult[*entity.LearnedLexeme]
+
+// LemmaGroup is a lemma with the user's lexemes that are forms of it, the lemma itself included.
+// A lexeme without a dictionary entry forms a group of its own, named after its term.
+type LemmaGroup struct {
+	Lemma   string
+	Members []entity.LearnedLexeme
+}
+
+const (
+	// _maxMasteryBatch bounds how many updates UpdateMasteryBatch accepts at once.
+	_maxMasteryBatch = 500
+	// _reattachBatchSize is how many lexemes ReattachDictionaryWords rewrites per transaction.
+	_reattachBatchSize = 500
+	// _maxCreatedByLength bounds a client-supplied created_by, in characters.
+	_maxCreatedByLength = 128
+	// DefaultAnonymousCreatedBy is recorded as created_by for anonymous collects without one.
+	DefaultAnonymousCreatedBy = "user"
+)
+
+// LearnedLexemeUsecaseOption customizes the learned lexeme usecase.
+type LearnedLexemeUsecaseOption func(*learnedLexemeUsecase)
+
+// WithLearnedLexemeMaxOffset rejects ListLearnedLexemes pages starting more than maxOffset rows in
+// with entity.ErrOffsetTooLarge; 0 leaves offsets unbounded.
+func WithLearnedLexemeMaxOffset(maxOffset int64) LearnedLexemeUsecaseOption {
+	return func(u *learnedLexemeUsecase) {
+		u.maxOffset = maxOffset
+	}
+}
+
+// WithLearnedLexemeFilterLimits bounds the number of predicates and the nesting depth of list,
+// count and delete filters; a zero limit uses the filterexpr default and a negative one disables it.
+func WithLearnedLexemeFilterLimits(maxPredicates, maxDepth int) LearnedLexemeUsecaseOption {
+	return func(u *learnedLexemeUsecase) {
+		u.filterLimits = filterexpr.FilterLimits{MaxPredicates: maxPredicates, MaxDepth: maxDepth}
+	}
+}
+
+// WithLearnedLexemeStrictLanguage makes CollectLexeme reject lexemes without a language with
+// entity.ErrLanguageRequired instead of defaulting them to English.
+func WithLearnedLexemeStrictLanguage() LearnedLexemeUsecaseOption {
+	return func(u *learnedLexemeUsecase) {
+		u.strictLanguage = true
+	}
+}
+
+// WithLearnedLexemeMaxTermLength makes CollectLexeme reject terms longer than maxLength
+// characters with entity.ErrInvalidLearnedLexemeText; 0 disables the limit. Defaults to
+// entity.DefaultMaxTextLength.
+func WithLearnedLexemeMaxTermLength(maxLength int) LearnedLexemeUsecaseOption {
+	return func(u *learnedLexemeUsecase) {
+		u.maxTermLength = maxLength
+	}
+}
+
+// WithLearnedLexemeDictionary lets ListGroupedByLemma resolve lexemes to dictionary lemmas.
+func WithLearnedLexemeDictionary(words repository.WordRepository) LearnedLexemeUsecaseOption {
+	return func(u *learnedLexemeUsecase) {
+		u.words = words
+	}
+}
+
+// WithLearnedLexemeAnonymousCreatedBy sets the created_by recorded when a collect carries neither
+// an authenticated identity nor a client-supplied value. Defaults to DefaultAnonymousCreatedBy.
+func WithLearnedLexemeAnonymousCreatedBy(name string) LearnedLexemeUsecaseOption {
+	return func(u *learnedLexemeUsecase) {
+		u.anonymousCreatedBy = name
+	}
+}
+
+// WithDueCountCache caches GetDueCount results per user for interval; collects, reviews and
+// deletes invalidate the user's entry. Run RefreshDueCounts every interval to keep polled counts
+// warm. An interval of 0 or less disables the cache.
+func WithDueCountCache(interval time.Duration) LearnedLexemeUsecaseOption {
+	return func(u *learnedLexemeUsecase) {
+		if interval > 0 {
+			u.dueCounts = newDueCountCache(interval)
+		}
+	}
 }
 
 // NewLearnedLexemeUsecase wires the repository with default behaviour.
-func NewLearnedLexemeUsecase(repo repository.LearnedLexemeRepository) LearnedLexemeUsecase {
-	return &learnedLexemeUsecase{
-		repo:  repo,
-		clock: time.Now,
+func NewLearnedLexemeUsecase(repo repository.LearnedLexemeRepository, opts ...LearnedLexemeUsecaseOption) LearnedLexemeUsecase {
+	u := &learnedLexemeUsecase{
+		repo:               repo,
+		clock:              time.Now,
+		maxTermLength:      entity.DefaultMaxTextLength,
+		anonymousCreatedBy: DefaultAnonymousCreatedBy,
 	}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
 }
 
 type learnedLexemeUsecase struct {
-	repo  repository.LearnedLexemeRepository
-	clock func() time.Time
+	repo               repository.LearnedLexemeRepository
+	clock              func() time.Time
+	maxOffset          int64
+	filterLimits       filterexpr.FilterLimits
+	strictLanguage     bool
+	maxTermLength      int
+	words              repository.WordRepository
+	anonymousCreatedBy string
+	dueCounts          *dueCountCache
 }
 
 func (u *learnedLexemeUsecase) CollectLexeme(ctx context.Context, userID int64, lexeme *entity.LearnedLexeme) (*entity.LearnedLexeme, error) {
@@ -38,22 +178,53 @@ func (u *learnedLexemeUsecase) CollectLexeme(ctx context.Context, userID int64,
 	if text == "" {
 		return nil, entity.ErrInvalidLearnedLexemeText
 	}
+	if err := entity.CheckText(text, u.maxTermLength); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidLearnedLexemeText, err)
+	}
+	if u.strictLanguage && lexeme.Language.Code() == "" {
+		return nil, entity.ErrLanguageRequired
+	}
+	if err := entity.CheckSentenceSources(lexeme.Sentences); err != nil {
+		return nil, err
+	}
+	if err := entity.CheckLearnedLexemeRelations(lexeme.Relations); err != nil {
+		return nil, err
+	}
+	if err := lexeme.Review.Validate(); err != nil {
+		return nil, err
+	}
+	tags := entity.NormalizeTags(lexeme.Tags)
+	if err := entity.CheckTags(tags); err != nil {
+		return nil, err
+	}
+	createdBy, err := u.resolveCreatedBy(ctx, lexeme.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	defer u.dueCounts.invalidate(userID)
 
-	existing, err := u.repo.FindByTerm(ctx, userID, text)
+	existing, err := u.findCollected(ctx, userID, lexeme.Language, text)
 	if err != nil {
 		return nil, err
 	}
 
 	now := u.clock()
 	if existing != nil {
+		// Bump the counter atomically; Update below never writes query_count.
+		if _, err := u.repo.IncrementQueryCount(ctx, userID, existing.ID); err != nil {
+			return nil, err
+		}
 		// Update lightweight fields on duplicate collects.
-		existing.QueryCount++
 		if lexeme.Language.Code() != "" {
 			existing.Language = entity.NormalizeLanguage(lexeme.Language)
 		}
 		if lexeme.Notes != "" {
 			existing.Notes = lexeme.Notes
 		}
+		// nil tags keep the stored ones; an explicit (possibly empty) list replaces them.
+		if tags != nil {
+			existing.Tags = tags
+		}
 		existing.Mastery = lexeme.Mastery
 		existing.Review = lexeme.Review
 		existing.Normalize(now)
@@ -62,12 +233,19 @@ func (u *learnedLexemeUsecase) CollectLexeme(ctx context.Context, userID int64,
 
 	copy := *lexeme
 	copy.Term = text
+	copy.Tags = tags
 	copy.UserID = userID
 	if copy.QueryCount == 0 {
 		copy.QueryCount = 1
 	}
-	if copy.CreatedBy == "" {
-		copy.CreatedBy = "user"
+	copy.CreatedBy = createdBy
+	if len(copy.Relations) > 0 {
+		copy.Relations = append([]entity.LearnedLexemeRelation(nil), copy.Relations...)
+		for i := range copy.Relations {
+			if copy.Relations[i].CreatedBy == "" {
+				copy.Relations[i].CreatedBy = createdBy
+			}
+		}
 	}
 	copy.Normalize(now)
 
@@ -78,17 +256,63 @@ func (u *learnedLexemeUsecase) CollectLexeme(ctx context.Context, userID int64,
 	return created, nil
 }
 
+// findCollected returns the lexeme a collect of term updates: a case variant in language ("Apple"
+// after "apple"), else the exact term in any language, which the collect moves to language.
+func (u *learnedLexemeUsecase) findCollected(ctx context.Context, userID int64, language entity.Language, term string) (*entity.LearnedLexeme, error) {
+	existing, err := u.repo.FindByNormalizedTerm(ctx, userID, language, term)
+	if err != nil || existing != nil {
+		return existing, err
+	}
+	return u.repo.FindByTerm(ctx, userID, term)
+}
+
+func (u *learnedLexemeUsecase) GetByTerm(ctx context.Context, userID int64, term string, language entity.Language) (*entity.LearnedLexeme, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, entity.ErrInvalidLearnedLexemeText
+	}
+	lexeme, err := u.repo.FindByNormalizedTerm(ctx, userID, language, term)
+	if err != nil {
+		return nil, err
+	}
+	if lexeme == nil {
+		return nil, fmt.Errorf("%w: %q", entity.ErrLearnedLexemeNotFound, term)
+	}
+	return lexeme, nil
+}
+
+// resolveCreatedBy credits a collect to the authenticated identity when the request carries one,
+// otherwise to the trimmed client-supplied value, and only for anonymous callers without one to
+// the configured default.
+func (u *learnedLexemeUsecase) resolveCreatedBy(ctx context.Context, supplied string) (string, error) {
+	if identity := entity.EditorFromContext(ctx); identity != "" {
+		return identity, nil
+	}
+	supplied = strings.TrimSpace(supplied)
+	if supplied == "" {
+		return u.anonymousCreatedBy, nil
+	}
+	if err := entity.CheckText(supplied, _maxCreatedByLength); err != nil {
+		return "", fmt.Errorf("%w: %v", entity.ErrInvalidCreatedBy, err)
+	}
+	return supplied, nil
+}
+
 func (u *learnedLexemeUsecase) UpdateMastery(ctx context.Context, userID, id int64, mastery entity.MasteryBreakdown, review entity.ReviewTiming, notes string) (*entity.LearnedLexeme, error) {
 	if id <= 0 {
 		return nil, entity.ErrLearnedLexemeNotFound
 	}
+	if err := review.Validate(); err != nil {
+		return nil, err
+	}
+	defer u.dueCounts.invalidate(userID)
 
 	existing, err := u.repo.GetByID(ctx, userID, id)
 	if err != nil {
 		return nil, err
 	}
 
-	existing.Mastery = mastery
+	existing.Mastery = withOverall(mastery)
 	existing.Review = review
 	if notes != "" {
 		existing.Notes = notes
@@ -98,13 +322,435 @@ func (u *learnedLexemeUsecase) UpdateMastery(ctx context.Context, userID, id int
 	return u.repo.Update(ctx, existing)
 }
 
+// withOverall derives Overall from the per-skill scores when any is set, matching what
+// recompute-mastery stores, so a client cannot persist an Overall that disagrees with them.
+func withOverall(mastery entity.MasteryBreakdown) entity.MasteryBreakdown {
+	if mastery.HasComponents() {
+		mastery.Recompute()
+	}
+	return mastery
+}
+
+func (u *learnedLexemeUsecase) RenameTerm(ctx context.Context, userID, id int64, newTerm string, merge bool) (*entity.LearnedLexeme, error) {
+	if id <= 0 {
+		return nil, entity.ErrLearnedLexemeNotFound
+	}
+	text := strings.TrimSpace(newTerm)
+	if text == "" {
+		return nil, entity.ErrInvalidLearnedLexemeText
+	}
+	if err := entity.CheckText(text, u.maxTermLength); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidLearnedLexemeText, err)
+	}
+
+	lexeme, err := u.repo.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if lexeme.Term == text {
+		return lexeme, nil
+	}
+	// A case variant of the lexeme itself ("apple" to "Apple") is a plain rename.
+	existing, err := u.repo.FindByNormalizedTerm(ctx, userID, lexeme.Language, text)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil || existing.ID == lexeme.ID {
+		lexeme.Term = text
+		return u.repo.Update(ctx, lexeme)
+	}
+	if !merge {
+		return nil, fmt.Errorf("%w: %q", entity.ErrDuplicateLearnedLexeme, existing.Term)
+	}
+
+	keep := *existing
+	if err := foldLearnedLexeme(&keep, *lexeme); err != nil {
+		return nil, err
+	}
+	keep.UpdatedAt = u.clock()
+	defer u.dueCounts.invalidate(userID)
+	if err := u.repo.MergeDuplicates(ctx, userID, []repository.LearnedLexemeMerge{{Keep: &keep, RemoveIDs: []int64{lexeme.ID}}}); err != nil {
+		return nil, err
+	}
+	return u.repo.GetByID(ctx, userID, keep.ID)
+}
+
+func (u *learnedLexemeUsecase) UpdateMasteryBatch(ctx context.Context, userID int64, updates []MasteryUpdate, atomic bool) ([]MasteryUpdateResult, error) {
+	if len(updates) > _maxMasteryBatch {
+		return nil, fmt.Errorf("%w: at most %d mastery updates per batch, got %d", entity.ErrBatchTooLarge, _maxMasteryBatch, len(updates))
+	}
+	defer u.dueCounts.invalidate(userID)
+
+	now := u.clock()
+	reviewedAt := func(upd MasteryUpdate) time.Time {
+		if upd.ReviewedAt.IsZero() || upd.ReviewedAt.After(now) {
+			return now
+		}
+		return upd.ReviewedAt
+	}
+	// Apply in review order so the latest review wins when a lexeme is updated more than once.
+	order := make([]int, len(updates))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return reviewedAt(updates[order[a]]).Before(reviewedAt(updates[order[b]]))
+	})
+
+	var ids []int64
+	for _, upd := range updates {
+		if upd.ID > 0 {
+			ids = append(ids, upd.ID)
+		}
+	}
+
+	var results []MasteryUpdateResult
+	var applied map[int64][]int
+	// The lexemes are read and written in one transaction so a concurrent batch cannot slip
+	// between the stale review check and the write.
+	apply := func(loaded map[int64]*entity.LearnedLexeme) ([]*entity.LearnedLexeme, error) {
+		results = make([]MasteryUpdateResult, len(updates))
+		for i := range results {
+			results[i].Index = i
+		}
+		applied = make(map[int64][]int)
+		var touched []*entity.LearnedLexeme
+		for _, i := range order {
+			upd := updates[i]
+			lexeme, ok := loaded[upd.ID]
+			if !ok {
+				results[i].Err = entity.ErrLearnedLexemeNotFound
+				continue
+			}
+
+			at := reviewedAt(upd)
+			if at.Before(lexeme.Review.LastReviewAt) {
+				results[i].Err = fmt.Errorf("%w: reviewed at %s, stored review at %s",
+					entity.ErrStaleReview, at.Format(time.RFC3339), lexeme.Review.LastReviewAt.Format(time.RFC3339))
+				continue
+			}
+			review := lexeme.Review
+			review.Reschedule(at)
+			if err := review.Validate(); err != nil {
+				results[i].Err = err
+				continue
+			}
+			lexeme.Mastery = withOverall(upd.Mastery)
+			lexeme.Review = review
+			if upd.Notes != "" {
+				lexeme.Notes = upd.Notes
+			}
+			if len(applied[upd.ID]) == 0 {
+				touched = append(touched, lexeme)
+			}
+			applied[upd.ID] = append(applied[upd.ID], i)
+		}
+
+		if atomic && entity.BatchFailed(results) {
+			for _, indexes := range applied {
+				for _, i := range indexes {
+					results[i].Err = entity.ErrBatchAborted
+				}
+			}
+			return nil, nil
+		}
+		for _, lexeme := range touched {
+			lexeme.Normalize(now)
+		}
+		return touched, nil
+	}
+
+	saved, err := u.repo.UpdateMany(ctx, userID, ids, apply)
+	if err != nil {
+		return nil, err
+	}
+	for _, lexeme := range saved {
+		for _, i := range applied[lexeme.ID] {
+			results[i].Value = lexeme
+		}
+	}
+	return results, nil
+}
+
 func (u *learnedLexemeUsecase) ListLearnedLexemes(ctx context.Context, query *repository.ListLearnedLexemeQuery) ([]entity.LearnedLexeme, int64, error) {
+	if query != nil {
+		if err := checkOffset(ctx, "learned lexemes", query.Pagination, u.maxOffset); err != nil {
+			return nil, 0, err
+		}
+		query.Limits = u.filterLimits
+	}
 	return u.repo.List(ctx, query)
 }
 
+func (u *learnedLexemeUsecase) CountLearnedLexemes(ctx context.Context, query *repository.ListLearnedLexemeQuery) (int64, error) {
+	if query != nil {
+		query.Limits = u.filterLimits
+	}
+	return u.repo.Count(ctx, query)
+}
+
 func (u *learnedLexemeUsecase) DeleteLearnedLexeme(ctx context.Context, userID, id int64) error {
 	if id <= 0 {
 		return entity.ErrLearnedLexemeNotFound
 	}
+	defer u.dueCounts.invalidate(userID)
 	return u.repo.Delete(ctx, userID, id)
 }
+
+// DeleteByFilter removes all of the user's lexemes matching query.Filter in one statement.
+// An empty filter is rejected unless query.All is set.
+func (u *learnedLexemeUsecase) DeleteByFilter(ctx context.Context, userID int64, query *repository.ListLearnedLexemeQuery) (int64, error) {
+	if query == nil {
+		query = &repository.ListLearnedLexemeQuery{}
+	}
+	scoped := *query
+	scoped.UserID = userID
+	scoped.Filter = strings.TrimSpace(scoped.Filter)
+	scoped.Limits = u.filterLimits
+	if scoped.Filter == "" && !scoped.All {
+		return 0, entity.ErrUnscopedDelete
+	}
+	defer u.dueCounts.invalidate(userID)
+	return u.repo.DeleteByFilter(ctx, &scoped)
+}
+
+// ListGroupedByLemma orders groups by lemma and members by term. A lexeme linked to a dictionary
+// word resolves through that word, any other through a lookup of its term. The words are loaded
+// in two batched queries, one by id and one by text.
+func (u *learnedLexemeUsecase) ListGroupedByLemma(ctx context.Context, userID int64, language entity.Language) ([]LemmaGroup, error) {
+	if u.words == nil {
+		return nil, errors.New("learned lexeme usecase: dictionary repository not configured")
+	}
+	language = entity.NormalizeLanguage(language)
+	items, _, err := u.repo.List(ctx, &repository.ListLearnedLexemeQuery{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	var members []entity.LearnedLexeme
+	var ids []int64
+	for _, item := range items {
+		if entity.NormalizeLanguage(item.Language) != language {
+			continue
+		}
+		members = append(members, item)
+		if item.WordID != nil {
+			ids = append(ids, *item.WordID)
+		}
+	}
+	linked, err := u.words.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*entity.Word, len(linked))
+	for _, w := range linked {
+		byID[w.ID] = w
+	}
+	var texts []string
+	for _, item := range members {
+		if item.WordID == nil || byID[*item.WordID] == nil {
+			texts = append(texts, item.Term)
+		}
+	}
+	looked, err := u.words.LookupMany(ctx, lo.Uniq(texts), language)
+	if err != nil {
+		return nil, err
+	}
+	byText := make(map[string]*entity.Word, len(looked))
+	for _, w := range looked {
+		byText[w.Text] = w
+	}
+
+	var groups []LemmaGroup
+	byLemma := make(map[string]int)
+	for _, item := range members {
+		var word *entity.Word
+		if item.WordID != nil {
+			word = byID[*item.WordID]
+		}
+		if word == nil {
+			word = byText[item.Term]
+		}
+		lemma := lemmaOf(word)
+		if lemma == "" {
+			groups = append(groups, LemmaGroup{Lemma: item.Term, Members: []entity.LearnedLexeme{item}})
+			continue
+		}
+		i, ok := byLemma[lemma]
+		if !ok {
+			i = len(groups)
+			byLemma[lemma] = i
+			groups = append(groups, LemmaGroup{Lemma: lemma})
+		}
+		groups[i].Members = append(groups[i].Members, item)
+	}
+
+	for _, group := range groups {
+		sort.SliceStable(group.Members, func(a, b int) bool { return group.Members[a].Term < group.Members[b].Term })
+	}
+	sort.SliceStable(groups, func(a, b int) bool { return groups[a].Lemma < groups[b].Lemma })
+	return groups, nil
+}
+
+// lemmaOf returns the dictionary lemma of word, or "" when there is no word.
+func lemmaOf(word *entity.Word) string {
+	if word == nil {
+		return ""
+	}
+	if word.Lemma != nil && *word.Lemma != "" {
+		return *word.Lemma
+	}
+	return word.Text
+}
+
+// MergeDuplicates folds lexemes of a user that only differ by case/whitespace (same normalized term
+// and language) into the earliest created row. It returns the number of rows merged away.
+func (u *learnedLexemeUsecase) MergeDuplicates(ctx context.Context, userID int64) (int, error) {
+	items, _, err := u.repo.List(ctx, &repository.ListLearnedLexemeQuery{UserID: userID})
+	if err != nil {
+		return 0, err
+	}
+
+	type groupKey struct {
+		language entity.Language
+		term     string
+	}
+	groups := make(map[groupKey][]entity.LearnedLexeme)
+	var order []groupKey
+	for _, item := range items {
+		key := groupKey{language: entity.NormalizeLanguage(item.Language), term: entity.NormalizeWordToken(item.Term)}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], item)
+	}
+
+	now := u.clock()
+	merged := 0
+	var merges []repository.LearnedLexemeMerge
+	for _, key := range order {
+		group := groups[key]
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			if group[i].CreatedAt.Equal(group[j].CreatedAt) {
+				return group[i].ID < group[j].ID
+			}
+			return group[i].CreatedAt.Before(group[j].CreatedAt)
+		})
+
+		keep := group[0]
+		removeIDs := make([]int64, 0, len(group)-1)
+		for _, other := range group[1:] {
+			if err := foldLearnedLexeme(&keep, other); err != nil {
+				return 0, err
+			}
+			removeIDs = append(removeIDs, other.ID)
+		}
+		keep.UpdatedAt = now
+		merges = append(merges, repository.LearnedLexemeMerge{Keep: &keep, RemoveIDs: removeIDs})
+		merged += len(removeIDs)
+	}
+
+	defer u.dueCounts.invalidate(userID)
+	if err := u.repo.MergeDuplicates(ctx, userID, merges); err != nil {
+		return 0, err
+	}
+	return merged, nil
+}
+
+func (u *learnedLexemeUsecase) GetDueCount(ctx context.Context, userID int64) (int64, error) {
+	now := u.clock()
+	count, ok, gen := u.dueCounts.get(userID, now)
+	if ok {
+		return count, nil
+	}
+	count, err := u.repo.CountDue(ctx, userID, now)
+	if err != nil {
+		return 0, err
+	}
+	u.dueCounts.put(userID, count, now, gen)
+	return count, nil
+}
+
+func (u *learnedLexemeUsecase) RefreshDueCounts(ctx context.Context) error {
+	for userID, gen := range u.dueCounts.prune() {
+		now := u.clock()
+		count, err := u.repo.CountDue(ctx, userID, now)
+		if err != nil {
+			return fmt.Errorf("refresh due count of user %d: %w", userID, err)
+		}
+		u.dueCounts.put(userID, count, now, gen)
+	}
+	return nil
+}
+
+func (u *learnedLexemeUsecase) ReattachDictionaryWords(ctx context.Context) (repository.ReattachStats, error) {
+	var total repository.ReattachStats
+	var afterID int64
+	for {
+		stats, lastID, err := u.repo.ReattachDictionaryWords(ctx, afterID, _reattachBatchSize)
+		if err != nil {
+			return total, err
+		}
+		if stats.Scanned == 0 {
+			return total, nil
+		}
+		total.Scanned += stats.Scanned
+		total.Linked += stats.Linked
+		total.Cleared += stats.Cleared
+		afterID = lastID
+	}
+}
+
+// foldLearnedLexeme merges other into keep: max mastery per skill, summed query counts,
+// unioned tags/sentences/relations and the most recent review state. It fails with
+// entity.ErrInvalidTags when the unioned tags exceed the tag limits.
+func foldLearnedLexeme(keep *entity.LearnedLexeme, other entity.LearnedLexeme) error {
+	keep.Mastery = entity.MasteryBreakdown{
+		Listen:    max(keep.Mastery.Listen, other.Mastery.Listen),
+		Read:      max(keep.Mastery.Read, other.Mastery.Read),
+		Spell:     max(keep.Mastery.Spell, other.Mastery.Spell),
+		Pronounce: max(keep.Mastery.Pronounce, other.Mastery.Pronounce),
+		Overall:   max(keep.Mastery.Overall, other.Mastery.Overall),
+	}
+	keep.QueryCount += other.QueryCount
+	if other.CreatedAt.Before(keep.CreatedAt) {
+		keep.CreatedAt = other.CreatedAt
+	}
+	if other.Review.LastReviewAt.After(keep.Review.LastReviewAt) {
+		keep.Review = other.Review
+	}
+	if keep.Notes == "" {
+		keep.Notes = other.Notes
+	}
+	if keep.WordID == nil {
+		keep.WordID = other.WordID
+	}
+
+	tags := entity.NormalizeTags(append(append([]string{}, keep.Tags...), other.Tags...))
+	if err := entity.CheckTags(tags); err != nil {
+		return fmt.Errorf("merge %q into %q: %w", other.Term, keep.Term, err)
+	}
+	keep.Tags = tags
+
+	keep.Sentences, _ = entity.MergeSentences(keep.Sentences, other.Sentences)
+
+	type relationKey struct {
+		word string
+		kind entity.RelationType
+	}
+	seenRelations := make(map[relationKey]struct{}, len(keep.Relations)+len(other.Relations))
+	relations := make([]entity.LearnedLexemeRelation, 0, len(keep.Relations)+len(other.Relations))
+	for _, rel := range append(append([]entity.LearnedLexemeRelation{}, keep.Relations...), other.Relations...) {
+		key := relationKey{word: strings.ToLower(strings.TrimSpace(rel.Word)), kind: rel.RelationType}
+		if _, ok := seenRelations[key]; ok {
+			continue
+		}
+		seenRelations[key] = struct{}{}
+		relations = append(relations, rel)
+	}
+	keep.Relations = relations
+	return nil
+}
@@ -3,7 +3,8 @@ package usecase
 import (
 	"context"
 	"errors"
-	"sort"
+	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -14,9 +15,11 @@ import (
 )
 
 type fakeLearnedLexemeRepo struct {
-	mu    sync.RWMutex
-	seq   int64
-	items map[int64]*entity.LearnedLexeme
+	mu            sync.RWMutex
+	seq           int64
+	items         map[int64]*entity.LearnedLexeme
+	deleteQueries []repository.ListLearnedLexemeQuery
+	dueCounts     int
 }
 
 func newFakeLearnedLexemeRepo() *fakeLearnedLexemeRepo {
@@ -45,6 +48,10 @@ func (r *fakeLearnedLexemeRepo) Update(ctx context.Context, uw *entity.LearnedLe
 	}
 	r.mu.Lock()
 	defer r.mu.Unlock()
+	return r.updateLocked(uw)
+}
+
+func (r *fakeLearnedLexemeRepo) updateLocked(uw *entity.LearnedLexeme) (*entity.LearnedLexeme, error) {
 	existing, ok := r.items[uw.ID]
 	if !ok || existing.UserID != uw.UserID {
 		return nil, entity.ErrLearnedLexemeNotFound
@@ -53,10 +60,61 @@ func (r *fakeLearnedLexemeRepo) Update(ctx context.Context, uw *entity.LearnedLe
 		return nil, entity.ErrDuplicateLearnedLexeme
 	}
 	copy := cloneLearnedLexeme(uw)
+	copy.QueryCount = existing.QueryCount // like the real repository, Update never writes the counter
+	if uw.Tags == nil {
+		copy.Tags = existing.Tags // nil tags mean "leave unchanged"
+	}
 	r.items[copy.ID] = copy
 	return cloneLearnedLexeme(copy), nil
 }
 
+// UpdateMany holds the write lock across the read, apply and write, like the real transaction.
+func (r *fakeLearnedLexemeRepo) UpdateMany(ctx context.Context, userID int64, ids []int64, apply func(map[int64]*entity.LearnedLexeme) ([]*entity.LearnedLexeme, error)) ([]*entity.LearnedLexeme, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	loaded := make(map[int64]*entity.LearnedLexeme, len(ids))
+	for _, id := range ids {
+		if item, ok := r.items[id]; ok && item.UserID == userID {
+			loaded[id] = cloneLearnedLexeme(item)
+		}
+	}
+	lexemes, err := apply(loaded)
+	if err != nil {
+		return nil, err
+	}
+	for _, uw := range lexemes {
+		if existing, ok := r.items[uw.ID]; !ok || existing.UserID != userID || uw.UserID != userID {
+			return nil, entity.ErrLearnedLexemeNotFound
+		}
+	}
+	saved := make([]*entity.LearnedLexeme, 0, len(lexemes))
+	for _, uw := range lexemes {
+		rec, err := r.updateLocked(uw)
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, rec)
+	}
+	return saved, nil
+}
+
+func (r *fakeLearnedLexemeRepo) IncrementQueryCount(ctx context.Context, userID, id int64) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, ok := r.items[id]
+	if !ok || existing.UserID != userID {
+		return 0, entity.ErrLearnedLexemeNotFound
+	}
+	existing.QueryCount++
+	return existing.QueryCount, nil
+}
+
 func (r *fakeLearnedLexemeRepo) GetByID(ctx context.Context, userID, id int64) (*entity.LearnedLexeme, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, err
@@ -82,6 +140,24 @@ func (r *fakeLearnedLexemeRepo) FindByTerm(ctx context.Context, userID int64, te
 	return nil, nil
 }
 
+func (r *fakeLearnedLexemeRepo) FindByNormalizedTerm(ctx context.Context, userID int64, language entity.Language, term string) (*entity.LearnedLexeme, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var found *entity.LearnedLexeme
+	for _, item := range r.items {
+		if item.UserID != userID || item.Language != entity.NormalizeLanguage(language) || entity.NormalizeWordToken(item.Term) != entity.NormalizeWordToken(term) {
+			continue
+		}
+		if found == nil || item.UpdatedAt.After(found.UpdatedAt) || (item.UpdatedAt.Equal(found.UpdatedAt) && item.ID < found.ID) {
+			found = item
+		}
+	}
+	return cloneLearnedLexeme(found), nil
+}
+
 func (r *fakeLearnedLexemeRepo) List(ctx context.Context, query *repository.ListLearnedLexemeQuery) ([]entity.LearnedLexeme, int64, error) {
 	if err := ctx.Err(); err != nil {
 		return nil, 0, err
@@ -95,23 +171,14 @@ func (r *fakeLearnedLexemeRepo) List(ctx context.Context, query *repository.List
 	keyword := strings.ToLower(strings.TrimSpace(extractKeyword(query.Filter)))
 	var filtered []*entity.LearnedLexeme
 	for _, item := range r.items {
-		if item.UserID != query.UserID {
-			continue
+		if fakeListMatches(item, query.UserID, keyword) {
+			filtered = append(filtered, cloneLearnedLexeme(item))
 		}
-		if keyword != "" {
-			if !strings.Contains(strings.ToLower(item.Term), keyword) && !strings.Contains(strings.ToLower(item.Notes), keyword) {
-				continue
-			}
-		}
-		filtered = append(filtered, cloneLearnedLexeme(item))
 	}
 
-	sort.Slice(filtered, func(i, j int) bool {
-		if filtered[i].CreatedAt.Equal(filtered[j].CreatedAt) {
-			return filtered[i].ID < filtered[j].ID
-		}
-		return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
-	})
+	if err := fakeLearnedLexemeOrder.sort(filtered, query.OrderBy, keyword); err != nil {
+		return nil, 0, err
+	}
 
 	total := int64(len(filtered))
 	pageNo := query.PageNo
@@ -142,6 +209,50 @@ func (r *fakeLearnedLexemeRepo) List(ctx context.Context, query *repository.List
 	return result, total, nil
 }
 
+func (r *fakeLearnedLexemeRepo) Count(ctx context.Context, query *repository.ListLearnedLexemeQuery) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	if query == nil {
+		return 0, errors.New("list query required")
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keyword := strings.ToLower(strings.TrimSpace(extractKeyword(query.Filter)))
+	var count int64
+	for _, item := range r.items {
+		if fakeListMatches(item, query.UserID, keyword) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// fakeListMatches applies the keyword filter List and Count understand.
+func fakeListMatches(item *entity.LearnedLexeme, userID int64, keyword string) bool {
+	if item.UserID != userID {
+		return false
+	}
+	if keyword == "" {
+		return true
+	}
+	return strings.Contains(strings.ToLower(item.Term), keyword) || strings.Contains(strings.ToLower(item.Notes), keyword)
+}
+
+func (r *fakeLearnedLexemeRepo) CountDue(ctx context.Context, userID int64, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.dueCounts++
+	var count int64
+	for _, item := range r.items {
+		if item.UserID == userID && !item.Review.NextReviewAt.IsZero() && !item.Review.NextReviewAt.After(now) {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func (r *fakeLearnedLexemeRepo) Delete(ctx context.Context, userID, id int64) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -156,6 +267,59 @@ func (r *fakeLearnedLexemeRepo) Delete(ctx context.Context, userID, id int64) er
 	return nil
 }
 
+func (r *fakeLearnedLexemeRepo) DeleteByFilter(ctx context.Context, query *repository.ListLearnedLexemeQuery) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deleteQueries = append(r.deleteQueries, *query)
+
+	keyword := strings.ToLower(strings.TrimSpace(extractKeyword(query.Filter)))
+	var deleted int64
+	for id, item := range r.items {
+		if item.UserID != query.UserID {
+			continue
+		}
+		if keyword != "" && !strings.Contains(strings.ToLower(item.Term), keyword) {
+			continue
+		}
+		delete(r.items, id)
+		deleted++
+	}
+	return deleted, nil
+}
+
+func (r *fakeLearnedLexemeRepo) MergeDuplicates(ctx context.Context, userID int64, merges []repository.LearnedLexemeMerge) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Validate everything first so the fake is all-or-nothing like the real transaction.
+	for _, merge := range merges {
+		if existing, ok := r.items[merge.Keep.ID]; !ok || existing.UserID != userID {
+			return entity.ErrLearnedLexemeNotFound
+		}
+		for _, id := range merge.RemoveIDs {
+			if existing, ok := r.items[id]; !ok || existing.UserID != userID {
+				return entity.ErrLearnedLexemeNotFound
+			}
+		}
+	}
+	for _, merge := range merges {
+		for _, id := range merge.RemoveIDs {
+			delete(r.items, id)
+		}
+		r.items[merge.Keep.ID] = cloneLearnedLexeme(merge.Keep)
+	}
+	return nil
+}
+
+func (r *fakeLearnedLexemeRepo) ReattachDictionaryWords(ctx context.Context, afterID int64, limit int) (repository.ReattachStats, int64, error) {
+	return repository.ReattachStats{}, afterID, ctx.Err()
+}
+
 func (r *fakeLearnedLexemeRepo) lookupLocked(userID int64, term string) (*entity.LearnedLexeme, bool) {
 	if term == "" {
 		return nil, false
@@ -180,6 +344,9 @@ func cloneLearnedLexeme(src *entity.LearnedLexeme) *entity.LearnedLexeme {
 	if src.Relations != nil {
 		copy.Relations = append([]entity.LearnedLexemeRelation(nil), src.Relations...)
 	}
+	if src.Tags != nil {
+		copy.Tags = append([]string{}, src.Tags...)
+	}
 	return &copy
 }
 
@@ -214,6 +381,242 @@ func TestCollectLexemeCreatesNewEntry(t *testing.T) {
 	}
 }
 
+func TestCollectLexemeStrictLanguage(t *testing.T) {
+	ctx := context.Background()
+
+	lenient := NewLearnedLexemeUsecase(newFakeLearnedLexemeRepo())
+	got, err := lenient.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "apple"})
+	if err != nil {
+		t.Fatalf("lenient CollectLexeme: %v", err)
+	}
+	if got.Language != entity.LanguageEnglish {
+		t.Fatalf("expected language to default to en, got %q", got.Language)
+	}
+
+	repo := newFakeLearnedLexemeRepo()
+	strict := NewLearnedLexemeUsecase(repo, WithLearnedLexemeStrictLanguage())
+	if _, err := strict.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "apple"}); !errors.Is(err, entity.ErrLanguageRequired) {
+		t.Fatalf("expected ErrLanguageRequired, got %v", err)
+	}
+	if len(repo.items) != 0 {
+		t.Fatalf("strict mode must not store the lexeme, got %d items", len(repo.items))
+	}
+	if _, err := strict.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "pomme", Language: entity.LanguageFrench}); err != nil {
+		t.Fatalf("strict CollectLexeme with language: %v", err)
+	}
+}
+
+func TestCollectLexemeCreatedBy(t *testing.T) {
+	ctx := context.Background()
+	authed := entity.WithEditor(ctx, "alice")
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		opts     []LearnedLexemeUsecaseOption
+		supplied string
+		want     string
+		wantErr  error
+	}{
+		{name: "authenticated identity wins", ctx: authed, supplied: "spoofed", want: "alice"},
+		{name: "authenticated without value", ctx: authed, want: "alice"},
+		{name: "client supplied is trimmed", ctx: ctx, supplied: "  importer ", want: "importer"},
+		{name: "client supplied too long", ctx: ctx, supplied: strings.Repeat("x", 129), wantErr: entity.ErrInvalidCreatedBy},
+		{name: "client supplied control character", ctx: ctx, supplied: "bad\nname", wantErr: entity.ErrInvalidCreatedBy},
+		{name: "anonymous default", ctx: ctx, supplied: "   ", want: DefaultAnonymousCreatedBy},
+		{name: "configured anonymous default", ctx: ctx, opts: []LearnedLexemeUsecaseOption{WithLearnedLexemeAnonymousCreatedBy("guest")}, want: "guest"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeLearnedLexemeRepo()
+			uc := NewLearnedLexemeUsecase(repo, tt.opts...)
+			got, err := uc.CollectLexeme(tt.ctx, 1, &entity.LearnedLexeme{
+				Term:      "apple",
+				CreatedBy: tt.supplied,
+				Relations: []entity.LearnedLexemeRelation{{Word: "fruit"}},
+			})
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if len(repo.items) != 0 {
+					t.Fatalf("rejected collect must not store the lexeme, got %d items", len(repo.items))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CollectLexeme: %v", err)
+			}
+			if got.CreatedBy != tt.want {
+				t.Fatalf("created_by = %q, want %q", got.CreatedBy, tt.want)
+			}
+			if got.Relations[0].CreatedBy != tt.want {
+				t.Fatalf("relation created_by = %q, want %q", got.Relations[0].CreatedBy, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectLexemeRelationTypeValidation(t *testing.T) {
+	ctx := context.Background()
+	for relationType, valid := range map[entity.RelationType]bool{
+		entity.RelationTypeUnspecified: true,
+		entity.RelationTypeAntonym:     true,
+		entity.RelationTypeCustom:      true,
+		8:                              false,
+		999:                            false,
+		-1:                             false,
+	} {
+		repo := newFakeLearnedLexemeRepo()
+		uc := NewLearnedLexemeUsecase(repo)
+		_, err := uc.CollectLexeme(ctx, 1, &entity.LearnedLexeme{
+			Term:      "hot",
+			Relations: []entity.LearnedLexemeRelation{{Word: "cold", RelationType: relationType}},
+		})
+		if valid {
+			if err != nil {
+				t.Fatalf("type %d: CollectLexeme: %v", relationType, err)
+			}
+			continue
+		}
+		if !errors.Is(err, entity.ErrInvalidRelationType) {
+			t.Fatalf("type %d: expected ErrInvalidRelationType, got %v", relationType, err)
+		}
+		if len(repo.items) != 0 {
+			t.Fatalf("type %d: rejected collect must not store the lexeme", relationType)
+		}
+	}
+}
+
+func TestGetDueCount(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	seed := func(repo *fakeLearnedLexemeRepo) (due int64) {
+		for i, next := range []time.Time{now.Add(-time.Hour), now.Add(-48 * time.Hour), now.Add(time.Hour), {}} {
+			repo.items[int64(i+1)] = &entity.LearnedLexeme{ID: int64(i + 1), UserID: 1, Term: fmt.Sprintf("w%d", i), Review: entity.ReviewTiming{NextReviewAt: next}}
+		}
+		return 2
+	}
+
+	t.Run("live without cache", func(t *testing.T) {
+		repo := newFakeLearnedLexemeRepo()
+		want := seed(repo)
+		uc := NewLearnedLexemeUsecase(repo)
+		uc.(*learnedLexemeUsecase).clock = func() time.Time { return now }
+		for range 2 {
+			if got, err := uc.GetDueCount(ctx, 1); err != nil || got != want {
+				t.Fatalf("GetDueCount = %d, %v; want %d", got, err, want)
+			}
+		}
+		if repo.dueCounts != 2 {
+			t.Fatalf("expected a live count per call, got %d", repo.dueCounts)
+		}
+	})
+
+	t.Run("cache hit until stale", func(t *testing.T) {
+		repo := newFakeLearnedLexemeRepo()
+		want := seed(repo)
+		clock := now
+		uc := NewLearnedLexemeUsecase(repo, WithDueCountCache(time.Minute))
+		uc.(*learnedLexemeUsecase).clock = func() time.Time { return clock }
+		for range 3 {
+			if got, err := uc.GetDueCount(ctx, 1); err != nil || got != want {
+				t.Fatalf("GetDueCount = %d, %v; want %d", got, err, want)
+			}
+		}
+		if repo.dueCounts != 1 {
+			t.Fatalf("expected one live count, got %d", repo.dueCounts)
+		}
+		if got, _ := uc.GetDueCount(ctx, 2); got != 0 || repo.dueCounts != 2 {
+			t.Fatalf("other users are counted separately: got %d after %d counts", got, repo.dueCounts)
+		}
+
+		clock = now.Add(2 * time.Hour)
+		if got, _ := uc.GetDueCount(ctx, 1); got != want+1 || repo.dueCounts != 3 {
+			t.Fatalf("stale entry must be recounted: got %d after %d counts", got, repo.dueCounts)
+		}
+	})
+
+	t.Run("review invalidates", func(t *testing.T) {
+		repo := newFakeLearnedLexemeRepo()
+		want := seed(repo)
+		uc := NewLearnedLexemeUsecase(repo, WithDueCountCache(time.Hour))
+		uc.(*learnedLexemeUsecase).clock = func() time.Time { return now }
+		if got, _ := uc.GetDueCount(ctx, 1); got != want {
+			t.Fatalf("GetDueCount = %d, want %d", got, want)
+		}
+		review := entity.ReviewTiming{LastReviewAt: now, NextReviewAt: now.Add(24 * time.Hour)}
+		if _, err := uc.UpdateMastery(ctx, 1, 1, entity.MasteryBreakdown{Overall: 200}, review, ""); err != nil {
+			t.Fatalf("UpdateMastery: %v", err)
+		}
+		if got, _ := uc.GetDueCount(ctx, 1); got != want-1 || repo.dueCounts != 2 {
+			t.Fatalf("review must invalidate the cached count: got %d after %d counts", got, repo.dueCounts)
+		}
+		if err := uc.DeleteLearnedLexeme(ctx, 1, 2); err != nil {
+			t.Fatalf("DeleteLearnedLexeme: %v", err)
+		}
+		if got, _ := uc.GetDueCount(ctx, 1); got != 0 || repo.dueCounts != 3 {
+			t.Fatalf("delete must invalidate the cached count: got %d after %d counts", got, repo.dueCounts)
+		}
+	})
+
+	t.Run("refresh keeps polled users warm", func(t *testing.T) {
+		repo := newFakeLearnedLexemeRepo()
+		want := seed(repo)
+		uc := NewLearnedLexemeUsecase(repo, WithDueCountCache(time.Hour))
+		uc.(*learnedLexemeUsecase).clock = func() time.Time { return now }
+		uc.GetDueCount(ctx, 1)
+		uc.GetDueCount(ctx, 1) // served from the cache, so user 1 counts as polling
+		uc.GetDueCount(ctx, 2)
+		if err := uc.RefreshDueCounts(ctx); err != nil {
+			t.Fatalf("RefreshDueCounts: %v", err)
+		}
+		if repo.dueCounts != 3 {
+			t.Fatalf("expected only the polled user to be recounted, got %d counts", repo.dueCounts)
+		}
+		if got, _ := uc.GetDueCount(ctx, 1); got != want || repo.dueCounts != 3 {
+			t.Fatalf("refreshed count must be served from the cache: got %d after %d counts", got, repo.dueCounts)
+		}
+	})
+}
+
+func TestCollectLexemeTermValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		term    string
+		wantErr string // empty means the term is accepted
+	}{
+		{name: "valid", term: "serendipity"},
+		{name: "valid phrase", term: "give up"},
+		{name: "over length", term: strings.Repeat("x", entity.DefaultMaxTextLength+1), wantErr: "limit is 256"},
+		{name: "control character", term: "give\tup", wantErr: "control character U+0009"},
+		{name: "invalid utf-8", term: "caf\xe9", wantErr: "not valid UTF-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeLearnedLexemeRepo()
+			_, err := NewLearnedLexemeUsecase(repo).CollectLexeme(context.Background(), 1, &entity.LearnedLexeme{Term: tt.term})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("CollectLexeme: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, entity.ErrInvalidLearnedLexemeText) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("CollectLexeme error = %v, want ErrInvalidLearnedLexemeText mentioning %q", err, tt.wantErr)
+			}
+			if len(repo.items) != 0 {
+				t.Fatalf("invalid term reached the repository")
+			}
+		})
+	}
+
+	uc := NewLearnedLexemeUsecase(newFakeLearnedLexemeRepo(), WithLearnedLexemeMaxTermLength(0))
+	if _, err := uc.CollectLexeme(context.Background(), 1, &entity.LearnedLexeme{Term: strings.Repeat("x", 1000)}); err != nil {
+		t.Fatalf("CollectLexeme with the limit disabled: %v", err)
+	}
+}
+
 func TestCollectLexemeDuplicateUpdatesExisting(t *testing.T) {
 	repo := newFakeLearnedLexemeRepo()
 	uc := NewLearnedLexemeUsecase(repo)
@@ -250,6 +653,77 @@ func TestCollectLexemeDuplicateUpdatesExisting(t *testing.T) {
 	}
 }
 
+func TestCollectLexemeDedupesCaseVariants(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+
+	first, err := uc.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "apple", Language: entity.LanguageEnglish})
+	if err != nil {
+		t.Fatalf("first collect: %v", err)
+	}
+	second, err := uc.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "Apple", Language: entity.LanguageEnglish, Notes: "fruit"})
+	if err != nil {
+		t.Fatalf("case variant collect: %v", err)
+	}
+	if second.ID != first.ID || second.Term != "apple" || second.QueryCount != 2 {
+		t.Fatalf("case variant collect = %+v, want lexeme %d updated", second, first.ID)
+	}
+	if len(repo.items) != 1 {
+		t.Fatalf("stored lexemes = %d, want 1", len(repo.items))
+	}
+
+	got, err := uc.GetByTerm(ctx, 1, "APPLE", entity.LanguageEnglish)
+	if err != nil || got.ID != first.ID || got.Notes != "fruit" {
+		t.Fatalf("get by term = %+v, %v; want lexeme %d", got, err, first.ID)
+	}
+	if _, err := uc.GetByTerm(ctx, 2, "apple", entity.LanguageEnglish); !errors.Is(err, entity.ErrLearnedLexemeNotFound) {
+		t.Fatalf("get for another user: err = %v, want ErrLearnedLexemeNotFound", err)
+	}
+}
+
+func TestCollectLexemeDuplicateTags(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+
+	first, err := uc.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "apple", Tags: []string{"fruit"}})
+	if err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	steps := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{name: "nil leaves unchanged", tags: nil, want: []string{"fruit"}},
+		{name: "set replaces", tags: []string{" Food", "red", "FOOD", ""}, want: []string{"food", "red"}},
+		{name: "nil after set leaves unchanged", tags: nil, want: []string{"food", "red"}},
+		{name: "empty clears", tags: []string{}, want: []string{}},
+	}
+	for _, step := range steps {
+		got, err := uc.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "apple", Tags: step.tags})
+		if err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if got.ID != first.ID {
+			t.Fatalf("%s: expected the existing lexeme %d, got %d", step.name, first.ID, got.ID)
+		}
+		if !slices.Equal(got.Tags, step.want) {
+			t.Fatalf("%s: tags = %v, want %v", step.name, got.Tags, step.want)
+		}
+	}
+
+	tooMany := make([]string, entity.MaxTags+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("tag%d", i)
+	}
+	if _, err := uc.CollectLexeme(ctx, 1, &entity.LearnedLexeme{Term: "apple", Tags: tooMany}); !errors.Is(err, entity.ErrInvalidTags) {
+		t.Fatalf("expected ErrInvalidTags for %d tags, got %v", len(tooMany), err)
+	}
+}
+
 func TestUpdateMastery(t *testing.T) {
 	repo := newFakeLearnedLexemeRepo()
 	uc := NewLearnedLexemeUsecase(repo)
@@ -268,8 +742,9 @@ func TestUpdateMastery(t *testing.T) {
 	if err != nil {
 		t.Fatalf("UpdateMastery failed: %v", err)
 	}
-	if updated.Mastery != mastery {
-		t.Errorf("expected mastery %+v, got %+v", mastery, updated.Mastery)
+	// Overall is derived from the per-skill scores, not taken from the request.
+	if want := (entity.MasteryBreakdown{Listen: 2, Read: 3, Overall: 125}); updated.Mastery != want {
+		t.Errorf("expected mastery %+v, got %+v", want, updated.Mastery)
 	}
 	if updated.Review.IntervalDays != 2 {
 		t.Errorf("expected interval days 2, got %d", updated.Review.IntervalDays)
@@ -279,6 +754,364 @@ func TestUpdateMastery(t *testing.T) {
 	}
 }
 
+func TestUpdateMastery_ReviewTimingValidation(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+	created, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "bridge"})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+
+	last := time.Date(2024, 1, 5, 11, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		name    string
+		review  entity.ReviewTiming
+		wantErr bool
+	}{
+		{name: "next after last", review: entity.ReviewTiming{LastReviewAt: last, NextReviewAt: last.Add(48 * time.Hour), IntervalDays: 2}},
+		{name: "next equals last", review: entity.ReviewTiming{LastReviewAt: last, NextReviewAt: last}},
+		{name: "only last set", review: entity.ReviewTiming{LastReviewAt: last, FailCount: 1}},
+		{name: "unset", review: entity.ReviewTiming{}},
+		{name: "next before last", review: entity.ReviewTiming{LastReviewAt: last, NextReviewAt: last.Add(-time.Hour)}, wantErr: true},
+		{name: "negative interval", review: entity.ReviewTiming{IntervalDays: -1}, wantErr: true},
+		{name: "negative fail count", review: entity.ReviewTiming{FailCount: -3}, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			updated, err := uc.UpdateMastery(ctx, 9, created.ID, entity.MasteryBreakdown{}, tc.review, "")
+			if tc.wantErr {
+				if !errors.Is(err, entity.ErrInvalidReviewTiming) {
+					t.Fatalf("UpdateMastery error = %v, want ErrInvalidReviewTiming", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateMastery: %v", err)
+			}
+			if updated.Review != tc.review {
+				t.Fatalf("stored review %+v, want %+v", updated.Review, tc.review)
+			}
+		})
+	}
+
+	_, err = uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "river", Review: entity.ReviewTiming{IntervalDays: -2}})
+	if !errors.Is(err, entity.ErrInvalidReviewTiming) {
+		t.Fatalf("CollectLexeme error = %v, want ErrInvalidReviewTiming", err)
+	}
+}
+
+func TestUpdateMasteryBatch(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	uc.(*learnedLexemeUsecase).clock = func() time.Time { return now }
+
+	bridge, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "bridge"})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+	river, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "river"})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+	stranger, err := uc.CollectLexeme(ctx, 7, &entity.LearnedLexeme{Term: "stone"})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+
+	morning := now.Add(-4 * time.Hour)
+	evening := now.Add(-1 * time.Hour)
+	results, err := uc.UpdateMasteryBatch(ctx, 9, []MasteryUpdate{
+		// Out of order on purpose: the evening review must win for bridge.
+		{ID: bridge.ID, Mastery: entity.MasteryBreakdown{Overall: 400}, ReviewedAt: evening},
+		{ID: 404, Mastery: entity.MasteryBreakdown{Overall: 100}, ReviewedAt: morning},
+		{ID: bridge.ID, Mastery: entity.MasteryBreakdown{Overall: 200}, ReviewedAt: morning},
+		{ID: stranger.ID, Mastery: entity.MasteryBreakdown{Overall: 100}},
+		{ID: river.ID, Mastery: entity.MasteryBreakdown{Listen: 4, Read: 4, Overall: 100}, Notes: "offline"},
+	}, false)
+	if err != nil {
+		t.Fatalf("UpdateMasteryBatch: %v", err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	for _, i := range []int{1, 3} {
+		if !errors.Is(results[i].Err, entity.ErrLearnedLexemeNotFound) || results[i].Value != nil {
+			t.Fatalf("result %d: expected not found, got %+v", i, results[i])
+		}
+	}
+	for _, i := range []int{0, 2, 4} {
+		if results[i].Err != nil || results[i].Value == nil {
+			t.Fatalf("result %d: expected an applied update, got %+v", i, results[i])
+		}
+	}
+
+	stored, err := repo.GetByID(ctx, 9, bridge.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if stored.Mastery.Overall != 400 || !stored.Review.LastReviewAt.Equal(evening) {
+		t.Fatalf("bridge = overall %d reviewed %s, want 400 at %s", stored.Mastery.Overall, stored.Review.LastReviewAt, evening)
+	}
+	stored, err = repo.GetByID(ctx, 9, river.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if stored.Mastery.Overall != 200 || stored.Notes != "offline" || !stored.Review.LastReviewAt.Equal(now) {
+		t.Fatalf("river = %+v, want overall 200 derived from its skills, notes and a review stamped with the sync time", stored)
+	}
+	if other, _ := repo.GetByID(ctx, 7, stranger.ID); other.Mastery.Overall != 0 {
+		t.Fatalf("another user's lexeme was updated: %+v", other.Mastery)
+	}
+
+	// A review recorded before the stored one is stale and rejected on its own.
+	results, err = uc.UpdateMasteryBatch(ctx, 9, []MasteryUpdate{{ID: bridge.ID, Mastery: entity.MasteryBreakdown{Overall: 0}, ReviewedAt: morning}}, false)
+	if err != nil {
+		t.Fatalf("UpdateMasteryBatch: %v", err)
+	}
+	if !errors.Is(results[0].Err, entity.ErrStaleReview) {
+		t.Fatalf("expected ErrStaleReview, got %+v", results[0])
+	}
+
+	if _, err := uc.UpdateMasteryBatch(ctx, 9, make([]MasteryUpdate, _maxMasteryBatch+1), false); !errors.Is(err, entity.ErrBatchTooLarge) {
+		t.Fatalf("expected ErrBatchTooLarge, got %v", err)
+	}
+}
+
+func TestUpdateMasteryBatchAtomic(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+
+	bridge, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "bridge"})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+	updates := []MasteryUpdate{
+		{ID: bridge.ID, Mastery: entity.MasteryBreakdown{Overall: 300}},
+		{ID: 404, Mastery: entity.MasteryBreakdown{Overall: 100}},
+	}
+
+	results, err := uc.UpdateMasteryBatch(ctx, 9, updates, true)
+	if err != nil {
+		t.Fatalf("UpdateMasteryBatch: %v", err)
+	}
+	if !errors.Is(results[0].Err, entity.ErrBatchAborted) || results[0].Value != nil || results[0].Index != 0 {
+		t.Fatalf("result 0 = %+v, want ErrBatchAborted", results[0])
+	}
+	if !errors.Is(results[1].Err, entity.ErrLearnedLexemeNotFound) || results[1].Index != 1 {
+		t.Fatalf("result 1 = %+v, want ErrLearnedLexemeNotFound", results[1])
+	}
+	if stored, _ := repo.GetByID(ctx, 9, bridge.ID); stored.Mastery.Overall != 0 {
+		t.Fatalf("atomic batch with a failed item wrote %+v", stored.Mastery)
+	}
+
+	// The same mixed batch without atomic mode keeps the successful item.
+	results, err = uc.UpdateMasteryBatch(ctx, 9, updates, false)
+	if err != nil {
+		t.Fatalf("UpdateMasteryBatch: %v", err)
+	}
+	if !results[0].OK() || results[1].OK() {
+		t.Fatalf("results = %+v, want only the first item applied", results)
+	}
+	if stored, _ := repo.GetByID(ctx, 9, bridge.ID); stored.Mastery.Overall != 300 {
+		t.Fatalf("bridge overall = %d, want 300", stored.Mastery.Overall)
+	}
+}
+
+func TestRenameTermMergeRejectsTooManyTags(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+
+	tags := func(prefix string) []string {
+		out := make([]string, entity.MaxTags)
+		for i := range out {
+			out[i] = fmt.Sprintf("%s-%d", prefix, i)
+		}
+		return out
+	}
+	if _, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "receive", Tags: tags("a")}); err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+	typo, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "recieve", Tags: tags("b")})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+
+	if _, err := uc.RenameTerm(ctx, 9, typo.ID, "receive", true); !errors.Is(err, entity.ErrInvalidTags) {
+		t.Fatalf("merge over the tag limit = %v, want ErrInvalidTags", err)
+	}
+	if _, err := repo.GetByID(ctx, 9, typo.ID); err != nil {
+		t.Fatalf("rejected merge removed the source lexeme: %v", err)
+	}
+}
+
+func TestUpdateMasteryBatchReschedulesNextReview(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	uc.(*learnedLexemeUsecase).clock = func() time.Time { return now }
+
+	scheduled, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "bridge", Review: entity.ReviewTiming{
+		LastReviewAt: now.Add(-72 * time.Hour),
+		NextReviewAt: now.Add(-24 * time.Hour),
+		IntervalDays: 2,
+	}})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+	unscheduled, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "river"})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+
+	reviewed := now.Add(-time.Hour)
+	results, err := uc.UpdateMasteryBatch(ctx, 9, []MasteryUpdate{
+		{ID: scheduled.ID, Mastery: entity.MasteryBreakdown{Overall: 200}, ReviewedAt: reviewed},
+		{ID: unscheduled.ID, Mastery: entity.MasteryBreakdown{Overall: 100}, ReviewedAt: reviewed},
+	}, false)
+	if err != nil {
+		t.Fatalf("UpdateMasteryBatch: %v", err)
+	}
+	if !results[0].OK() || !results[1].OK() {
+		t.Fatalf("results = %+v, want both applied", results)
+	}
+
+	want := entity.ReviewTiming{LastReviewAt: reviewed, NextReviewAt: reviewed.AddDate(0, 0, 2), IntervalDays: 2}
+	if stored, _ := repo.GetByID(ctx, 9, scheduled.ID); stored.Review != want {
+		t.Fatalf("scheduled review = %+v, want %+v", stored.Review, want)
+	}
+	if stored, _ := repo.GetByID(ctx, 9, unscheduled.ID); !stored.Review.NextReviewAt.IsZero() {
+		t.Fatalf("unscheduled lexeme got next review %s", stored.Review.NextReviewAt)
+	}
+}
+
+func TestUpdateMasteryBatchConcurrentKeepsLatestReview(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	uc.(*learnedLexemeUsecase).clock = func() time.Time { return now }
+
+	bridge, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "bridge"})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+
+	// Each device syncs one review; whatever order the batches land in, the latest review wins
+	// and older ones are rejected as stale rather than overwriting it.
+	const devices = 20
+	var wg sync.WaitGroup
+	for n := 1; n <= devices; n++ {
+		wg.Add(1)
+		go func(n int) {
+			defer wg.Done()
+			results, err := uc.UpdateMasteryBatch(ctx, 9, []MasteryUpdate{{
+				ID:         bridge.ID,
+				Mastery:    entity.MasteryBreakdown{Overall: int32(n)},
+				ReviewedAt: now.Add(time.Duration(n-devices) * time.Minute),
+			}}, false)
+			if err != nil {
+				t.Errorf("UpdateMasteryBatch(%d): %v", n, err)
+				return
+			}
+			if !results[0].OK() && !errors.Is(results[0].Err, entity.ErrStaleReview) {
+				t.Errorf("UpdateMasteryBatch(%d) = %+v, want applied or ErrStaleReview", n, results[0])
+			}
+		}(n)
+	}
+	wg.Wait()
+
+	stored, err := repo.GetByID(ctx, 9, bridge.ID)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if stored.Mastery.Overall != devices || !stored.Review.LastReviewAt.Equal(now) {
+		t.Fatalf("bridge = overall %d reviewed %s, want %d at %s", stored.Mastery.Overall, stored.Review.LastReviewAt, devices, now)
+	}
+}
+
+func TestRenameTerm(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+
+	reviewed := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	typo, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{
+		Term:    "recieve",
+		Notes:   "from chapter 2",
+		Mastery: entity.MasteryBreakdown{Read: 3, Overall: 300},
+		Review:  entity.ReviewTiming{LastReviewAt: reviewed, IntervalDays: 4},
+	})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+
+	renamed, err := uc.RenameTerm(ctx, 9, typo.ID, " receive ", false)
+	if err != nil {
+		t.Fatalf("RenameTerm: %v", err)
+	}
+	if renamed.ID != typo.ID || renamed.Term != "receive" {
+		t.Fatalf("renamed = id %d term %q, want id %d term receive", renamed.ID, renamed.Term, typo.ID)
+	}
+	if renamed.Mastery != typo.Mastery || !renamed.Review.LastReviewAt.Equal(reviewed) || renamed.Review.IntervalDays != 4 ||
+		renamed.Notes != typo.Notes || !renamed.CreatedAt.Equal(typo.CreatedAt) {
+		t.Fatalf("rename lost history: %+v, was %+v", renamed, typo)
+	}
+	if _, err := uc.GetByTerm(ctx, 9, "recieve", entity.LanguageEnglish); !errors.Is(err, entity.ErrLearnedLexemeNotFound) {
+		t.Fatalf("old term still resolves: %v", err)
+	}
+
+	if _, err := uc.RenameTerm(ctx, 9, 404, "anything", false); !errors.Is(err, entity.ErrLearnedLexemeNotFound) {
+		t.Fatalf("rename of a missing lexeme = %v, want ErrLearnedLexemeNotFound", err)
+	}
+	if _, err := uc.RenameTerm(ctx, 9, typo.ID, "  ", false); !errors.Is(err, entity.ErrInvalidLearnedLexemeText) {
+		t.Fatalf("rename to a blank term = %v, want ErrInvalidLearnedLexemeText", err)
+	}
+}
+
+func TestRenameTermOntoExistingLexeme(t *testing.T) {
+	ctx := context.Background()
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+
+	bridge, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "bridge", Mastery: entity.MasteryBreakdown{Read: 1, Overall: 100}, Tags: []string{"city"}})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+	typo, err := uc.CollectLexeme(ctx, 9, &entity.LearnedLexeme{Term: "bridg", Mastery: entity.MasteryBreakdown{Spell: 2, Overall: 250}, Tags: []string{"travel"}})
+	if err != nil {
+		t.Fatalf("CollectLexeme: %v", err)
+	}
+
+	if _, err := uc.RenameTerm(ctx, 9, typo.ID, "Bridge", false); !errors.Is(err, entity.ErrDuplicateLearnedLexeme) {
+		t.Fatalf("colliding rename = %v, want ErrDuplicateLearnedLexeme", err)
+	}
+	if stored, err := repo.GetByID(ctx, 9, typo.ID); err != nil || stored.Term != "bridg" {
+		t.Fatalf("failed rename changed the lexeme: %+v, %v", stored, err)
+	}
+
+	merged, err := uc.RenameTerm(ctx, 9, typo.ID, "Bridge", true)
+	if err != nil {
+		t.Fatalf("RenameTerm with merge: %v", err)
+	}
+	if merged.ID != bridge.ID || merged.Term != "bridge" {
+		t.Fatalf("merged into id %d term %q, want the existing bridge %d", merged.ID, merged.Term, bridge.ID)
+	}
+	if want := (entity.MasteryBreakdown{Read: 1, Spell: 2, Overall: 250}); merged.Mastery != want {
+		t.Fatalf("merged mastery = %+v, want %+v", merged.Mastery, want)
+	}
+	if fmt.Sprint(merged.Tags) != "[city travel]" {
+		t.Fatalf("merged tags = %v, want [city travel]", merged.Tags)
+	}
+	if _, err := repo.GetByID(ctx, 9, typo.ID); !errors.Is(err, entity.ErrLearnedLexemeNotFound) {
+		t.Fatalf("renamed lexeme survived the merge: %v", err)
+	}
+}
+
 func TestListLearnedLexemesFiltersByKeyword(t *testing.T) {
 	repo := newFakeLearnedLexemeRepo()
 	uc := NewLearnedLexemeUsecase(repo)
@@ -305,6 +1138,214 @@ func TestListLearnedLexemesFiltersByKeyword(t *testing.T) {
 	}
 }
 
+func TestListGroupedByLemma(t *testing.T) {
+	ctx := context.Background()
+	run := "run"
+	words := &mockVocRepo{words: map[string]*entity.Word{
+		"run":     {ID: 1, Text: "run", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+		"ran":     {ID: 2, Text: "ran", Language: entity.LanguageEnglish, WordType: "past", Lemma: &run},
+		"running": {ID: 3, Text: "running", Language: entity.LanguageEnglish, WordType: "ing", Lemma: &run},
+		"apple":   {ID: 4, Text: "apple", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+	}}
+	repo := newFakeLearnedLexemeRepo()
+	if _, err := NewLearnedLexemeUsecase(repo).ListGroupedByLemma(ctx, 5, entity.LanguageEnglish); err == nil {
+		t.Fatal("expected an error without a dictionary repository")
+	}
+
+	runningID := int64(3)
+	for i, lexeme := range []*entity.LearnedLexeme{
+		{Term: "ran"},
+		{Term: "jogging-ish"},                // no dictionary entry
+		{Term: "sprint", WordID: &runningID}, // linked word wins over the term
+		{Term: "apple"},
+		{Term: "run"},
+		{Term: "laufen", Language: entity.LanguageGerman},
+	} {
+		lexeme.ID = int64(i + 1)
+		lexeme.UserID = 5
+		if lexeme.Language == "" {
+			lexeme.Language = entity.LanguageEnglish
+		}
+		repo.items[lexeme.ID] = lexeme
+	}
+	repo.items[99] = &entity.LearnedLexeme{ID: 99, UserID: 6, Term: "running", Language: entity.LanguageEnglish}
+
+	uc := NewLearnedLexemeUsecase(repo, WithLearnedLexemeDictionary(words))
+	groups, err := uc.ListGroupedByLemma(ctx, 5, entity.LanguageEnglish)
+	if err != nil {
+		t.Fatalf("ListGroupedByLemma: %v", err)
+	}
+	got := make(map[string][]string, len(groups))
+	var lemmas []string
+	for _, group := range groups {
+		lemmas = append(lemmas, group.Lemma)
+		for _, member := range group.Members {
+			got[group.Lemma] = append(got[group.Lemma], member.Term)
+		}
+	}
+	if !slices.Equal(lemmas, []string{"apple", "jogging-ish", "run"}) {
+		t.Fatalf("lemmas = %q", lemmas)
+	}
+	if !slices.Equal(got["run"], []string{"ran", "run", "sprint"}) || !slices.Equal(got["jogging-ish"], []string{"jogging-ish"}) || !slices.Equal(got["apple"], []string{"apple"}) {
+		t.Fatalf("groups = %v", got)
+	}
+	if words.lookups != 0 || words.batchGets != 1 || words.batchLookups != 1 {
+		t.Fatalf("dictionary queries: %d lookups, %d batch gets, %d batch lookups; want one batch of each",
+			words.lookups, words.batchGets, words.batchLookups)
+	}
+}
+
+func TestMergeDuplicatesFoldsCaseVariants(t *testing.T) {
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+	impl := uc.(*learnedLexemeUsecase)
+	fixed := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	impl.clock = func() time.Time { return fixed }
+
+	early := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := early.Add(24 * time.Hour)
+	// Seed directly: the fake's Create rejects case-insensitive duplicates.
+	repo.items[1] = &entity.LearnedLexeme{
+		ID: 1, UserID: 7, Term: "Apple", Language: entity.LanguageEnglish,
+		Mastery:    entity.MasteryBreakdown{Listen: 3, Read: 1, Overall: 2},
+		Review:     entity.ReviewTiming{LastReviewAt: early, IntervalDays: 1},
+		QueryCount: 2,
+		Tags:       []string{"fruit"},
+		Sentences:  []entity.Sentence{{Text: "An apple a day.", Source: 1}},
+		Relations:  []entity.LearnedLexemeRelation{{Word: "pear", RelationType: 1}},
+		CreatedAt:  late,
+	}
+	repo.items[2] = &entity.LearnedLexeme{
+		ID: 2, UserID: 7, Term: "apple", Language: entity.LanguageEnglish,
+		Mastery:    entity.MasteryBreakdown{Listen: 1, Read: 4, Spell: 2, Overall: 1},
+		Review:     entity.ReviewTiming{LastReviewAt: late, IntervalDays: 5},
+		QueryCount: 3,
+		Tags:       []string{"Fruit", "food"},
+		Sentences:  []entity.Sentence{{Text: "An apple a day.", Source: 1}, {Text: "Apple pie.", Source: 2}},
+		Relations:  []entity.LearnedLexemeRelation{{Word: "Pear", RelationType: 1}, {Word: "tree", RelationType: 2}},
+		CreatedAt:  early,
+	}
+	repo.items[3] = &entity.LearnedLexeme{ID: 3, UserID: 7, Term: "banana", Language: entity.LanguageEnglish, CreatedAt: early}
+	repo.items[4] = &entity.LearnedLexeme{ID: 4, UserID: 8, Term: "APPLE", Language: entity.LanguageEnglish, CreatedAt: early}
+	repo.seq = 4
+
+	merged, err := uc.MergeDuplicates(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("MergeDuplicates returned error: %v", err)
+	}
+	if merged != 1 {
+		t.Fatalf("expected 1 merged pair, got %d", merged)
+	}
+	if _, ok := repo.items[1]; ok {
+		t.Fatal("expected the later-created duplicate to be deleted")
+	}
+	if _, ok := repo.items[4]; !ok {
+		t.Fatal("other users' lexemes must not be touched")
+	}
+
+	got := repo.items[2]
+	if got == nil {
+		t.Fatal("expected earliest row to be kept")
+	}
+	wantMastery := entity.MasteryBreakdown{Listen: 3, Read: 4, Spell: 2, Overall: 2}
+	if got.Mastery != wantMastery {
+		t.Fatalf("mastery = %+v, want %+v", got.Mastery, wantMastery)
+	}
+	if got.QueryCount != 5 {
+		t.Fatalf("query count = %d, want 5", got.QueryCount)
+	}
+	if !got.CreatedAt.Equal(early) || !got.UpdatedAt.Equal(fixed) {
+		t.Fatalf("unexpected timestamps: created %v updated %v", got.CreatedAt, got.UpdatedAt)
+	}
+	if got.Review.IntervalDays != 5 {
+		t.Fatalf("expected most recent review state, got %+v", got.Review)
+	}
+	if strings.Join(got.Tags, ",") != "fruit,food" {
+		t.Fatalf("tags = %v, want [fruit food]", got.Tags)
+	}
+	if len(got.Sentences) != 2 {
+		t.Fatalf("expected 2 unique sentences, got %+v", got.Sentences)
+	}
+	if len(got.Relations) != 2 {
+		t.Fatalf("expected 2 unique relations, got %+v", got.Relations)
+	}
+
+	again, err := uc.MergeDuplicates(context.Background(), 7)
+	if err != nil || again != 0 {
+		t.Fatalf("second run should be a no-op, got %d, %v", again, err)
+	}
+}
+
+func TestDeleteByFilterRemovesMatchingLexemes(t *testing.T) {
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+	ctx := context.Background()
+
+	for _, term := range []string{"apple", "pineapple", "banana"} {
+		if _, err := uc.CollectLexeme(ctx, 7, &entity.LearnedLexeme{Term: term, Language: entity.LanguageEnglish}); err != nil {
+			t.Fatalf("collect %q: %v", term, err)
+		}
+	}
+	if _, err := uc.CollectLexeme(ctx, 8, &entity.LearnedLexeme{Term: "apple", Language: entity.LanguageEnglish}); err != nil {
+		t.Fatalf("collect for other user: %v", err)
+	}
+
+	deleted, err := uc.DeleteByFilter(ctx, 7, &repository.ListLearnedLexemeQuery{
+		UserID:      8, // ignored: the caller's user always scopes the delete
+		FilterOrder: repository.FilterOrder{Filter: `keyword == "apple"`},
+	})
+	if err != nil {
+		t.Fatalf("DeleteByFilter returned error: %v", err)
+	}
+	if deleted != 2 {
+		t.Fatalf("expected 2 deleted, got %d", deleted)
+	}
+	if got := repo.deleteQueries[0].UserID; got != 7 {
+		t.Fatalf("expected delete scoped to user 7, got %d", got)
+	}
+
+	remaining, total, err := uc.ListLearnedLexemes(ctx, &repository.ListLearnedLexemeQuery{UserID: 7})
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if total != 1 || remaining[0].Term != "banana" {
+		t.Fatalf("expected only banana to remain, got %+v", remaining)
+	}
+	if _, total, _ := uc.ListLearnedLexemes(ctx, &repository.ListLearnedLexemeQuery{UserID: 8}); total != 1 {
+		t.Fatalf("other user's lexemes must not be deleted, got %d", total)
+	}
+}
+
+func TestDeleteByFilterRequiresConfirmationForEmptyFilter(t *testing.T) {
+	repo := newFakeLearnedLexemeRepo()
+	uc := NewLearnedLexemeUsecase(repo)
+	ctx := context.Background()
+
+	if _, err := uc.CollectLexeme(ctx, 7, &entity.LearnedLexeme{Term: "apple", Language: entity.LanguageEnglish}); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+
+	for _, query := range []*repository.ListLearnedLexemeQuery{
+		nil,
+		{FilterOrder: repository.FilterOrder{Filter: "   "}},
+	} {
+		if _, err := uc.DeleteByFilter(ctx, 7, query); !errors.Is(err, entity.ErrUnscopedDelete) {
+			t.Fatalf("expected ErrUnscopedDelete, got %v", err)
+		}
+	}
+	if len(repo.deleteQueries) != 0 {
+		t.Fatalf("guard must reject before reaching the repository, got %d calls", len(repo.deleteQueries))
+	}
+
+	deleted, err := uc.DeleteByFilter(ctx, 7, &repository.ListLearnedLexemeQuery{All: true})
+	if err != nil {
+		t.Fatalf("DeleteByFilter with All returned error: %v", err)
+	}
+	if deleted != 1 {
+		t.Fatalf("expected 1 deleted, got %d", deleted)
+	}
+}
+
 func extractKeyword(filter string) string {
 	filter = strings.TrimSpace(filter)
 	if filter == "" {
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/repository"
+)
+
+// _offsetWarnRatio is the fraction of the max offset past which deep pages are logged.
+const _offsetWarnRatio = 0.8
+
+// checkOffset rejects pages starting beyond maxOffset rows with entity.ErrOffsetTooLarge,
+// since the database still scans and discards every skipped row. maxOffset <= 0 disables the check.
+func checkOffset(ctx context.Context, list string, p repository.Pagination, maxOffset int64) error {
+	offset := p.Offset()
+	if maxOffset <= 0 || offset == 0 {
+		return nil
+	}
+	if offset > maxOffset {
+		return fmt.Errorf("%w: %s offset %d exceeds %d; narrow the filter instead of paging deeper", entity.ErrOffsetTooLarge, list, offset, maxOffset)
+	}
+	if float64(offset) >= float64(maxOffset)*_offsetWarnRatio {
+		slog.WarnContext(ctx, "deep pagination approaching max offset",
+			slog.String("list", list), slog.Int64("offset", offset), slog.Int64("max_offset", maxOffset))
+	}
+	return nil
+}
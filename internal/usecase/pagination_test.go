@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/eslsoft/vocnet/internal/entity"
+	"github.com/eslsoft/vocnet/internal/repository"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
+)
+
+func TestListRejectsDeepOffsets(t *testing.T) {
+	ctx := context.Background()
+	const maxOffset = 1000
+
+	tests := []struct {
+		name    string
+		page    repository.Pagination
+		wantErr bool
+	}{
+		{name: "first page", page: repository.Pagination{PageNo: 1, PageSize: 20}},
+		{name: "below threshold", page: repository.Pagination{PageNo: 50, PageSize: 20}},                // offset 980
+		{name: "at threshold", page: repository.Pagination{PageNo: 51, PageSize: 20}},                   // offset 1000
+		{name: "above threshold", page: repository.Pagination{PageNo: 52, PageSize: 20}, wantErr: true}, // offset 1020
+		{name: "int32 overflow", page: repository.Pagination{PageNo: math.MaxInt32, PageSize: 10000}, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			words := &mockVocRepo{}
+			_, _, err := NewWordUsecase(words, WithMaxOffset(maxOffset)).List(ctx, &repository.ListWordQuery{Pagination: tc.page})
+			checkOffsetResult(t, "words", err, len(words.listed) == 1, tc.wantErr)
+
+			lexemes := newFakeLearnedLexemeRepo()
+			_, _, err = NewLearnedLexemeUsecase(lexemes, WithLearnedLexemeMaxOffset(maxOffset)).
+				ListLearnedLexemes(ctx, &repository.ListLearnedLexemeQuery{Pagination: tc.page, UserID: 1})
+			checkOffsetResult(t, "learned lexemes", err, err == nil, tc.wantErr)
+		})
+	}
+
+	t.Run("unbounded by default", func(t *testing.T) {
+		words := &mockVocRepo{}
+		page := repository.Pagination{PageNo: 1_000_000, PageSize: 100}
+		if _, _, err := NewWordUsecase(words).List(ctx, &repository.ListWordQuery{Pagination: page}); err != nil {
+			t.Fatalf("List: %v", err)
+		}
+	})
+}
+
+func checkOffsetResult(t *testing.T, list string, err error, reachedRepo, wantErr bool) {
+	t.Helper()
+	if wantErr {
+		if !errors.Is(err, entity.ErrOffsetTooLarge) {
+			t.Fatalf("%s: expected ErrOffsetTooLarge, got %v", list, err)
+		}
+		return
+	}
+	if err != nil || !reachedRepo {
+		t.Fatalf("%s: expected the query to reach the repository, err=%v", list, err)
+	}
+}
+
+func TestListIncludeFormsCapsPageSize(t *testing.T) {
+	ctx := context.Background()
+	for _, tc := range []struct {
+		pageSize int32
+		wantErr  bool
+	}{
+		{pageSize: 20},
+		{pageSize: _maxIncludeFormsPageSize},
+		{pageSize: _maxIncludeFormsPageSize + 1, wantErr: true},
+		{pageSize: 0, wantErr: true},
+	} {
+		words := &mockVocRepo{}
+		query := &repository.ListWordQuery{Pagination: repository.Pagination{PageNo: 1, PageSize: tc.pageSize}, IncludeForms: true}
+		_, _, err := NewWordUsecase(words).List(ctx, query)
+		if tc.wantErr {
+			if !errors.Is(err, entity.ErrPageSizeTooLarge) || len(words.listed) != 0 {
+				t.Fatalf("page size %d: expected ErrPageSizeTooLarge before the repository, got %v", tc.pageSize, err)
+			}
+			continue
+		}
+		if err != nil || len(words.listed) != 1 || !words.listed[0].IncludeForms {
+			t.Fatalf("page size %d: expected IncludeForms to reach the repository, err=%v", tc.pageSize, err)
+		}
+	}
+}
+
+func TestFilterLimitsReachRepository(t *testing.T) {
+	ctx := context.Background()
+	want := filterexpr.FilterLimits{MaxPredicates: 3, MaxDepth: 4}
+
+	words := &mockVocRepo{}
+	if _, _, err := NewWordUsecase(words, WithFilterLimits(3, 4)).List(ctx, &repository.ListWordQuery{}); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if got := words.listed[0].Limits; got != want {
+		t.Fatalf("word list limits = %+v, want %+v", got, want)
+	}
+
+	lexemes := newFakeLearnedLexemeRepo()
+	query := &repository.ListLearnedLexemeQuery{UserID: 1}
+	if _, _, err := NewLearnedLexemeUsecase(lexemes, WithLearnedLexemeFilterLimits(3, 4)).ListLearnedLexemes(ctx, query); err != nil {
+		t.Fatalf("ListLearnedLexemes: %v", err)
+	}
+	if query.Limits != want {
+		t.Fatalf("learned lexeme list limits = %+v, want %+v", query.Limits, want)
+	}
+}
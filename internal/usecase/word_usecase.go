@@ -3,87 +3,597 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/eslsoft/vocnet/internal/entity"
 	"github.com/eslsoft/vocnet/internal/repository"
+	"github.com/eslsoft/vocnet/internal/usecase/inflection"
+	"github.com/eslsoft/vocnet/pkg/filterexpr"
+	"github.com/samber/lo"
 )
 
 // WordUsecase defines business logic for words.
 type WordUsecase interface {
 	Create(ctx context.Context, word *entity.Word) (*entity.Word, error)
-	Update(ctx context.Context, word *entity.Word) (*entity.Word, error)
-	Get(ctx context.Context, id int64) (*entity.Word, error)
-	Lookup(ctx context.Context, lemma string, language entity.Language) (*entity.Word, error)
+	Update(ctx context.Context, word *entity.Word, fields ...entity.WordField) (*entity.Word, error)
+	// Upsert creates word, or when an entry with the same (language, text, word_type) exists,
+	// replaces it if overwrite is set and otherwise keeps it.
+	Upsert(ctx context.Context, word *entity.Word, overwrite bool) (*entity.Word, entity.UpsertOutcome, error)
+	// Get and Lookup keep only sentences from sentenceSources when any are given.
+	Get(ctx context.Context, id int64, sentenceSources ...int32) (*entity.Word, error)
+	// GetByIDs fetches up to _maxGetByIDs unique ids in one query; missing ids are absent from the map.
+	GetByIDs(ctx context.Context, ids []int64) (map[int64]*entity.Word, error)
+	// Lookup returns entity.ErrVocNotFound when the dictionary has no entry, never a nil word.
+	// prefer decides whether an inflected form returns its own entry or its lemma's.
+	Lookup(ctx context.Context, lemma string, language entity.Language, prefer entity.LookupPreference, sentenceSources ...int32) (*entity.Word, error)
+	// LookupWithUserState is Lookup plus the user's learned lexeme for the entry, nil when the user
+	// has not collected it. It requires WithLearnedLexemes.
+	LookupWithUserState(ctx context.Context, userID int64, text string, language entity.Language, prefer entity.LookupPreference) (*entity.Word, *entity.LearnedLexeme, error)
 	List(ctx context.Context, filter *repository.ListWordQuery) ([]*entity.Word, int64, error)
+	Stream(ctx context.Context, filter *repository.ListWordQuery, fn func(*entity.Word) error) error
 	Delete(ctx context.Context, id int64) error
+	AppendSentences(ctx context.Context, wordID int64, sentences []entity.Sentence) error
+	Lemmatize(ctx context.Context, token string, language entity.Language) (lemma string, wordType entity.WordType, err error)
+	LemmatizeBatch(ctx context.Context, tokens []string, language entity.Language) ([]entity.Lemmatization, error)
+	// ListForms returns the forms of lemma, optionally limited to some word types and a maximum count.
+	ListForms(ctx context.Context, lemma string, language entity.Language, query repository.ListFormsQuery) ([]entity.WordFormRef, error)
+	// RelatedWords combines a word's forms, stored relations and the other forms of its lemma.
+	RelatedWords(ctx context.Context, id int64) (entity.RelatedWords, error)
+	// ListWordAudit returns the newest edits of a word, or entity.ErrWordAuditDisabled when
+	// auditing is off.
+	ListWordAudit(ctx context.Context, wordID int64, limit int) ([]*entity.WordAudit, error)
+	// LanguageStats lists the languages that have dictionary entries with their entry counts,
+	// largest first.
+	LanguageStats(ctx context.Context) ([]entity.LanguageCount, error)
 }
 
 const (
 	_defaultLanguage = entity.LanguageEnglish
 	_defaultLimit    = int32(20)
 	_maxLimit        = int32(10000)
+
+	// Per-word caps keep a single entry from growing without bound.
+	_maxWordDefinitions = 200
+	_maxWordSentences   = 500
+	_maxWordRelations   = 500
+	_maxWordItemBytes   = 4096
+
+	// _maxGetByIDs bounds how many ids GetByIDs accepts at once.
+	_maxGetByIDs = 500
+
+	// _maxIncludeFormsPageSize bounds list pages that also load every lemma's forms.
+	_maxIncludeFormsPageSize = int32(200)
 )
 
 type wordUsecase struct {
-	repo repository.WordRepository
+	repo             repository.WordRepository
+	autoCreateLemma  bool
+	allowCustomTypes bool
+	strictDialects   bool
+	strictLanguage   bool
+	maxOffset        int64
+	filterLimits     filterexpr.FilterLimits
+	maxTextLength    int
+	audits           repository.WordAuditRepository
+	learned          repository.LearnedLexemeRepository
+	dialectFallback  []string
+	deinflect        bool
 }
 
-func NewWordUsecase(repo repository.WordRepository) WordUsecase {
-	return &wordUsecase{repo: repo}
+// WordUsecaseOption customizes the word usecase.
+type WordUsecaseOption func(*wordUsecase)
+
+// WithAutoCreateLemma makes Create/Update insert a bare lemma entry when a form references
+// a lemma that does not exist yet, instead of failing with entity.ErrLemmaNotFound.
+func WithAutoCreateLemma() WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.autoCreateLemma = true
+	}
+}
+
+// WithCustomWordTypes accepts word types outside the known entity.WordType set instead of
+// failing with entity.ErrInvalidWordType. Known types and aliases are still canonicalized.
+func WithCustomWordTypes() WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.allowCustomTypes = true
+	}
+}
+
+// WithStrictDialects rejects phonetics whose dialect is not a known code or alias with
+// entity.ErrInvalidDialect instead of clearing the dialect.
+func WithStrictDialects() WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.strictDialects = true
+	}
+}
+
+// WithStrictLanguage rejects requests without a language with entity.ErrLanguageRequired
+// instead of defaulting them to English.
+func WithStrictLanguage() WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.strictLanguage = true
+	}
+}
+
+// WithMaxOffset rejects List pages starting more than maxOffset rows in with
+// entity.ErrOffsetTooLarge; 0 leaves offsets unbounded.
+func WithMaxOffset(maxOffset int64) WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.maxOffset = maxOffset
+	}
+}
+
+// WithFilterLimits bounds the number of predicates and the nesting depth of List and Stream
+// filters; a zero limit uses the filterexpr default and a negative one disables it.
+func WithFilterLimits(maxPredicates, maxDepth int) WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.filterLimits = filterexpr.FilterLimits{MaxPredicates: maxPredicates, MaxDepth: maxDepth}
+	}
+}
+
+// WithMaxTextLength rejects word text longer than maxLength characters with
+// entity.ErrInvalidVocText; 0 disables the limit. Defaults to entity.DefaultMaxTextLength.
+func WithMaxTextLength(maxLength int) WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.maxTextLength = maxLength
+	}
+}
+
+// WithWordAudit records every Update, with the editor from entity.EditorFromContext and the
+// changed fields, through audits in the same transaction as the write; nil leaves auditing off.
+func WithWordAudit(audits repository.WordAuditRepository) WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.audits = audits
+	}
+}
+
+// WithLearnedLexemes lets LookupWithUserState join dictionary entries with users' learned lexemes.
+func WithLearnedLexemes(learned repository.LearnedLexemeRepository) WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.learned = learned
+	}
+}
+
+// WithDialectFallback sets the canonical dialect codes Lookup lists phonetics in after the dialect
+// from entity.DialectFromContext. Defaults to entity.DefaultDialectFallback.
+func WithDialectFallback(chain ...string) WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.dialectFallback = chain
+	}
+}
+
+// WithEnglishLookupFallback makes Lookup retry an English miss with the lemmas the text may be a
+// form of ("mice" for "mouse", "apples" for "apple"), returning the first lemma entry found with
+// FallbackFrom set to the text looked up.
+func WithEnglishLookupFallback() WordUsecaseOption {
+	return func(u *wordUsecase) {
+		u.deinflect = true
+	}
+}
+
+func NewWordUsecase(repo repository.WordRepository, opts ...WordUsecaseOption) WordUsecase {
+	u := &wordUsecase{repo: repo, maxTextLength: entity.DefaultMaxTextLength, dialectFallback: entity.DefaultDialectFallback}
+	for _, opt := range opts {
+		opt(u)
+	}
+	return u
 }
 
 func (u *wordUsecase) Create(ctx context.Context, word *entity.Word) (*entity.Word, error) {
-	norm, err := normalizeVocForUpsert(word)
+	norm, err := u.normalizeVocForUpsert(word)
 	if err != nil {
 		return nil, err
 	}
+	if err := u.ensureLemma(ctx, norm); err != nil {
+		return nil, err
+	}
 	return u.repo.Create(ctx, norm)
 }
 
-func (u *wordUsecase) Update(ctx context.Context, word *entity.Word) (*entity.Word, error) {
-	norm, err := normalizeVocForUpsert(word)
+// Update replaces the whole entry, or only the given fields when fields is non-empty.
+func (u *wordUsecase) Update(ctx context.Context, word *entity.Word, fields ...entity.WordField) (*entity.Word, error) {
+	if len(fields) > 0 {
+		return u.updateFields(ctx, word, fields)
+	}
+	// An update without a language keeps the stored one instead of defaulting it.
+	if word != nil && word.ID > 0 && word.Language == entity.LanguageUnspecified {
+		existing, err := u.repo.GetByID(ctx, word.ID)
+		if err != nil {
+			return nil, err
+		}
+		withLanguage := *word
+		withLanguage.Language = existing.Language
+		word = &withLanguage
+	}
+	norm, err := u.normalizeVocForUpsert(word)
 	if err != nil {
 		return nil, err
 	}
 	if norm.ID <= 0 {
 		return nil, entity.ErrInvalidVocID
 	}
-	return u.repo.Update(ctx, norm)
+	if err := u.ensureLemma(ctx, norm); err != nil {
+		return nil, err
+	}
+	return u.save(ctx, norm)
+}
+
+// save persists an Update, through the audit repository when auditing is on.
+func (u *wordUsecase) save(ctx context.Context, word *entity.Word, fields ...entity.WordField) (*entity.Word, error) {
+	if u.audits != nil {
+		return u.audits.UpdateAudited(ctx, word, entity.EditorFromContext(ctx), fields...)
+	}
+	return u.repo.Update(ctx, word, fields...)
+}
+
+func (u *wordUsecase) Upsert(ctx context.Context, word *entity.Word, overwrite bool) (*entity.Word, entity.UpsertOutcome, error) {
+	norm, err := u.normalizeVocForUpsert(word)
+	if err != nil {
+		return nil, "", err
+	}
+	// Check the key up front rather than relying on the unique constraint, so callers can run
+	// upserts inside a transaction (a failed insert aborts the whole transaction on Postgres).
+	existing, err := u.repo.GetByKey(ctx, norm.Language, norm.Text, norm.WordType)
+	if err != nil {
+		return nil, "", err
+	}
+	if existing != nil && !overwrite {
+		return existing, entity.UpsertSkipped, nil
+	}
+	if err := u.ensureLemma(ctx, norm); err != nil {
+		return nil, "", err
+	}
+	if existing == nil {
+		norm.ID = 0
+		created, err := u.repo.Create(ctx, norm)
+		if err != nil {
+			return nil, "", err
+		}
+		return created, entity.UpsertCreated, nil
+	}
+	norm.ID = existing.ID
+	updated, err := u.repo.Update(ctx, norm)
+	if err != nil {
+		return nil, "", err
+	}
+	return updated, entity.UpsertUpdated, nil
+}
+
+// updateFields merges the masked fields onto the stored entry, validates the result and persists
+// only those fields so everything else stays untouched.
+func (u *wordUsecase) updateFields(ctx context.Context, word *entity.Word, fields []entity.WordField) (*entity.Word, error) {
+	if word == nil {
+		return nil, errors.New("word payload required")
+	}
+	if word.ID <= 0 {
+		return nil, entity.ErrInvalidVocID
+	}
+	// Reject oversized payloads before touching the database.
+	if err := checkWordLimits(word); err != nil {
+		return nil, err
+	}
+	existing, err := u.repo.GetByID(ctx, word.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *existing
+	for _, field := range fields {
+		switch field {
+		case entity.WordFieldText:
+			merged.Text = word.Text
+		case entity.WordFieldLanguage:
+			merged.Language = word.Language
+		case entity.WordFieldWordType:
+			merged.WordType = word.WordType
+		case entity.WordFieldLemma:
+			merged.Lemma = word.Lemma
+		case entity.WordFieldPhonetics:
+			merged.Phonetics = word.Phonetics
+		case entity.WordFieldDefinitions:
+			merged.Definitions = word.Definitions
+		case entity.WordFieldCategories:
+			merged.Categories = word.Categories
+		case entity.WordFieldPhrases:
+			merged.Phrases = word.Phrases
+		case entity.WordFieldSentences:
+			merged.Sentences = word.Sentences
+		case entity.WordFieldRelations:
+			merged.Relations = word.Relations
+		}
+	}
+
+	norm, err := u.normalizeVocForUpsert(&merged)
+	if err != nil {
+		return nil, err
+	}
+	// Only re-check the lemma link when the fields that define it change, so legacy rows
+	// can still be edited field by field.
+	if lo.ContainsBy(fields, isLemmaLinkField) {
+		if err := u.ensureLemma(ctx, norm); err != nil {
+			return nil, err
+		}
+		// Normalization may drop the lemma when word_type becomes lemma; persist that too.
+		if !lo.Contains(fields, entity.WordFieldLemma) {
+			fields = append(fields, entity.WordFieldLemma)
+		}
+	}
+	return u.save(ctx, norm, fields...)
 }
 
-func (u *wordUsecase) Get(ctx context.Context, id int64) (*entity.Word, error) {
+func isLemmaLinkField(field entity.WordField) bool {
+	switch field {
+	case entity.WordFieldWordType, entity.WordFieldLemma, entity.WordFieldLanguage:
+		return true
+	default:
+		return false
+	}
+}
+
+// ensureLemma verifies that a non-lemma word points at an existing entry in the same language,
+// optionally creating a stub lemma when it is missing.
+func (u *wordUsecase) ensureLemma(ctx context.Context, word *entity.Word) error {
+	if word.WordType == entity.WordTypeLemma || word.Lemma == nil {
+		return nil
+	}
+	lemma := *word.Lemma
+	existing, err := u.repo.Lookup(ctx, lemma, word.Language)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		return nil
+	}
+	if !u.autoCreateLemma {
+		return fmt.Errorf("%w: %q (%s)", entity.ErrLemmaNotFound, lemma, word.Language)
+	}
+	_, err = u.repo.Create(ctx, &entity.Word{
+		Text:     lemma,
+		Language: word.Language,
+		WordType: entity.WordTypeLemma,
+	})
+	// A concurrent writer may have created the lemma in the meantime; that is fine.
+	if err != nil && !errors.Is(err, entity.ErrDuplicateWord) {
+		return fmt.Errorf("create stub lemma %q: %w", lemma, err)
+	}
+	return nil
+}
+
+func (u *wordUsecase) Get(ctx context.Context, id int64, sentenceSources ...int32) (*entity.Word, error) {
 	if id <= 0 {
 		return nil, entity.ErrInvalidVocID
 	}
-	return u.repo.GetByID(ctx, id)
+	if err := checkSourceFilter(sentenceSources); err != nil {
+		return nil, err
+	}
+	w, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	filterSentencesBySource(w, sentenceSources)
+	return w, nil
 }
 
-func (u *wordUsecase) Lookup(ctx context.Context, lemma string, language entity.Language) (*entity.Word, error) {
+func (u *wordUsecase) GetByIDs(ctx context.Context, ids []int64) (map[int64]*entity.Word, error) {
+	if len(ids) > _maxGetByIDs {
+		return nil, fmt.Errorf("%w: at most %d ids per batch, got %d", entity.ErrBatchTooLarge, _maxGetByIDs, len(ids))
+	}
+	seen := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, fmt.Errorf("%w: %d", entity.ErrInvalidVocID, id)
+		}
+		if _, dup := seen[id]; dup {
+			return nil, fmt.Errorf("%w: duplicate id %d", entity.ErrInvalidVocID, id)
+		}
+		seen[id] = struct{}{}
+	}
+	words, err := u.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[int64]*entity.Word, len(words))
+	for _, w := range words {
+		found[w.ID] = w
+	}
+	return found, nil
+}
+
+func (u *wordUsecase) Lookup(ctx context.Context, lemma string, language entity.Language, prefer entity.LookupPreference, sentenceSources ...int32) (*entity.Word, error) {
 	lemma = strings.TrimSpace(lemma)
 	if lemma == "" {
 		return nil, entity.ErrInvalidVocText
 	}
-	if language == entity.LanguageUnspecified {
-		language = _defaultLanguage
+	if err := checkSourceFilter(sentenceSources); err != nil {
+		return nil, err
+	}
+	language, err := u.resolveLanguage(language)
+	if err != nil {
+		return nil, err
 	}
 	v, err := u.repo.Lookup(ctx, lemma, language)
-	if err != nil || v == nil {
-		return v, err
+	if err != nil {
+		return nil, err
+	}
+	if v != nil && prefer != entity.LookupPreferExact {
+		if v, err = u.lemmaEntry(ctx, v); err != nil {
+			return nil, err
+		}
+	}
+	if v == nil && u.deinflect && language == entity.LanguageEnglish {
+		if v, err = u.lookupDeinflected(ctx, lemma); err != nil {
+			return nil, err
+		}
+	}
+	if v == nil {
+		return nil, fmt.Errorf("%w: %q (%s)", entity.ErrVocNotFound, lemma, language)
 	}
 	if v.WordType == entity.WordTypeLemma {
-		forms, ferr := u.repo.ListFormsByLemma(ctx, v.Text, v.Language)
+		forms, ferr := u.repo.ListFormsByLemma(ctx, v.Text, v.Language, repository.ListFormsQuery{})
 		if ferr == nil {
 			v.Forms = forms
 		}
 	}
+	filterSentencesBySource(v, sentenceSources)
+	v.PreferDialects(u.dialectChain(ctx)...)
 	return v, nil
 }
 
+// lemmaEntry returns the entry of the lemma form is an inflection of, marked with FallbackFrom,
+// or form itself when it is a lemma or its lemma has no entry.
+func (u *wordUsecase) lemmaEntry(ctx context.Context, form *entity.Word) (*entity.Word, error) {
+	if form.WordType == entity.WordTypeLemma || form.Lemma == nil || *form.Lemma == "" || *form.Lemma == form.Text {
+		return form, nil
+	}
+	lemma, err := u.repo.Lookup(ctx, *form.Lemma, form.Language)
+	if err != nil || lemma == nil {
+		return form, err
+	}
+	lemma.FallbackFrom = form.Text
+	return lemma, nil
+}
+
+// lookupDeinflected returns the first lemma entry among the English lemmas text may be a form of,
+// or nil when none is in the dictionary.
+func (u *wordUsecase) lookupDeinflected(ctx context.Context, text string) (*entity.Word, error) {
+	for _, candidate := range inflection.EnglishCandidates(text) {
+		v, err := u.repo.Lookup(ctx, candidate.Lemma, entity.LanguageEnglish)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil && v.WordType == entity.WordTypeLemma {
+			v.FallbackFrom = text
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+// dialectChain puts the requested dialect, when any, ahead of the configured fallback chain.
+func (u *wordUsecase) dialectChain(ctx context.Context) []string {
+	requested := entity.DialectFromContext(ctx)
+	if requested == "" {
+		return u.dialectFallback
+	}
+	return append([]string{requested}, u.dialectFallback...)
+}
+
+func (u *wordUsecase) LookupWithUserState(ctx context.Context, userID int64, text string, language entity.Language, prefer entity.LookupPreference) (*entity.Word, *entity.LearnedLexeme, error) {
+	if u.learned == nil {
+		return nil, nil, errors.New("word usecase: learned lexeme repository not configured")
+	}
+	w, err := u.Lookup(ctx, text, language, prefer)
+	if err != nil {
+		return nil, nil, err
+	}
+	learned, err := u.learned.FindByNormalizedTerm(ctx, userID, w.Language, w.Text)
+	if err != nil {
+		return nil, nil, err
+	}
+	return w, learned, nil
+}
+
+// RelatedWords resolves the word's lemma and collects everything linked to it. A missing lemma
+// row is tolerated so legacy forms still report their own relations.
+func (u *wordUsecase) RelatedWords(ctx context.Context, id int64) (entity.RelatedWords, error) {
+	if id <= 0 {
+		return entity.RelatedWords{}, entity.ErrInvalidVocID
+	}
+	w, err := u.repo.GetByID(ctx, id)
+	if err != nil {
+		return entity.RelatedWords{}, err
+	}
+	related := entity.RelatedWords{Word: w, Lemma: w.Text}
+	relations := w.Relations
+
+	lemma := w
+	if w.WordType != entity.WordTypeLemma && w.Lemma != nil && *w.Lemma != "" {
+		related.Lemma = *w.Lemma
+		if lemma, err = u.repo.Lookup(ctx, related.Lemma, w.Language); err != nil {
+			return entity.RelatedWords{}, err
+		}
+		if lemma != nil {
+			relations = append(append([]entity.WordRelation(nil), relations...), lemma.Relations...)
+		}
+	}
+
+	forms, err := u.repo.ListFormsByLemma(ctx, related.Lemma, w.Language, repository.ListFormsQuery{})
+	if err != nil {
+		return entity.RelatedWords{}, err
+	}
+	seen := map[string]struct{}{w.Text: {}}
+	if lemma == w {
+		related.Forms = uniqueFormRefs(forms, seen)
+	} else {
+		siblings := forms
+		if lemma != nil {
+			siblings = append([]entity.WordFormRef{{Text: lemma.Text, WordType: lemma.WordType}}, forms...)
+		}
+		related.Siblings = uniqueFormRefs(siblings, seen)
+	}
+	related.Relations = uniqueRelations(relations, w.Text, related.Lemma)
+	return related, nil
+}
+
+// uniqueFormRefs drops refs whose text is already in seen, recording the ones it keeps.
+func uniqueFormRefs(refs []entity.WordFormRef, seen map[string]struct{}) []entity.WordFormRef {
+	out := make([]entity.WordFormRef, 0, len(refs))
+	for _, ref := range refs {
+		if _, ok := seen[ref.Text]; ok {
+			continue
+		}
+		seen[ref.Text] = struct{}{}
+		out = append(out, ref)
+	}
+	return out
+}
+
+// uniqueRelations drops blank and repeated (word, type) pairs as well as links to the skipped
+// words (the word itself and its lemma, which the view already shows). The first occurrence of
+// a pair wins, so the word's own annotations take precedence over its lemma's.
+func uniqueRelations(relations []entity.WordRelation, skip ...string) []entity.WordRelation {
+	type relationKey struct {
+		word         string
+		relationType entity.RelationType
+	}
+	seen := make(map[relationKey]struct{}, len(relations))
+	out := make([]entity.WordRelation, 0, len(relations))
+	for _, rel := range relations {
+		rel.Word = strings.TrimSpace(rel.Word)
+		if rel.Word == "" || lo.Contains(skip, rel.Word) {
+			continue
+		}
+		key := relationKey{word: rel.Word, relationType: rel.RelationType}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, rel)
+	}
+	return out
+}
+
 func (u *wordUsecase) List(ctx context.Context, query *repository.ListWordQuery) ([]*entity.Word, int64, error) {
+	if query != nil {
+		if err := checkOffset(ctx, "words", query.Pagination, u.maxOffset); err != nil {
+			return nil, 0, err
+		}
+		if query.IncludeForms && (query.PageSize <= 0 || query.PageSize > _maxIncludeFormsPageSize) {
+			return nil, 0, fmt.Errorf("%w: include_forms requires a page size between 1 and %d", entity.ErrPageSizeTooLarge, _maxIncludeFormsPageSize)
+		}
+		query.Limits = u.filterLimits
+	}
 	return u.repo.List(ctx, query)
 }
 
+func (u *wordUsecase) Stream(ctx context.Context, query *repository.ListWordQuery, fn func(*entity.Word) error) error {
+	if query != nil {
+		query.Limits = u.filterLimits
+	}
+	return u.repo.Iterate(ctx, query, fn)
+}
+
 func (u *wordUsecase) Delete(ctx context.Context, id int64) error {
 	if id <= 0 {
 		return entity.ErrInvalidVocID
@@ -91,7 +601,173 @@ func (u *wordUsecase) Delete(ctx context.Context, id int64) error {
 	return u.repo.Delete(ctx, id)
 }
 
-func normalizeVocForUpsert(in *entity.Word) (*entity.Word, error) {
+func (u *wordUsecase) ListForms(ctx context.Context, lemma string, language entity.Language, query repository.ListFormsQuery) ([]entity.WordFormRef, error) {
+	lemma = strings.TrimSpace(lemma)
+	if lemma == "" {
+		return nil, entity.ErrInvalidVocText
+	}
+	language, err := u.resolveLanguage(language)
+	if err != nil {
+		return nil, err
+	}
+	types := make([]entity.WordType, 0, len(query.WordTypes))
+	for _, t := range query.WordTypes {
+		if strings.TrimSpace(string(t)) == "" {
+			continue
+		}
+		wordType, err := entity.ParseWordType(string(t))
+		if err != nil {
+			if !u.allowCustomTypes {
+				return nil, err
+			}
+			wordType = entity.WordType(strings.TrimSpace(string(t)))
+		}
+		types = append(types, wordType)
+	}
+	query.WordTypes = lo.Uniq(types)
+	if query.Limit < 0 {
+		query.Limit = 0
+	}
+	if query.Limit > int(_maxLimit) {
+		query.Limit = int(_maxLimit)
+	}
+	return u.repo.ListFormsByLemma(ctx, lemma, language, query)
+}
+
+func (u *wordUsecase) ListWordAudit(ctx context.Context, wordID int64, limit int) ([]*entity.WordAudit, error) {
+	if u.audits == nil {
+		return nil, entity.ErrWordAuditDisabled
+	}
+	if wordID <= 0 {
+		return nil, entity.ErrInvalidVocID
+	}
+	if limit <= 0 {
+		limit = int(_defaultLimit)
+	}
+	if limit > int(_maxLimit) {
+		limit = int(_maxLimit)
+	}
+	return u.audits.ListByWord(ctx, wordID, limit)
+}
+
+func (u *wordUsecase) LanguageStats(ctx context.Context) ([]entity.LanguageCount, error) {
+	return u.repo.LanguageStats(ctx)
+}
+
+func (u *wordUsecase) AppendSentences(ctx context.Context, wordID int64, sentences []entity.Sentence) error {
+	if wordID <= 0 {
+		return entity.ErrInvalidVocID
+	}
+	cleaned := make([]entity.Sentence, 0, len(sentences))
+	for _, s := range sentences {
+		s.Text = strings.TrimSpace(s.Text)
+		s.SourceRef = strings.TrimSpace(s.SourceRef)
+		if s.Text == "" {
+			continue
+		}
+		cleaned = append(cleaned, s)
+	}
+	if len(cleaned) == 0 {
+		return nil
+	}
+	if err := entity.CheckSentenceSources(cleaned); err != nil {
+		return err
+	}
+	return u.repo.AppendSentences(ctx, wordID, cleaned)
+}
+
+// checkSourceFilter rejects sentence source filters naming unknown sources.
+func checkSourceFilter(sources []int32) error {
+	for _, source := range sources {
+		if !entity.ValidSentenceSource(source) {
+			return fmt.Errorf("%w: %d", entity.ErrInvalidSentenceSource, source)
+		}
+	}
+	return nil
+}
+
+// filterSentencesBySource drops w's sentences whose source is not listed; no sources keeps them all.
+func filterSentencesBySource(w *entity.Word, sources []int32) {
+	if w == nil || len(sources) == 0 {
+		return
+	}
+	kept := make([]entity.Sentence, 0, len(w.Sentences))
+	for _, s := range w.Sentences {
+		if lo.Contains(sources, s.Source) {
+			kept = append(kept, s)
+		}
+	}
+	w.Sentences = kept
+}
+
+// Lemmatize maps a token to its lemma. Unknown tokens are returned unchanged with an empty word type.
+func (u *wordUsecase) Lemmatize(ctx context.Context, token string, language entity.Language) (string, entity.WordType, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", "", entity.ErrInvalidVocText
+	}
+	res, err := u.lemmatize(ctx, token, language)
+	if err != nil {
+		return "", "", err
+	}
+	return res.Lemma, res.WordType, nil
+}
+
+// LemmatizeBatch lemmatizes tokens in order; repeated tokens are looked up once and blanks pass through.
+func (u *wordUsecase) LemmatizeBatch(ctx context.Context, tokens []string, language entity.Language) ([]entity.Lemmatization, error) {
+	results := make([]entity.Lemmatization, len(tokens))
+	cache := make(map[string]entity.Lemmatization, len(tokens))
+	for i, raw := range tokens {
+		token := strings.TrimSpace(raw)
+		if token == "" {
+			results[i] = entity.Lemmatization{Token: raw, Lemma: raw}
+			continue
+		}
+		res, ok := cache[token]
+		if !ok {
+			var err error
+			if res, err = u.lemmatize(ctx, token, language); err != nil {
+				return nil, err
+			}
+			cache[token] = res
+		}
+		res.Token = raw
+		results[i] = res
+	}
+	return results, nil
+}
+
+func (u *wordUsecase) lemmatize(ctx context.Context, token string, language entity.Language) (entity.Lemmatization, error) {
+	res := entity.Lemmatization{Token: token, Lemma: token}
+	language, err := u.resolveLanguage(language)
+	if err != nil {
+		return res, err
+	}
+
+	w, err := u.repo.Lookup(ctx, token, language)
+	if err != nil {
+		return res, err
+	}
+	// Tokens from running text are often capitalized; retry with the folded form.
+	if folded := strings.ToLower(token); w == nil && folded != token {
+		if w, err = u.repo.Lookup(ctx, folded, language); err != nil {
+			return res, err
+		}
+	}
+	if w == nil {
+		return res, nil
+	}
+
+	res.Found = true
+	res.WordType = w.WordType
+	res.Lemma = w.Text
+	if w.WordType != entity.WordTypeLemma && w.Lemma != nil && *w.Lemma != "" {
+		res.Lemma = *w.Lemma
+	}
+	return res, nil
+}
+
+func (u *wordUsecase) normalizeVocForUpsert(in *entity.Word) (*entity.Word, error) {
 	if in == nil {
 		return nil, errors.New("word payload required")
 	}
@@ -99,15 +775,25 @@ func normalizeVocForUpsert(in *entity.Word) (*entity.Word, error) {
 	if text == "" {
 		return nil, entity.ErrInvalidVocText
 	}
+	if err := entity.CheckText(text, u.maxTextLength); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidVocText, err)
+	}
 	out := *in
 	out.Text = text
-	if out.Language == entity.LanguageUnspecified {
-		out.Language = _defaultLanguage
+	out.Source = entity.WordSourceManual
+	language, err := u.resolveLanguage(out.Language)
+	if err != nil {
+		return nil, err
 	}
-	out.WordType = strings.TrimSpace(out.WordType)
-	if out.WordType == "" {
-		out.WordType = entity.WordTypeLemma
+	out.Language = language
+	wordType, err := entity.ParseWordType(string(out.WordType))
+	if err != nil {
+		if !u.allowCustomTypes {
+			return nil, err
+		}
+		wordType = entity.WordType(strings.TrimSpace(string(out.WordType)))
 	}
+	out.WordType = wordType
 	if out.WordType != entity.WordTypeLemma {
 		if out.Lemma == nil || strings.TrimSpace(*out.Lemma) == "" {
 			return nil, errors.New("lemma reference required for non-lemma entries")
@@ -117,6 +803,58 @@ func normalizeVocForUpsert(in *entity.Word) (*entity.Word, error) {
 	} else {
 		out.Lemma = nil
 	}
+	if err := out.NormalizePhonetics(u.strictDialects); err != nil {
+		return nil, err
+	}
+	// Definitions sent without explicit orders keep their position; explicit orders reorder them.
+	out.Definitions = entity.NormalizeDefinitionOrder(out.Definitions)
+	out.Categories = entity.NormalizeTags(out.Categories)
+	if err := entity.CheckTags(out.Categories); err != nil {
+		return nil, err
+	}
 
+	if err := checkWordLimits(&out); err != nil {
+		return nil, err
+	}
+	if err := entity.CheckSentenceSources(out.Sentences); err != nil {
+		return nil, err
+	}
+	if err := entity.CheckWordRelations(out.Relations); err != nil {
+		return nil, err
+	}
 	return &out, nil
 }
+
+// resolveLanguage defaults an unspecified language to English, or rejects it in strict mode.
+func (u *wordUsecase) resolveLanguage(language entity.Language) (entity.Language, error) {
+	if language != entity.LanguageUnspecified {
+		return language, nil
+	}
+	if u.strictLanguage {
+		return "", entity.ErrLanguageRequired
+	}
+	return _defaultLanguage, nil
+}
+
+// checkWordLimits rejects entries exceeding the per-word item counts or per-item text size.
+func checkWordLimits(w *entity.Word) error {
+	if err := checkItems("definitions", len(w.Definitions), _maxWordDefinitions, func(i int) string { return w.Definitions[i].Text }); err != nil {
+		return err
+	}
+	if err := checkItems("sentences", len(w.Sentences), _maxWordSentences, func(i int) string { return w.Sentences[i].Text }); err != nil {
+		return err
+	}
+	return checkItems("relations", len(w.Relations), _maxWordRelations, func(i int) string { return w.Relations[i].Word })
+}
+
+func checkItems(field string, n, limit int, text func(int) string) error {
+	if n > limit {
+		return fmt.Errorf("%w: %d %s exceeds limit of %d", entity.ErrWordTooLarge, n, field, limit)
+	}
+	for i := 0; i < n; i++ {
+		if size := len(text(i)); size > _maxWordItemBytes {
+			return fmt.Errorf("%w: %s[%d] is %d bytes, limit is %d", entity.ErrWordTooLarge, field, i, size, _maxWordItemBytes)
+		}
+	}
+	return nil
+}
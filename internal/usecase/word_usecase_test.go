@@ -3,10 +3,14 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/eslsoft/vocnet/internal/entity"
 	"github.com/eslsoft/vocnet/internal/repository"
+	"github.com/samber/lo"
 )
 
 // minimal in-memory mock repository for testing forms logic
@@ -15,36 +19,129 @@ type mockVocRepo struct {
 	forms        []entity.WordFormRef
 	lookupErr    error
 	listFormsErr error
+	appended     []entity.Sentence
+	words        map[string]*entity.Word // when set, Lookup resolves by text
+	lookups      int
+	batchGets    int
+	batchLookups int
+	created      []*entity.Word
+	updated      []*entity.Word
+	listed       []*repository.ListWordQuery
 }
 
 func (m *mockVocRepo) Create(ctx context.Context, word *entity.Word) (*entity.Word, error) {
-	return nil, errors.New("not implemented")
+	if m.words == nil {
+		return nil, errors.New("not implemented")
+	}
+	saved := *word
+	saved.ID = int64(len(m.words) + 1)
+	m.words[saved.Text] = &saved
+	m.created = append(m.created, &saved)
+	return &saved, nil
 }
-func (m *mockVocRepo) Update(ctx context.Context, word *entity.Word) (*entity.Word, error) {
-	return nil, errors.New("not implemented")
+func (m *mockVocRepo) Update(ctx context.Context, word *entity.Word, fields ...entity.WordField) (*entity.Word, error) {
+	if m.words == nil {
+		return nil, errors.New("not implemented")
+	}
+	saved := *word
+	m.words[saved.Text] = &saved
+	m.updated = append(m.updated, &saved)
+	return &saved, nil
 }
 func (m *mockVocRepo) GetByID(ctx context.Context, id int64) (*entity.Word, error) {
-	return nil, errors.New("not implemented")
+	if m.words == nil {
+		return nil, errors.New("not implemented")
+	}
+	for _, w := range m.words {
+		if w.ID == id {
+			return w, nil
+		}
+	}
+	return nil, entity.ErrVocNotFound
+}
+func (m *mockVocRepo) GetByIDs(ctx context.Context, ids []int64) ([]*entity.Word, error) {
+	m.batchGets++
+	var found []*entity.Word
+	for _, id := range ids {
+		if w, err := m.GetByID(ctx, id); err == nil {
+			found = append(found, w)
+		}
+	}
+	return found, nil
 }
 func (m *mockVocRepo) Lookup(ctx context.Context, text string, language entity.Language) (*entity.Word, error) {
+	m.lookups++
+	if m.words != nil {
+		return m.words[text], m.lookupErr
+	}
 	return m.word, m.lookupErr
 }
+func (m *mockVocRepo) LookupMany(ctx context.Context, texts []string, language entity.Language) ([]*entity.Word, error) {
+	m.batchLookups++
+	var found []*entity.Word
+	for _, text := range texts {
+		if w := m.words[text]; w != nil && w.Language == language {
+			found = append(found, w)
+		}
+	}
+	return found, m.lookupErr
+}
+func (m *mockVocRepo) GetByKey(ctx context.Context, language entity.Language, text string, wordType entity.WordType) (*entity.Word, error) {
+	if m.words == nil {
+		return nil, errors.New("not implemented")
+	}
+	if w := m.words[text]; w != nil && w.Language == language && w.WordType == wordType {
+		return w, nil
+	}
+	return nil, nil
+}
 func (m *mockVocRepo) List(ctx context.Context, filter *repository.ListWordQuery) ([]*entity.Word, int64, error) {
-	return nil, 0, errors.New("not implemented")
+	m.listed = append(m.listed, filter)
+	if m.words == nil {
+		return nil, 0, nil
+	}
+	keyword := strings.ToLower(strings.TrimSpace(extractKeyword(filter.Filter)))
+	var matched []*entity.Word
+	for _, w := range m.words {
+		if keyword == "" || strings.Contains(strings.ToLower(w.Text), keyword) {
+			matched = append(matched, w)
+		}
+	}
+	if err := fakeWordOrder.sort(matched, filter.OrderBy, keyword); err != nil {
+		return nil, 0, err
+	}
+	return matched, int64(len(matched)), nil
+}
+func (m *mockVocRepo) Iterate(ctx context.Context, filter *repository.ListWordQuery, fn func(*entity.Word) error) error {
+	return errors.New("not implemented")
+}
+func (m *mockVocRepo) StreamByTags(ctx context.Context, tags []string, language entity.Language, fn func(*entity.Word) error) error {
+	return errors.New("not implemented")
 }
-func (m *mockVocRepo) ListFormsByLemma(ctx context.Context, lemma string, language entity.Language) ([]entity.WordFormRef, error) {
+func (m *mockVocRepo) ListFormsByLemma(ctx context.Context, lemma string, language entity.Language, query repository.ListFormsQuery) ([]entity.WordFormRef, error) {
 	return m.forms, m.listFormsErr
 }
 func (m *mockVocRepo) Delete(ctx context.Context, id int64) error {
 	return errors.New("not implemented")
 }
+func (m *mockVocRepo) LanguageStats(ctx context.Context) ([]entity.LanguageCount, error) {
+	return nil, errors.New("not implemented")
+}
+func (m *mockVocRepo) AppendSentences(ctx context.Context, wordID int64, sentences []entity.Sentence) error {
+	if m.word == nil || m.word.ID != wordID {
+		return entity.ErrVocNotFound
+	}
+	m.appended = append(m.appended, sentences...)
+	m.word.Sentences, _ = entity.MergeSentences(m.word.Sentences, sentences)
+	return nil
+}
 
 func TestLookup_PopulatesFormsForLemma(t *testing.T) {
 	lemmaText := "run"
 	repo := &mockVocRepo{word: &entity.Word{ID: 1, Text: lemmaText, Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma}, forms: []entity.WordFormRef{{Text: "ran", WordType: "past"}, {Text: "running", WordType: "ing"}}}
 	uc := NewWordUsecase(repo)
 
-	v, err := uc.Lookup(context.Background(), lemmaText, entity.LanguageEnglish)
+	v, err := uc.Lookup(context.Background(), lemmaText, entity.LanguageEnglish, entity.LookupPreferLemma)
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
@@ -53,12 +150,79 @@ func TestLookup_PopulatesFormsForLemma(t *testing.T) {
 	}
 }
 
+func TestLookup_UnknownWordIsNotFound(t *testing.T) {
+	repo := &mockVocRepo{}
+	uc := NewWordUsecase(repo)
+
+	v, err := uc.Lookup(context.Background(), "zzyzx", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if !errors.Is(err, entity.ErrVocNotFound) {
+		t.Fatalf("expected ErrVocNotFound, got %v", err)
+	}
+	if v != nil {
+		t.Fatalf("expected nil word, got %+v", v)
+	}
+}
+
+func TestLookup_EnglishFallback(t *testing.T) {
+	words := func() map[string]*entity.Word {
+		return map[string]*entity.Word{
+			"mouse": {ID: 1, Text: "mouse", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+			"apple": {ID: 2, Text: "apple", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+		}
+	}
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name, text, want string
+	}{
+		{name: "irregular plural", text: "mice", want: "mouse"},
+		{name: "regular plural", text: "apples", want: "apple"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := NewWordUsecase(&mockVocRepo{words: words()}, WithEnglishLookupFallback())
+			v, err := uc.Lookup(ctx, tc.text, entity.LanguageEnglish, entity.LookupPreferLemma)
+			if err != nil {
+				t.Fatalf("Lookup(%q): %v", tc.text, err)
+			}
+			if v.Text != tc.want || v.FallbackFrom != tc.text {
+				t.Fatalf("Lookup(%q) = %q from %q, want %q flagged as a fallback", tc.text, v.Text, v.FallbackFrom, tc.want)
+			}
+		})
+	}
+
+	t.Run("direct match is not flagged", func(t *testing.T) {
+		uc := NewWordUsecase(&mockVocRepo{words: words()}, WithEnglishLookupFallback())
+		v, err := uc.Lookup(ctx, "mouse", entity.LanguageEnglish, entity.LookupPreferLemma)
+		if err != nil || v.FallbackFrom != "" {
+			t.Fatalf("Lookup(mouse) = %+v, %v; want a direct match", v, err)
+		}
+	})
+
+	t.Run("genuine miss", func(t *testing.T) {
+		uc := NewWordUsecase(&mockVocRepo{words: words()}, WithEnglishLookupFallback())
+		if _, err := uc.Lookup(ctx, "pears", entity.LanguageEnglish, entity.LookupPreferLemma); !errors.Is(err, entity.ErrVocNotFound) {
+			t.Fatalf("Lookup(pears) error = %v, want ErrVocNotFound", err)
+		}
+	})
+
+	t.Run("off by default and English only", func(t *testing.T) {
+		uc := NewWordUsecase(&mockVocRepo{words: words()})
+		if _, err := uc.Lookup(ctx, "mice", entity.LanguageEnglish, entity.LookupPreferLemma); !errors.Is(err, entity.ErrVocNotFound) {
+			t.Fatalf("Lookup(mice) without the option = %v, want ErrVocNotFound", err)
+		}
+		uc = NewWordUsecase(&mockVocRepo{words: words()}, WithEnglishLookupFallback())
+		if _, err := uc.Lookup(ctx, "mice", entity.LanguageFrench, entity.LookupPreferLemma); !errors.Is(err, entity.ErrVocNotFound) {
+			t.Fatalf("Lookup(mice, fr) = %v, want ErrVocNotFound", err)
+		}
+	})
+}
+
 func TestLookup_NoFormsWhenNotLemma(t *testing.T) {
 	lemmaStr := "run"
 	repo := &mockVocRepo{word: &entity.Word{ID: 2, Text: "ran", Language: entity.LanguageEnglish, WordType: "past", Lemma: &lemmaStr}, forms: []entity.WordFormRef{{Text: "ran", WordType: "past"}}}
 	uc := NewWordUsecase(repo)
 
-	v, err := uc.Lookup(context.Background(), "ran", entity.LanguageEnglish)
+	v, err := uc.Lookup(context.Background(), "ran", entity.LanguageEnglish, entity.LookupPreferExact)
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
@@ -66,3 +230,866 @@ func TestLookup_NoFormsWhenNotLemma(t *testing.T) {
 		t.Fatalf("expected 0 forms for non-lemma, got %d", len(v.Forms))
 	}
 }
+
+func TestLookupPreference(t *testing.T) {
+	goText, eat := "go", "eat"
+	newRepo := func() *mockVocRepo {
+		return &mockVocRepo{words: map[string]*entity.Word{
+			"go":   {ID: 1, Text: "go", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+			"went": {ID: 2, Text: "went", Language: entity.LanguageEnglish, WordType: entity.WordTypePast, Lemma: &goText},
+			"ate":  {ID: 3, Text: "ate", Language: entity.LanguageEnglish, WordType: entity.WordTypePast, Lemma: &eat},
+		}}
+	}
+
+	for _, tc := range []struct {
+		text         string
+		prefer       entity.LookupPreference
+		wantID       int64
+		fallbackFrom string
+	}{
+		{text: "went", prefer: "", wantID: 1, fallbackFrom: "went"},
+		{text: "went", prefer: entity.LookupPreferLemma, wantID: 1, fallbackFrom: "went"},
+		{text: "went", prefer: entity.LookupPreferExact, wantID: 2},
+		{text: "go", prefer: entity.LookupPreferLemma, wantID: 1},
+		{text: "ate", prefer: entity.LookupPreferLemma, wantID: 3}, // "eat" has no entry
+	} {
+		v, err := NewWordUsecase(newRepo()).Lookup(context.Background(), tc.text, entity.LanguageEnglish, tc.prefer)
+		if err != nil {
+			t.Fatalf("lookup %q prefer %q: %v", tc.text, tc.prefer, err)
+		}
+		if v.ID != tc.wantID || v.FallbackFrom != tc.fallbackFrom {
+			t.Fatalf("lookup %q prefer %q = id %d fallback %q, want id %d fallback %q",
+				tc.text, tc.prefer, v.ID, v.FallbackFrom, tc.wantID, tc.fallbackFrom)
+		}
+	}
+}
+
+func TestGetByIDs(t *testing.T) {
+	repo := &mockVocRepo{words: map[string]*entity.Word{
+		"apple":  {ID: 1, Text: "apple", Language: entity.LanguageEnglish},
+		"banana": {ID: 2, Text: "banana", Language: entity.LanguageEnglish},
+		"cherry": {ID: 5, Text: "cherry", Language: entity.LanguageEnglish},
+	}}
+	uc := NewWordUsecase(repo)
+
+	got, err := uc.GetByIDs(context.Background(), []int64{5, 3, 1, 42})
+	if err != nil {
+		t.Fatalf("GetByIDs: %v", err)
+	}
+	if repo.batchGets != 1 {
+		t.Fatalf("expected a single repository query, got %d", repo.batchGets)
+	}
+	if len(got) != 2 || got[1].Text != "apple" || got[5].Text != "cherry" {
+		t.Fatalf("unexpected words %v", got)
+	}
+	if _, ok := got[3]; ok {
+		t.Fatal("missing id 3 must be absent")
+	}
+
+	tooMany := make([]int64, _maxGetByIDs+1)
+	for i := range tooMany {
+		tooMany[i] = int64(i + 1)
+	}
+	for name, tc := range map[string]struct {
+		ids  []int64
+		want error
+	}{
+		"duplicate":    {ids: []int64{1, 2, 1}, want: entity.ErrInvalidVocID},
+		"non-positive": {ids: []int64{1, 0}, want: entity.ErrInvalidVocID},
+		"over limit":   {ids: tooMany, want: entity.ErrBatchTooLarge},
+	} {
+		if _, err := uc.GetByIDs(context.Background(), tc.ids); !errors.Is(err, tc.want) {
+			t.Fatalf("%s: expected %v, got %v", name, tc.want, err)
+		}
+	}
+	if repo.batchGets != 1 {
+		t.Fatalf("rejected batches must not query the repository, got %d queries", repo.batchGets)
+	}
+}
+
+func TestRelatedWords(t *testing.T) {
+	lemma := "run"
+	synonym := entity.WordRelation{Word: "sprint", RelationType: 1}
+	repo := &mockVocRepo{
+		words: map[string]*entity.Word{
+			"run":     {ID: 1, Text: "run", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma, Relations: []entity.WordRelation{synonym, synonym, {Word: "run", RelationType: 1}}},
+			"ran":     {ID: 2, Text: "ran", Language: entity.LanguageEnglish, WordType: entity.WordTypePast, Lemma: &lemma},
+			"running": {ID: 3, Text: "running", Language: entity.LanguageEnglish, WordType: entity.WordTypeIng, Lemma: &lemma, Relations: []entity.WordRelation{synonym}},
+		},
+		// The repeated form checks that refs are de-duplicated.
+		forms: []entity.WordFormRef{{Text: "ran", WordType: entity.WordTypePast}, {Text: "running", WordType: entity.WordTypeIng}, {Text: "ran", WordType: entity.WordTypePast}},
+	}
+	uc := NewWordUsecase(repo)
+
+	tests := []struct {
+		name     string
+		id       int64
+		forms    []entity.WordFormRef
+		siblings []entity.WordFormRef
+	}{
+		{
+			name:  "lemma",
+			id:    1,
+			forms: []entity.WordFormRef{{Text: "ran", WordType: entity.WordTypePast}, {Text: "running", WordType: entity.WordTypeIng}},
+		},
+		{
+			name:     "form",
+			id:       3,
+			siblings: []entity.WordFormRef{{Text: "run", WordType: entity.WordTypeLemma}, {Text: "ran", WordType: entity.WordTypePast}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := uc.RelatedWords(context.Background(), tt.id)
+			if err != nil {
+				t.Fatalf("RelatedWords: %v", err)
+			}
+			if got.Word == nil || got.Word.ID != tt.id {
+				t.Fatalf("expected word %d, got %+v", tt.id, got.Word)
+			}
+			if got.Lemma != lemma {
+				t.Fatalf("expected lemma %q, got %q", lemma, got.Lemma)
+			}
+			if len(got.Forms) != len(tt.forms) || (len(tt.forms) > 0 && !reflect.DeepEqual(got.Forms, tt.forms)) {
+				t.Fatalf("forms = %+v, want %+v", got.Forms, tt.forms)
+			}
+			if len(got.Siblings) != len(tt.siblings) || (len(tt.siblings) > 0 && !reflect.DeepEqual(got.Siblings, tt.siblings)) {
+				t.Fatalf("siblings = %+v, want %+v", got.Siblings, tt.siblings)
+			}
+			if want := []entity.WordRelation{synonym}; !reflect.DeepEqual(got.Relations, want) {
+				t.Fatalf("relations = %+v, want %+v", got.Relations, want)
+			}
+		})
+	}
+
+	if _, err := uc.RelatedWords(context.Background(), 0); !errors.Is(err, entity.ErrInvalidVocID) {
+		t.Fatalf("expected ErrInvalidVocID, got %v", err)
+	}
+	if _, err := uc.RelatedWords(context.Background(), 99); !errors.Is(err, entity.ErrVocNotFound) {
+		t.Fatalf("expected ErrVocNotFound, got %v", err)
+	}
+}
+
+// fakeWordAuditRepo records audited updates by delegating the write to a mockVocRepo.
+type fakeWordAuditRepo struct {
+	words     *mockVocRepo
+	editors   []string
+	lastLimit int
+}
+
+func (f *fakeWordAuditRepo) UpdateAudited(ctx context.Context, word *entity.Word, editor string, fields ...entity.WordField) (*entity.Word, error) {
+	f.editors = append(f.editors, editor)
+	return f.words.Update(ctx, word, fields...)
+}
+
+func (f *fakeWordAuditRepo) ListByWord(ctx context.Context, wordID int64, limit int) ([]*entity.WordAudit, error) {
+	f.lastLimit = limit
+	return []*entity.WordAudit{{WordID: wordID}}, nil
+}
+
+func TestLookup_PreferDialects(t *testing.T) {
+	phonetic := func(dialect string) entity.WordPhonetic {
+		return entity.WordPhonetic{IPA: "/" + dialect + "/", Dialect: dialect}
+	}
+	for _, tc := range []struct {
+		name      string
+		available []string
+		requested string
+		fallback  []string // nil keeps the default chain
+		want      []string
+	}{
+		{name: "default chain", available: []string{"", "en-AU", "en-GB", "en-US"}, want: []string{"en-US", "en-GB", "", "en-AU"}},
+		{name: "requested first", available: []string{"en-US", "en-GB", "en-AU"}, requested: "en-AU", want: []string{"en-AU", "en-US", "en-GB"}},
+		{name: "requested missing falls back", available: []string{"en-GB", "en-AU"}, requested: "en-US", want: []string{"en-GB", "en-AU"}},
+		{name: "configured chain", available: []string{"en-US", "en-AU", "en-GB"}, fallback: []string{"en-GB", "en-AU"}, want: []string{"en-GB", "en-AU", "en-US"}},
+		{name: "nothing preferred keeps order", available: []string{"en-AU", ""}, fallback: []string{}, want: []string{"en-AU", ""}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			word := &entity.Word{ID: 1, Text: "tomato", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma}
+			for _, dialect := range tc.available {
+				word.Phonetics = append(word.Phonetics, phonetic(dialect))
+			}
+			var opts []WordUsecaseOption
+			if tc.fallback != nil {
+				opts = append(opts, WithDialectFallback(tc.fallback...))
+			}
+			ctx := context.Background()
+			if tc.requested != "" {
+				ctx = entity.WithDialect(ctx, tc.requested)
+			}
+
+			got, err := NewWordUsecase(&mockVocRepo{word: word}, opts...).Lookup(ctx, "tomato", entity.LanguageEnglish, entity.LookupPreferLemma)
+			if err != nil {
+				t.Fatalf("Lookup: %v", err)
+			}
+			dialects := lo.Map(got.Phonetics, func(p entity.WordPhonetic, _ int) string { return p.Dialect })
+			if !reflect.DeepEqual(dialects, tc.want) {
+				t.Fatalf("phonetic dialects = %q, want %q", dialects, tc.want)
+			}
+		})
+	}
+}
+
+func TestLookupWithUserState(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockVocRepo{words: map[string]*entity.Word{
+		"apple": {ID: 1, Text: "apple", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+		"pear":  {ID: 2, Text: "pear", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+	}}
+	if _, _, err := NewWordUsecase(repo).LookupWithUserState(ctx, 7, "apple", entity.LanguageEnglish, entity.LookupPreferLemma); err == nil {
+		t.Fatal("expected an error without a learned lexeme repository")
+	}
+
+	learned := newFakeLearnedLexemeRepo()
+	learned.items[1] = &entity.LearnedLexeme{ID: 1, UserID: 7, Term: "Apple", Language: entity.LanguageEnglish, Mastery: entity.MasteryBreakdown{Overall: 250}}
+	learned.items[2] = &entity.LearnedLexeme{ID: 2, UserID: 8, Term: "pear", Language: entity.LanguageEnglish}
+	uc := NewWordUsecase(repo, WithLearnedLexemes(learned))
+
+	word, state, err := uc.LookupWithUserState(ctx, 7, "apple", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup collected word: %v", err)
+	}
+	if word.ID != 1 || state == nil || state.ID != 1 || state.Mastery.Overall != 250 {
+		t.Fatalf("collected word = %+v with state %+v, want the user's lexeme", word, state)
+	}
+
+	word, state, err = uc.LookupWithUserState(ctx, 7, "pear", entity.LanguageEnglish, entity.LookupPreferLemma)
+	if err != nil {
+		t.Fatalf("lookup uncollected word: %v", err)
+	}
+	if word.ID != 2 || state != nil {
+		t.Fatalf("uncollected word = %+v with state %+v, want no learned state", word, state)
+	}
+
+	if _, _, err := uc.LookupWithUserState(ctx, 7, "quince", entity.LanguageEnglish, entity.LookupPreferLemma); !errors.Is(err, entity.ErrVocNotFound) {
+		t.Fatalf("missing word error = %v, want ErrVocNotFound", err)
+	}
+}
+
+func TestUpdate_Audit(t *testing.T) {
+	repo := &mockVocRepo{words: map[string]*entity.Word{
+		"run": {ID: 1, Text: "run", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+	}}
+	if _, err := NewWordUsecase(repo).ListWordAudit(context.Background(), 1, 0); !errors.Is(err, entity.ErrWordAuditDisabled) {
+		t.Fatalf("ListWordAudit without auditing = %v, want ErrWordAuditDisabled", err)
+	}
+
+	audits := &fakeWordAuditRepo{words: repo}
+	uc := NewWordUsecase(repo, WithWordAudit(audits))
+	ctx := entity.WithEditor(context.Background(), "curator")
+	if _, err := uc.Update(ctx, &entity.Word{ID: 1, Categories: []string{"cet4"}}, entity.WordFieldCategories); err != nil {
+		t.Fatalf("partial Update: %v", err)
+	}
+	if _, err := uc.Update(context.Background(), &entity.Word{ID: 1, Text: "run"}); err != nil {
+		t.Fatalf("full Update: %v", err)
+	}
+	if !reflect.DeepEqual(audits.editors, []string{"curator", ""}) || len(repo.updated) != 2 {
+		t.Fatalf("audited editors = %q, repository updates = %d", audits.editors, len(repo.updated))
+	}
+
+	listed, err := uc.ListWordAudit(ctx, 1, 0)
+	if err != nil || len(listed) != 1 || audits.lastLimit != int(_defaultLimit) {
+		t.Fatalf("ListWordAudit = %+v, %v with limit %d; want the default limit applied", listed, err, audits.lastLimit)
+	}
+	if _, err := uc.ListWordAudit(ctx, 0, 0); !errors.Is(err, entity.ErrInvalidVocID) {
+		t.Fatalf("ListWordAudit(0) = %v, want ErrInvalidVocID", err)
+	}
+}
+
+func TestUpdate_KeepsStoredLanguage(t *testing.T) {
+	repo := &mockVocRepo{words: map[string]*entity.Word{
+		"laufen": {ID: 1, Text: "laufen", Language: entity.LanguageGerman, WordType: entity.WordTypeLemma},
+	}}
+	for _, opts := range [][]WordUsecaseOption{nil, {WithStrictLanguage()}} {
+		updated, err := NewWordUsecase(repo, opts...).Update(context.Background(), &entity.Word{ID: 1, Text: "laufen"})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if updated.Language != entity.LanguageGerman {
+			t.Fatalf("language = %q, want the stored %q", updated.Language, entity.LanguageGerman)
+		}
+	}
+}
+
+func TestUpdate_ReordersDefinitions(t *testing.T) {
+	repo := &mockVocRepo{words: map[string]*entity.Word{
+		"run": {ID: 1, Text: "run", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma, Definitions: []entity.WordDefinition{
+			{Text: "move fast", Order: 1},
+			{Text: "operate", Order: 2},
+			{Text: "manage", Order: 3},
+		}},
+	}}
+	uc := NewWordUsecase(repo)
+	ctx := context.Background()
+
+	// Promote "manage" to the primary definition; the others keep their relative order.
+	updated, err := uc.Update(ctx, &entity.Word{ID: 1, Definitions: []entity.WordDefinition{
+		{Text: "move fast", Order: 2},
+		{Text: "operate", Order: 3},
+		{Text: "manage", Order: 1},
+	}}, entity.WordFieldDefinitions)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+	want := []entity.WordDefinition{{Text: "manage", Order: 1}, {Text: "move fast", Order: 2}, {Text: "operate", Order: 3}}
+	if !reflect.DeepEqual(updated.Definitions, want) {
+		t.Fatalf("definitions = %+v, want %+v", updated.Definitions, want)
+	}
+
+	// Without explicit orders the list position wins and orders are filled in densely.
+	created, err := uc.Create(ctx, &entity.Word{Text: "walk", Definitions: []entity.WordDefinition{{Text: "go on foot"}, {Text: "accompany", Order: 0}}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if got := []int{created.Definitions[0].Order, created.Definitions[1].Order}; !reflect.DeepEqual(got, []int{1, 2}) || created.Definitions[0].Text != "go on foot" {
+		t.Fatalf("default orders = %+v", created.Definitions)
+	}
+}
+
+func TestStrictLanguage(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []WordUsecaseOption
+		wantErr error
+	}{
+		{name: "defaults to english"},
+		{name: "strict rejects", opts: []WordUsecaseOption{WithStrictLanguage()}, wantErr: entity.ErrLanguageRequired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockVocRepo{words: map[string]*entity.Word{}}
+			uc := NewWordUsecase(repo, tt.opts...)
+			ctx := context.Background()
+
+			created, err := uc.Create(ctx, &entity.Word{Text: "apple"})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && created.Language != entity.LanguageEnglish {
+				t.Fatalf("Create language = %q, want %q", created.Language, entity.LanguageEnglish)
+			}
+
+			if _, err := uc.Lookup(ctx, "apple", entity.LanguageUnspecified, entity.LookupPreferLemma); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Lookup error = %v, want %v", err, tt.wantErr)
+			}
+			if _, _, err := uc.Lemmatize(ctx, "apple", entity.LanguageUnspecified); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Lemmatize error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && repo.lookups != 0 {
+				t.Fatalf("strict mode must reject before querying, got %d lookups", repo.lookups)
+			}
+
+			// An explicit language always works.
+			if _, err := uc.Create(ctx, &entity.Word{Text: "pomme", Language: entity.LanguageFrench}); err != nil {
+				t.Fatalf("Create with language: %v", err)
+			}
+			if _, err := uc.Lookup(ctx, "pomme", entity.LanguageFrench, entity.LookupPreferLemma); err != nil {
+				t.Fatalf("Lookup with language: %v", err)
+			}
+		})
+	}
+}
+
+func TestAppendSentences_MergesAndDedupes(t *testing.T) {
+	repo := &mockVocRepo{word: &entity.Word{
+		ID:        7,
+		Text:      "run",
+		Sentences: []entity.Sentence{{Text: "I run daily.", Source: 1}},
+	}}
+	uc := NewWordUsecase(repo)
+
+	err := uc.AppendSentences(context.Background(), 7, []entity.Sentence{
+		{Text: "  I run daily.  ", Source: 1},
+		{Text: "I run daily.", Source: 2, SourceRef: " web "},
+		{Text: "   "},
+		{Text: "They run fast.", Source: 1},
+		{Text: "They run fast.", Source: 1},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.appended) != 4 {
+		t.Fatalf("expected blank sentence dropped before repo, got %d", len(repo.appended))
+	}
+
+	want := []entity.Sentence{
+		{Text: "I run daily.", Source: 1},
+		{Text: "I run daily.", Source: 2, SourceRef: "web"},
+		{Text: "They run fast.", Source: 1},
+	}
+	if len(repo.word.Sentences) != len(want) {
+		t.Fatalf("expected %d sentences, got %+v", len(want), repo.word.Sentences)
+	}
+	for i, s := range want {
+		if repo.word.Sentences[i] != s {
+			t.Fatalf("sentence %d = %+v, want %+v", i, repo.word.Sentences[i], s)
+		}
+	}
+}
+
+func TestAppendSentences_Validation(t *testing.T) {
+	repo := &mockVocRepo{}
+	uc := NewWordUsecase(repo)
+
+	if err := uc.AppendSentences(context.Background(), 0, []entity.Sentence{{Text: "x"}}); !errors.Is(err, entity.ErrInvalidVocID) {
+		t.Fatalf("expected ErrInvalidVocID, got %v", err)
+	}
+	if err := uc.AppendSentences(context.Background(), 3, []entity.Sentence{{Text: " "}}); err != nil {
+		t.Fatalf("expected no-op for empty input, got %v", err)
+	}
+	if len(repo.appended) != 0 {
+		t.Fatalf("repository should not be called for empty input")
+	}
+}
+
+func TestSentenceSourceValidation(t *testing.T) {
+	ctx := context.Background()
+	repo := &mockVocRepo{word: &entity.Word{ID: 3, Text: "run"}, words: map[string]*entity.Word{}}
+	uc := NewWordUsecase(repo)
+
+	bad := []entity.Sentence{{Text: "ok", Source: entity.SentenceSourceWeb}, {Text: "bad", Source: 6}}
+	if err := uc.AppendSentences(ctx, 3, bad); !errors.Is(err, entity.ErrInvalidSentenceSource) {
+		t.Fatalf("AppendSentences: expected ErrInvalidSentenceSource, got %v", err)
+	}
+	if len(repo.appended) != 0 {
+		t.Fatalf("repository should not be called with an unknown source")
+	}
+	if _, err := uc.Create(ctx, &entity.Word{Text: "walk", Sentences: bad}); !errors.Is(err, entity.ErrInvalidSentenceSource) {
+		t.Fatalf("Create: expected ErrInvalidSentenceSource, got %v", err)
+	}
+	if _, err := uc.Create(ctx, &entity.Word{Text: "walk", Sentences: bad[:1]}); err != nil {
+		t.Fatalf("Create with known source: %v", err)
+	}
+}
+
+func TestLookupFiltersSentencesBySource(t *testing.T) {
+	ctx := context.Background()
+	newRepo := func() *mockVocRepo {
+		return &mockVocRepo{word: &entity.Word{ID: 1, Text: "run", Sentences: []entity.Sentence{
+			{Text: "from a book", Source: entity.SentenceSourceBook},
+			{Text: "typed in", Source: entity.SentenceSourceManual},
+			{Text: "no source"},
+		}}}
+	}
+
+	tests := []struct {
+		name    string
+		sources []int32
+		want    []string
+	}{
+		{name: "no filter", want: []string{"from a book", "typed in", "no source"}},
+		{name: "manual", sources: []int32{entity.SentenceSourceManual}, want: []string{"typed in"}},
+		{name: "book and unspecified", sources: []int32{entity.SentenceSourceBook, entity.SentenceSourceUnspecified}, want: []string{"from a book", "no source"}},
+		{name: "no match", sources: []int32{entity.SentenceSourceVideo}, want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := NewWordUsecase(newRepo()).Lookup(ctx, "run", entity.LanguageEnglish, entity.LookupPreferLemma, tt.sources...)
+			if err != nil {
+				t.Fatalf("Lookup: %v", err)
+			}
+			got := lo.Map(w.Sentences, func(s entity.Sentence, _ int) string { return s.Text })
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("sentences = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	repo := newRepo()
+	if _, err := NewWordUsecase(repo).Lookup(ctx, "run", entity.LanguageEnglish, entity.LookupPreferLemma, 42); !errors.Is(err, entity.ErrInvalidSentenceSource) {
+		t.Fatalf("expected ErrInvalidSentenceSource, got %v", err)
+	}
+	if repo.lookups != 0 {
+		t.Fatalf("repository should not be called with an unknown source filter")
+	}
+}
+
+func TestMergeSentences(t *testing.T) {
+	existing := []entity.Sentence{{Text: "a", Source: 1}, {Text: "b", Source: 2}}
+	merged, added := entity.MergeSentences(existing, []entity.Sentence{
+		{Text: "a", Source: 1},
+		{Text: "a", Source: 3},
+		{Text: " b ", Source: 2},
+		{Text: "c"},
+	})
+	if added != 2 {
+		t.Fatalf("expected 2 added, got %d", added)
+	}
+	got := make([]string, 0, len(merged))
+	for _, s := range merged {
+		got = append(got, s.Text)
+	}
+	if len(got) != 4 || got[2] != "a" || merged[2].Source != 3 || got[3] != "c" {
+		t.Fatalf("unexpected merge result: %+v", merged)
+	}
+	if len(existing) != 2 {
+		t.Fatalf("existing slice must not be modified")
+	}
+}
+
+func newLemmaRepo() *mockVocRepo {
+	run := "run"
+	return &mockVocRepo{words: map[string]*entity.Word{
+		"run":     {ID: 1, Text: "run", Language: entity.LanguageEnglish, WordType: entity.WordTypeLemma},
+		"running": {ID: 2, Text: "running", Language: entity.LanguageEnglish, WordType: "ing", Lemma: &run},
+		"ran":     {ID: 3, Text: "ran", Language: entity.LanguageEnglish, WordType: "past", Lemma: &run},
+	}}
+}
+
+func TestLemmatize(t *testing.T) {
+	uc := NewWordUsecase(newLemmaRepo())
+
+	tests := []struct {
+		token        string
+		wantLemma    string
+		wantWordType entity.WordType
+	}{
+		{token: "run", wantLemma: "run", wantWordType: entity.WordTypeLemma},
+		{token: "running", wantLemma: "run", wantWordType: "ing"},
+		{token: " Ran ", wantLemma: "run", wantWordType: "past"},
+		{token: "zzyzx", wantLemma: "zzyzx", wantWordType: ""},
+	}
+	for _, tt := range tests {
+		lemma, wordType, err := uc.Lemmatize(context.Background(), tt.token, entity.LanguageUnspecified)
+		if err != nil {
+			t.Fatalf("Lemmatize(%q) unexpected error: %v", tt.token, err)
+		}
+		if lemma != tt.wantLemma || wordType != tt.wantWordType {
+			t.Fatalf("Lemmatize(%q) = (%q, %q), want (%q, %q)", tt.token, lemma, wordType, tt.wantLemma, tt.wantWordType)
+		}
+	}
+
+	if _, _, err := uc.Lemmatize(context.Background(), "  ", entity.LanguageEnglish); !errors.Is(err, entity.ErrInvalidVocText) {
+		t.Fatalf("expected ErrInvalidVocText for blank token, got %v", err)
+	}
+}
+
+func TestLemmatizeBatch(t *testing.T) {
+	repo := newLemmaRepo()
+	uc := NewWordUsecase(repo)
+
+	got, err := uc.LemmatizeBatch(context.Background(), []string{"ran", "unknown", "ran", "", "run"}, entity.LanguageEnglish)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []entity.Lemmatization{
+		{Token: "ran", Lemma: "run", WordType: "past", Found: true},
+		{Token: "unknown", Lemma: "unknown"},
+		{Token: "ran", Lemma: "run", WordType: "past", Found: true},
+		{Token: "", Lemma: ""},
+		{Token: "run", Lemma: "run", WordType: entity.WordTypeLemma, Found: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d results, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("result %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if repo.lookups != 3 {
+		t.Fatalf("expected repeated tokens to be looked up once (3 lookups), got %d", repo.lookups)
+	}
+}
+
+func TestLemmatizeBatch_PropagatesRepoError(t *testing.T) {
+	repo := newLemmaRepo()
+	repo.lookupErr = errors.New("db down")
+	uc := NewWordUsecase(repo)
+
+	if _, err := uc.LemmatizeBatch(context.Background(), []string{"run"}, entity.LanguageEnglish); err == nil {
+		t.Fatal("expected repository error")
+	}
+}
+
+func TestCreate_TextValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		maxLength int
+		wantErr   string // empty means the word is accepted
+	}{
+		{name: "valid", text: "run"},
+		{name: "valid multibyte at limit", text: "走走走", maxLength: 3},
+		{name: "surrounding whitespace trimmed", text: "  run\n", maxLength: 3},
+		{name: "over length", text: strings.Repeat("a", entity.DefaultMaxTextLength+1), wantErr: "257 characters, limit is 256"},
+		{name: "custom limit", text: "running", maxLength: 3, wantErr: "limit is 3"},
+		{name: "limit disabled", text: strings.Repeat("a", 10000), maxLength: -1},
+		{name: "control character", text: "ru\x00n", wantErr: "control character U+0000"},
+		{name: "embedded newline", text: "run\nrun", wantErr: "control character U+000A"},
+		{name: "invalid utf-8", text: "ru\xffn", wantErr: "not valid UTF-8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []WordUsecaseOption
+			if tt.maxLength != 0 {
+				opts = append(opts, WithMaxTextLength(max(tt.maxLength, 0)))
+			}
+			repo := &mockVocRepo{words: map[string]*entity.Word{}}
+			_, err := NewWordUsecase(repo, opts...).Create(context.Background(), &entity.Word{Text: tt.text})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Create: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, entity.ErrInvalidVocText) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Create error = %v, want ErrInvalidVocText mentioning %q", err, tt.wantErr)
+			}
+			if len(repo.created) != 0 {
+				t.Fatalf("invalid word reached the repository: %+v", repo.created)
+			}
+		})
+	}
+}
+
+func TestCreate_LemmaReference(t *testing.T) {
+	ctx := context.Background()
+	form := func(text, lemma string) *entity.Word {
+		return &entity.Word{Text: text, Language: entity.LanguageEnglish, WordType: "past", Lemma: &lemma}
+	}
+
+	t.Run("present lemma", func(t *testing.T) {
+		repo := newLemmaRepo()
+		uc := NewWordUsecase(repo)
+		if _, err := uc.Create(ctx, form("runned", "run")); err != nil {
+			t.Fatalf("Create unexpected error: %v", err)
+		}
+		if len(repo.created) != 1 || repo.created[0].Text != "runned" {
+			t.Fatalf("expected only the form to be created, got %+v", repo.created)
+		}
+	})
+
+	t.Run("missing lemma", func(t *testing.T) {
+		repo := newLemmaRepo()
+		uc := NewWordUsecase(repo)
+		_, err := uc.Create(ctx, form("went", "go"))
+		if !errors.Is(err, entity.ErrLemmaNotFound) {
+			t.Fatalf("expected ErrLemmaNotFound, got %v", err)
+		}
+		if len(repo.created) != 0 {
+			t.Fatalf("expected nothing to be created, got %+v", repo.created)
+		}
+
+		update := form("ran", "rnu")
+		update.ID = 3
+		_, err = uc.Update(ctx, update)
+		if !errors.Is(err, entity.ErrLemmaNotFound) {
+			t.Fatalf("expected ErrLemmaNotFound on update, got %v", err)
+		}
+		if len(repo.updated) != 0 {
+			t.Fatalf("expected no update, got %+v", repo.updated)
+		}
+	})
+
+	t.Run("auto-create lemma", func(t *testing.T) {
+		repo := newLemmaRepo()
+		uc := NewWordUsecase(repo, WithAutoCreateLemma())
+		created, err := uc.Create(ctx, form("went", "go"))
+		if err != nil {
+			t.Fatalf("Create unexpected error: %v", err)
+		}
+		if created.Text != "went" {
+			t.Fatalf("expected form to be returned, got %+v", created)
+		}
+		if len(repo.created) != 2 {
+			t.Fatalf("expected stub lemma and form to be created, got %+v", repo.created)
+		}
+		stub := repo.created[0]
+		if stub.Text != "go" || stub.WordType != entity.WordTypeLemma || stub.Lemma != nil || stub.Language != entity.LanguageEnglish {
+			t.Fatalf("unexpected stub lemma: %+v", stub)
+		}
+	})
+}
+
+func TestCreate_RejectsOversizedPayloadBeforeRepo(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]*entity.Word{
+		"too many sentences":   {Text: "big", Sentences: make([]entity.Sentence, _maxWordSentences+1)},
+		"too many definitions": {Text: "big", Definitions: make([]entity.WordDefinition, _maxWordDefinitions+1)},
+		"too many relations":   {Text: "big", Relations: make([]entity.WordRelation, _maxWordRelations+1)},
+		"oversized definition": {Text: "big", Definitions: []entity.WordDefinition{{Text: strings.Repeat("x", _maxWordItemBytes+1)}}},
+	}
+	for name, word := range tests {
+		t.Run(name, func(t *testing.T) {
+			repo := newLemmaRepo()
+			uc := NewWordUsecase(repo)
+			if _, err := uc.Create(ctx, word); !errors.Is(err, entity.ErrWordTooLarge) {
+				t.Fatalf("expected ErrWordTooLarge, got %v", err)
+			}
+			if repo.lookups != 0 || len(repo.created) != 0 {
+				t.Fatalf("repository must not be touched: lookups=%d created=%d", repo.lookups, len(repo.created))
+			}
+		})
+	}
+
+	repo := newLemmaRepo()
+	uc := NewWordUsecase(repo)
+	_, err := uc.Update(ctx, &entity.Word{ID: 1, Sentences: make([]entity.Sentence, _maxWordSentences+1)}, entity.WordFieldSentences)
+	if !errors.Is(err, entity.ErrWordTooLarge) {
+		t.Fatalf("expected ErrWordTooLarge on partial update, got %v", err)
+	}
+	if len(repo.updated) != 0 {
+		t.Fatalf("repository must not be touched, got %d updates", len(repo.updated))
+	}
+}
+
+func TestCreate_RelationTypeValidation(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		relationType entity.RelationType
+		valid        bool
+	}{
+		{relationType: entity.RelationTypeUnspecified, valid: true},
+		{relationType: entity.RelationTypeSynonym, valid: true},
+		{relationType: entity.RelationTypePartWhole, valid: true},
+		{relationType: 8},
+		{relationType: entity.RelationTypeMnemonic, valid: true},
+		{relationType: entity.RelationTypeCustom, valid: true},
+		{relationType: 101},
+		{relationType: 999},
+		{relationType: -1},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprint(tc.relationType), func(t *testing.T) {
+			repo := newLemmaRepo()
+			word := &entity.Word{Text: "walk", Language: entity.LanguageEnglish, Relations: []entity.WordRelation{{Word: "stroll", RelationType: tc.relationType}}}
+			_, err := NewWordUsecase(repo).Create(ctx, word)
+			if tc.valid {
+				if err != nil || len(repo.created) != 1 {
+					t.Fatalf("Create: err=%v created=%d", err, len(repo.created))
+				}
+				return
+			}
+			if !errors.Is(err, entity.ErrInvalidRelationType) {
+				t.Fatalf("expected ErrInvalidRelationType, got %v", err)
+			}
+			if len(repo.created) != 0 {
+				t.Fatalf("expected nothing to be created, got %+v", repo.created)
+			}
+		})
+	}
+
+	repo := newLemmaRepo()
+	_, err := NewWordUsecase(repo).Update(ctx, &entity.Word{ID: 1, Relations: []entity.WordRelation{{Word: "stroll", RelationType: 999}}}, entity.WordFieldRelations)
+	if !errors.Is(err, entity.ErrInvalidRelationType) {
+		t.Fatalf("expected ErrInvalidRelationType on partial update, got %v", err)
+	}
+}
+
+func TestCreate_WordTypeValidation(t *testing.T) {
+	ctx := context.Background()
+	form := func(wordType string) *entity.Word {
+		lemma := "run"
+		return &entity.Word{Text: "runs", Language: entity.LanguageEnglish, WordType: entity.WordType(wordType), Lemma: &lemma}
+	}
+
+	tests := []struct {
+		name     string
+		wordType string
+		want     entity.WordType
+	}{
+		{name: "known", wordType: "3sg", want: entity.WordType3SG},
+		{name: "case and spaces", wordType: " Plural ", want: entity.WordTypePlural},
+		{name: "alias", wordType: "past participle", want: entity.WordTypePP},
+		{name: "alias gerund", wordType: "gerund", want: entity.WordTypeIng},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := newLemmaRepo()
+			if _, err := NewWordUsecase(repo).Create(ctx, form(tc.wordType)); err != nil {
+				t.Fatalf("Create(%q) unexpected error: %v", tc.wordType, err)
+			}
+			if len(repo.created) != 1 || repo.created[0].WordType != tc.want {
+				t.Fatalf("Create(%q) stored %+v, want word_type %q", tc.wordType, repo.created, tc.want)
+			}
+		})
+	}
+
+	t.Run("empty defaults to lemma", func(t *testing.T) {
+		repo := newLemmaRepo()
+		if _, err := NewWordUsecase(repo).Create(ctx, &entity.Word{Text: "walk", Language: entity.LanguageEnglish}); err != nil {
+			t.Fatalf("Create unexpected error: %v", err)
+		}
+		if len(repo.created) != 1 || repo.created[0].WordType != entity.WordTypeLemma {
+			t.Fatalf("expected lemma word type, got %+v", repo.created)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		repo := newLemmaRepo()
+		_, err := NewWordUsecase(repo).Create(ctx, form("pural"))
+		if !errors.Is(err, entity.ErrInvalidWordType) {
+			t.Fatalf("expected ErrInvalidWordType, got %v", err)
+		}
+		if len(repo.created) != 0 {
+			t.Fatalf("expected nothing to be created, got %+v", repo.created)
+		}
+	})
+
+	t.Run("custom allowed", func(t *testing.T) {
+		repo := newLemmaRepo()
+		if _, err := NewWordUsecase(repo, WithCustomWordTypes()).Create(ctx, form(" dialectal ")); err != nil {
+			t.Fatalf("Create unexpected error: %v", err)
+		}
+		if len(repo.created) != 1 || repo.created[0].WordType != "dialectal" {
+			t.Fatalf("expected custom word type to be kept, got %+v", repo.created)
+		}
+	})
+}
+
+func TestCreate_NormalizesPhonetics(t *testing.T) {
+	ctx := context.Background()
+	word := func(phonetics ...entity.WordPhonetic) *entity.Word {
+		return &entity.Word{Text: "tomato", Language: entity.LanguageEnglish, Phonetics: phonetics}
+	}
+
+	t.Run("dedupe and canonicalize", func(t *testing.T) {
+		repo := newLemmaRepo()
+		_, err := NewWordUsecase(repo).Create(ctx, word(
+			entity.WordPhonetic{IPA: " təˈmeɪtoʊ ", Dialect: "us"},
+			entity.WordPhonetic{IPA: "təˈmeɪtoʊ", Dialect: "en-US"},
+			entity.WordPhonetic{IPA: "təˈmɑːtəʊ", Dialect: "UK"},
+			entity.WordPhonetic{IPA: "təˈmɑːtəʊ", Dialect: "en_gb"},
+			entity.WordPhonetic{IPA: "təˈmɑːtəʊ"},
+			entity.WordPhonetic{IPA: "  ", Dialect: "us"},
+		))
+		if err != nil {
+			t.Fatalf("Create unexpected error: %v", err)
+		}
+		want := []entity.WordPhonetic{
+			{IPA: "təˈmeɪtoʊ", Dialect: entity.DialectUS},
+			{IPA: "təˈmɑːtəʊ", Dialect: entity.DialectGB},
+			{IPA: "təˈmɑːtəʊ"},
+		}
+		if len(repo.created) != 1 || !reflect.DeepEqual(repo.created[0].Phonetics, want) {
+			t.Fatalf("phonetics = %+v, want %+v", repo.created, want)
+		}
+	})
+
+	t.Run("unknown dialect cleared", func(t *testing.T) {
+		repo := newLemmaRepo()
+		_, err := NewWordUsecase(repo).Create(ctx, word(
+			entity.WordPhonetic{IPA: "təˈmɑːtəʊ", Dialect: "klingon"},
+			entity.WordPhonetic{IPA: "təˈmɑːtəʊ"},
+		))
+		if err != nil {
+			t.Fatalf("Create unexpected error: %v", err)
+		}
+		want := []entity.WordPhonetic{{IPA: "təˈmɑːtəʊ"}}
+		if len(repo.created) != 1 || !reflect.DeepEqual(repo.created[0].Phonetics, want) {
+			t.Fatalf("phonetics = %+v, want %+v", repo.created, want)
+		}
+	})
+
+	t.Run("unknown dialect rejected when strict", func(t *testing.T) {
+		repo := newLemmaRepo()
+		_, err := NewWordUsecase(repo, WithStrictDialects()).Create(ctx, word(entity.WordPhonetic{IPA: "təˈmɑːtəʊ", Dialect: "klingon"}))
+		if !errors.Is(err, entity.ErrInvalidDialect) {
+			t.Fatalf("expected ErrInvalidDialect, got %v", err)
+		}
+		if len(repo.created) != 0 {
+			t.Fatalf("nothing should be stored, got %+v", repo.created)
+		}
+	})
+}
@@ -322,8 +322,10 @@ func (x *PaginationRequest) GetPageSize() int32 {
 // Pagination response metadata
 type PaginationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                 // Total number of items
-	PageNo        int32                  `protobuf:"varint,2,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"` // Current page number (calculated from offset/limit)
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`                                  // Total number of items
+	PageNo        int32                  `protobuf:"varint,2,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"`                  // Current page number (calculated from offset/limit)
+	TotalPages    int32                  `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`      // Number of pages for the current page size (0 when there are no items)
+	HasNextPage   bool                   `protobuf:"varint,4,opt,name=has_next_page,json=hasNextPage,proto3" json:"has_next_page,omitempty"` // Whether a page after page_no exists
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -372,6 +374,81 @@ func (x *PaginationResponse) GetPageNo() int32 {
 	return 0
 }
 
+func (x *PaginationResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *PaginationResponse) GetHasNextPage() bool {
+	if x != nil {
+		return x.HasNextPage
+	}
+	return false
+}
+
+// BatchItemError reports one failed item of a batch request; items without an entry succeeded
+type BatchItemError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`    // position of the item in the request
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`       // gRPC status code name, e.g. "NotFound"
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // why the item failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchItemError) Reset() {
+	*x = BatchItemError{}
+	mi := &file_common_v1_types_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemError) ProtoMessage() {}
+
+func (x *BatchItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_common_v1_types_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemError.ProtoReflect.Descriptor instead.
+func (*BatchItemError) Descriptor() ([]byte, []int) {
+	return file_common_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchItemError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BatchItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_common_v1_types_proto protoreflect.FileDescriptor
 
 const file_common_v1_types_proto_rawDesc = "" +
@@ -381,10 +458,17 @@ const file_common_v1_types_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"I\n" +
 	"\x11PaginationRequest\x12\x17\n" +
 	"\apage_no\x18\x01 \x01(\x05R\x06pageNo\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"C\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x88\x01\n" +
 	"\x12PaginationResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x17\n" +
-	"\apage_no\x18\x02 \x01(\x05R\x06pageNo*\xbc\x01\n" +
+	"\apage_no\x18\x02 \x01(\x05R\x06pageNo\x12\x1f\n" +
+	"\vtotal_pages\x18\x03 \x01(\x05R\n" +
+	"totalPages\x12\"\n" +
+	"\rhas_next_page\x18\x04 \x01(\bR\vhasNextPage\"T\n" +
+	"\x0eBatchItemError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*\xbc\x01\n" +
 	"\bLanguage\x12\x18\n" +
 	"\x14LANGUAGE_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10LANGUAGE_ENGLISH\x10\x01\x12\x14\n" +
@@ -436,7 +520,7 @@ func file_common_v1_types_proto_rawDescGZIP() []byte {
 }
 
 var file_common_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_common_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_common_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_common_v1_types_proto_goTypes = []any{
 	(Language)(0),              // 0: common.v1.Language
 	(RelationType)(0),          // 1: common.v1.RelationType
@@ -444,6 +528,7 @@ var file_common_v1_types_proto_goTypes = []any{
 	(*IDRequest)(nil),          // 3: common.v1.IDRequest
 	(*PaginationRequest)(nil),  // 4: common.v1.PaginationRequest
 	(*PaginationResponse)(nil), // 5: common.v1.PaginationResponse
+	(*BatchItemError)(nil),     // 6: common.v1.BatchItemError
 }
 var file_common_v1_types_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -464,7 +549,7 @@ func file_common_v1_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_v1_types_proto_rawDesc), len(file_common_v1_types_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
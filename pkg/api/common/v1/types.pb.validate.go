@@ -277,6 +277,10 @@ func (m *PaginationResponse) validate(all bool) error {
 
 	// no validation rules for PageNo
 
+	// no validation rules for TotalPages
+
+	// no validation rules for HasNextPage
+
 	if len(errors) > 0 {
 		return PaginationResponseMultiError(errors)
 	}
@@ -356,3 +360,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = PaginationResponseValidationError{}
+
+// Validate checks the field values on BatchItemError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BatchItemError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchItemError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BatchItemErrorMultiError,
+// or nil if none found.
+func (m *BatchItemError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchItemError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	// no validation rules for Code
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return BatchItemErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchItemErrorMultiError is an error wrapping multiple validation errors
+// returned by BatchItemError.ValidateAll() if the designated constraints
+// aren't met.
+type BatchItemErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchItemErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchItemErrorMultiError) AllErrors() []error { return m }
+
+// BatchItemErrorValidationError is the validation error returned by
+// BatchItemError.Validate if the designated constraints aren't met.
+type BatchItemErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchItemErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchItemErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchItemErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchItemErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchItemErrorValidationError) ErrorName() string { return "BatchItemErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BatchItemErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchItemError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchItemErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchItemErrorValidationError{}
@@ -41,10 +41,28 @@ const (
 	WordServiceUpdateWordProcedure = "/dict.v1.WordService/UpdateWord"
 	// WordServiceGetWordProcedure is the fully-qualified name of the WordService's GetWord RPC.
 	WordServiceGetWordProcedure = "/dict.v1.WordService/GetWord"
+	// WordServiceBatchGetWordsProcedure is the fully-qualified name of the WordService's BatchGetWords
+	// RPC.
+	WordServiceBatchGetWordsProcedure = "/dict.v1.WordService/BatchGetWords"
 	// WordServiceListWordsProcedure is the fully-qualified name of the WordService's ListWords RPC.
 	WordServiceListWordsProcedure = "/dict.v1.WordService/ListWords"
+	// WordServiceStreamWordsProcedure is the fully-qualified name of the WordService's StreamWords RPC.
+	WordServiceStreamWordsProcedure = "/dict.v1.WordService/StreamWords"
 	// WordServiceLookupWordProcedure is the fully-qualified name of the WordService's LookupWord RPC.
 	WordServiceLookupWordProcedure = "/dict.v1.WordService/LookupWord"
+	// WordServiceListFormsProcedure is the fully-qualified name of the WordService's ListForms RPC.
+	WordServiceListFormsProcedure = "/dict.v1.WordService/ListForms"
+	// WordServiceLemmatizeProcedure is the fully-qualified name of the WordService's Lemmatize RPC.
+	WordServiceLemmatizeProcedure = "/dict.v1.WordService/Lemmatize"
+	// WordServiceGetRelatedWordsProcedure is the fully-qualified name of the WordService's
+	// GetRelatedWords RPC.
+	WordServiceGetRelatedWordsProcedure = "/dict.v1.WordService/GetRelatedWords"
+	// WordServiceListWordAuditProcedure is the fully-qualified name of the WordService's ListWordAudit
+	// RPC.
+	WordServiceListWordAuditProcedure = "/dict.v1.WordService/ListWordAudit"
+	// WordServiceListLanguagesProcedure is the fully-qualified name of the WordService's ListLanguages
+	// RPC.
+	WordServiceListLanguagesProcedure = "/dict.v1.WordService/ListLanguages"
 	// WordServiceDeleteWordProcedure is the fully-qualified name of the WordService's DeleteWord RPC.
 	WordServiceDeleteWordProcedure = "/dict.v1.WordService/DeleteWord"
 )
@@ -54,13 +72,29 @@ type WordServiceClient interface {
 	// Create a new wordabulary entry (admin/system use)
 	CreateWord(context.Context, *connect.Request[v1.CreateWordRequest]) (*connect.Response[v1.Word], error)
 	// Update a wordabulary entry by id (admin/system use)
-	UpdateWord(context.Context, *connect.Request[v1.Word]) (*connect.Response[v1.Word], error)
+	UpdateWord(context.Context, *connect.Request[v1.UpdateWordRequest]) (*connect.Response[v1.Word], error)
 	// Get wordabulary entry details by id or composite key
-	GetWord(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[v1.Word], error)
+	GetWord(context.Context, *connect.Request[v1.GetWordRequest]) (*connect.Response[v1.Word], error)
+	// Get several wordabulary entries by id, e.g. to refresh a client-side cache
+	BatchGetWords(context.Context, *connect.Request[v1.BatchGetWordsRequest]) (*connect.Response[v1.BatchGetWordsResponse], error)
 	// List wordabulary entries with filtering and pagination
 	ListWords(context.Context, *connect.Request[v1.ListWordsRequest]) (*connect.Response[v1.ListWordsResponse], error)
+	// Stream all wordabulary entries matching the filter, one message per entry.
+	// Pagination is ignored and entries come in id order unless order_by is set; the server pages
+	// internally so large exports stay bounded in memory.
+	StreamWords(context.Context, *connect.Request[v1.ListWordsRequest]) (*connect.ServerStreamForClient[v1.Word], error)
 	// Lookup wordabulary entry by exact text match in specified language
 	LookupWord(context.Context, *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.Word], error)
+	// List the forms of a lemma, optionally filtered by word type
+	ListForms(context.Context, *connect.Request[v1.ListFormsRequest]) (*connect.Response[v1.ListFormsResponse], error)
+	// Resolve tokens to their lemma; unknown tokens are returned unchanged
+	Lemmatize(context.Context, *connect.Request[v1.LemmatizeRequest]) (*connect.Response[v1.LemmatizeResponse], error)
+	// Forms, relations and lemma siblings of a word, for "words like this" views
+	GetRelatedWords(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[v1.GetRelatedWordsResponse], error)
+	// Edit history of a word; fails with FAILED_PRECONDITION when auditing is disabled
+	ListWordAudit(context.Context, *connect.Request[v1.ListWordAuditRequest]) (*connect.Response[v1.ListWordAuditResponse], error)
+	// Languages that have dictionary data, with their entry counts, e.g. for a language switcher
+	ListLanguages(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListLanguagesResponse], error)
 	// Delete a wordabulary entry by id (admin/system use)
 	DeleteWord(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error)
 }
@@ -82,30 +116,72 @@ func NewWordServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(wordServiceMethods.ByName("CreateWord")),
 			connect.WithClientOptions(opts...),
 		),
-		updateWord: connect.NewClient[v1.Word, v1.Word](
+		updateWord: connect.NewClient[v1.UpdateWordRequest, v1.Word](
 			httpClient,
 			baseURL+WordServiceUpdateWordProcedure,
 			connect.WithSchema(wordServiceMethods.ByName("UpdateWord")),
 			connect.WithClientOptions(opts...),
 		),
-		getWord: connect.NewClient[v11.IDRequest, v1.Word](
+		getWord: connect.NewClient[v1.GetWordRequest, v1.Word](
 			httpClient,
 			baseURL+WordServiceGetWordProcedure,
 			connect.WithSchema(wordServiceMethods.ByName("GetWord")),
 			connect.WithClientOptions(opts...),
 		),
+		batchGetWords: connect.NewClient[v1.BatchGetWordsRequest, v1.BatchGetWordsResponse](
+			httpClient,
+			baseURL+WordServiceBatchGetWordsProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("BatchGetWords")),
+			connect.WithClientOptions(opts...),
+		),
 		listWords: connect.NewClient[v1.ListWordsRequest, v1.ListWordsResponse](
 			httpClient,
 			baseURL+WordServiceListWordsProcedure,
 			connect.WithSchema(wordServiceMethods.ByName("ListWords")),
 			connect.WithClientOptions(opts...),
 		),
+		streamWords: connect.NewClient[v1.ListWordsRequest, v1.Word](
+			httpClient,
+			baseURL+WordServiceStreamWordsProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("StreamWords")),
+			connect.WithClientOptions(opts...),
+		),
 		lookupWord: connect.NewClient[v1.LookupWordRequest, v1.Word](
 			httpClient,
 			baseURL+WordServiceLookupWordProcedure,
 			connect.WithSchema(wordServiceMethods.ByName("LookupWord")),
 			connect.WithClientOptions(opts...),
 		),
+		listForms: connect.NewClient[v1.ListFormsRequest, v1.ListFormsResponse](
+			httpClient,
+			baseURL+WordServiceListFormsProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("ListForms")),
+			connect.WithClientOptions(opts...),
+		),
+		lemmatize: connect.NewClient[v1.LemmatizeRequest, v1.LemmatizeResponse](
+			httpClient,
+			baseURL+WordServiceLemmatizeProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("Lemmatize")),
+			connect.WithClientOptions(opts...),
+		),
+		getRelatedWords: connect.NewClient[v11.IDRequest, v1.GetRelatedWordsResponse](
+			httpClient,
+			baseURL+WordServiceGetRelatedWordsProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("GetRelatedWords")),
+			connect.WithClientOptions(opts...),
+		),
+		listWordAudit: connect.NewClient[v1.ListWordAuditRequest, v1.ListWordAuditResponse](
+			httpClient,
+			baseURL+WordServiceListWordAuditProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("ListWordAudit")),
+			connect.WithClientOptions(opts...),
+		),
+		listLanguages: connect.NewClient[emptypb.Empty, v1.ListLanguagesResponse](
+			httpClient,
+			baseURL+WordServiceListLanguagesProcedure,
+			connect.WithSchema(wordServiceMethods.ByName("ListLanguages")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteWord: connect.NewClient[v11.IDRequest, emptypb.Empty](
 			httpClient,
 			baseURL+WordServiceDeleteWordProcedure,
@@ -117,12 +193,19 @@ func NewWordServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // wordServiceClient implements WordServiceClient.
 type wordServiceClient struct {
-	createWord *connect.Client[v1.CreateWordRequest, v1.Word]
-	updateWord *connect.Client[v1.Word, v1.Word]
-	getWord    *connect.Client[v11.IDRequest, v1.Word]
-	listWords  *connect.Client[v1.ListWordsRequest, v1.ListWordsResponse]
-	lookupWord *connect.Client[v1.LookupWordRequest, v1.Word]
-	deleteWord *connect.Client[v11.IDRequest, emptypb.Empty]
+	createWord      *connect.Client[v1.CreateWordRequest, v1.Word]
+	updateWord      *connect.Client[v1.UpdateWordRequest, v1.Word]
+	getWord         *connect.Client[v1.GetWordRequest, v1.Word]
+	batchGetWords   *connect.Client[v1.BatchGetWordsRequest, v1.BatchGetWordsResponse]
+	listWords       *connect.Client[v1.ListWordsRequest, v1.ListWordsResponse]
+	streamWords     *connect.Client[v1.ListWordsRequest, v1.Word]
+	lookupWord      *connect.Client[v1.LookupWordRequest, v1.Word]
+	listForms       *connect.Client[v1.ListFormsRequest, v1.ListFormsResponse]
+	lemmatize       *connect.Client[v1.LemmatizeRequest, v1.LemmatizeResponse]
+	getRelatedWords *connect.Client[v11.IDRequest, v1.GetRelatedWordsResponse]
+	listWordAudit   *connect.Client[v1.ListWordAuditRequest, v1.ListWordAuditResponse]
+	listLanguages   *connect.Client[emptypb.Empty, v1.ListLanguagesResponse]
+	deleteWord      *connect.Client[v11.IDRequest, emptypb.Empty]
 }
 
 // CreateWord calls dict.v1.WordService.CreateWord.
@@ -131,25 +214,60 @@ func (c *wordServiceClient) CreateWord(ctx context.Context, req *connect.Request
 }
 
 // UpdateWord calls dict.v1.WordService.UpdateWord.
-func (c *wordServiceClient) UpdateWord(ctx context.Context, req *connect.Request[v1.Word]) (*connect.Response[v1.Word], error) {
+func (c *wordServiceClient) UpdateWord(ctx context.Context, req *connect.Request[v1.UpdateWordRequest]) (*connect.Response[v1.Word], error) {
 	return c.updateWord.CallUnary(ctx, req)
 }
 
 // GetWord calls dict.v1.WordService.GetWord.
-func (c *wordServiceClient) GetWord(ctx context.Context, req *connect.Request[v11.IDRequest]) (*connect.Response[v1.Word], error) {
+func (c *wordServiceClient) GetWord(ctx context.Context, req *connect.Request[v1.GetWordRequest]) (*connect.Response[v1.Word], error) {
 	return c.getWord.CallUnary(ctx, req)
 }
 
+// BatchGetWords calls dict.v1.WordService.BatchGetWords.
+func (c *wordServiceClient) BatchGetWords(ctx context.Context, req *connect.Request[v1.BatchGetWordsRequest]) (*connect.Response[v1.BatchGetWordsResponse], error) {
+	return c.batchGetWords.CallUnary(ctx, req)
+}
+
 // ListWords calls dict.v1.WordService.ListWords.
 func (c *wordServiceClient) ListWords(ctx context.Context, req *connect.Request[v1.ListWordsRequest]) (*connect.Response[v1.ListWordsResponse], error) {
 	return c.listWords.CallUnary(ctx, req)
 }
 
+// StreamWords calls dict.v1.WordService.StreamWords.
+func (c *wordServiceClient) StreamWords(ctx context.Context, req *connect.Request[v1.ListWordsRequest]) (*connect.ServerStreamForClient[v1.Word], error) {
+	return c.streamWords.CallServerStream(ctx, req)
+}
+
 // LookupWord calls dict.v1.WordService.LookupWord.
 func (c *wordServiceClient) LookupWord(ctx context.Context, req *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.Word], error) {
 	return c.lookupWord.CallUnary(ctx, req)
 }
 
+// ListForms calls dict.v1.WordService.ListForms.
+func (c *wordServiceClient) ListForms(ctx context.Context, req *connect.Request[v1.ListFormsRequest]) (*connect.Response[v1.ListFormsResponse], error) {
+	return c.listForms.CallUnary(ctx, req)
+}
+
+// Lemmatize calls dict.v1.WordService.Lemmatize.
+func (c *wordServiceClient) Lemmatize(ctx context.Context, req *connect.Request[v1.LemmatizeRequest]) (*connect.Response[v1.LemmatizeResponse], error) {
+	return c.lemmatize.CallUnary(ctx, req)
+}
+
+// GetRelatedWords calls dict.v1.WordService.GetRelatedWords.
+func (c *wordServiceClient) GetRelatedWords(ctx context.Context, req *connect.Request[v11.IDRequest]) (*connect.Response[v1.GetRelatedWordsResponse], error) {
+	return c.getRelatedWords.CallUnary(ctx, req)
+}
+
+// ListWordAudit calls dict.v1.WordService.ListWordAudit.
+func (c *wordServiceClient) ListWordAudit(ctx context.Context, req *connect.Request[v1.ListWordAuditRequest]) (*connect.Response[v1.ListWordAuditResponse], error) {
+	return c.listWordAudit.CallUnary(ctx, req)
+}
+
+// ListLanguages calls dict.v1.WordService.ListLanguages.
+func (c *wordServiceClient) ListLanguages(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListLanguagesResponse], error) {
+	return c.listLanguages.CallUnary(ctx, req)
+}
+
 // DeleteWord calls dict.v1.WordService.DeleteWord.
 func (c *wordServiceClient) DeleteWord(ctx context.Context, req *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.deleteWord.CallUnary(ctx, req)
@@ -160,13 +278,29 @@ type WordServiceHandler interface {
 	// Create a new wordabulary entry (admin/system use)
 	CreateWord(context.Context, *connect.Request[v1.CreateWordRequest]) (*connect.Response[v1.Word], error)
 	// Update a wordabulary entry by id (admin/system use)
-	UpdateWord(context.Context, *connect.Request[v1.Word]) (*connect.Response[v1.Word], error)
+	UpdateWord(context.Context, *connect.Request[v1.UpdateWordRequest]) (*connect.Response[v1.Word], error)
 	// Get wordabulary entry details by id or composite key
-	GetWord(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[v1.Word], error)
+	GetWord(context.Context, *connect.Request[v1.GetWordRequest]) (*connect.Response[v1.Word], error)
+	// Get several wordabulary entries by id, e.g. to refresh a client-side cache
+	BatchGetWords(context.Context, *connect.Request[v1.BatchGetWordsRequest]) (*connect.Response[v1.BatchGetWordsResponse], error)
 	// List wordabulary entries with filtering and pagination
 	ListWords(context.Context, *connect.Request[v1.ListWordsRequest]) (*connect.Response[v1.ListWordsResponse], error)
+	// Stream all wordabulary entries matching the filter, one message per entry.
+	// Pagination is ignored and entries come in id order unless order_by is set; the server pages
+	// internally so large exports stay bounded in memory.
+	StreamWords(context.Context, *connect.Request[v1.ListWordsRequest], *connect.ServerStream[v1.Word]) error
 	// Lookup wordabulary entry by exact text match in specified language
 	LookupWord(context.Context, *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.Word], error)
+	// List the forms of a lemma, optionally filtered by word type
+	ListForms(context.Context, *connect.Request[v1.ListFormsRequest]) (*connect.Response[v1.ListFormsResponse], error)
+	// Resolve tokens to their lemma; unknown tokens are returned unchanged
+	Lemmatize(context.Context, *connect.Request[v1.LemmatizeRequest]) (*connect.Response[v1.LemmatizeResponse], error)
+	// Forms, relations and lemma siblings of a word, for "words like this" views
+	GetRelatedWords(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[v1.GetRelatedWordsResponse], error)
+	// Edit history of a word; fails with FAILED_PRECONDITION when auditing is disabled
+	ListWordAudit(context.Context, *connect.Request[v1.ListWordAuditRequest]) (*connect.Response[v1.ListWordAuditResponse], error)
+	// Languages that have dictionary data, with their entry counts, e.g. for a language switcher
+	ListLanguages(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListLanguagesResponse], error)
 	// Delete a wordabulary entry by id (admin/system use)
 	DeleteWord(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error)
 }
@@ -196,18 +330,60 @@ func NewWordServiceHandler(svc WordServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(wordServiceMethods.ByName("GetWord")),
 		connect.WithHandlerOptions(opts...),
 	)
+	wordServiceBatchGetWordsHandler := connect.NewUnaryHandler(
+		WordServiceBatchGetWordsProcedure,
+		svc.BatchGetWords,
+		connect.WithSchema(wordServiceMethods.ByName("BatchGetWords")),
+		connect.WithHandlerOptions(opts...),
+	)
 	wordServiceListWordsHandler := connect.NewUnaryHandler(
 		WordServiceListWordsProcedure,
 		svc.ListWords,
 		connect.WithSchema(wordServiceMethods.ByName("ListWords")),
 		connect.WithHandlerOptions(opts...),
 	)
+	wordServiceStreamWordsHandler := connect.NewServerStreamHandler(
+		WordServiceStreamWordsProcedure,
+		svc.StreamWords,
+		connect.WithSchema(wordServiceMethods.ByName("StreamWords")),
+		connect.WithHandlerOptions(opts...),
+	)
 	wordServiceLookupWordHandler := connect.NewUnaryHandler(
 		WordServiceLookupWordProcedure,
 		svc.LookupWord,
 		connect.WithSchema(wordServiceMethods.ByName("LookupWord")),
 		connect.WithHandlerOptions(opts...),
 	)
+	wordServiceListFormsHandler := connect.NewUnaryHandler(
+		WordServiceListFormsProcedure,
+		svc.ListForms,
+		connect.WithSchema(wordServiceMethods.ByName("ListForms")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceLemmatizeHandler := connect.NewUnaryHandler(
+		WordServiceLemmatizeProcedure,
+		svc.Lemmatize,
+		connect.WithSchema(wordServiceMethods.ByName("Lemmatize")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceGetRelatedWordsHandler := connect.NewUnaryHandler(
+		WordServiceGetRelatedWordsProcedure,
+		svc.GetRelatedWords,
+		connect.WithSchema(wordServiceMethods.ByName("GetRelatedWords")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceListWordAuditHandler := connect.NewUnaryHandler(
+		WordServiceListWordAuditProcedure,
+		svc.ListWordAudit,
+		connect.WithSchema(wordServiceMethods.ByName("ListWordAudit")),
+		connect.WithHandlerOptions(opts...),
+	)
+	wordServiceListLanguagesHandler := connect.NewUnaryHandler(
+		WordServiceListLanguagesProcedure,
+		svc.ListLanguages,
+		connect.WithSchema(wordServiceMethods.ByName("ListLanguages")),
+		connect.WithHandlerOptions(opts...),
+	)
 	wordServiceDeleteWordHandler := connect.NewUnaryHandler(
 		WordServiceDeleteWordProcedure,
 		svc.DeleteWord,
@@ -222,10 +398,24 @@ func NewWordServiceHandler(svc WordServiceHandler, opts ...connect.HandlerOption
 			wordServiceUpdateWordHandler.ServeHTTP(w, r)
 		case WordServiceGetWordProcedure:
 			wordServiceGetWordHandler.ServeHTTP(w, r)
+		case WordServiceBatchGetWordsProcedure:
+			wordServiceBatchGetWordsHandler.ServeHTTP(w, r)
 		case WordServiceListWordsProcedure:
 			wordServiceListWordsHandler.ServeHTTP(w, r)
+		case WordServiceStreamWordsProcedure:
+			wordServiceStreamWordsHandler.ServeHTTP(w, r)
 		case WordServiceLookupWordProcedure:
 			wordServiceLookupWordHandler.ServeHTTP(w, r)
+		case WordServiceListFormsProcedure:
+			wordServiceListFormsHandler.ServeHTTP(w, r)
+		case WordServiceLemmatizeProcedure:
+			wordServiceLemmatizeHandler.ServeHTTP(w, r)
+		case WordServiceGetRelatedWordsProcedure:
+			wordServiceGetRelatedWordsHandler.ServeHTTP(w, r)
+		case WordServiceListWordAuditProcedure:
+			wordServiceListWordAuditHandler.ServeHTTP(w, r)
+		case WordServiceListLanguagesProcedure:
+			wordServiceListLanguagesHandler.ServeHTTP(w, r)
 		case WordServiceDeleteWordProcedure:
 			wordServiceDeleteWordHandler.ServeHTTP(w, r)
 		default:
@@ -241,22 +431,50 @@ func (UnimplementedWordServiceHandler) CreateWord(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.CreateWord is not implemented"))
 }
 
-func (UnimplementedWordServiceHandler) UpdateWord(context.Context, *connect.Request[v1.Word]) (*connect.Response[v1.Word], error) {
+func (UnimplementedWordServiceHandler) UpdateWord(context.Context, *connect.Request[v1.UpdateWordRequest]) (*connect.Response[v1.Word], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.UpdateWord is not implemented"))
 }
 
-func (UnimplementedWordServiceHandler) GetWord(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[v1.Word], error) {
+func (UnimplementedWordServiceHandler) GetWord(context.Context, *connect.Request[v1.GetWordRequest]) (*connect.Response[v1.Word], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.GetWord is not implemented"))
 }
 
+func (UnimplementedWordServiceHandler) BatchGetWords(context.Context, *connect.Request[v1.BatchGetWordsRequest]) (*connect.Response[v1.BatchGetWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.BatchGetWords is not implemented"))
+}
+
 func (UnimplementedWordServiceHandler) ListWords(context.Context, *connect.Request[v1.ListWordsRequest]) (*connect.Response[v1.ListWordsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.ListWords is not implemented"))
 }
 
+func (UnimplementedWordServiceHandler) StreamWords(context.Context, *connect.Request[v1.ListWordsRequest], *connect.ServerStream[v1.Word]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.StreamWords is not implemented"))
+}
+
 func (UnimplementedWordServiceHandler) LookupWord(context.Context, *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.Word], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.LookupWord is not implemented"))
 }
 
+func (UnimplementedWordServiceHandler) ListForms(context.Context, *connect.Request[v1.ListFormsRequest]) (*connect.Response[v1.ListFormsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.ListForms is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) Lemmatize(context.Context, *connect.Request[v1.LemmatizeRequest]) (*connect.Response[v1.LemmatizeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.Lemmatize is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) GetRelatedWords(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[v1.GetRelatedWordsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.GetRelatedWords is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) ListWordAudit(context.Context, *connect.Request[v1.ListWordAuditRequest]) (*connect.Response[v1.ListWordAuditResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.ListWordAudit is not implemented"))
+}
+
+func (UnimplementedWordServiceHandler) ListLanguages(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListLanguagesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.ListLanguages is not implemented"))
+}
+
 func (UnimplementedWordServiceHandler) DeleteWord(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("dict.v1.WordService.DeleteWord is not implemented"))
 }
@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -26,6 +27,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// LookupPreference picks the entry returned when the text is an inflected form, e.g. "went"
+type LookupPreference int32
+
+const (
+	LookupPreference_LOOKUP_PREFERENCE_UNSPECIFIED LookupPreference = 0 // same as LOOKUP_PREFERENCE_LEMMA
+	LookupPreference_LOOKUP_PREFERENCE_LEMMA       LookupPreference = 1 // the entry of its lemma ("go"), with fallback_from set, when the dictionary has one
+	LookupPreference_LOOKUP_PREFERENCE_EXACT       LookupPreference = 2 // the inflected form entry, as typed
+)
+
+// Enum value maps for LookupPreference.
+var (
+	LookupPreference_name = map[int32]string{
+		0: "LOOKUP_PREFERENCE_UNSPECIFIED",
+		1: "LOOKUP_PREFERENCE_LEMMA",
+		2: "LOOKUP_PREFERENCE_EXACT",
+	}
+	LookupPreference_value = map[string]int32{
+		"LOOKUP_PREFERENCE_UNSPECIFIED": 0,
+		"LOOKUP_PREFERENCE_LEMMA":       1,
+		"LOOKUP_PREFERENCE_EXACT":       2,
+	}
+)
+
+func (x LookupPreference) Enum() *LookupPreference {
+	p := new(LookupPreference)
+	*p = x
+	return p
+}
+
+func (x LookupPreference) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LookupPreference) Descriptor() protoreflect.EnumDescriptor {
+	return file_dict_v1_word_proto_enumTypes[0].Descriptor()
+}
+
+func (LookupPreference) Type() protoreflect.EnumType {
+	return &file_dict_v1_word_proto_enumTypes[0]
+}
+
+func (x LookupPreference) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LookupPreference.Descriptor instead.
+func (LookupPreference) EnumDescriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{0}
+}
+
 type Word struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
 	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                     // Auto-increment ID (CRUD only)
@@ -44,8 +95,15 @@ type Word struct {
 	// When this entry is a non-lemma form, forms is empty; the original lemma text can be
 	// obtained from the `lemma` field. We return structured objects instead of plain strings
 	// so the client knows which type each form is without extra lookups.
-	Forms         []*WordFormRef         `protobuf:"bytes,30,rep,name=forms,proto3" json:"forms,omitempty"`
-	Relations     []*WordRelation        `protobuf:"bytes,31,rep,name=relations,proto3" json:"relations,omitempty"`                   // Relationships to other words (e.g. synonyms, antonyms)
+	Forms     []*WordFormRef  `protobuf:"bytes,30,rep,name=forms,proto3" json:"forms,omitempty"`
+	Relations []*WordRelation `protobuf:"bytes,31,rep,name=relations,proto3" json:"relations,omitempty"`  // Relationships to other words (e.g. synonyms, antonyms)
+	Source    string          `protobuf:"bytes,32,opt,name=source,proto3" json:"source,omitempty"`        // Output only. Provenance: "ecdict" for imported entries, "manual" once created or edited through the API; imports never overwrite manual entries
+	Frequency int64           `protobuf:"varint,33,opt,name=frequency,proto3" json:"frequency,omitempty"` // Output only. Corpus frequency rank from the ECDICT import, 1 = most common; 0 when unranked
+	Cefr      string          `protobuf:"bytes,34,opt,name=cefr,proto3" json:"cefr,omitempty"`            // Output only. CEFR level "A1".."C2" derived from ECDICT exam tags at import; empty when unknown
+	// Output only. Set by LookupWord when this lemma was returned for another text: a form entry
+	// under LOOKUP_PREFERENCE_LEMMA ("went" on "go"), or a miss found by de-inflecting it
+	// (WORD_LOOKUP_FALLBACK, "mice" on "mouse"); empty otherwise
+	FallbackFrom  string                 `protobuf:"bytes,35,opt,name=fallback_from,json=fallbackFrom,proto3" json:"fallback_from,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,100,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // Creation timestamp
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Last update timestamp
 	unknownFields protoimpl.UnknownFields
@@ -166,6 +224,34 @@ func (x *Word) GetRelations() []*WordRelation {
 	return nil
 }
 
+func (x *Word) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Word) GetFrequency() int64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *Word) GetCefr() string {
+	if x != nil {
+		return x.Cefr
+	}
+	return ""
+}
+
+func (x *Word) GetFallbackFrom() string {
+	if x != nil {
+		return x.FallbackFrom
+	}
+	return ""
+}
+
 func (x *Word) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -237,6 +323,7 @@ type Definition struct {
 	Pos           string                 `protobuf:"bytes,1,opt,name=pos,proto3" json:"pos,omitempty"`                                    // Part of speech, e.g. n., v., adj.
 	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`                                  // Definition text
 	Language      v1.Language            `protobuf:"varint,3,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // Language of the translation
+	Order         int32                  `protobuf:"varint,4,opt,name=order,proto3" json:"order,omitempty"`                               // Display rank starting at 1 (the primary definition); 0 keeps the position in the list
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -292,6 +379,13 @@ func (x *Definition) GetLanguage() v1.Language {
 	return v1.Language(0)
 }
 
+func (x *Definition) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
 // Minimal reference for an inflected / variant form; no id to keep payload light.
 type WordFormRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -350,6 +444,8 @@ type WordRelation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
 	RelationType  v1.RelationType        `protobuf:"varint,2,opt,name=relation_type,json=relationType,proto3,enum=common.v1.RelationType" json:"relation_type,omitempty"` // Type of relationship
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`                                                                  // Curator's note on why the words relate
+	Strength      int32                  `protobuf:"varint,4,opt,name=strength,proto3" json:"strength,omitempty"`                                                         // How strongly the words relate; 0 when unrated
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -398,6 +494,20 @@ func (x *WordRelation) GetRelationType() v1.RelationType {
 	return v1.RelationType(0)
 }
 
+func (x *WordRelation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *WordRelation) GetStrength() int32 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
 type Sentence struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`                                // Surface form of the sentence
@@ -503,21 +613,80 @@ func (x *CreateWordRequest) GetWord() *Word {
 	return nil
 }
 
+// UpdateWord request; when update_mask is empty every updatable field is replaced.
+type UpdateWordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Word  *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	// Fields of word to overwrite, using Word field names, e.g. "categories", "definitions".
+	// Masked fields left empty in word are cleared.
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWordRequest) Reset() {
+	*x = UpdateWordRequest{}
+	mi := &file_dict_v1_word_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWordRequest) ProtoMessage() {}
+
+func (x *UpdateWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWordRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWordRequest) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateWordRequest) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *UpdateWordRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 // ListWords request
 type ListWordsRequest struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	Pagination *v1.PaginationRequest  `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// filtering options using CEL expressions
+	// filtering options using CEL expressions, e.g. `cefr in ["A1", "A2"]`
 	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	// ordering options. e.g. "word asc", "mastery.overall desc"
-	OrderBy       string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// ordering options. e.g. "text asc", "updated_at desc", "frequency asc" (most common first,
+	// unranked words last), "cefr asc" (easiest first, unleveled words last); alphabetical
+	// ("text asc") when empty
+	OrderBy string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// attach the forms of every listed lemma; limited to pages of at most 200 words
+	IncludeForms  bool `protobuf:"varint,4,opt,name=include_forms,json=includeForms,proto3" json:"include_forms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListWordsRequest) Reset() {
 	*x = ListWordsRequest{}
-	mi := &file_dict_v1_word_proto_msgTypes[7]
+	mi := &file_dict_v1_word_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -529,7 +698,7 @@ func (x *ListWordsRequest) String() string {
 func (*ListWordsRequest) ProtoMessage() {}
 
 func (x *ListWordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dict_v1_word_proto_msgTypes[7]
+	mi := &file_dict_v1_word_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +711,7 @@ func (x *ListWordsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWordsRequest.ProtoReflect.Descriptor instead.
 func (*ListWordsRequest) Descriptor() ([]byte, []int) {
-	return file_dict_v1_word_proto_rawDescGZIP(), []int{7}
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListWordsRequest) GetPagination() *v1.PaginationRequest {
@@ -566,6 +735,13 @@ func (x *ListWordsRequest) GetOrderBy() string {
 	return ""
 }
 
+func (x *ListWordsRequest) GetIncludeForms() bool {
+	if x != nil {
+		return x.IncludeForms
+	}
+	return false
+}
+
 type ListWordsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pagination    *v1.PaginationResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -576,7 +752,7 @@ type ListWordsResponse struct {
 
 func (x *ListWordsResponse) Reset() {
 	*x = ListWordsResponse{}
-	mi := &file_dict_v1_word_proto_msgTypes[8]
+	mi := &file_dict_v1_word_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -588,7 +764,7 @@ func (x *ListWordsResponse) String() string {
 func (*ListWordsResponse) ProtoMessage() {}
 
 func (x *ListWordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_dict_v1_word_proto_msgTypes[8]
+	mi := &file_dict_v1_word_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -601,7 +777,7 @@ func (x *ListWordsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWordsResponse.ProtoReflect.Descriptor instead.
 func (*ListWordsResponse) Descriptor() ([]byte, []int) {
-	return file_dict_v1_word_proto_rawDescGZIP(), []int{8}
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListWordsResponse) GetPagination() *v1.PaginationResponse {
@@ -618,18 +794,173 @@ func (x *ListWordsResponse) GetWords() []*Word {
 	return nil
 }
 
+// GetWordRequest fetches a word by id; wire-compatible with common.v1.IDRequest.
+type GetWordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only return sentences from these sources; empty returns all of them
+	SentenceSources []v1.SourceType `protobuf:"varint,2,rep,packed,name=sentence_sources,json=sentenceSources,proto3,enum=common.v1.SourceType" json:"sentence_sources,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetWordRequest) Reset() {
+	*x = GetWordRequest{}
+	mi := &file_dict_v1_word_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWordRequest) ProtoMessage() {}
+
+func (x *GetWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWordRequest.ProtoReflect.Descriptor instead.
+func (*GetWordRequest) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetWordRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetWordRequest) GetSentenceSources() []v1.SourceType {
+	if x != nil {
+		return x.SentenceSources
+	}
+	return nil
+}
+
+// BatchGetWordsRequest fetches several words by id in one round trip; ids must be unique.
+type BatchGetWordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetWordsRequest) Reset() {
+	*x = BatchGetWordsRequest{}
+	mi := &file_dict_v1_word_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetWordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetWordsRequest) ProtoMessage() {}
+
+func (x *BatchGetWordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetWordsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetWordsRequest) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchGetWordsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Words         map[int64]*Word        `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Keyed by id; ids without an entry are absent
+	Errors        []*v1.BatchItemError   `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`                                                                          // one per id without an entry, code "NotFound"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetWordsResponse) Reset() {
+	*x = BatchGetWordsResponse{}
+	mi := &file_dict_v1_word_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetWordsResponse) ProtoMessage() {}
+
+func (x *BatchGetWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetWordsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchGetWordsResponse) GetWords() map[int64]*Word {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *BatchGetWordsResponse) GetErrors() []*v1.BatchItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 // LookupWordRequest performs an exact text lookup in specified language (default en)
 type LookupWordRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
-	Language      v1.Language            `protobuf:"varint,2,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // optional; if unspecified, server default language
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Word     string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Language v1.Language            `protobuf:"varint,2,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // optional; if unspecified, server default language
+	// Only return sentences from these sources; empty returns all of them
+	SentenceSources []v1.SourceType `protobuf:"varint,3,rep,packed,name=sentence_sources,json=sentenceSources,proto3,enum=common.v1.SourceType" json:"sentence_sources,omitempty"`
+	// Whether an inflected form returns its lemma's entry or its own; defaults to the lemma
+	Prefer        LookupPreference `protobuf:"varint,4,opt,name=prefer,proto3,enum=dict.v1.LookupPreference" json:"prefer,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LookupWordRequest) Reset() {
 	*x = LookupWordRequest{}
-	mi := &file_dict_v1_word_proto_msgTypes[9]
+	mi := &file_dict_v1_word_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +972,7 @@ func (x *LookupWordRequest) String() string {
 func (*LookupWordRequest) ProtoMessage() {}
 
 func (x *LookupWordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dict_v1_word_proto_msgTypes[9]
+	mi := &file_dict_v1_word_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +985,7 @@ func (x *LookupWordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookupWordRequest.ProtoReflect.Descriptor instead.
 func (*LookupWordRequest) Descriptor() ([]byte, []int) {
-	return file_dict_v1_word_proto_rawDescGZIP(), []int{9}
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LookupWordRequest) GetWord() string {
@@ -671,75 +1002,859 @@ func (x *LookupWordRequest) GetLanguage() v1.Language {
 	return v1.Language(0)
 }
 
-var File_dict_v1_word_proto protoreflect.FileDescriptor
+func (x *LookupWordRequest) GetSentenceSources() []v1.SourceType {
+	if x != nil {
+		return x.SentenceSources
+	}
+	return nil
+}
 
-const file_dict_v1_word_proto_rawDesc = "" +
-	"\n" +
-	"\x12dict/v1/word.proto\x12\adict.v1\x1a\x15common/v1/types.proto\x1a\x14dict/v1/phrase.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xc9\x04\n" +
-	"\x04Word\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\x12/\n" +
-	"\blanguage\x18\x03 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\x12\x1b\n" +
-	"\tword_type\x18\x04 \x01(\tR\bwordType\x12\x14\n" +
-	"\x05lemma\x18\x05 \x01(\tR\x05lemma\x12/\n" +
-	"\tphonetics\x18\x06 \x03(\v2\x11.dict.v1.PhoneticR\tphonetics\x125\n" +
-	"\vdefinitions\x18\a \x03(\v2\x13.dict.v1.DefinitionR\vdefinitions\x12\x1e\n" +
-	"\n" +
-	"categories\x18\b \x03(\tR\n" +
-	"categories\x12)\n" +
-	"\aphrases\x18\t \x03(\v2\x0f.dict.v1.PhraseR\aphrases\x12/\n" +
-	"\tsentences\x18\n" +
-	" \x03(\v2\x11.dict.v1.SentenceR\tsentences\x12*\n" +
-	"\x05forms\x18\x1e \x03(\v2\x14.dict.v1.WordFormRefR\x05forms\x123\n" +
-	"\trelations\x18\x1f \x03(\v2\x15.dict.v1.WordRelationR\trelations\x129\n" +
-	"\n" +
-	"created_at\x18d \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"6\n" +
-	"\bPhonetic\x12\x10\n" +
-	"\x03ipa\x18\x01 \x01(\tR\x03ipa\x12\x18\n" +
-	"\adialect\x18\x02 \x01(\tR\adialect\"c\n" +
-	"\n" +
-	"Definition\x12\x10\n" +
-	"\x03pos\x18\x01 \x01(\tR\x03pos\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\x12/\n" +
-	"\blanguage\x18\x03 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\">\n" +
-	"\vWordFormRef\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1b\n" +
-	"\tword_type\x18\x02 \x01(\tR\bwordType\"`\n" +
-	"\fWordRelation\x12\x12\n" +
-	"\x04word\x18\x01 \x01(\tR\x04word\x12<\n" +
-	"\rrelation_type\x18\x02 \x01(\x0e2\x17.common.v1.RelationTypeR\frelationType\"l\n" +
-	"\bSentence\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text\x12-\n" +
-	"\x06source\x18\x02 \x01(\x0e2\x15.common.v1.SourceTypeR\x06source\x12\x1d\n" +
+func (x *LookupWordRequest) GetPrefer() LookupPreference {
+	if x != nil {
+		return x.Prefer
+	}
+	return LookupPreference_LOOKUP_PREFERENCE_UNSPECIFIED
+}
+
+// ListFormsRequest lists the forms of a lemma, optionally only some word types.
+type ListFormsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lemma         string                 `protobuf:"bytes,1,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	Language      v1.Language            `protobuf:"varint,2,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // optional; if unspecified, server default language
+	WordTypes     []string               `protobuf:"bytes,3,rep,name=word_types,json=wordTypes,proto3" json:"word_types,omitempty"`       // e.g. "past", "pp"; empty returns every form type
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                               // maximum forms returned; 0 returns all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFormsRequest) Reset() {
+	*x = ListFormsRequest{}
+	mi := &file_dict_v1_word_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFormsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFormsRequest) ProtoMessage() {}
+
+func (x *ListFormsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFormsRequest.ProtoReflect.Descriptor instead.
+func (*ListFormsRequest) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListFormsRequest) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *ListFormsRequest) GetLanguage() v1.Language {
+	if x != nil {
+		return x.Language
+	}
+	return v1.Language(0)
+}
+
+func (x *ListFormsRequest) GetWordTypes() []string {
+	if x != nil {
+		return x.WordTypes
+	}
+	return nil
+}
+
+func (x *ListFormsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFormsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Forms         []*WordFormRef         `protobuf:"bytes,1,rep,name=forms,proto3" json:"forms,omitempty"` // Ordered by text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFormsResponse) Reset() {
+	*x = ListFormsResponse{}
+	mi := &file_dict_v1_word_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFormsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFormsResponse) ProtoMessage() {}
+
+func (x *ListFormsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFormsResponse.ProtoReflect.Descriptor instead.
+func (*ListFormsResponse) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListFormsResponse) GetForms() []*WordFormRef {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+// LemmatizeRequest maps surface tokens (e.g. "running", "ran") back to their lemma.
+type LemmatizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []string               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Language      v1.Language            `protobuf:"varint,2,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // optional; if unspecified, server default language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LemmatizeRequest) Reset() {
+	*x = LemmatizeRequest{}
+	mi := &file_dict_v1_word_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LemmatizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LemmatizeRequest) ProtoMessage() {}
+
+func (x *LemmatizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LemmatizeRequest.ProtoReflect.Descriptor instead.
+func (*LemmatizeRequest) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LemmatizeRequest) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *LemmatizeRequest) GetLanguage() v1.Language {
+	if x != nil {
+		return x.Language
+	}
+	return v1.Language(0)
+}
+
+type LemmatizeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`                       // Token as sent by the client
+	Lemma         string                 `protobuf:"bytes,2,opt,name=lemma,proto3" json:"lemma,omitempty"`                       // Lemma text; equals token when the word is a lemma or unknown
+	WordType      string                 `protobuf:"bytes,3,opt,name=word_type,json=wordType,proto3" json:"word_type,omitempty"` // word_type of the matched entry; empty when unknown
+	Found         bool                   `protobuf:"varint,4,opt,name=found,proto3" json:"found,omitempty"`                      // Whether the token matched a dictionary entry
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LemmatizeResult) Reset() {
+	*x = LemmatizeResult{}
+	mi := &file_dict_v1_word_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LemmatizeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LemmatizeResult) ProtoMessage() {}
+
+func (x *LemmatizeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LemmatizeResult.ProtoReflect.Descriptor instead.
+func (*LemmatizeResult) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LemmatizeResult) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LemmatizeResult) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *LemmatizeResult) GetWordType() string {
+	if x != nil {
+		return x.WordType
+	}
+	return ""
+}
+
+func (x *LemmatizeResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+type LemmatizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*LemmatizeResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // Same order as the request tokens
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LemmatizeResponse) Reset() {
+	*x = LemmatizeResponse{}
+	mi := &file_dict_v1_word_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LemmatizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LemmatizeResponse) ProtoMessage() {}
+
+func (x *LemmatizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LemmatizeResponse.ProtoReflect.Descriptor instead.
+func (*LemmatizeResponse) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LemmatizeResponse) GetResults() []*LemmatizeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// GetRelatedWordsResponse groups the entries connected to a word; no list repeats an entry or
+// contains the word itself.
+type GetRelatedWordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          *Word                  `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Lemma         string                 `protobuf:"bytes,2,opt,name=lemma,proto3" json:"lemma,omitempty"`         // Lemma text; equals word.text for lemma entries
+	Forms         []*WordFormRef         `protobuf:"bytes,3,rep,name=forms,proto3" json:"forms,omitempty"`         // Forms of the word, only when it is a lemma
+	Siblings      []*WordFormRef         `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`   // The lemma and its other forms, only when the word is a form
+	Relations     []*WordRelation        `protobuf:"bytes,5,rep,name=relations,proto3" json:"relations,omitempty"` // Stored relations of the word, then of its lemma
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelatedWordsResponse) Reset() {
+	*x = GetRelatedWordsResponse{}
+	mi := &file_dict_v1_word_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelatedWordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedWordsResponse) ProtoMessage() {}
+
+func (x *GetRelatedWordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedWordsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedWordsResponse) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRelatedWordsResponse) GetWord() *Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *GetRelatedWordsResponse) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *GetRelatedWordsResponse) GetForms() []*WordFormRef {
+	if x != nil {
+		return x.Forms
+	}
+	return nil
+}
+
+func (x *GetRelatedWordsResponse) GetSiblings() []*WordFormRef {
+	if x != nil {
+		return x.Siblings
+	}
+	return nil
+}
+
+func (x *GetRelatedWordsResponse) GetRelations() []*WordRelation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+type ListWordAuditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WordId        int64                  `protobuf:"varint,1,opt,name=word_id,json=wordId,proto3" json:"word_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // maximum entries returned; 0 uses the server default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWordAuditRequest) Reset() {
+	*x = ListWordAuditRequest{}
+	mi := &file_dict_v1_word_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWordAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWordAuditRequest) ProtoMessage() {}
+
+func (x *ListWordAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWordAuditRequest.ProtoReflect.Descriptor instead.
+func (*ListWordAuditRequest) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListWordAuditRequest) GetWordId() int64 {
+	if x != nil {
+		return x.WordId
+	}
+	return 0
+}
+
+func (x *ListWordAuditRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// WordFieldChange holds one edited field as JSON, in the shape the field is stored.
+type WordFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // Update mask name, e.g. "definitions"
+	OldJson       string                 `protobuf:"bytes,2,opt,name=old_json,json=oldJson,proto3" json:"old_json,omitempty"`
+	NewJson       string                 `protobuf:"bytes,3,opt,name=new_json,json=newJson,proto3" json:"new_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordFieldChange) Reset() {
+	*x = WordFieldChange{}
+	mi := &file_dict_v1_word_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordFieldChange) ProtoMessage() {}
+
+func (x *WordFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordFieldChange.ProtoReflect.Descriptor instead.
+func (*WordFieldChange) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WordFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *WordFieldChange) GetOldJson() string {
+	if x != nil {
+		return x.OldJson
+	}
+	return ""
+}
+
+func (x *WordFieldChange) GetNewJson() string {
+	if x != nil {
+		return x.NewJson
+	}
+	return ""
+}
+
+// WordAuditEntry records one edit of a word made while auditing was enabled.
+type WordAuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WordId        int64                  `protobuf:"varint,2,opt,name=word_id,json=wordId,proto3" json:"word_id,omitempty"`
+	Editor        string                 `protobuf:"bytes,3,opt,name=editor,proto3" json:"editor,omitempty"`   // Editor identity of the request; empty when none was given
+	Changes       []*WordFieldChange     `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"` // Ordered by field name
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,100,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WordAuditEntry) Reset() {
+	*x = WordAuditEntry{}
+	mi := &file_dict_v1_word_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WordAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordAuditEntry) ProtoMessage() {}
+
+func (x *WordAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordAuditEntry.ProtoReflect.Descriptor instead.
+func (*WordAuditEntry) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *WordAuditEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WordAuditEntry) GetWordId() int64 {
+	if x != nil {
+		return x.WordId
+	}
+	return 0
+}
+
+func (x *WordAuditEntry) GetEditor() string {
+	if x != nil {
+		return x.Editor
+	}
+	return ""
+}
+
+func (x *WordAuditEntry) GetChanges() []*WordFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *WordAuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListWordAuditResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*WordAuditEntry      `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWordAuditResponse) Reset() {
+	*x = ListWordAuditResponse{}
+	mi := &file_dict_v1_word_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWordAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWordAuditResponse) ProtoMessage() {}
+
+func (x *ListWordAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWordAuditResponse.ProtoReflect.Descriptor instead.
+func (*ListWordAuditResponse) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListWordAuditResponse) GetEntries() []*WordAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// LanguageWordCount is the number of dictionary entries, lemmas and forms alike, in a language.
+type LanguageWordCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      v1.Language            `protobuf:"varint,1,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"`
+	WordCount     int64                  `protobuf:"varint,2,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LanguageWordCount) Reset() {
+	*x = LanguageWordCount{}
+	mi := &file_dict_v1_word_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LanguageWordCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageWordCount) ProtoMessage() {}
+
+func (x *LanguageWordCount) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageWordCount.ProtoReflect.Descriptor instead.
+func (*LanguageWordCount) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LanguageWordCount) GetLanguage() v1.Language {
+	if x != nil {
+		return x.Language
+	}
+	return v1.Language(0)
+}
+
+func (x *LanguageWordCount) GetWordCount() int64 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+type ListLanguagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Languages     []*LanguageWordCount   `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"` // Only languages with entries, largest word_count first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLanguagesResponse) Reset() {
+	*x = ListLanguagesResponse{}
+	mi := &file_dict_v1_word_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLanguagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLanguagesResponse) ProtoMessage() {}
+
+func (x *ListLanguagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_v1_word_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLanguagesResponse.ProtoReflect.Descriptor instead.
+func (*ListLanguagesResponse) Descriptor() ([]byte, []int) {
+	return file_dict_v1_word_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListLanguagesResponse) GetLanguages() []*LanguageWordCount {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+var File_dict_v1_word_proto protoreflect.FileDescriptor
+
+const file_dict_v1_word_proto_rawDesc = "" +
+	"\n" +
+	"\x12dict/v1/word.proto\x12\adict.v1\x1a\x15common/v1/types.proto\x1a\x14dict/v1/phrase.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xb8\x05\n" +
+	"\x04Word\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12/\n" +
+	"\blanguage\x18\x03 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\x12\x1b\n" +
+	"\tword_type\x18\x04 \x01(\tR\bwordType\x12\x14\n" +
+	"\x05lemma\x18\x05 \x01(\tR\x05lemma\x12/\n" +
+	"\tphonetics\x18\x06 \x03(\v2\x11.dict.v1.PhoneticR\tphonetics\x125\n" +
+	"\vdefinitions\x18\a \x03(\v2\x13.dict.v1.DefinitionR\vdefinitions\x12\x1e\n" +
+	"\n" +
+	"categories\x18\b \x03(\tR\n" +
+	"categories\x12)\n" +
+	"\aphrases\x18\t \x03(\v2\x0f.dict.v1.PhraseR\aphrases\x12/\n" +
+	"\tsentences\x18\n" +
+	" \x03(\v2\x11.dict.v1.SentenceR\tsentences\x12*\n" +
+	"\x05forms\x18\x1e \x03(\v2\x14.dict.v1.WordFormRefR\x05forms\x123\n" +
+	"\trelations\x18\x1f \x03(\v2\x15.dict.v1.WordRelationR\trelations\x12\x16\n" +
+	"\x06source\x18  \x01(\tR\x06source\x12\x1c\n" +
+	"\tfrequency\x18! \x01(\x03R\tfrequency\x12\x12\n" +
+	"\x04cefr\x18\" \x01(\tR\x04cefr\x12#\n" +
+	"\rfallback_from\x18# \x01(\tR\ffallbackFrom\x129\n" +
+	"\n" +
+	"created_at\x18d \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"6\n" +
+	"\bPhonetic\x12\x10\n" +
+	"\x03ipa\x18\x01 \x01(\tR\x03ipa\x12\x18\n" +
+	"\adialect\x18\x02 \x01(\tR\adialect\"\x82\x01\n" +
+	"\n" +
+	"Definition\x12\x10\n" +
+	"\x03pos\x18\x01 \x01(\tR\x03pos\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12/\n" +
+	"\blanguage\x18\x03 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\x12\x1d\n" +
+	"\x05order\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05order\">\n" +
+	"\vWordFormRef\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1b\n" +
+	"\tword_type\x18\x02 \x01(\tR\bwordType\"\x99\x01\n" +
+	"\fWordRelation\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12<\n" +
+	"\rrelation_type\x18\x02 \x01(\x0e2\x17.common.v1.RelationTypeR\frelationType\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12#\n" +
+	"\bstrength\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\bstrength\"l\n" +
+	"\bSentence\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12-\n" +
+	"\x06source\x18\x02 \x01(\x0e2\x15.common.v1.SourceTypeR\x06source\x12\x1d\n" +
 	"\n" +
 	"source_ref\x18\x03 \x01(\tR\tsourceRef\"@\n" +
 	"\x11CreateWordRequest\x12+\n" +
-	"\x04word\x18\x01 \x01(\v2\r.dict.v1.WordB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04word\"\x83\x01\n" +
+	"\x04word\x18\x01 \x01(\v2\r.dict.v1.WordB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04word\"}\n" +
+	"\x11UpdateWordRequest\x12+\n" +
+	"\x04word\x18\x01 \x01(\v2\r.dict.v1.WordB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x04word\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"\xa8\x01\n" +
 	"\x10ListWordsRequest\x12<\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1c.common.v1.PaginationRequestR\n" +
 	"pagination\x12\x16\n" +
 	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x19\n" +
-	"\border_by\x18\x03 \x01(\tR\aorderBy\"w\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\x12#\n" +
+	"\rinclude_forms\x18\x04 \x01(\bR\fincludeForms\"w\n" +
 	"\x11ListWordsResponse\x12=\n" +
 	"\n" +
 	"pagination\x18\x01 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
 	"pagination\x12#\n" +
-	"\x05words\x18\x02 \x03(\v2\r.dict.v1.WordR\x05words\"a\n" +
+	"\x05words\x18\x02 \x03(\v2\r.dict.v1.WordR\x05words\"k\n" +
+	"\x0eGetWordRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\x12@\n" +
+	"\x10sentence_sources\x18\x02 \x03(\x0e2\x15.common.v1.SourceTypeR\x0fsentenceSources\"5\n" +
+	"\x14BatchGetWordsRequest\x12\x1d\n" +
+	"\x03ids\x18\x01 \x03(\x03B\v\xfaB\b\x92\x01\x05\b\x01\x10\xf4\x03R\x03ids\"\xd4\x01\n" +
+	"\x15BatchGetWordsResponse\x12?\n" +
+	"\x05words\x18\x01 \x03(\v2).dict.v1.BatchGetWordsResponse.WordsEntryR\x05words\x121\n" +
+	"\x06errors\x18\x02 \x03(\v2\x19.common.v1.BatchItemErrorR\x06errors\x1aG\n" +
+	"\n" +
+	"WordsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12#\n" +
+	"\x05value\x18\x02 \x01(\v2\r.dict.v1.WordR\x05value:\x028\x01\"\xd6\x01\n" +
 	"\x11LookupWordRequest\x12\x1b\n" +
 	"\x04word\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04word\x12/\n" +
-	"\blanguage\x18\x02 \x01(\x0e2\x13.common.v1.LanguageR\blanguage2\x81\x04\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\x12@\n" +
+	"\x10sentence_sources\x18\x03 \x03(\x0e2\x15.common.v1.SourceTypeR\x0fsentenceSources\x121\n" +
+	"\x06prefer\x18\x04 \x01(\x0e2\x19.dict.v1.LookupPreferenceR\x06prefer\"\xa0\x01\n" +
+	"\x10ListFormsRequest\x12\x1d\n" +
+	"\x05lemma\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05lemma\x12/\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\x12\x1d\n" +
+	"\n" +
+	"word_types\x18\x03 \x03(\tR\twordTypes\x12\x1d\n" +
+	"\x05limit\x18\x04 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05limit\"?\n" +
+	"\x11ListFormsResponse\x12*\n" +
+	"\x05forms\x18\x01 \x03(\v2\x14.dict.v1.WordFormRefR\x05forms\"h\n" +
+	"\x10LemmatizeRequest\x12#\n" +
+	"\x06tokens\x18\x01 \x03(\tB\v\xfaB\b\x92\x01\x05\b\x01\x10\xe8\aR\x06tokens\x12/\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\"p\n" +
+	"\x0fLemmatizeResult\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x14\n" +
+	"\x05lemma\x18\x02 \x01(\tR\x05lemma\x12\x1b\n" +
+	"\tword_type\x18\x03 \x01(\tR\bwordType\x12\x14\n" +
+	"\x05found\x18\x04 \x01(\bR\x05found\"G\n" +
+	"\x11LemmatizeResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.dict.v1.LemmatizeResultR\aresults\"\xe5\x01\n" +
+	"\x17GetRelatedWordsResponse\x12!\n" +
+	"\x04word\x18\x01 \x01(\v2\r.dict.v1.WordR\x04word\x12\x14\n" +
+	"\x05lemma\x18\x02 \x01(\tR\x05lemma\x12*\n" +
+	"\x05forms\x18\x03 \x03(\v2\x14.dict.v1.WordFormRefR\x05forms\x120\n" +
+	"\bsiblings\x18\x04 \x03(\v2\x14.dict.v1.WordFormRefR\bsiblings\x123\n" +
+	"\trelations\x18\x05 \x03(\v2\x15.dict.v1.WordRelationR\trelations\"W\n" +
+	"\x14ListWordAuditRequest\x12 \n" +
+	"\aword_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06wordId\x12\x1d\n" +
+	"\x05limit\x18\x02 \x01(\x05B\a\xfaB\x04\x1a\x02(\x00R\x05limit\"]\n" +
+	"\x0fWordFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x19\n" +
+	"\bold_json\x18\x02 \x01(\tR\aoldJson\x12\x19\n" +
+	"\bnew_json\x18\x03 \x01(\tR\anewJson\"\xc0\x01\n" +
+	"\x0eWordAuditEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\aword_id\x18\x02 \x01(\x03R\x06wordId\x12\x16\n" +
+	"\x06editor\x18\x03 \x01(\tR\x06editor\x122\n" +
+	"\achanges\x18\x04 \x03(\v2\x18.dict.v1.WordFieldChangeR\achanges\x129\n" +
+	"\n" +
+	"created_at\x18d \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"\x15ListWordAuditResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.dict.v1.WordAuditEntryR\aentries\"c\n" +
+	"\x11LanguageWordCount\x12/\n" +
+	"\blanguage\x18\x01 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\x12\x1d\n" +
+	"\n" +
+	"word_count\x18\x02 \x01(\x03R\twordCount\"Q\n" +
+	"\x15ListLanguagesResponse\x128\n" +
+	"\tlanguages\x18\x01 \x03(\v2\x1a.dict.v1.LanguageWordCountR\tlanguages*o\n" +
+	"\x10LookupPreference\x12!\n" +
+	"\x1dLOOKUP_PREFERENCE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17LOOKUP_PREFERENCE_LEMMA\x10\x01\x12\x1b\n" +
+	"\x17LOOKUP_PREFERENCE_EXACT\x10\x022\xd7\t\n" +
 	"\vWordService\x12Q\n" +
 	"\n" +
-	"CreateWord\x12\x1a.dict.v1.CreateWordRequest\x1a\r.dict.v1.Word\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/words\x12I\n" +
+	"CreateWord\x12\x1a.dict.v1.CreateWordRequest\x1a\r.dict.v1.Word\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/words\x12[\n" +
 	"\n" +
-	"UpdateWord\x12\r.dict.v1.Word\x1a\r.dict.v1.Word\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/api/v1/words/{id}\x12J\n" +
-	"\aGetWord\x12\x14.common.v1.IDRequest\x1a\r.dict.v1.Word\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/words/{id}\x12Y\n" +
-	"\tListWords\x12\x19.dict.v1.ListWordsRequest\x1a\x1a.dict.v1.ListWordsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/words\x12U\n" +
+	"UpdateWord\x12\x1a.dict.v1.UpdateWordRequest\x1a\r.dict.v1.Word\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/words/{word.id}\x12M\n" +
+	"\aGetWord\x12\x17.dict.v1.GetWordRequest\x1a\r.dict.v1.Word\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/api/v1/words/{id}\x12q\n" +
+	"\rBatchGetWords\x12\x1d.dict.v1.BatchGetWordsRequest\x1a\x1e.dict.v1.BatchGetWordsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/words:batchGet\x12Y\n" +
+	"\tListWords\x12\x19.dict.v1.ListWordsRequest\x1a\x1a.dict.v1.ListWordsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/words\x129\n" +
+	"\vStreamWords\x12\x19.dict.v1.ListWordsRequest\x1a\r.dict.v1.Word0\x01\x12U\n" +
 	"\n" +
-	"LookupWord\x12\x1a.dict.v1.LookupWordRequest\x1a\r.dict.v1.Word\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/words:lookup\x12V\n" +
+	"LookupWord\x12\x1a.dict.v1.LookupWordRequest\x1a\r.dict.v1.Word\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/words:lookup\x12_\n" +
+	"\tListForms\x12\x19.dict.v1.ListFormsRequest\x1a\x1a.dict.v1.ListFormsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/words:forms\x12f\n" +
+	"\tLemmatize\x12\x19.dict.v1.LemmatizeRequest\x1a\x1a.dict.v1.LemmatizeResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/words:lemmatize\x12m\n" +
+	"\x0fGetRelatedWords\x12\x14.common.v1.IDRequest\x1a .dict.v1.GetRelatedWordsResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/words/{id}/related\x12u\n" +
+	"\rListWordAudit\x12\x1d.dict.v1.ListWordAuditRequest\x1a\x1e.dict.v1.ListWordAuditResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/words/{word_id}/audit\x12b\n" +
+	"\rListLanguages\x12\x16.google.protobuf.Empty\x1a\x1e.dict.v1.ListLanguagesResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/languages\x12V\n" +
 	"\n" +
 	"DeleteWord\x12\x14.common.v1.IDRequest\x1a\x16.google.protobuf.Empty\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/api/v1/words/{id}B\x87\x01\n" +
 	"\vcom.dict.v1B\tWordProtoP\x01Z0github.com/eslsoft/vocnet/pkg/api/dict/v1;dictv1\xa2\x02\x03DXX\xaa\x02\aDict.V1\xca\x02\aDict\\V1\xe2\x02\x13Dict\\V1\\GPBMetadata\xea\x02\bDict::V1b\x06proto3"
@@ -756,63 +1871,119 @@ func file_dict_v1_word_proto_rawDescGZIP() []byte {
 	return file_dict_v1_word_proto_rawDescData
 }
 
-var file_dict_v1_word_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_dict_v1_word_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dict_v1_word_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_dict_v1_word_proto_goTypes = []any{
-	(*Word)(nil),                  // 0: dict.v1.Word
-	(*Phonetic)(nil),              // 1: dict.v1.Phonetic
-	(*Definition)(nil),            // 2: dict.v1.Definition
-	(*WordFormRef)(nil),           // 3: dict.v1.WordFormRef
-	(*WordRelation)(nil),          // 4: dict.v1.WordRelation
-	(*Sentence)(nil),              // 5: dict.v1.Sentence
-	(*CreateWordRequest)(nil),     // 6: dict.v1.CreateWordRequest
-	(*ListWordsRequest)(nil),      // 7: dict.v1.ListWordsRequest
-	(*ListWordsResponse)(nil),     // 8: dict.v1.ListWordsResponse
-	(*LookupWordRequest)(nil),     // 9: dict.v1.LookupWordRequest
-	(v1.Language)(0),              // 10: common.v1.Language
-	(*Phrase)(nil),                // 11: dict.v1.Phrase
-	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
-	(v1.RelationType)(0),          // 13: common.v1.RelationType
-	(v1.SourceType)(0),            // 14: common.v1.SourceType
-	(*v1.PaginationRequest)(nil),  // 15: common.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil), // 16: common.v1.PaginationResponse
-	(*v1.IDRequest)(nil),          // 17: common.v1.IDRequest
-	(*emptypb.Empty)(nil),         // 18: google.protobuf.Empty
+	(LookupPreference)(0),           // 0: dict.v1.LookupPreference
+	(*Word)(nil),                    // 1: dict.v1.Word
+	(*Phonetic)(nil),                // 2: dict.v1.Phonetic
+	(*Definition)(nil),              // 3: dict.v1.Definition
+	(*WordFormRef)(nil),             // 4: dict.v1.WordFormRef
+	(*WordRelation)(nil),            // 5: dict.v1.WordRelation
+	(*Sentence)(nil),                // 6: dict.v1.Sentence
+	(*CreateWordRequest)(nil),       // 7: dict.v1.CreateWordRequest
+	(*UpdateWordRequest)(nil),       // 8: dict.v1.UpdateWordRequest
+	(*ListWordsRequest)(nil),        // 9: dict.v1.ListWordsRequest
+	(*ListWordsResponse)(nil),       // 10: dict.v1.ListWordsResponse
+	(*GetWordRequest)(nil),          // 11: dict.v1.GetWordRequest
+	(*BatchGetWordsRequest)(nil),    // 12: dict.v1.BatchGetWordsRequest
+	(*BatchGetWordsResponse)(nil),   // 13: dict.v1.BatchGetWordsResponse
+	(*LookupWordRequest)(nil),       // 14: dict.v1.LookupWordRequest
+	(*ListFormsRequest)(nil),        // 15: dict.v1.ListFormsRequest
+	(*ListFormsResponse)(nil),       // 16: dict.v1.ListFormsResponse
+	(*LemmatizeRequest)(nil),        // 17: dict.v1.LemmatizeRequest
+	(*LemmatizeResult)(nil),         // 18: dict.v1.LemmatizeResult
+	(*LemmatizeResponse)(nil),       // 19: dict.v1.LemmatizeResponse
+	(*GetRelatedWordsResponse)(nil), // 20: dict.v1.GetRelatedWordsResponse
+	(*ListWordAuditRequest)(nil),    // 21: dict.v1.ListWordAuditRequest
+	(*WordFieldChange)(nil),         // 22: dict.v1.WordFieldChange
+	(*WordAuditEntry)(nil),          // 23: dict.v1.WordAuditEntry
+	(*ListWordAuditResponse)(nil),   // 24: dict.v1.ListWordAuditResponse
+	(*LanguageWordCount)(nil),       // 25: dict.v1.LanguageWordCount
+	(*ListLanguagesResponse)(nil),   // 26: dict.v1.ListLanguagesResponse
+	nil,                             // 27: dict.v1.BatchGetWordsResponse.WordsEntry
+	(v1.Language)(0),                // 28: common.v1.Language
+	(*Phrase)(nil),                  // 29: dict.v1.Phrase
+	(*timestamppb.Timestamp)(nil),   // 30: google.protobuf.Timestamp
+	(v1.RelationType)(0),            // 31: common.v1.RelationType
+	(v1.SourceType)(0),              // 32: common.v1.SourceType
+	(*fieldmaskpb.FieldMask)(nil),   // 33: google.protobuf.FieldMask
+	(*v1.PaginationRequest)(nil),    // 34: common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),   // 35: common.v1.PaginationResponse
+	(*v1.BatchItemError)(nil),       // 36: common.v1.BatchItemError
+	(*v1.IDRequest)(nil),            // 37: common.v1.IDRequest
+	(*emptypb.Empty)(nil),           // 38: google.protobuf.Empty
 }
 var file_dict_v1_word_proto_depIdxs = []int32{
-	10, // 0: dict.v1.Word.language:type_name -> common.v1.Language
-	1,  // 1: dict.v1.Word.phonetics:type_name -> dict.v1.Phonetic
-	2,  // 2: dict.v1.Word.definitions:type_name -> dict.v1.Definition
-	11, // 3: dict.v1.Word.phrases:type_name -> dict.v1.Phrase
-	5,  // 4: dict.v1.Word.sentences:type_name -> dict.v1.Sentence
-	3,  // 5: dict.v1.Word.forms:type_name -> dict.v1.WordFormRef
-	4,  // 6: dict.v1.Word.relations:type_name -> dict.v1.WordRelation
-	12, // 7: dict.v1.Word.created_at:type_name -> google.protobuf.Timestamp
-	12, // 8: dict.v1.Word.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 9: dict.v1.Definition.language:type_name -> common.v1.Language
-	13, // 10: dict.v1.WordRelation.relation_type:type_name -> common.v1.RelationType
-	14, // 11: dict.v1.Sentence.source:type_name -> common.v1.SourceType
-	0,  // 12: dict.v1.CreateWordRequest.word:type_name -> dict.v1.Word
-	15, // 13: dict.v1.ListWordsRequest.pagination:type_name -> common.v1.PaginationRequest
-	16, // 14: dict.v1.ListWordsResponse.pagination:type_name -> common.v1.PaginationResponse
-	0,  // 15: dict.v1.ListWordsResponse.words:type_name -> dict.v1.Word
-	10, // 16: dict.v1.LookupWordRequest.language:type_name -> common.v1.Language
-	6,  // 17: dict.v1.WordService.CreateWord:input_type -> dict.v1.CreateWordRequest
-	0,  // 18: dict.v1.WordService.UpdateWord:input_type -> dict.v1.Word
-	17, // 19: dict.v1.WordService.GetWord:input_type -> common.v1.IDRequest
-	7,  // 20: dict.v1.WordService.ListWords:input_type -> dict.v1.ListWordsRequest
-	9,  // 21: dict.v1.WordService.LookupWord:input_type -> dict.v1.LookupWordRequest
-	17, // 22: dict.v1.WordService.DeleteWord:input_type -> common.v1.IDRequest
-	0,  // 23: dict.v1.WordService.CreateWord:output_type -> dict.v1.Word
-	0,  // 24: dict.v1.WordService.UpdateWord:output_type -> dict.v1.Word
-	0,  // 25: dict.v1.WordService.GetWord:output_type -> dict.v1.Word
-	8,  // 26: dict.v1.WordService.ListWords:output_type -> dict.v1.ListWordsResponse
-	0,  // 27: dict.v1.WordService.LookupWord:output_type -> dict.v1.Word
-	18, // 28: dict.v1.WordService.DeleteWord:output_type -> google.protobuf.Empty
-	23, // [23:29] is the sub-list for method output_type
-	17, // [17:23] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	28, // 0: dict.v1.Word.language:type_name -> common.v1.Language
+	2,  // 1: dict.v1.Word.phonetics:type_name -> dict.v1.Phonetic
+	3,  // 2: dict.v1.Word.definitions:type_name -> dict.v1.Definition
+	29, // 3: dict.v1.Word.phrases:type_name -> dict.v1.Phrase
+	6,  // 4: dict.v1.Word.sentences:type_name -> dict.v1.Sentence
+	4,  // 5: dict.v1.Word.forms:type_name -> dict.v1.WordFormRef
+	5,  // 6: dict.v1.Word.relations:type_name -> dict.v1.WordRelation
+	30, // 7: dict.v1.Word.created_at:type_name -> google.protobuf.Timestamp
+	30, // 8: dict.v1.Word.updated_at:type_name -> google.protobuf.Timestamp
+	28, // 9: dict.v1.Definition.language:type_name -> common.v1.Language
+	31, // 10: dict.v1.WordRelation.relation_type:type_name -> common.v1.RelationType
+	32, // 11: dict.v1.Sentence.source:type_name -> common.v1.SourceType
+	1,  // 12: dict.v1.CreateWordRequest.word:type_name -> dict.v1.Word
+	1,  // 13: dict.v1.UpdateWordRequest.word:type_name -> dict.v1.Word
+	33, // 14: dict.v1.UpdateWordRequest.update_mask:type_name -> google.protobuf.FieldMask
+	34, // 15: dict.v1.ListWordsRequest.pagination:type_name -> common.v1.PaginationRequest
+	35, // 16: dict.v1.ListWordsResponse.pagination:type_name -> common.v1.PaginationResponse
+	1,  // 17: dict.v1.ListWordsResponse.words:type_name -> dict.v1.Word
+	32, // 18: dict.v1.GetWordRequest.sentence_sources:type_name -> common.v1.SourceType
+	27, // 19: dict.v1.BatchGetWordsResponse.words:type_name -> dict.v1.BatchGetWordsResponse.WordsEntry
+	36, // 20: dict.v1.BatchGetWordsResponse.errors:type_name -> common.v1.BatchItemError
+	28, // 21: dict.v1.LookupWordRequest.language:type_name -> common.v1.Language
+	32, // 22: dict.v1.LookupWordRequest.sentence_sources:type_name -> common.v1.SourceType
+	0,  // 23: dict.v1.LookupWordRequest.prefer:type_name -> dict.v1.LookupPreference
+	28, // 24: dict.v1.ListFormsRequest.language:type_name -> common.v1.Language
+	4,  // 25: dict.v1.ListFormsResponse.forms:type_name -> dict.v1.WordFormRef
+	28, // 26: dict.v1.LemmatizeRequest.language:type_name -> common.v1.Language
+	18, // 27: dict.v1.LemmatizeResponse.results:type_name -> dict.v1.LemmatizeResult
+	1,  // 28: dict.v1.GetRelatedWordsResponse.word:type_name -> dict.v1.Word
+	4,  // 29: dict.v1.GetRelatedWordsResponse.forms:type_name -> dict.v1.WordFormRef
+	4,  // 30: dict.v1.GetRelatedWordsResponse.siblings:type_name -> dict.v1.WordFormRef
+	5,  // 31: dict.v1.GetRelatedWordsResponse.relations:type_name -> dict.v1.WordRelation
+	22, // 32: dict.v1.WordAuditEntry.changes:type_name -> dict.v1.WordFieldChange
+	30, // 33: dict.v1.WordAuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	23, // 34: dict.v1.ListWordAuditResponse.entries:type_name -> dict.v1.WordAuditEntry
+	28, // 35: dict.v1.LanguageWordCount.language:type_name -> common.v1.Language
+	25, // 36: dict.v1.ListLanguagesResponse.languages:type_name -> dict.v1.LanguageWordCount
+	1,  // 37: dict.v1.BatchGetWordsResponse.WordsEntry.value:type_name -> dict.v1.Word
+	7,  // 38: dict.v1.WordService.CreateWord:input_type -> dict.v1.CreateWordRequest
+	8,  // 39: dict.v1.WordService.UpdateWord:input_type -> dict.v1.UpdateWordRequest
+	11, // 40: dict.v1.WordService.GetWord:input_type -> dict.v1.GetWordRequest
+	12, // 41: dict.v1.WordService.BatchGetWords:input_type -> dict.v1.BatchGetWordsRequest
+	9,  // 42: dict.v1.WordService.ListWords:input_type -> dict.v1.ListWordsRequest
+	9,  // 43: dict.v1.WordService.StreamWords:input_type -> dict.v1.ListWordsRequest
+	14, // 44: dict.v1.WordService.LookupWord:input_type -> dict.v1.LookupWordRequest
+	15, // 45: dict.v1.WordService.ListForms:input_type -> dict.v1.ListFormsRequest
+	17, // 46: dict.v1.WordService.Lemmatize:input_type -> dict.v1.LemmatizeRequest
+	37, // 47: dict.v1.WordService.GetRelatedWords:input_type -> common.v1.IDRequest
+	21, // 48: dict.v1.WordService.ListWordAudit:input_type -> dict.v1.ListWordAuditRequest
+	38, // 49: dict.v1.WordService.ListLanguages:input_type -> google.protobuf.Empty
+	37, // 50: dict.v1.WordService.DeleteWord:input_type -> common.v1.IDRequest
+	1,  // 51: dict.v1.WordService.CreateWord:output_type -> dict.v1.Word
+	1,  // 52: dict.v1.WordService.UpdateWord:output_type -> dict.v1.Word
+	1,  // 53: dict.v1.WordService.GetWord:output_type -> dict.v1.Word
+	13, // 54: dict.v1.WordService.BatchGetWords:output_type -> dict.v1.BatchGetWordsResponse
+	10, // 55: dict.v1.WordService.ListWords:output_type -> dict.v1.ListWordsResponse
+	1,  // 56: dict.v1.WordService.StreamWords:output_type -> dict.v1.Word
+	1,  // 57: dict.v1.WordService.LookupWord:output_type -> dict.v1.Word
+	16, // 58: dict.v1.WordService.ListForms:output_type -> dict.v1.ListFormsResponse
+	19, // 59: dict.v1.WordService.Lemmatize:output_type -> dict.v1.LemmatizeResponse
+	20, // 60: dict.v1.WordService.GetRelatedWords:output_type -> dict.v1.GetRelatedWordsResponse
+	24, // 61: dict.v1.WordService.ListWordAudit:output_type -> dict.v1.ListWordAuditResponse
+	26, // 62: dict.v1.WordService.ListLanguages:output_type -> dict.v1.ListLanguagesResponse
+	38, // 63: dict.v1.WordService.DeleteWord:output_type -> google.protobuf.Empty
+	51, // [51:64] is the sub-list for method output_type
+	38, // [38:51] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_dict_v1_word_proto_init() }
@@ -826,13 +1997,14 @@ func file_dict_v1_word_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dict_v1_word_proto_rawDesc), len(file_dict_v1_word_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      1,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_dict_v1_word_proto_goTypes,
 		DependencyIndexes: file_dict_v1_word_proto_depIdxs,
+		EnumInfos:         file_dict_v1_word_proto_enumTypes,
 		MessageInfos:      file_dict_v1_word_proto_msgTypes,
 	}.Build()
 	File_dict_v1_word_proto = out.File
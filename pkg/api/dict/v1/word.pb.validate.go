@@ -274,6 +274,14 @@ func (m *Word) validate(all bool) error {
 
 	}
 
+	// no validation rules for Source
+
+	// no validation rules for Frequency
+
+	// no validation rules for Cefr
+
+	// no validation rules for FallbackFrom
+
 	if all {
 		switch v := interface{}(m.GetCreatedAt()).(type) {
 		case interface{ ValidateAll() error }:
@@ -540,6 +548,17 @@ func (m *Definition) validate(all bool) error {
 
 	// no validation rules for Language
 
+	if m.GetOrder() < 0 {
+		err := DefinitionValidationError{
+			field:  "Order",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return DefinitionMultiError(errors)
 	}
@@ -746,6 +765,19 @@ func (m *WordRelation) validate(all bool) error {
 
 	// no validation rules for RelationType
 
+	// no validation rules for Note
+
+	if m.GetStrength() < 0 {
+		err := WordRelationValidationError{
+			field:  "Strength",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return WordRelationMultiError(errors)
 	}
@@ -1070,6 +1102,177 @@ var _ interface {
 	ErrorName() string
 } = CreateWordRequestValidationError{}
 
+// Validate checks the field values on UpdateWordRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateWordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateWordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateWordRequestMultiError, or nil if none found.
+func (m *UpdateWordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateWordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWord() == nil {
+		err := UpdateWordRequestValidationError{
+			field:  "Word",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWordRequestValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWordRequestValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWordRequestValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWordRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWordRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWordRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateWordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateWordRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateWordRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateWordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateWordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateWordRequestMultiError) AllErrors() []error { return m }
+
+// UpdateWordRequestValidationError is the validation error returned by
+// UpdateWordRequest.Validate if the designated constraints aren't met.
+type UpdateWordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateWordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateWordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateWordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateWordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateWordRequestValidationError) ErrorName() string {
+	return "UpdateWordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateWordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateWordRequestValidationError{}
+
 // Validate checks the field values on ListWordsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1125,6 +1328,8 @@ func (m *ListWordsRequest) validate(all bool) error {
 
 	// no validation rules for OrderBy
 
+	// no validation rules for IncludeForms
+
 	if len(errors) > 0 {
 		return ListWordsRequestMultiError(errors)
 	}
@@ -1368,32 +1573,32 @@ var _ interface {
 	ErrorName() string
 } = ListWordsResponseValidationError{}
 
-// Validate checks the field values on LookupWordRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *LookupWordRequest) Validate() error {
+// Validate checks the field values on GetWordRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetWordRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LookupWordRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// LookupWordRequestMultiError, or nil if none found.
-func (m *LookupWordRequest) ValidateAll() error {
+// ValidateAll checks the field values on GetWordRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetWordRequestMultiError,
+// or nil if none found.
+func (m *GetWordRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LookupWordRequest) validate(all bool) error {
+func (m *GetWordRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetWord()) < 1 {
-		err := LookupWordRequestValidationError{
-			field:  "Word",
-			reason: "value length must be at least 1 runes",
+	if m.GetId() <= 0 {
+		err := GetWordRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1401,22 +1606,20 @@ func (m *LookupWordRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Language
-
 	if len(errors) > 0 {
-		return LookupWordRequestMultiError(errors)
+		return GetWordRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// LookupWordRequestMultiError is an error wrapping multiple validation errors
-// returned by LookupWordRequest.ValidateAll() if the designated constraints
+// GetWordRequestMultiError is an error wrapping multiple validation errors
+// returned by GetWordRequest.ValidateAll() if the designated constraints
 // aren't met.
-type LookupWordRequestMultiError []error
+type GetWordRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LookupWordRequestMultiError) Error() string {
+func (m GetWordRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1425,11 +1628,11 @@ func (m LookupWordRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LookupWordRequestMultiError) AllErrors() []error { return m }
+func (m GetWordRequestMultiError) AllErrors() []error { return m }
 
-// LookupWordRequestValidationError is the validation error returned by
-// LookupWordRequest.Validate if the designated constraints aren't met.
-type LookupWordRequestValidationError struct {
+// GetWordRequestValidationError is the validation error returned by
+// GetWordRequest.Validate if the designated constraints aren't met.
+type GetWordRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1437,24 +1640,22 @@ type LookupWordRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e LookupWordRequestValidationError) Field() string { return e.field }
+func (e GetWordRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LookupWordRequestValidationError) Reason() string { return e.reason }
+func (e GetWordRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LookupWordRequestValidationError) Cause() error { return e.cause }
+func (e GetWordRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LookupWordRequestValidationError) Key() bool { return e.key }
+func (e GetWordRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LookupWordRequestValidationError) ErrorName() string {
-	return "LookupWordRequestValidationError"
-}
+func (e GetWordRequestValidationError) ErrorName() string { return "GetWordRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e LookupWordRequestValidationError) Error() string {
+func (e GetWordRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1466,14 +1667,14 @@ func (e LookupWordRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLookupWordRequest.%s: %s%s",
+		"invalid %sGetWordRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LookupWordRequestValidationError{}
+var _ error = GetWordRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1481,4 +1682,2045 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LookupWordRequestValidationError{}
+} = GetWordRequestValidationError{}
+
+// Validate checks the field values on BatchGetWordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetWordsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetWordsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetWordsRequestMultiError, or nil if none found.
+func (m *BatchGetWordsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetWordsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetIds()); l < 1 || l > 500 {
+		err := BatchGetWordsRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BatchGetWordsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetWordsRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchGetWordsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetWordsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetWordsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetWordsRequestMultiError) AllErrors() []error { return m }
+
+// BatchGetWordsRequestValidationError is the validation error returned by
+// BatchGetWordsRequest.Validate if the designated constraints aren't met.
+type BatchGetWordsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetWordsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetWordsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetWordsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetWordsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetWordsRequestValidationError) ErrorName() string {
+	return "BatchGetWordsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetWordsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetWordsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetWordsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetWordsRequestValidationError{}
+
+// Validate checks the field values on BatchGetWordsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchGetWordsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchGetWordsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchGetWordsResponseMultiError, or nil if none found.
+func (m *BatchGetWordsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchGetWordsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]int64, len(m.GetWords()))
+		i := 0
+		for key := range m.GetWords() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetWords()[key]
+			_ = val
+
+			// no validation rules for Words[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, BatchGetWordsResponseValidationError{
+							field:  fmt.Sprintf("Words[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, BatchGetWordsResponseValidationError{
+							field:  fmt.Sprintf("Words[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return BatchGetWordsResponseValidationError{
+						field:  fmt.Sprintf("Words[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchGetWordsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchGetWordsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchGetWordsResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchGetWordsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchGetWordsResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchGetWordsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchGetWordsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchGetWordsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchGetWordsResponseMultiError) AllErrors() []error { return m }
+
+// BatchGetWordsResponseValidationError is the validation error returned by
+// BatchGetWordsResponse.Validate if the designated constraints aren't met.
+type BatchGetWordsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchGetWordsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchGetWordsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchGetWordsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchGetWordsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchGetWordsResponseValidationError) ErrorName() string {
+	return "BatchGetWordsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchGetWordsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchGetWordsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchGetWordsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchGetWordsResponseValidationError{}
+
+// Validate checks the field values on LookupWordRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LookupWordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LookupWordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LookupWordRequestMultiError, or nil if none found.
+func (m *LookupWordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LookupWordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetWord()) < 1 {
+		err := LookupWordRequestValidationError{
+			field:  "Word",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Language
+
+	// no validation rules for Prefer
+
+	if len(errors) > 0 {
+		return LookupWordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LookupWordRequestMultiError is an error wrapping multiple validation errors
+// returned by LookupWordRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LookupWordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LookupWordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LookupWordRequestMultiError) AllErrors() []error { return m }
+
+// LookupWordRequestValidationError is the validation error returned by
+// LookupWordRequest.Validate if the designated constraints aren't met.
+type LookupWordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LookupWordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LookupWordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LookupWordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LookupWordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LookupWordRequestValidationError) ErrorName() string {
+	return "LookupWordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LookupWordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLookupWordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LookupWordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LookupWordRequestValidationError{}
+
+// Validate checks the field values on ListFormsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFormsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFormsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFormsRequestMultiError, or nil if none found.
+func (m *ListFormsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFormsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetLemma()) < 1 {
+		err := ListFormsRequestValidationError{
+			field:  "Lemma",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Language
+
+	if m.GetLimit() < 0 {
+		err := ListFormsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListFormsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFormsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListFormsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListFormsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFormsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFormsRequestMultiError) AllErrors() []error { return m }
+
+// ListFormsRequestValidationError is the validation error returned by
+// ListFormsRequest.Validate if the designated constraints aren't met.
+type ListFormsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFormsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFormsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFormsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFormsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFormsRequestValidationError) ErrorName() string { return "ListFormsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListFormsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFormsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFormsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFormsRequestValidationError{}
+
+// Validate checks the field values on ListFormsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFormsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListFormsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListFormsResponseMultiError, or nil if none found.
+func (m *ListFormsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListFormsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetForms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListFormsResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListFormsResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListFormsResponseValidationError{
+					field:  fmt.Sprintf("Forms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListFormsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListFormsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListFormsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListFormsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListFormsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListFormsResponseMultiError) AllErrors() []error { return m }
+
+// ListFormsResponseValidationError is the validation error returned by
+// ListFormsResponse.Validate if the designated constraints aren't met.
+type ListFormsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListFormsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListFormsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListFormsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListFormsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListFormsResponseValidationError) ErrorName() string {
+	return "ListFormsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListFormsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListFormsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListFormsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListFormsResponseValidationError{}
+
+// Validate checks the field values on LemmatizeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LemmatizeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LemmatizeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LemmatizeRequestMultiError, or nil if none found.
+func (m *LemmatizeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LemmatizeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetTokens()); l < 1 || l > 1000 {
+		err := LemmatizeRequestValidationError{
+			field:  "Tokens",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Language
+
+	if len(errors) > 0 {
+		return LemmatizeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LemmatizeRequestMultiError is an error wrapping multiple validation errors
+// returned by LemmatizeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LemmatizeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LemmatizeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LemmatizeRequestMultiError) AllErrors() []error { return m }
+
+// LemmatizeRequestValidationError is the validation error returned by
+// LemmatizeRequest.Validate if the designated constraints aren't met.
+type LemmatizeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LemmatizeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LemmatizeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LemmatizeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LemmatizeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LemmatizeRequestValidationError) ErrorName() string { return "LemmatizeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LemmatizeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLemmatizeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LemmatizeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LemmatizeRequestValidationError{}
+
+// Validate checks the field values on LemmatizeResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LemmatizeResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LemmatizeResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LemmatizeResultMultiError, or nil if none found.
+func (m *LemmatizeResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LemmatizeResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Lemma
+
+	// no validation rules for WordType
+
+	// no validation rules for Found
+
+	if len(errors) > 0 {
+		return LemmatizeResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// LemmatizeResultMultiError is an error wrapping multiple validation errors
+// returned by LemmatizeResult.ValidateAll() if the designated constraints
+// aren't met.
+type LemmatizeResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LemmatizeResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LemmatizeResultMultiError) AllErrors() []error { return m }
+
+// LemmatizeResultValidationError is the validation error returned by
+// LemmatizeResult.Validate if the designated constraints aren't met.
+type LemmatizeResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LemmatizeResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LemmatizeResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LemmatizeResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LemmatizeResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LemmatizeResultValidationError) ErrorName() string { return "LemmatizeResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LemmatizeResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLemmatizeResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LemmatizeResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LemmatizeResultValidationError{}
+
+// Validate checks the field values on LemmatizeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LemmatizeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LemmatizeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LemmatizeResponseMultiError, or nil if none found.
+func (m *LemmatizeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LemmatizeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LemmatizeResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LemmatizeResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LemmatizeResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LemmatizeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LemmatizeResponseMultiError is an error wrapping multiple validation errors
+// returned by LemmatizeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LemmatizeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LemmatizeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LemmatizeResponseMultiError) AllErrors() []error { return m }
+
+// LemmatizeResponseValidationError is the validation error returned by
+// LemmatizeResponse.Validate if the designated constraints aren't met.
+type LemmatizeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LemmatizeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LemmatizeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LemmatizeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LemmatizeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LemmatizeResponseValidationError) ErrorName() string {
+	return "LemmatizeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LemmatizeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLemmatizeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LemmatizeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LemmatizeResponseValidationError{}
+
+// Validate checks the field values on GetRelatedWordsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRelatedWordsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRelatedWordsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRelatedWordsResponseMultiError, or nil if none found.
+func (m *GetRelatedWordsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRelatedWordsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRelatedWordsResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRelatedWordsResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRelatedWordsResponseValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Lemma
+
+	for idx, item := range m.GetForms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRelatedWordsResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRelatedWordsResponseValidationError{
+						field:  fmt.Sprintf("Forms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRelatedWordsResponseValidationError{
+					field:  fmt.Sprintf("Forms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSiblings() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRelatedWordsResponseValidationError{
+						field:  fmt.Sprintf("Siblings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRelatedWordsResponseValidationError{
+						field:  fmt.Sprintf("Siblings[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRelatedWordsResponseValidationError{
+					field:  fmt.Sprintf("Siblings[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRelations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRelatedWordsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRelatedWordsResponseValidationError{
+						field:  fmt.Sprintf("Relations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRelatedWordsResponseValidationError{
+					field:  fmt.Sprintf("Relations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRelatedWordsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRelatedWordsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetRelatedWordsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetRelatedWordsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRelatedWordsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRelatedWordsResponseMultiError) AllErrors() []error { return m }
+
+// GetRelatedWordsResponseValidationError is the validation error returned by
+// GetRelatedWordsResponse.Validate if the designated constraints aren't met.
+type GetRelatedWordsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRelatedWordsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRelatedWordsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRelatedWordsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRelatedWordsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRelatedWordsResponseValidationError) ErrorName() string {
+	return "GetRelatedWordsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRelatedWordsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRelatedWordsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRelatedWordsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRelatedWordsResponseValidationError{}
+
+// Validate checks the field values on ListWordAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWordAuditRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWordAuditRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWordAuditRequestMultiError, or nil if none found.
+func (m *ListWordAuditRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWordAuditRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWordId() <= 0 {
+		err := ListWordAuditRequestValidationError{
+			field:  "WordId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLimit() < 0 {
+		err := ListWordAuditRequestValidationError{
+			field:  "Limit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWordAuditRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWordAuditRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWordAuditRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWordAuditRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWordAuditRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWordAuditRequestMultiError) AllErrors() []error { return m }
+
+// ListWordAuditRequestValidationError is the validation error returned by
+// ListWordAuditRequest.Validate if the designated constraints aren't met.
+type ListWordAuditRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWordAuditRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWordAuditRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWordAuditRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWordAuditRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWordAuditRequestValidationError) ErrorName() string {
+	return "ListWordAuditRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWordAuditRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWordAuditRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWordAuditRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWordAuditRequestValidationError{}
+
+// Validate checks the field values on WordFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WordFieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WordFieldChangeMultiError, or nil if none found.
+func (m *WordFieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordFieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	// no validation rules for OldJson
+
+	// no validation rules for NewJson
+
+	if len(errors) > 0 {
+		return WordFieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by WordFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type WordFieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordFieldChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordFieldChangeMultiError) AllErrors() []error { return m }
+
+// WordFieldChangeValidationError is the validation error returned by
+// WordFieldChange.Validate if the designated constraints aren't met.
+type WordFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordFieldChangeValidationError) ErrorName() string { return "WordFieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WordFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordFieldChangeValidationError{}
+
+// Validate checks the field values on WordAuditEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WordAuditEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WordAuditEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WordAuditEntryMultiError,
+// or nil if none found.
+func (m *WordAuditEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WordAuditEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for WordId
+
+	// no validation rules for Editor
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WordAuditEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WordAuditEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WordAuditEntryValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WordAuditEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WordAuditEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WordAuditEntryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WordAuditEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// WordAuditEntryMultiError is an error wrapping multiple validation errors
+// returned by WordAuditEntry.ValidateAll() if the designated constraints
+// aren't met.
+type WordAuditEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WordAuditEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WordAuditEntryMultiError) AllErrors() []error { return m }
+
+// WordAuditEntryValidationError is the validation error returned by
+// WordAuditEntry.Validate if the designated constraints aren't met.
+type WordAuditEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WordAuditEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WordAuditEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WordAuditEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WordAuditEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WordAuditEntryValidationError) ErrorName() string { return "WordAuditEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WordAuditEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWordAuditEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WordAuditEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WordAuditEntryValidationError{}
+
+// Validate checks the field values on ListWordAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWordAuditResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWordAuditResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWordAuditResponseMultiError, or nil if none found.
+func (m *ListWordAuditResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWordAuditResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWordAuditResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWordAuditResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWordAuditResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWordAuditResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWordAuditResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWordAuditResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWordAuditResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWordAuditResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWordAuditResponseMultiError) AllErrors() []error { return m }
+
+// ListWordAuditResponseValidationError is the validation error returned by
+// ListWordAuditResponse.Validate if the designated constraints aren't met.
+type ListWordAuditResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWordAuditResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWordAuditResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWordAuditResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWordAuditResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWordAuditResponseValidationError) ErrorName() string {
+	return "ListWordAuditResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWordAuditResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWordAuditResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWordAuditResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWordAuditResponseValidationError{}
+
+// Validate checks the field values on LanguageWordCount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LanguageWordCount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LanguageWordCount with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LanguageWordCountMultiError, or nil if none found.
+func (m *LanguageWordCount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LanguageWordCount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Language
+
+	// no validation rules for WordCount
+
+	if len(errors) > 0 {
+		return LanguageWordCountMultiError(errors)
+	}
+
+	return nil
+}
+
+// LanguageWordCountMultiError is an error wrapping multiple validation errors
+// returned by LanguageWordCount.ValidateAll() if the designated constraints
+// aren't met.
+type LanguageWordCountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LanguageWordCountMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LanguageWordCountMultiError) AllErrors() []error { return m }
+
+// LanguageWordCountValidationError is the validation error returned by
+// LanguageWordCount.Validate if the designated constraints aren't met.
+type LanguageWordCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LanguageWordCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LanguageWordCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LanguageWordCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LanguageWordCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LanguageWordCountValidationError) ErrorName() string {
+	return "LanguageWordCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LanguageWordCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLanguageWordCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LanguageWordCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LanguageWordCountValidationError{}
+
+// Validate checks the field values on ListLanguagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLanguagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLanguagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLanguagesResponseMultiError, or nil if none found.
+func (m *ListLanguagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLanguagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLanguages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLanguagesResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLanguagesResponseValidationError{
+						field:  fmt.Sprintf("Languages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLanguagesResponseValidationError{
+					field:  fmt.Sprintf("Languages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLanguagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLanguagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListLanguagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListLanguagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLanguagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLanguagesResponseMultiError) AllErrors() []error { return m }
+
+// ListLanguagesResponseValidationError is the validation error returned by
+// ListLanguagesResponse.Validate if the designated constraints aren't met.
+type ListLanguagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLanguagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLanguagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLanguagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLanguagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLanguagesResponseValidationError) ErrorName() string {
+	return "ListLanguagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLanguagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLanguagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLanguagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLanguagesResponseValidationError{}
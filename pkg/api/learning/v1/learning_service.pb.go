@@ -9,9 +9,11 @@ package learningv1
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	v1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
+	v11 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -26,8 +28,11 @@ const (
 
 // CollectLexeme request - main API for adding lexemes to user vocabulary
 type CollectLexemeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lexeme        *LearnedLexeme         `protobuf:"bytes,1,opt,name=lexeme,proto3" json:"lexeme,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Lexeme *LearnedLexeme         `protobuf:"bytes,1,opt,name=lexeme,proto3" json:"lexeme,omitempty"`
+	// Remove every tag of an already collected lexeme. An empty lexeme.spec.tags otherwise means
+	// "leave tags unchanged", since repeated fields cannot tell "not sent" from "empty".
+	ClearTags     bool `protobuf:"varint,2,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -69,6 +74,65 @@ func (x *CollectLexemeRequest) GetLexeme() *LearnedLexeme {
 	return nil
 }
 
+func (x *CollectLexemeRequest) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
+type GetLearnedLexemeByTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	Language      v1.Language            `protobuf:"varint,2,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // optional; if unspecified, server default language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLearnedLexemeByTermRequest) Reset() {
+	*x = GetLearnedLexemeByTermRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLearnedLexemeByTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLearnedLexemeByTermRequest) ProtoMessage() {}
+
+func (x *GetLearnedLexemeByTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLearnedLexemeByTermRequest.ProtoReflect.Descriptor instead.
+func (*GetLearnedLexemeByTermRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLearnedLexemeByTermRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *GetLearnedLexemeByTermRequest) GetLanguage() v1.Language {
+	if x != nil {
+		return x.Language
+	}
+	return v1.Language(0)
+}
+
 // UpdateLearnedLexemeMasteryRequest request
 type UpdateMasteryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -81,7 +145,7 @@ type UpdateMasteryRequest struct {
 
 func (x *UpdateMasteryRequest) Reset() {
 	*x = UpdateMasteryRequest{}
-	mi := &file_learning_v1_learning_service_proto_msgTypes[1]
+	mi := &file_learning_v1_learning_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -93,7 +157,7 @@ func (x *UpdateMasteryRequest) String() string {
 func (*UpdateMasteryRequest) ProtoMessage() {}
 
 func (x *UpdateMasteryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_learning_v1_learning_service_proto_msgTypes[1]
+	mi := &file_learning_v1_learning_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -106,7 +170,7 @@ func (x *UpdateMasteryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateMasteryRequest.ProtoReflect.Descriptor instead.
 func (*UpdateMasteryRequest) Descriptor() ([]byte, []int) {
-	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{1}
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UpdateMasteryRequest) GetLexemeId() int64 {
@@ -130,34 +194,33 @@ func (x *UpdateMasteryRequest) GetNotes() string {
 	return ""
 }
 
-// ListLearnedLexemesRequest request with comprehensive filtering
-type ListLearnedLexemesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// pagination parameters
-	Pagination *v1.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// filtering options using CEL expressions
-	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	// ordering options. e.g. "lexeme asc", "mastery.overall desc"
-	OrderBy       string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+// RenameLexemeRequest renames a lexeme to term and re-links it to the matching dictionary entry
+type RenameLexemeRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	LexemeId int64                  `protobuf:"varint,1,opt,name=lexeme_id,json=lexemeId,proto3" json:"lexeme_id,omitempty"`
+	Term     string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	// when the user already collected term, fold this lexeme into that one (keeping the higher
+	// mastery and the latest review) instead of failing with AlreadyExists
+	Merge         bool `protobuf:"varint,3,opt,name=merge,proto3" json:"merge,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLearnedLexemesRequest) Reset() {
-	*x = ListLearnedLexemesRequest{}
-	mi := &file_learning_v1_learning_service_proto_msgTypes[2]
+func (x *RenameLexemeRequest) Reset() {
+	*x = RenameLexemeRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLearnedLexemesRequest) String() string {
+func (x *RenameLexemeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLearnedLexemesRequest) ProtoMessage() {}
+func (*RenameLexemeRequest) ProtoMessage() {}
 
-func (x *ListLearnedLexemesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_learning_v1_learning_service_proto_msgTypes[2]
+func (x *RenameLexemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -168,55 +231,180 @@ func (x *ListLearnedLexemesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLearnedLexemesRequest.ProtoReflect.Descriptor instead.
-func (*ListLearnedLexemesRequest) Descriptor() ([]byte, []int) {
-	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use RenameLexemeRequest.ProtoReflect.Descriptor instead.
+func (*RenameLexemeRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ListLearnedLexemesRequest) GetPagination() *v1.PaginationRequest {
+func (x *RenameLexemeRequest) GetLexemeId() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.LexemeId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListLearnedLexemesRequest) GetFilter() string {
+func (x *RenameLexemeRequest) GetTerm() string {
 	if x != nil {
-		return x.Filter
+		return x.Term
 	}
 	return ""
 }
 
-func (x *ListLearnedLexemesRequest) GetOrderBy() string {
+func (x *RenameLexemeRequest) GetMerge() bool {
 	if x != nil {
-		return x.OrderBy
+		return x.Merge
+	}
+	return false
+}
+
+// MasteryUpdate is one review result recorded by the client
+type MasteryUpdate struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	LexemeId int64                  `protobuf:"varint,1,opt,name=lexeme_id,json=lexemeId,proto3" json:"lexeme_id,omitempty"`
+	Mastery  *MasteryBreakdown      `protobuf:"bytes,2,opt,name=mastery,proto3" json:"mastery,omitempty"`
+	Notes    string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	// when the review happened on the client; unset or future values mean the time of the sync
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MasteryUpdate) Reset() {
+	*x = MasteryUpdate{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MasteryUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MasteryUpdate) ProtoMessage() {}
+
+func (x *MasteryUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MasteryUpdate.ProtoReflect.Descriptor instead.
+func (*MasteryUpdate) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MasteryUpdate) GetLexemeId() int64 {
+	if x != nil {
+		return x.LexemeId
+	}
+	return 0
+}
+
+func (x *MasteryUpdate) GetMastery() *MasteryBreakdown {
+	if x != nil {
+		return x.Mastery
+	}
+	return nil
+}
+
+func (x *MasteryUpdate) GetNotes() string {
+	if x != nil {
+		return x.Notes
 	}
 	return ""
 }
 
-type ListLearnedLexemesResponse struct {
+func (x *MasteryUpdate) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+type BatchUpdateMasteryRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Updates []*MasteryUpdate       `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	// atomic applies all updates or none: when any item fails nothing is written and the items
+	// that would have applied report code "Aborted"
+	Atomic        bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMasteryRequest) Reset() {
+	*x = BatchUpdateMasteryRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMasteryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMasteryRequest) ProtoMessage() {}
+
+func (x *BatchUpdateMasteryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMasteryRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMasteryRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchUpdateMasteryRequest) GetUpdates() []*MasteryUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+func (x *BatchUpdateMasteryRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+// MasteryUpdateResult reports one update, in the order of the request
+type MasteryUpdateResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pagination    *v1.PaginationResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Lexemes       []*LearnedLexeme       `protobuf:"bytes,2,rep,name=lexemes,proto3" json:"lexemes,omitempty"`
+	LexemeId      int64                  `protobuf:"varint,1,opt,name=lexeme_id,json=lexemeId,proto3" json:"lexeme_id,omitempty"`
+	Lexeme        *LearnedLexeme         `protobuf:"bytes,2,opt,name=lexeme,proto3" json:"lexeme,omitempty"` // set when the update was applied; see errors otherwise
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLearnedLexemesResponse) Reset() {
-	*x = ListLearnedLexemesResponse{}
-	mi := &file_learning_v1_learning_service_proto_msgTypes[3]
+func (x *MasteryUpdateResult) Reset() {
+	*x = MasteryUpdateResult{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLearnedLexemesResponse) String() string {
+func (x *MasteryUpdateResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLearnedLexemesResponse) ProtoMessage() {}
+func (*MasteryUpdateResult) ProtoMessage() {}
 
-func (x *ListLearnedLexemesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_learning_v1_learning_service_proto_msgTypes[3]
+func (x *MasteryUpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,52 +415,769 @@ func (x *ListLearnedLexemesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLearnedLexemesResponse.ProtoReflect.Descriptor instead.
-func (*ListLearnedLexemesResponse) Descriptor() ([]byte, []int) {
-	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MasteryUpdateResult.ProtoReflect.Descriptor instead.
+func (*MasteryUpdateResult) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListLearnedLexemesResponse) GetPagination() *v1.PaginationResponse {
+func (x *MasteryUpdateResult) GetLexemeId() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.LexemeId
+	}
+	return 0
+}
+
+func (x *MasteryUpdateResult) GetLexeme() *LearnedLexeme {
+	if x != nil {
+		return x.Lexeme
 	}
 	return nil
 }
 
-func (x *ListLearnedLexemesResponse) GetLexemes() []*LearnedLexeme {
+type BatchUpdateMasteryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*MasteryUpdateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Errors        []*v1.BatchItemError   `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"` // one per rejected update, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateMasteryResponse) Reset() {
+	*x = BatchUpdateMasteryResponse{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateMasteryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateMasteryResponse) ProtoMessage() {}
+
+func (x *BatchUpdateMasteryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[7]
 	if x != nil {
-		return x.Lexemes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateMasteryResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateMasteryResponse) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchUpdateMasteryResponse) GetResults() []*MasteryUpdateResult {
+	if x != nil {
+		return x.Results
 	}
 	return nil
 }
 
-var File_learning_v1_learning_service_proto protoreflect.FileDescriptor
+func (x *BatchUpdateMasteryResponse) GetErrors() []*v1.BatchItemError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
 
-const file_learning_v1_learning_service_proto_rawDesc = "" +
-	"\n" +
-	"\"learning/v1/learning_service.proto\x12\vlearning.v1\x1a\x15common/v1/types.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1alearning/v1/learning.proto\x1a\x17validate/validate.proto\"J\n" +
-	"\x14CollectLexemeRequest\x122\n" +
-	"\x06lexeme\x18\x01 \x01(\v2\x1a.learning.v1.LearnedLexemeR\x06lexeme\"\x8b\x01\n" +
-	"\x14UpdateMasteryRequest\x12$\n" +
-	"\tlexeme_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\blexemeId\x127\n" +
-	"\amastery\x18\x02 \x01(\v2\x1d.learning.v1.MasteryBreakdownR\amastery\x12\x14\n" +
-	"\x05notes\x18\x03 \x01(\tR\x05notes\"\x8c\x01\n" +
-	"\x19ListLearnedLexemesRequest\x12<\n" +
-	"\n" +
-	"pagination\x18\x01 \x01(\v2\x1c.common.v1.PaginationRequestR\n" +
-	"pagination\x12\x16\n" +
-	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x19\n" +
-	"\border_by\x18\x03 \x01(\tR\aorderBy\"\x91\x01\n" +
-	"\x1aListLearnedLexemesResponse\x12=\n" +
-	"\n" +
-	"pagination\x18\x01 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
-	"pagination\x124\n" +
-	"\alexemes\x18\x02 \x03(\v2\x1a.learning.v1.LearnedLexemeR\alexemes2\xe1\x02\n" +
-	"\x0fLearningService\x12P\n" +
-	"\rCollectLexeme\x12!.learning.v1.CollectLexemeRequest\x1a\x1a.learning.v1.LearnedLexeme\"\x00\x12A\n" +
-	"\x0fUncollectLexeme\x12\x14.common.v1.IDRequest\x1a\x16.google.protobuf.Empty\"\x00\x12g\n" +
-	"\x12ListLearnedLexemes\x12&.learning.v1.ListLearnedLexemesRequest\x1a'.learning.v1.ListLearnedLexemesResponse\"\x00\x12P\n" +
-	"\rUpdateMastery\x12!.learning.v1.UpdateMasteryRequest\x1a\x1a.learning.v1.LearnedLexeme\"\x00B\xae\x01\n" +
+// BatchUncollectRequest selects lexemes to remove using the same CEL filter as ListLearnedLexemes
+type BatchUncollectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filtering options using CEL expressions, e.g. `tag in ["old"] && mastery_overall <= 100`
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// all must be set to remove every lexeme when filter is empty
+	All           bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUncollectRequest) Reset() {
+	*x = BatchUncollectRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUncollectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUncollectRequest) ProtoMessage() {}
+
+func (x *BatchUncollectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUncollectRequest.ProtoReflect.Descriptor instead.
+func (*BatchUncollectRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchUncollectRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *BatchUncollectRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type BatchUncollectResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// number of lexemes removed
+	Deleted       int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUncollectResponse) Reset() {
+	*x = BatchUncollectResponse{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUncollectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUncollectResponse) ProtoMessage() {}
+
+func (x *BatchUncollectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUncollectResponse.ProtoReflect.Descriptor instead.
+func (*BatchUncollectResponse) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchUncollectResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+// ListLearnedLexemesRequest request with comprehensive filtering
+type ListLearnedLexemesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pagination parameters
+	Pagination *v1.PaginationRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// filtering options using CEL expressions, e.g. `review_state == "due"`
+	// (review_state is one of new, learning, mastered, due)
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// ordering options. e.g. "lexeme asc", "mastery.overall desc"
+	OrderBy       string `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLearnedLexemesRequest) Reset() {
+	*x = ListLearnedLexemesRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLearnedLexemesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLearnedLexemesRequest) ProtoMessage() {}
+
+func (x *ListLearnedLexemesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLearnedLexemesRequest.ProtoReflect.Descriptor instead.
+func (*ListLearnedLexemesRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLearnedLexemesRequest) GetPagination() *v1.PaginationRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListLearnedLexemesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListLearnedLexemesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+type ListLearnedLexemesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pagination    *v1.PaginationResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Lexemes       []*LearnedLexeme       `protobuf:"bytes,2,rep,name=lexemes,proto3" json:"lexemes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLearnedLexemesResponse) Reset() {
+	*x = ListLearnedLexemesResponse{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLearnedLexemesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLearnedLexemesResponse) ProtoMessage() {}
+
+func (x *ListLearnedLexemesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLearnedLexemesResponse.ProtoReflect.Descriptor instead.
+func (*ListLearnedLexemesResponse) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListLearnedLexemesResponse) GetPagination() *v1.PaginationResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListLearnedLexemesResponse) GetLexemes() []*LearnedLexeme {
+	if x != nil {
+		return x.Lexemes
+	}
+	return nil
+}
+
+// CountLearnedLexemesRequest accepts the same filter as ListLearnedLexemesRequest
+type CountLearnedLexemesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        string                 `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountLearnedLexemesRequest) Reset() {
+	*x = CountLearnedLexemesRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountLearnedLexemesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountLearnedLexemesRequest) ProtoMessage() {}
+
+func (x *CountLearnedLexemesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountLearnedLexemesRequest.ProtoReflect.Descriptor instead.
+func (*CountLearnedLexemesRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CountLearnedLexemesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type CountLearnedLexemesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int64                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountLearnedLexemesResponse) Reset() {
+	*x = CountLearnedLexemesResponse{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountLearnedLexemesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountLearnedLexemesResponse) ProtoMessage() {}
+
+func (x *CountLearnedLexemesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountLearnedLexemesResponse.ProtoReflect.Descriptor instead.
+func (*CountLearnedLexemesResponse) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CountLearnedLexemesResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// LookupWordRequest looks up a dictionary entry like dict.v1.LookupWordRequest
+type LookupWordRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Word     string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Language v1.Language            `protobuf:"varint,2,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // optional; if unspecified, server default language
+	// Whether an inflected form returns its lemma's entry or its own; defaults to the lemma
+	Prefer        v11.LookupPreference `protobuf:"varint,3,opt,name=prefer,proto3,enum=dict.v1.LookupPreference" json:"prefer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupWordRequest) Reset() {
+	*x = LookupWordRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupWordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupWordRequest) ProtoMessage() {}
+
+func (x *LookupWordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupWordRequest.ProtoReflect.Descriptor instead.
+func (*LookupWordRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LookupWordRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *LookupWordRequest) GetLanguage() v1.Language {
+	if x != nil {
+		return x.Language
+	}
+	return v1.Language(0)
+}
+
+func (x *LookupWordRequest) GetPrefer() v11.LookupPreference {
+	if x != nil {
+		return x.Prefer
+	}
+	return v11.LookupPreference(0)
+}
+
+type LookupWordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          *v11.Word              `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	LearnedLexeme *LearnedLexeme         `protobuf:"bytes,2,opt,name=learned_lexeme,json=learnedLexeme,proto3" json:"learned_lexeme,omitempty"` // unset when the user has not collected the word
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LookupWordResponse) Reset() {
+	*x = LookupWordResponse{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LookupWordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupWordResponse) ProtoMessage() {}
+
+func (x *LookupWordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupWordResponse.ProtoReflect.Descriptor instead.
+func (*LookupWordResponse) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LookupWordResponse) GetWord() *v11.Word {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *LookupWordResponse) GetLearnedLexeme() *LearnedLexeme {
+	if x != nil {
+		return x.LearnedLexeme
+	}
+	return nil
+}
+
+type ListLexemesByLemmaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Language      v1.Language            `protobuf:"varint,1,opt,name=language,proto3,enum=common.v1.Language" json:"language,omitempty"` // optional; if unspecified, server default language
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLexemesByLemmaRequest) Reset() {
+	*x = ListLexemesByLemmaRequest{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLexemesByLemmaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLexemesByLemmaRequest) ProtoMessage() {}
+
+func (x *ListLexemesByLemmaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLexemesByLemmaRequest.ProtoReflect.Descriptor instead.
+func (*ListLexemesByLemmaRequest) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListLexemesByLemmaRequest) GetLanguage() v1.Language {
+	if x != nil {
+		return x.Language
+	}
+	return v1.Language(0)
+}
+
+// LemmaGroup holds the user's lexemes that are forms of one lemma; a lexeme without a dictionary
+// entry forms a group of its own, named after its term
+type LemmaGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lemma         string                 `protobuf:"bytes,1,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	Lexemes       []*LearnedLexeme       `protobuf:"bytes,2,rep,name=lexemes,proto3" json:"lexemes,omitempty"` // ordered by term
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LemmaGroup) Reset() {
+	*x = LemmaGroup{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LemmaGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LemmaGroup) ProtoMessage() {}
+
+func (x *LemmaGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LemmaGroup.ProtoReflect.Descriptor instead.
+func (*LemmaGroup) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LemmaGroup) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *LemmaGroup) GetLexemes() []*LearnedLexeme {
+	if x != nil {
+		return x.Lexemes
+	}
+	return nil
+}
+
+type ListLexemesByLemmaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*LemmaGroup          `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // ordered by lemma
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLexemesByLemmaResponse) Reset() {
+	*x = ListLexemesByLemmaResponse{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLexemesByLemmaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLexemesByLemmaResponse) ProtoMessage() {}
+
+func (x *ListLexemesByLemmaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLexemesByLemmaResponse.ProtoReflect.Descriptor instead.
+func (*ListLexemesByLemmaResponse) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListLexemesByLemmaResponse) GetGroups() []*LemmaGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetDueCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DueCount      int64                  `protobuf:"varint,1,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDueCountResponse) Reset() {
+	*x = GetDueCountResponse{}
+	mi := &file_learning_v1_learning_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDueCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDueCountResponse) ProtoMessage() {}
+
+func (x *GetDueCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_learning_v1_learning_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDueCountResponse.ProtoReflect.Descriptor instead.
+func (*GetDueCountResponse) Descriptor() ([]byte, []int) {
+	return file_learning_v1_learning_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetDueCountResponse) GetDueCount() int64 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+var File_learning_v1_learning_service_proto protoreflect.FileDescriptor
+
+const file_learning_v1_learning_service_proto_rawDesc = "" +
+	"\n" +
+	"\"learning/v1/learning_service.proto\x12\vlearning.v1\x1a\x15common/v1/types.proto\x1a\x12dict/v1/word.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1alearning/v1/learning.proto\x1a\x17validate/validate.proto\"i\n" +
+	"\x14CollectLexemeRequest\x122\n" +
+	"\x06lexeme\x18\x01 \x01(\v2\x1a.learning.v1.LearnedLexemeR\x06lexeme\x12\x1d\n" +
+	"\n" +
+	"clear_tags\x18\x02 \x01(\bR\tclearTags\"m\n" +
+	"\x1dGetLearnedLexemeByTermRequest\x12\x1b\n" +
+	"\x04term\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04term\x12/\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\"\x8b\x01\n" +
+	"\x14UpdateMasteryRequest\x12$\n" +
+	"\tlexeme_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\blexemeId\x127\n" +
+	"\amastery\x18\x02 \x01(\v2\x1d.learning.v1.MasteryBreakdownR\amastery\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\"n\n" +
+	"\x13RenameLexemeRequest\x12$\n" +
+	"\tlexeme_id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\blexemeId\x12\x1b\n" +
+	"\x04term\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04term\x12\x14\n" +
+	"\x05merge\x18\x03 \x01(\bR\x05merge\"\xb8\x01\n" +
+	"\rMasteryUpdate\x12\x1b\n" +
+	"\tlexeme_id\x18\x01 \x01(\x03R\blexemeId\x127\n" +
+	"\amastery\x18\x02 \x01(\v2\x1d.learning.v1.MasteryBreakdownR\amastery\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12;\n" +
+	"\vreviewed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\"v\n" +
+	"\x19BatchUpdateMasteryRequest\x12A\n" +
+	"\aupdates\x18\x01 \x03(\v2\x1a.learning.v1.MasteryUpdateB\v\xfaB\b\x92\x01\x05\b\x01\x10\xf4\x03R\aupdates\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"\x7f\n" +
+	"\x13MasteryUpdateResult\x12\x1b\n" +
+	"\tlexeme_id\x18\x01 \x01(\x03R\blexemeId\x122\n" +
+	"\x06lexeme\x18\x02 \x01(\v2\x1a.learning.v1.LearnedLexemeR\x06lexemeJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05R\x04codeR\x05error\"\x8b\x01\n" +
+	"\x1aBatchUpdateMasteryResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .learning.v1.MasteryUpdateResultR\aresults\x121\n" +
+	"\x06errors\x18\x02 \x03(\v2\x19.common.v1.BatchItemErrorR\x06errors\"A\n" +
+	"\x15BatchUncollectRequest\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"2\n" +
+	"\x16BatchUncollectResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"\x8c\x01\n" +
+	"\x19ListLearnedLexemesRequest\x12<\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1c.common.v1.PaginationRequestR\n" +
+	"pagination\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x19\n" +
+	"\border_by\x18\x03 \x01(\tR\aorderBy\"\x91\x01\n" +
+	"\x1aListLearnedLexemesResponse\x12=\n" +
+	"\n" +
+	"pagination\x18\x01 \x01(\v2\x1d.common.v1.PaginationResponseR\n" +
+	"pagination\x124\n" +
+	"\alexemes\x18\x02 \x03(\v2\x1a.learning.v1.LearnedLexemeR\alexemes\"4\n" +
+	"\x1aCountLearnedLexemesRequest\x12\x16\n" +
+	"\x06filter\x18\x01 \x01(\tR\x06filter\"3\n" +
+	"\x1bCountLearnedLexemesResponse\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x03R\x05count\"\x94\x01\n" +
+	"\x11LookupWordRequest\x12\x1b\n" +
+	"\x04word\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04word\x12/\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\x121\n" +
+	"\x06prefer\x18\x03 \x01(\x0e2\x19.dict.v1.LookupPreferenceR\x06prefer\"z\n" +
+	"\x12LookupWordResponse\x12!\n" +
+	"\x04word\x18\x01 \x01(\v2\r.dict.v1.WordR\x04word\x12A\n" +
+	"\x0elearned_lexeme\x18\x02 \x01(\v2\x1a.learning.v1.LearnedLexemeR\rlearnedLexeme\"L\n" +
+	"\x19ListLexemesByLemmaRequest\x12/\n" +
+	"\blanguage\x18\x01 \x01(\x0e2\x13.common.v1.LanguageR\blanguage\"X\n" +
+	"\n" +
+	"LemmaGroup\x12\x14\n" +
+	"\x05lemma\x18\x01 \x01(\tR\x05lemma\x124\n" +
+	"\alexemes\x18\x02 \x03(\v2\x1a.learning.v1.LearnedLexemeR\alexemes\"M\n" +
+	"\x1aListLexemesByLemmaResponse\x12/\n" +
+	"\x06groups\x18\x01 \x03(\v2\x17.learning.v1.LemmaGroupR\x06groups\"2\n" +
+	"\x13GetDueCountResponse\x12\x1b\n" +
+	"\tdue_count\x18\x01 \x01(\x03R\bdueCount2\xcc\b\n" +
+	"\x0fLearningService\x12P\n" +
+	"\rCollectLexeme\x12!.learning.v1.CollectLexemeRequest\x1a\x1a.learning.v1.LearnedLexeme\"\x00\x12b\n" +
+	"\x16GetLearnedLexemeByTerm\x12*.learning.v1.GetLearnedLexemeByTermRequest\x1a\x1a.learning.v1.LearnedLexeme\"\x00\x12A\n" +
+	"\x0fUncollectLexeme\x12\x14.common.v1.IDRequest\x1a\x16.google.protobuf.Empty\"\x00\x12[\n" +
+	"\x0eBatchUncollect\x12\".learning.v1.BatchUncollectRequest\x1a#.learning.v1.BatchUncollectResponse\"\x00\x12g\n" +
+	"\x12ListLearnedLexemes\x12&.learning.v1.ListLearnedLexemesRequest\x1a'.learning.v1.ListLearnedLexemesResponse\"\x00\x12j\n" +
+	"\x13CountLearnedLexemes\x12'.learning.v1.CountLearnedLexemesRequest\x1a(.learning.v1.CountLearnedLexemesResponse\"\x00\x12P\n" +
+	"\rUpdateMastery\x12!.learning.v1.UpdateMasteryRequest\x1a\x1a.learning.v1.LearnedLexeme\"\x00\x12N\n" +
+	"\fRenameLexeme\x12 .learning.v1.RenameLexemeRequest\x1a\x1a.learning.v1.LearnedLexeme\"\x00\x12g\n" +
+	"\x12BatchUpdateMastery\x12&.learning.v1.BatchUpdateMasteryRequest\x1a'.learning.v1.BatchUpdateMasteryResponse\"\x00\x12O\n" +
+	"\n" +
+	"LookupWord\x12\x1e.learning.v1.LookupWordRequest\x1a\x1f.learning.v1.LookupWordResponse\"\x00\x12g\n" +
+	"\x12ListLexemesByLemma\x12&.learning.v1.ListLexemesByLemmaRequest\x1a'.learning.v1.ListLexemesByLemmaResponse\"\x00\x12I\n" +
+	"\vGetDueCount\x12\x16.google.protobuf.Empty\x1a .learning.v1.GetDueCountResponse\"\x00B\xae\x01\n" +
 	"\x0fcom.learning.v1B\x14LearningServiceProtoP\x01Z8github.com/eslsoft/vocnet/pkg/api/learning/v1;learningv1\xa2\x02\x03LXX\xaa\x02\vLearning.V1\xca\x02\vLearning\\V1\xe2\x02\x17Learning\\V1\\GPBMetadata\xea\x02\fLearning::V1b\x06proto3"
 
 var (
@@ -287,38 +1192,89 @@ func file_learning_v1_learning_service_proto_rawDescGZIP() []byte {
 	return file_learning_v1_learning_service_proto_rawDescData
 }
 
-var file_learning_v1_learning_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_learning_v1_learning_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_learning_v1_learning_service_proto_goTypes = []any{
-	(*CollectLexemeRequest)(nil),       // 0: learning.v1.CollectLexemeRequest
-	(*UpdateMasteryRequest)(nil),       // 1: learning.v1.UpdateMasteryRequest
-	(*ListLearnedLexemesRequest)(nil),  // 2: learning.v1.ListLearnedLexemesRequest
-	(*ListLearnedLexemesResponse)(nil), // 3: learning.v1.ListLearnedLexemesResponse
-	(*LearnedLexeme)(nil),              // 4: learning.v1.LearnedLexeme
-	(*MasteryBreakdown)(nil),           // 5: learning.v1.MasteryBreakdown
-	(*v1.PaginationRequest)(nil),       // 6: common.v1.PaginationRequest
-	(*v1.PaginationResponse)(nil),      // 7: common.v1.PaginationResponse
-	(*v1.IDRequest)(nil),               // 8: common.v1.IDRequest
-	(*emptypb.Empty)(nil),              // 9: google.protobuf.Empty
+	(*CollectLexemeRequest)(nil),          // 0: learning.v1.CollectLexemeRequest
+	(*GetLearnedLexemeByTermRequest)(nil), // 1: learning.v1.GetLearnedLexemeByTermRequest
+	(*UpdateMasteryRequest)(nil),          // 2: learning.v1.UpdateMasteryRequest
+	(*RenameLexemeRequest)(nil),           // 3: learning.v1.RenameLexemeRequest
+	(*MasteryUpdate)(nil),                 // 4: learning.v1.MasteryUpdate
+	(*BatchUpdateMasteryRequest)(nil),     // 5: learning.v1.BatchUpdateMasteryRequest
+	(*MasteryUpdateResult)(nil),           // 6: learning.v1.MasteryUpdateResult
+	(*BatchUpdateMasteryResponse)(nil),    // 7: learning.v1.BatchUpdateMasteryResponse
+	(*BatchUncollectRequest)(nil),         // 8: learning.v1.BatchUncollectRequest
+	(*BatchUncollectResponse)(nil),        // 9: learning.v1.BatchUncollectResponse
+	(*ListLearnedLexemesRequest)(nil),     // 10: learning.v1.ListLearnedLexemesRequest
+	(*ListLearnedLexemesResponse)(nil),    // 11: learning.v1.ListLearnedLexemesResponse
+	(*CountLearnedLexemesRequest)(nil),    // 12: learning.v1.CountLearnedLexemesRequest
+	(*CountLearnedLexemesResponse)(nil),   // 13: learning.v1.CountLearnedLexemesResponse
+	(*LookupWordRequest)(nil),             // 14: learning.v1.LookupWordRequest
+	(*LookupWordResponse)(nil),            // 15: learning.v1.LookupWordResponse
+	(*ListLexemesByLemmaRequest)(nil),     // 16: learning.v1.ListLexemesByLemmaRequest
+	(*LemmaGroup)(nil),                    // 17: learning.v1.LemmaGroup
+	(*ListLexemesByLemmaResponse)(nil),    // 18: learning.v1.ListLexemesByLemmaResponse
+	(*GetDueCountResponse)(nil),           // 19: learning.v1.GetDueCountResponse
+	(*LearnedLexeme)(nil),                 // 20: learning.v1.LearnedLexeme
+	(v1.Language)(0),                      // 21: common.v1.Language
+	(*MasteryBreakdown)(nil),              // 22: learning.v1.MasteryBreakdown
+	(*timestamppb.Timestamp)(nil),         // 23: google.protobuf.Timestamp
+	(*v1.BatchItemError)(nil),             // 24: common.v1.BatchItemError
+	(*v1.PaginationRequest)(nil),          // 25: common.v1.PaginationRequest
+	(*v1.PaginationResponse)(nil),         // 26: common.v1.PaginationResponse
+	(v11.LookupPreference)(0),             // 27: dict.v1.LookupPreference
+	(*v11.Word)(nil),                      // 28: dict.v1.Word
+	(*v1.IDRequest)(nil),                  // 29: common.v1.IDRequest
+	(*emptypb.Empty)(nil),                 // 30: google.protobuf.Empty
 }
 var file_learning_v1_learning_service_proto_depIdxs = []int32{
-	4, // 0: learning.v1.CollectLexemeRequest.lexeme:type_name -> learning.v1.LearnedLexeme
-	5, // 1: learning.v1.UpdateMasteryRequest.mastery:type_name -> learning.v1.MasteryBreakdown
-	6, // 2: learning.v1.ListLearnedLexemesRequest.pagination:type_name -> common.v1.PaginationRequest
-	7, // 3: learning.v1.ListLearnedLexemesResponse.pagination:type_name -> common.v1.PaginationResponse
-	4, // 4: learning.v1.ListLearnedLexemesResponse.lexemes:type_name -> learning.v1.LearnedLexeme
-	0, // 5: learning.v1.LearningService.CollectLexeme:input_type -> learning.v1.CollectLexemeRequest
-	8, // 6: learning.v1.LearningService.UncollectLexeme:input_type -> common.v1.IDRequest
-	2, // 7: learning.v1.LearningService.ListLearnedLexemes:input_type -> learning.v1.ListLearnedLexemesRequest
-	1, // 8: learning.v1.LearningService.UpdateMastery:input_type -> learning.v1.UpdateMasteryRequest
-	4, // 9: learning.v1.LearningService.CollectLexeme:output_type -> learning.v1.LearnedLexeme
-	9, // 10: learning.v1.LearningService.UncollectLexeme:output_type -> google.protobuf.Empty
-	3, // 11: learning.v1.LearningService.ListLearnedLexemes:output_type -> learning.v1.ListLearnedLexemesResponse
-	4, // 12: learning.v1.LearningService.UpdateMastery:output_type -> learning.v1.LearnedLexeme
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	20, // 0: learning.v1.CollectLexemeRequest.lexeme:type_name -> learning.v1.LearnedLexeme
+	21, // 1: learning.v1.GetLearnedLexemeByTermRequest.language:type_name -> common.v1.Language
+	22, // 2: learning.v1.UpdateMasteryRequest.mastery:type_name -> learning.v1.MasteryBreakdown
+	22, // 3: learning.v1.MasteryUpdate.mastery:type_name -> learning.v1.MasteryBreakdown
+	23, // 4: learning.v1.MasteryUpdate.reviewed_at:type_name -> google.protobuf.Timestamp
+	4,  // 5: learning.v1.BatchUpdateMasteryRequest.updates:type_name -> learning.v1.MasteryUpdate
+	20, // 6: learning.v1.MasteryUpdateResult.lexeme:type_name -> learning.v1.LearnedLexeme
+	6,  // 7: learning.v1.BatchUpdateMasteryResponse.results:type_name -> learning.v1.MasteryUpdateResult
+	24, // 8: learning.v1.BatchUpdateMasteryResponse.errors:type_name -> common.v1.BatchItemError
+	25, // 9: learning.v1.ListLearnedLexemesRequest.pagination:type_name -> common.v1.PaginationRequest
+	26, // 10: learning.v1.ListLearnedLexemesResponse.pagination:type_name -> common.v1.PaginationResponse
+	20, // 11: learning.v1.ListLearnedLexemesResponse.lexemes:type_name -> learning.v1.LearnedLexeme
+	21, // 12: learning.v1.LookupWordRequest.language:type_name -> common.v1.Language
+	27, // 13: learning.v1.LookupWordRequest.prefer:type_name -> dict.v1.LookupPreference
+	28, // 14: learning.v1.LookupWordResponse.word:type_name -> dict.v1.Word
+	20, // 15: learning.v1.LookupWordResponse.learned_lexeme:type_name -> learning.v1.LearnedLexeme
+	21, // 16: learning.v1.ListLexemesByLemmaRequest.language:type_name -> common.v1.Language
+	20, // 17: learning.v1.LemmaGroup.lexemes:type_name -> learning.v1.LearnedLexeme
+	17, // 18: learning.v1.ListLexemesByLemmaResponse.groups:type_name -> learning.v1.LemmaGroup
+	0,  // 19: learning.v1.LearningService.CollectLexeme:input_type -> learning.v1.CollectLexemeRequest
+	1,  // 20: learning.v1.LearningService.GetLearnedLexemeByTerm:input_type -> learning.v1.GetLearnedLexemeByTermRequest
+	29, // 21: learning.v1.LearningService.UncollectLexeme:input_type -> common.v1.IDRequest
+	8,  // 22: learning.v1.LearningService.BatchUncollect:input_type -> learning.v1.BatchUncollectRequest
+	10, // 23: learning.v1.LearningService.ListLearnedLexemes:input_type -> learning.v1.ListLearnedLexemesRequest
+	12, // 24: learning.v1.LearningService.CountLearnedLexemes:input_type -> learning.v1.CountLearnedLexemesRequest
+	2,  // 25: learning.v1.LearningService.UpdateMastery:input_type -> learning.v1.UpdateMasteryRequest
+	3,  // 26: learning.v1.LearningService.RenameLexeme:input_type -> learning.v1.RenameLexemeRequest
+	5,  // 27: learning.v1.LearningService.BatchUpdateMastery:input_type -> learning.v1.BatchUpdateMasteryRequest
+	14, // 28: learning.v1.LearningService.LookupWord:input_type -> learning.v1.LookupWordRequest
+	16, // 29: learning.v1.LearningService.ListLexemesByLemma:input_type -> learning.v1.ListLexemesByLemmaRequest
+	30, // 30: learning.v1.LearningService.GetDueCount:input_type -> google.protobuf.Empty
+	20, // 31: learning.v1.LearningService.CollectLexeme:output_type -> learning.v1.LearnedLexeme
+	20, // 32: learning.v1.LearningService.GetLearnedLexemeByTerm:output_type -> learning.v1.LearnedLexeme
+	30, // 33: learning.v1.LearningService.UncollectLexeme:output_type -> google.protobuf.Empty
+	9,  // 34: learning.v1.LearningService.BatchUncollect:output_type -> learning.v1.BatchUncollectResponse
+	11, // 35: learning.v1.LearningService.ListLearnedLexemes:output_type -> learning.v1.ListLearnedLexemesResponse
+	13, // 36: learning.v1.LearningService.CountLearnedLexemes:output_type -> learning.v1.CountLearnedLexemesResponse
+	20, // 37: learning.v1.LearningService.UpdateMastery:output_type -> learning.v1.LearnedLexeme
+	20, // 38: learning.v1.LearningService.RenameLexeme:output_type -> learning.v1.LearnedLexeme
+	7,  // 39: learning.v1.LearningService.BatchUpdateMastery:output_type -> learning.v1.BatchUpdateMasteryResponse
+	15, // 40: learning.v1.LearningService.LookupWord:output_type -> learning.v1.LookupWordResponse
+	18, // 41: learning.v1.LearningService.ListLexemesByLemma:output_type -> learning.v1.ListLexemesByLemmaResponse
+	19, // 42: learning.v1.LearningService.GetDueCount:output_type -> learning.v1.GetDueCountResponse
+	31, // [31:43] is the sub-list for method output_type
+	19, // [19:31] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_learning_v1_learning_service_proto_init() }
@@ -333,7 +1289,7 @@ func file_learning_v1_learning_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_learning_v1_learning_service_proto_rawDesc), len(file_learning_v1_learning_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -17,6 +17,10 @@ import (
 	"unicode/utf8"
 
 	"google.golang.org/protobuf/types/known/anypb"
+
+	commonv1 "github.com/eslsoft/vocnet/pkg/api/common/v1"
+
+	dictv1 "github.com/eslsoft/vocnet/pkg/api/dict/v1"
 )
 
 // ensure the imports are used
@@ -33,6 +37,10 @@ var (
 	_ = (*mail.Address)(nil)
 	_ = anypb.Any{}
 	_ = sort.Sort
+
+	_ = commonv1.Language(0)
+
+	_ = dictv1.LookupPreference(0)
 )
 
 // Validate checks the field values on CollectLexemeRequest with the rules
@@ -86,6 +94,8 @@ func (m *CollectLexemeRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ClearTags
+
 	if len(errors) > 0 {
 		return CollectLexemeRequestMultiError(errors)
 	}
@@ -166,6 +176,122 @@ var _ interface {
 	ErrorName() string
 } = CollectLexemeRequestValidationError{}
 
+// Validate checks the field values on GetLearnedLexemeByTermRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetLearnedLexemeByTermRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLearnedLexemeByTermRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetLearnedLexemeByTermRequestMultiError, or nil if none found.
+func (m *GetLearnedLexemeByTermRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLearnedLexemeByTermRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTerm()) < 1 {
+		err := GetLearnedLexemeByTermRequestValidationError{
+			field:  "Term",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Language
+
+	if len(errors) > 0 {
+		return GetLearnedLexemeByTermRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLearnedLexemeByTermRequestMultiError is an error wrapping multiple
+// validation errors returned by GetLearnedLexemeByTermRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetLearnedLexemeByTermRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLearnedLexemeByTermRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLearnedLexemeByTermRequestMultiError) AllErrors() []error { return m }
+
+// GetLearnedLexemeByTermRequestValidationError is the validation error
+// returned by GetLearnedLexemeByTermRequest.Validate if the designated
+// constraints aren't met.
+type GetLearnedLexemeByTermRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLearnedLexemeByTermRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLearnedLexemeByTermRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLearnedLexemeByTermRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLearnedLexemeByTermRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLearnedLexemeByTermRequestValidationError) ErrorName() string {
+	return "GetLearnedLexemeByTermRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLearnedLexemeByTermRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLearnedLexemeByTermRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLearnedLexemeByTermRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLearnedLexemeByTermRequestValidationError{}
+
 // Validate checks the field values on UpdateMasteryRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -310,75 +436,66 @@ var _ interface {
 	ErrorName() string
 } = UpdateMasteryRequestValidationError{}
 
-// Validate checks the field values on ListLearnedLexemesRequest with the rules
+// Validate checks the field values on RenameLexemeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListLearnedLexemesRequest) Validate() error {
+func (m *RenameLexemeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLearnedLexemesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RenameLexemeRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListLearnedLexemesRequestMultiError, or nil if none found.
-func (m *ListLearnedLexemesRequest) ValidateAll() error {
+// RenameLexemeRequestMultiError, or nil if none found.
+func (m *RenameLexemeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLearnedLexemesRequest) validate(all bool) error {
+func (m *RenameLexemeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListLearnedLexemesRequestValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListLearnedLexemesRequestValidationError{
-					field:  "Pagination",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if m.GetLexemeId() <= 0 {
+		err := RenameLexemeRequestValidationError{
+			field:  "LexemeId",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListLearnedLexemesRequestValidationError{
-				field:  "Pagination",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Filter
+	if utf8.RuneCountInString(m.GetTerm()) < 1 {
+		err := RenameLexemeRequestValidationError{
+			field:  "Term",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for OrderBy
+	// no validation rules for Merge
 
 	if len(errors) > 0 {
-		return ListLearnedLexemesRequestMultiError(errors)
+		return RenameLexemeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLearnedLexemesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListLearnedLexemesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListLearnedLexemesRequestMultiError []error
+// RenameLexemeRequestMultiError is an error wrapping multiple validation
+// errors returned by RenameLexemeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenameLexemeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLearnedLexemesRequestMultiError) Error() string {
+func (m RenameLexemeRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -387,11 +504,11 @@ func (m ListLearnedLexemesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLearnedLexemesRequestMultiError) AllErrors() []error { return m }
+func (m RenameLexemeRequestMultiError) AllErrors() []error { return m }
 
-// ListLearnedLexemesRequestValidationError is the validation error returned by
-// ListLearnedLexemesRequest.Validate if the designated constraints aren't met.
-type ListLearnedLexemesRequestValidationError struct {
+// RenameLexemeRequestValidationError is the validation error returned by
+// RenameLexemeRequest.Validate if the designated constraints aren't met.
+type RenameLexemeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -399,24 +516,24 @@ type ListLearnedLexemesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLearnedLexemesRequestValidationError) Field() string { return e.field }
+func (e RenameLexemeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLearnedLexemesRequestValidationError) Reason() string { return e.reason }
+func (e RenameLexemeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLearnedLexemesRequestValidationError) Cause() error { return e.cause }
+func (e RenameLexemeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLearnedLexemesRequestValidationError) Key() bool { return e.key }
+func (e RenameLexemeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLearnedLexemesRequestValidationError) ErrorName() string {
-	return "ListLearnedLexemesRequestValidationError"
+func (e RenameLexemeRequestValidationError) ErrorName() string {
+	return "RenameLexemeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListLearnedLexemesRequestValidationError) Error() string {
+func (e RenameLexemeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -428,14 +545,14 @@ func (e ListLearnedLexemesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLearnedLexemesRequest.%s: %s%s",
+		"invalid %sRenameLexemeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLearnedLexemesRequestValidationError{}
+var _ error = RenameLexemeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -443,107 +560,106 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLearnedLexemesRequestValidationError{}
+} = RenameLexemeRequestValidationError{}
 
-// Validate checks the field values on ListLearnedLexemesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListLearnedLexemesResponse) Validate() error {
+// Validate checks the field values on MasteryUpdate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MasteryUpdate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListLearnedLexemesResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListLearnedLexemesResponseMultiError, or nil if none found.
-func (m *ListLearnedLexemesResponse) ValidateAll() error {
+// ValidateAll checks the field values on MasteryUpdate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MasteryUpdateMultiError, or
+// nil if none found.
+func (m *MasteryUpdate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListLearnedLexemesResponse) validate(all bool) error {
+func (m *MasteryUpdate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for LexemeId
+
 	if all {
-		switch v := interface{}(m.GetPagination()).(type) {
+		switch v := interface{}(m.GetMastery()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListLearnedLexemesResponseValidationError{
-					field:  "Pagination",
+				errors = append(errors, MasteryUpdateValidationError{
+					field:  "Mastery",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListLearnedLexemesResponseValidationError{
-					field:  "Pagination",
+				errors = append(errors, MasteryUpdateValidationError{
+					field:  "Mastery",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetMastery()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListLearnedLexemesResponseValidationError{
-				field:  "Pagination",
+			return MasteryUpdateValidationError{
+				field:  "Mastery",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	for idx, item := range m.GetLexemes() {
-		_, _ = idx, item
+	// no validation rules for Notes
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListLearnedLexemesResponseValidationError{
-						field:  fmt.Sprintf("Lexemes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListLearnedLexemesResponseValidationError{
-						field:  fmt.Sprintf("Lexemes[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetReviewedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MasteryUpdateValidationError{
+					field:  "ReviewedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListLearnedLexemesResponseValidationError{
-					field:  fmt.Sprintf("Lexemes[%v]", idx),
+				errors = append(errors, MasteryUpdateValidationError{
+					field:  "ReviewedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReviewedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MasteryUpdateValidationError{
+				field:  "ReviewedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListLearnedLexemesResponseMultiError(errors)
+		return MasteryUpdateMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListLearnedLexemesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListLearnedLexemesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListLearnedLexemesResponseMultiError []error
+// MasteryUpdateMultiError is an error wrapping multiple validation errors
+// returned by MasteryUpdate.ValidateAll() if the designated constraints
+// aren't met.
+type MasteryUpdateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListLearnedLexemesResponseMultiError) Error() string {
+func (m MasteryUpdateMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -552,11 +668,11 @@ func (m ListLearnedLexemesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListLearnedLexemesResponseMultiError) AllErrors() []error { return m }
+func (m MasteryUpdateMultiError) AllErrors() []error { return m }
 
-// ListLearnedLexemesResponseValidationError is the validation error returned
-// by ListLearnedLexemesResponse.Validate if the designated constraints aren't met.
-type ListLearnedLexemesResponseValidationError struct {
+// MasteryUpdateValidationError is the validation error returned by
+// MasteryUpdate.Validate if the designated constraints aren't met.
+type MasteryUpdateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -564,24 +680,22 @@ type ListLearnedLexemesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListLearnedLexemesResponseValidationError) Field() string { return e.field }
+func (e MasteryUpdateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListLearnedLexemesResponseValidationError) Reason() string { return e.reason }
+func (e MasteryUpdateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListLearnedLexemesResponseValidationError) Cause() error { return e.cause }
+func (e MasteryUpdateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListLearnedLexemesResponseValidationError) Key() bool { return e.key }
+func (e MasteryUpdateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListLearnedLexemesResponseValidationError) ErrorName() string {
-	return "ListLearnedLexemesResponseValidationError"
-}
+func (e MasteryUpdateValidationError) ErrorName() string { return "MasteryUpdateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListLearnedLexemesResponseValidationError) Error() string {
+func (e MasteryUpdateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -593,14 +707,14 @@ func (e ListLearnedLexemesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListLearnedLexemesResponse.%s: %s%s",
+		"invalid %sMasteryUpdate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListLearnedLexemesResponseValidationError{}
+var _ error = MasteryUpdateValidationError{}
 
 var _ interface {
 	Field() string
@@ -608,4 +722,1931 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListLearnedLexemesResponseValidationError{}
+} = MasteryUpdateValidationError{}
+
+// Validate checks the field values on BatchUpdateMasteryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUpdateMasteryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUpdateMasteryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchUpdateMasteryRequestMultiError, or nil if none found.
+func (m *BatchUpdateMasteryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUpdateMasteryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUpdates()); l < 1 || l > 500 {
+		err := BatchUpdateMasteryRequestValidationError{
+			field:  "Updates",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUpdates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchUpdateMasteryRequestValidationError{
+						field:  fmt.Sprintf("Updates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchUpdateMasteryRequestValidationError{
+						field:  fmt.Sprintf("Updates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpdateMasteryRequestValidationError{
+					field:  fmt.Sprintf("Updates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Atomic
+
+	if len(errors) > 0 {
+		return BatchUpdateMasteryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUpdateMasteryRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchUpdateMasteryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type BatchUpdateMasteryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUpdateMasteryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUpdateMasteryRequestMultiError) AllErrors() []error { return m }
+
+// BatchUpdateMasteryRequestValidationError is the validation error returned by
+// BatchUpdateMasteryRequest.Validate if the designated constraints aren't met.
+type BatchUpdateMasteryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateMasteryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateMasteryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateMasteryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateMasteryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateMasteryRequestValidationError) ErrorName() string {
+	return "BatchUpdateMasteryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateMasteryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateMasteryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateMasteryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateMasteryRequestValidationError{}
+
+// Validate checks the field values on MasteryUpdateResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MasteryUpdateResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MasteryUpdateResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MasteryUpdateResultMultiError, or nil if none found.
+func (m *MasteryUpdateResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MasteryUpdateResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for LexemeId
+
+	if all {
+		switch v := interface{}(m.GetLexeme()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MasteryUpdateResultValidationError{
+					field:  "Lexeme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MasteryUpdateResultValidationError{
+					field:  "Lexeme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLexeme()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MasteryUpdateResultValidationError{
+				field:  "Lexeme",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MasteryUpdateResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// MasteryUpdateResultMultiError is an error wrapping multiple validation
+// errors returned by MasteryUpdateResult.ValidateAll() if the designated
+// constraints aren't met.
+type MasteryUpdateResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MasteryUpdateResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MasteryUpdateResultMultiError) AllErrors() []error { return m }
+
+// MasteryUpdateResultValidationError is the validation error returned by
+// MasteryUpdateResult.Validate if the designated constraints aren't met.
+type MasteryUpdateResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MasteryUpdateResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MasteryUpdateResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MasteryUpdateResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MasteryUpdateResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MasteryUpdateResultValidationError) ErrorName() string {
+	return "MasteryUpdateResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MasteryUpdateResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMasteryUpdateResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MasteryUpdateResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MasteryUpdateResultValidationError{}
+
+// Validate checks the field values on BatchUpdateMasteryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUpdateMasteryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUpdateMasteryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchUpdateMasteryResponseMultiError, or nil if none found.
+func (m *BatchUpdateMasteryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUpdateMasteryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchUpdateMasteryResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchUpdateMasteryResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpdateMasteryResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchUpdateMasteryResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchUpdateMasteryResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchUpdateMasteryResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchUpdateMasteryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUpdateMasteryResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchUpdateMasteryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchUpdateMasteryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUpdateMasteryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUpdateMasteryResponseMultiError) AllErrors() []error { return m }
+
+// BatchUpdateMasteryResponseValidationError is the validation error returned
+// by BatchUpdateMasteryResponse.Validate if the designated constraints aren't met.
+type BatchUpdateMasteryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateMasteryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateMasteryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateMasteryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateMasteryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateMasteryResponseValidationError) ErrorName() string {
+	return "BatchUpdateMasteryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateMasteryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateMasteryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateMasteryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateMasteryResponseValidationError{}
+
+// Validate checks the field values on BatchUncollectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUncollectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUncollectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchUncollectRequestMultiError, or nil if none found.
+func (m *BatchUncollectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUncollectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filter
+
+	// no validation rules for All
+
+	if len(errors) > 0 {
+		return BatchUncollectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUncollectRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchUncollectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchUncollectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUncollectRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUncollectRequestMultiError) AllErrors() []error { return m }
+
+// BatchUncollectRequestValidationError is the validation error returned by
+// BatchUncollectRequest.Validate if the designated constraints aren't met.
+type BatchUncollectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUncollectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUncollectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUncollectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUncollectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUncollectRequestValidationError) ErrorName() string {
+	return "BatchUncollectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUncollectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUncollectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUncollectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUncollectRequestValidationError{}
+
+// Validate checks the field values on BatchUncollectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchUncollectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchUncollectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchUncollectResponseMultiError, or nil if none found.
+func (m *BatchUncollectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchUncollectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Deleted
+
+	if len(errors) > 0 {
+		return BatchUncollectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchUncollectResponseMultiError is an error wrapping multiple validation
+// errors returned by BatchUncollectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchUncollectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchUncollectResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchUncollectResponseMultiError) AllErrors() []error { return m }
+
+// BatchUncollectResponseValidationError is the validation error returned by
+// BatchUncollectResponse.Validate if the designated constraints aren't met.
+type BatchUncollectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUncollectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUncollectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUncollectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUncollectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUncollectResponseValidationError) ErrorName() string {
+	return "BatchUncollectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUncollectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUncollectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUncollectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUncollectResponseValidationError{}
+
+// Validate checks the field values on ListLearnedLexemesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLearnedLexemesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLearnedLexemesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLearnedLexemesRequestMultiError, or nil if none found.
+func (m *ListLearnedLexemesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLearnedLexemesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLearnedLexemesRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLearnedLexemesRequestValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLearnedLexemesRequestValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Filter
+
+	// no validation rules for OrderBy
+
+	if len(errors) > 0 {
+		return ListLearnedLexemesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLearnedLexemesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLearnedLexemesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListLearnedLexemesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLearnedLexemesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLearnedLexemesRequestMultiError) AllErrors() []error { return m }
+
+// ListLearnedLexemesRequestValidationError is the validation error returned by
+// ListLearnedLexemesRequest.Validate if the designated constraints aren't met.
+type ListLearnedLexemesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLearnedLexemesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLearnedLexemesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLearnedLexemesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLearnedLexemesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLearnedLexemesRequestValidationError) ErrorName() string {
+	return "ListLearnedLexemesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLearnedLexemesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLearnedLexemesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLearnedLexemesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLearnedLexemesRequestValidationError{}
+
+// Validate checks the field values on ListLearnedLexemesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLearnedLexemesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLearnedLexemesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLearnedLexemesResponseMultiError, or nil if none found.
+func (m *ListLearnedLexemesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLearnedLexemesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPagination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListLearnedLexemesResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListLearnedLexemesResponseValidationError{
+					field:  "Pagination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPagination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListLearnedLexemesResponseValidationError{
+				field:  "Pagination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetLexemes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLearnedLexemesResponseValidationError{
+						field:  fmt.Sprintf("Lexemes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLearnedLexemesResponseValidationError{
+						field:  fmt.Sprintf("Lexemes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLearnedLexemesResponseValidationError{
+					field:  fmt.Sprintf("Lexemes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLearnedLexemesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLearnedLexemesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListLearnedLexemesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListLearnedLexemesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLearnedLexemesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLearnedLexemesResponseMultiError) AllErrors() []error { return m }
+
+// ListLearnedLexemesResponseValidationError is the validation error returned
+// by ListLearnedLexemesResponse.Validate if the designated constraints aren't met.
+type ListLearnedLexemesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLearnedLexemesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLearnedLexemesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLearnedLexemesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLearnedLexemesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLearnedLexemesResponseValidationError) ErrorName() string {
+	return "ListLearnedLexemesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLearnedLexemesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLearnedLexemesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLearnedLexemesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLearnedLexemesResponseValidationError{}
+
+// Validate checks the field values on CountLearnedLexemesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CountLearnedLexemesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CountLearnedLexemesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CountLearnedLexemesRequestMultiError, or nil if none found.
+func (m *CountLearnedLexemesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CountLearnedLexemesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filter
+
+	if len(errors) > 0 {
+		return CountLearnedLexemesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountLearnedLexemesRequestMultiError is an error wrapping multiple
+// validation errors returned by CountLearnedLexemesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CountLearnedLexemesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountLearnedLexemesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountLearnedLexemesRequestMultiError) AllErrors() []error { return m }
+
+// CountLearnedLexemesRequestValidationError is the validation error returned
+// by CountLearnedLexemesRequest.Validate if the designated constraints aren't met.
+type CountLearnedLexemesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountLearnedLexemesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountLearnedLexemesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountLearnedLexemesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountLearnedLexemesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountLearnedLexemesRequestValidationError) ErrorName() string {
+	return "CountLearnedLexemesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CountLearnedLexemesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountLearnedLexemesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountLearnedLexemesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountLearnedLexemesRequestValidationError{}
+
+// Validate checks the field values on CountLearnedLexemesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CountLearnedLexemesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CountLearnedLexemesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CountLearnedLexemesResponseMultiError, or nil if none found.
+func (m *CountLearnedLexemesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CountLearnedLexemesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return CountLearnedLexemesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CountLearnedLexemesResponseMultiError is an error wrapping multiple
+// validation errors returned by CountLearnedLexemesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CountLearnedLexemesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CountLearnedLexemesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CountLearnedLexemesResponseMultiError) AllErrors() []error { return m }
+
+// CountLearnedLexemesResponseValidationError is the validation error returned
+// by CountLearnedLexemesResponse.Validate if the designated constraints
+// aren't met.
+type CountLearnedLexemesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountLearnedLexemesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountLearnedLexemesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountLearnedLexemesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountLearnedLexemesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountLearnedLexemesResponseValidationError) ErrorName() string {
+	return "CountLearnedLexemesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CountLearnedLexemesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountLearnedLexemesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountLearnedLexemesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountLearnedLexemesResponseValidationError{}
+
+// Validate checks the field values on LookupWordRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LookupWordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LookupWordRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LookupWordRequestMultiError, or nil if none found.
+func (m *LookupWordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LookupWordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetWord()) < 1 {
+		err := LookupWordRequestValidationError{
+			field:  "Word",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Language
+
+	// no validation rules for Prefer
+
+	if len(errors) > 0 {
+		return LookupWordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LookupWordRequestMultiError is an error wrapping multiple validation errors
+// returned by LookupWordRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LookupWordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LookupWordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LookupWordRequestMultiError) AllErrors() []error { return m }
+
+// LookupWordRequestValidationError is the validation error returned by
+// LookupWordRequest.Validate if the designated constraints aren't met.
+type LookupWordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LookupWordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LookupWordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LookupWordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LookupWordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LookupWordRequestValidationError) ErrorName() string {
+	return "LookupWordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LookupWordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLookupWordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LookupWordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LookupWordRequestValidationError{}
+
+// Validate checks the field values on LookupWordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LookupWordResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LookupWordResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LookupWordResponseMultiError, or nil if none found.
+func (m *LookupWordResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LookupWordResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWord()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LookupWordResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LookupWordResponseValidationError{
+					field:  "Word",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWord()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LookupWordResponseValidationError{
+				field:  "Word",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLearnedLexeme()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LookupWordResponseValidationError{
+					field:  "LearnedLexeme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LookupWordResponseValidationError{
+					field:  "LearnedLexeme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLearnedLexeme()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LookupWordResponseValidationError{
+				field:  "LearnedLexeme",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LookupWordResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LookupWordResponseMultiError is an error wrapping multiple validation errors
+// returned by LookupWordResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LookupWordResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LookupWordResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LookupWordResponseMultiError) AllErrors() []error { return m }
+
+// LookupWordResponseValidationError is the validation error returned by
+// LookupWordResponse.Validate if the designated constraints aren't met.
+type LookupWordResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LookupWordResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LookupWordResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LookupWordResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LookupWordResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LookupWordResponseValidationError) ErrorName() string {
+	return "LookupWordResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LookupWordResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLookupWordResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LookupWordResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LookupWordResponseValidationError{}
+
+// Validate checks the field values on ListLexemesByLemmaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLexemesByLemmaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLexemesByLemmaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLexemesByLemmaRequestMultiError, or nil if none found.
+func (m *ListLexemesByLemmaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLexemesByLemmaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Language
+
+	if len(errors) > 0 {
+		return ListLexemesByLemmaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLexemesByLemmaRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLexemesByLemmaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListLexemesByLemmaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLexemesByLemmaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLexemesByLemmaRequestMultiError) AllErrors() []error { return m }
+
+// ListLexemesByLemmaRequestValidationError is the validation error returned by
+// ListLexemesByLemmaRequest.Validate if the designated constraints aren't met.
+type ListLexemesByLemmaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLexemesByLemmaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLexemesByLemmaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLexemesByLemmaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLexemesByLemmaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLexemesByLemmaRequestValidationError) ErrorName() string {
+	return "ListLexemesByLemmaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLexemesByLemmaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLexemesByLemmaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLexemesByLemmaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLexemesByLemmaRequestValidationError{}
+
+// Validate checks the field values on LemmaGroup with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LemmaGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LemmaGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LemmaGroupMultiError, or
+// nil if none found.
+func (m *LemmaGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LemmaGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Lemma
+
+	for idx, item := range m.GetLexemes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LemmaGroupValidationError{
+						field:  fmt.Sprintf("Lexemes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LemmaGroupValidationError{
+						field:  fmt.Sprintf("Lexemes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LemmaGroupValidationError{
+					field:  fmt.Sprintf("Lexemes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LemmaGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// LemmaGroupMultiError is an error wrapping multiple validation errors
+// returned by LemmaGroup.ValidateAll() if the designated constraints aren't met.
+type LemmaGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LemmaGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LemmaGroupMultiError) AllErrors() []error { return m }
+
+// LemmaGroupValidationError is the validation error returned by
+// LemmaGroup.Validate if the designated constraints aren't met.
+type LemmaGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LemmaGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LemmaGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LemmaGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LemmaGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LemmaGroupValidationError) ErrorName() string { return "LemmaGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LemmaGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLemmaGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LemmaGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LemmaGroupValidationError{}
+
+// Validate checks the field values on ListLexemesByLemmaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListLexemesByLemmaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLexemesByLemmaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLexemesByLemmaResponseMultiError, or nil if none found.
+func (m *ListLexemesByLemmaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLexemesByLemmaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLexemesByLemmaResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLexemesByLemmaResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLexemesByLemmaResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLexemesByLemmaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLexemesByLemmaResponseMultiError is an error wrapping multiple
+// validation errors returned by ListLexemesByLemmaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListLexemesByLemmaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLexemesByLemmaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLexemesByLemmaResponseMultiError) AllErrors() []error { return m }
+
+// ListLexemesByLemmaResponseValidationError is the validation error returned
+// by ListLexemesByLemmaResponse.Validate if the designated constraints aren't met.
+type ListLexemesByLemmaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLexemesByLemmaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLexemesByLemmaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLexemesByLemmaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLexemesByLemmaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLexemesByLemmaResponseValidationError) ErrorName() string {
+	return "ListLexemesByLemmaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLexemesByLemmaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLexemesByLemmaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLexemesByLemmaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLexemesByLemmaResponseValidationError{}
+
+// Validate checks the field values on GetDueCountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDueCountResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDueCountResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDueCountResponseMultiError, or nil if none found.
+func (m *GetDueCountResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDueCountResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DueCount
+
+	if len(errors) > 0 {
+		return GetDueCountResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDueCountResponseMultiError is an error wrapping multiple validation
+// errors returned by GetDueCountResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetDueCountResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDueCountResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDueCountResponseMultiError) AllErrors() []error { return m }
+
+// GetDueCountResponseValidationError is the validation error returned by
+// GetDueCountResponse.Validate if the designated constraints aren't met.
+type GetDueCountResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDueCountResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDueCountResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDueCountResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDueCountResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDueCountResponseValidationError) ErrorName() string {
+	return "GetDueCountResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDueCountResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDueCountResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDueCountResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDueCountResponseValidationError{}
@@ -38,27 +38,69 @@ const (
 	// LearningServiceCollectLexemeProcedure is the fully-qualified name of the LearningService's
 	// CollectLexeme RPC.
 	LearningServiceCollectLexemeProcedure = "/learning.v1.LearningService/CollectLexeme"
+	// LearningServiceGetLearnedLexemeByTermProcedure is the fully-qualified name of the
+	// LearningService's GetLearnedLexemeByTerm RPC.
+	LearningServiceGetLearnedLexemeByTermProcedure = "/learning.v1.LearningService/GetLearnedLexemeByTerm"
 	// LearningServiceUncollectLexemeProcedure is the fully-qualified name of the LearningService's
 	// UncollectLexeme RPC.
 	LearningServiceUncollectLexemeProcedure = "/learning.v1.LearningService/UncollectLexeme"
+	// LearningServiceBatchUncollectProcedure is the fully-qualified name of the LearningService's
+	// BatchUncollect RPC.
+	LearningServiceBatchUncollectProcedure = "/learning.v1.LearningService/BatchUncollect"
 	// LearningServiceListLearnedLexemesProcedure is the fully-qualified name of the LearningService's
 	// ListLearnedLexemes RPC.
 	LearningServiceListLearnedLexemesProcedure = "/learning.v1.LearningService/ListLearnedLexemes"
+	// LearningServiceCountLearnedLexemesProcedure is the fully-qualified name of the LearningService's
+	// CountLearnedLexemes RPC.
+	LearningServiceCountLearnedLexemesProcedure = "/learning.v1.LearningService/CountLearnedLexemes"
 	// LearningServiceUpdateMasteryProcedure is the fully-qualified name of the LearningService's
 	// UpdateMastery RPC.
 	LearningServiceUpdateMasteryProcedure = "/learning.v1.LearningService/UpdateMastery"
+	// LearningServiceRenameLexemeProcedure is the fully-qualified name of the LearningService's
+	// RenameLexeme RPC.
+	LearningServiceRenameLexemeProcedure = "/learning.v1.LearningService/RenameLexeme"
+	// LearningServiceBatchUpdateMasteryProcedure is the fully-qualified name of the LearningService's
+	// BatchUpdateMastery RPC.
+	LearningServiceBatchUpdateMasteryProcedure = "/learning.v1.LearningService/BatchUpdateMastery"
+	// LearningServiceLookupWordProcedure is the fully-qualified name of the LearningService's
+	// LookupWord RPC.
+	LearningServiceLookupWordProcedure = "/learning.v1.LearningService/LookupWord"
+	// LearningServiceListLexemesByLemmaProcedure is the fully-qualified name of the LearningService's
+	// ListLexemesByLemma RPC.
+	LearningServiceListLexemesByLemmaProcedure = "/learning.v1.LearningService/ListLexemesByLemma"
+	// LearningServiceGetDueCountProcedure is the fully-qualified name of the LearningService's
+	// GetDueCount RPC.
+	LearningServiceGetDueCountProcedure = "/learning.v1.LearningService/GetDueCount"
 )
 
 // LearningServiceClient is a client for the learning.v1.LearningService service.
 type LearningServiceClient interface {
 	// CollectLexeme collects a term to user's vocabulary (creates global lexeme if needed)
 	CollectLexeme(context.Context, *connect.Request[v1.CollectLexemeRequest]) (*connect.Response[v1.LearnedLexeme], error)
+	// GetLearnedLexemeByTerm returns the user's lexeme for a term, matched case-insensitively
+	GetLearnedLexemeByTerm(context.Context, *connect.Request[v1.GetLearnedLexemeByTermRequest]) (*connect.Response[v1.LearnedLexeme], error)
 	// UncollectLexeme removes a lexeme from user's vocabulary
 	UncollectLexeme(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error)
+	// BatchUncollect removes every lexeme matching the filter from user's vocabulary
+	BatchUncollect(context.Context, *connect.Request[v1.BatchUncollectRequest]) (*connect.Response[v1.BatchUncollectResponse], error)
 	// List user's lexemes with filtering and sorting
 	ListLearnedLexemes(context.Context, *connect.Request[v1.ListLearnedLexemesRequest]) (*connect.Response[v1.ListLearnedLexemesResponse], error)
+	// CountLearnedLexemes counts user's lexemes matching a ListLearnedLexemes filter without fetching them
+	CountLearnedLexemes(context.Context, *connect.Request[v1.CountLearnedLexemesRequest]) (*connect.Response[v1.CountLearnedLexemesResponse], error)
 	// Update mastery level and learning status
 	UpdateMastery(context.Context, *connect.Request[v1.UpdateMasteryRequest]) (*connect.Response[v1.LearnedLexeme], error)
+	// RenameLexeme corrects the spelling of a lexeme's term, keeping its mastery and review history
+	RenameLexeme(context.Context, *connect.Request[v1.RenameLexemeRequest]) (*connect.Response[v1.LearnedLexeme], error)
+	// BatchUpdateMastery syncs review results recorded offline in one transaction; items that cannot
+	// be applied are reported individually and do not fail the rest of the batch unless atomic is set
+	BatchUpdateMastery(context.Context, *connect.Request[v1.BatchUpdateMasteryRequest]) (*connect.Response[v1.BatchUpdateMasteryResponse], error)
+	// LookupWord returns a dictionary entry together with the user's learned state for it
+	LookupWord(context.Context, *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.LookupWordResponse], error)
+	// ListLexemesByLemma groups the user's lexemes under their dictionary lemma, e.g. "ran" and "running" under "run"
+	ListLexemesByLemma(context.Context, *connect.Request[v1.ListLexemesByLemmaRequest]) (*connect.Response[v1.ListLexemesByLemmaResponse], error)
+	// GetDueCount returns how many of the user's lexemes are due for review, for "due today" badges;
+	// lexemes whose review time just passed may take up to the server's cache interval to show up
+	GetDueCount(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDueCountResponse], error)
 }
 
 // NewLearningServiceClient constructs a client for the learning.v1.LearningService service. By
@@ -78,33 +120,89 @@ func NewLearningServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(learningServiceMethods.ByName("CollectLexeme")),
 			connect.WithClientOptions(opts...),
 		),
+		getLearnedLexemeByTerm: connect.NewClient[v1.GetLearnedLexemeByTermRequest, v1.LearnedLexeme](
+			httpClient,
+			baseURL+LearningServiceGetLearnedLexemeByTermProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("GetLearnedLexemeByTerm")),
+			connect.WithClientOptions(opts...),
+		),
 		uncollectLexeme: connect.NewClient[v11.IDRequest, emptypb.Empty](
 			httpClient,
 			baseURL+LearningServiceUncollectLexemeProcedure,
 			connect.WithSchema(learningServiceMethods.ByName("UncollectLexeme")),
 			connect.WithClientOptions(opts...),
 		),
+		batchUncollect: connect.NewClient[v1.BatchUncollectRequest, v1.BatchUncollectResponse](
+			httpClient,
+			baseURL+LearningServiceBatchUncollectProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("BatchUncollect")),
+			connect.WithClientOptions(opts...),
+		),
 		listLearnedLexemes: connect.NewClient[v1.ListLearnedLexemesRequest, v1.ListLearnedLexemesResponse](
 			httpClient,
 			baseURL+LearningServiceListLearnedLexemesProcedure,
 			connect.WithSchema(learningServiceMethods.ByName("ListLearnedLexemes")),
 			connect.WithClientOptions(opts...),
 		),
+		countLearnedLexemes: connect.NewClient[v1.CountLearnedLexemesRequest, v1.CountLearnedLexemesResponse](
+			httpClient,
+			baseURL+LearningServiceCountLearnedLexemesProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("CountLearnedLexemes")),
+			connect.WithClientOptions(opts...),
+		),
 		updateMastery: connect.NewClient[v1.UpdateMasteryRequest, v1.LearnedLexeme](
 			httpClient,
 			baseURL+LearningServiceUpdateMasteryProcedure,
 			connect.WithSchema(learningServiceMethods.ByName("UpdateMastery")),
 			connect.WithClientOptions(opts...),
 		),
+		renameLexeme: connect.NewClient[v1.RenameLexemeRequest, v1.LearnedLexeme](
+			httpClient,
+			baseURL+LearningServiceRenameLexemeProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("RenameLexeme")),
+			connect.WithClientOptions(opts...),
+		),
+		batchUpdateMastery: connect.NewClient[v1.BatchUpdateMasteryRequest, v1.BatchUpdateMasteryResponse](
+			httpClient,
+			baseURL+LearningServiceBatchUpdateMasteryProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("BatchUpdateMastery")),
+			connect.WithClientOptions(opts...),
+		),
+		lookupWord: connect.NewClient[v1.LookupWordRequest, v1.LookupWordResponse](
+			httpClient,
+			baseURL+LearningServiceLookupWordProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("LookupWord")),
+			connect.WithClientOptions(opts...),
+		),
+		listLexemesByLemma: connect.NewClient[v1.ListLexemesByLemmaRequest, v1.ListLexemesByLemmaResponse](
+			httpClient,
+			baseURL+LearningServiceListLexemesByLemmaProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("ListLexemesByLemma")),
+			connect.WithClientOptions(opts...),
+		),
+		getDueCount: connect.NewClient[emptypb.Empty, v1.GetDueCountResponse](
+			httpClient,
+			baseURL+LearningServiceGetDueCountProcedure,
+			connect.WithSchema(learningServiceMethods.ByName("GetDueCount")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // learningServiceClient implements LearningServiceClient.
 type learningServiceClient struct {
-	collectLexeme      *connect.Client[v1.CollectLexemeRequest, v1.LearnedLexeme]
-	uncollectLexeme    *connect.Client[v11.IDRequest, emptypb.Empty]
-	listLearnedLexemes *connect.Client[v1.ListLearnedLexemesRequest, v1.ListLearnedLexemesResponse]
-	updateMastery      *connect.Client[v1.UpdateMasteryRequest, v1.LearnedLexeme]
+	collectLexeme          *connect.Client[v1.CollectLexemeRequest, v1.LearnedLexeme]
+	getLearnedLexemeByTerm *connect.Client[v1.GetLearnedLexemeByTermRequest, v1.LearnedLexeme]
+	uncollectLexeme        *connect.Client[v11.IDRequest, emptypb.Empty]
+	batchUncollect         *connect.Client[v1.BatchUncollectRequest, v1.BatchUncollectResponse]
+	listLearnedLexemes     *connect.Client[v1.ListLearnedLexemesRequest, v1.ListLearnedLexemesResponse]
+	countLearnedLexemes    *connect.Client[v1.CountLearnedLexemesRequest, v1.CountLearnedLexemesResponse]
+	updateMastery          *connect.Client[v1.UpdateMasteryRequest, v1.LearnedLexeme]
+	renameLexeme           *connect.Client[v1.RenameLexemeRequest, v1.LearnedLexeme]
+	batchUpdateMastery     *connect.Client[v1.BatchUpdateMasteryRequest, v1.BatchUpdateMasteryResponse]
+	lookupWord             *connect.Client[v1.LookupWordRequest, v1.LookupWordResponse]
+	listLexemesByLemma     *connect.Client[v1.ListLexemesByLemmaRequest, v1.ListLexemesByLemmaResponse]
+	getDueCount            *connect.Client[emptypb.Empty, v1.GetDueCountResponse]
 }
 
 // CollectLexeme calls learning.v1.LearningService.CollectLexeme.
@@ -112,31 +210,89 @@ func (c *learningServiceClient) CollectLexeme(ctx context.Context, req *connect.
 	return c.collectLexeme.CallUnary(ctx, req)
 }
 
+// GetLearnedLexemeByTerm calls learning.v1.LearningService.GetLearnedLexemeByTerm.
+func (c *learningServiceClient) GetLearnedLexemeByTerm(ctx context.Context, req *connect.Request[v1.GetLearnedLexemeByTermRequest]) (*connect.Response[v1.LearnedLexeme], error) {
+	return c.getLearnedLexemeByTerm.CallUnary(ctx, req)
+}
+
 // UncollectLexeme calls learning.v1.LearningService.UncollectLexeme.
 func (c *learningServiceClient) UncollectLexeme(ctx context.Context, req *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.uncollectLexeme.CallUnary(ctx, req)
 }
 
+// BatchUncollect calls learning.v1.LearningService.BatchUncollect.
+func (c *learningServiceClient) BatchUncollect(ctx context.Context, req *connect.Request[v1.BatchUncollectRequest]) (*connect.Response[v1.BatchUncollectResponse], error) {
+	return c.batchUncollect.CallUnary(ctx, req)
+}
+
 // ListLearnedLexemes calls learning.v1.LearningService.ListLearnedLexemes.
 func (c *learningServiceClient) ListLearnedLexemes(ctx context.Context, req *connect.Request[v1.ListLearnedLexemesRequest]) (*connect.Response[v1.ListLearnedLexemesResponse], error) {
 	return c.listLearnedLexemes.CallUnary(ctx, req)
 }
 
+// CountLearnedLexemes calls learning.v1.LearningService.CountLearnedLexemes.
+func (c *learningServiceClient) CountLearnedLexemes(ctx context.Context, req *connect.Request[v1.CountLearnedLexemesRequest]) (*connect.Response[v1.CountLearnedLexemesResponse], error) {
+	return c.countLearnedLexemes.CallUnary(ctx, req)
+}
+
 // UpdateMastery calls learning.v1.LearningService.UpdateMastery.
 func (c *learningServiceClient) UpdateMastery(ctx context.Context, req *connect.Request[v1.UpdateMasteryRequest]) (*connect.Response[v1.LearnedLexeme], error) {
 	return c.updateMastery.CallUnary(ctx, req)
 }
 
+// RenameLexeme calls learning.v1.LearningService.RenameLexeme.
+func (c *learningServiceClient) RenameLexeme(ctx context.Context, req *connect.Request[v1.RenameLexemeRequest]) (*connect.Response[v1.LearnedLexeme], error) {
+	return c.renameLexeme.CallUnary(ctx, req)
+}
+
+// BatchUpdateMastery calls learning.v1.LearningService.BatchUpdateMastery.
+func (c *learningServiceClient) BatchUpdateMastery(ctx context.Context, req *connect.Request[v1.BatchUpdateMasteryRequest]) (*connect.Response[v1.BatchUpdateMasteryResponse], error) {
+	return c.batchUpdateMastery.CallUnary(ctx, req)
+}
+
+// LookupWord calls learning.v1.LearningService.LookupWord.
+func (c *learningServiceClient) LookupWord(ctx context.Context, req *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.LookupWordResponse], error) {
+	return c.lookupWord.CallUnary(ctx, req)
+}
+
+// ListLexemesByLemma calls learning.v1.LearningService.ListLexemesByLemma.
+func (c *learningServiceClient) ListLexemesByLemma(ctx context.Context, req *connect.Request[v1.ListLexemesByLemmaRequest]) (*connect.Response[v1.ListLexemesByLemmaResponse], error) {
+	return c.listLexemesByLemma.CallUnary(ctx, req)
+}
+
+// GetDueCount calls learning.v1.LearningService.GetDueCount.
+func (c *learningServiceClient) GetDueCount(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDueCountResponse], error) {
+	return c.getDueCount.CallUnary(ctx, req)
+}
+
 // LearningServiceHandler is an implementation of the learning.v1.LearningService service.
 type LearningServiceHandler interface {
 	// CollectLexeme collects a term to user's vocabulary (creates global lexeme if needed)
 	CollectLexeme(context.Context, *connect.Request[v1.CollectLexemeRequest]) (*connect.Response[v1.LearnedLexeme], error)
+	// GetLearnedLexemeByTerm returns the user's lexeme for a term, matched case-insensitively
+	GetLearnedLexemeByTerm(context.Context, *connect.Request[v1.GetLearnedLexemeByTermRequest]) (*connect.Response[v1.LearnedLexeme], error)
 	// UncollectLexeme removes a lexeme from user's vocabulary
 	UncollectLexeme(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error)
+	// BatchUncollect removes every lexeme matching the filter from user's vocabulary
+	BatchUncollect(context.Context, *connect.Request[v1.BatchUncollectRequest]) (*connect.Response[v1.BatchUncollectResponse], error)
 	// List user's lexemes with filtering and sorting
 	ListLearnedLexemes(context.Context, *connect.Request[v1.ListLearnedLexemesRequest]) (*connect.Response[v1.ListLearnedLexemesResponse], error)
+	// CountLearnedLexemes counts user's lexemes matching a ListLearnedLexemes filter without fetching them
+	CountLearnedLexemes(context.Context, *connect.Request[v1.CountLearnedLexemesRequest]) (*connect.Response[v1.CountLearnedLexemesResponse], error)
 	// Update mastery level and learning status
 	UpdateMastery(context.Context, *connect.Request[v1.UpdateMasteryRequest]) (*connect.Response[v1.LearnedLexeme], error)
+	// RenameLexeme corrects the spelling of a lexeme's term, keeping its mastery and review history
+	RenameLexeme(context.Context, *connect.Request[v1.RenameLexemeRequest]) (*connect.Response[v1.LearnedLexeme], error)
+	// BatchUpdateMastery syncs review results recorded offline in one transaction; items that cannot
+	// be applied are reported individually and do not fail the rest of the batch unless atomic is set
+	BatchUpdateMastery(context.Context, *connect.Request[v1.BatchUpdateMasteryRequest]) (*connect.Response[v1.BatchUpdateMasteryResponse], error)
+	// LookupWord returns a dictionary entry together with the user's learned state for it
+	LookupWord(context.Context, *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.LookupWordResponse], error)
+	// ListLexemesByLemma groups the user's lexemes under their dictionary lemma, e.g. "ran" and "running" under "run"
+	ListLexemesByLemma(context.Context, *connect.Request[v1.ListLexemesByLemmaRequest]) (*connect.Response[v1.ListLexemesByLemmaResponse], error)
+	// GetDueCount returns how many of the user's lexemes are due for review, for "due today" badges;
+	// lexemes whose review time just passed may take up to the server's cache interval to show up
+	GetDueCount(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDueCountResponse], error)
 }
 
 // NewLearningServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -152,34 +308,98 @@ func NewLearningServiceHandler(svc LearningServiceHandler, opts ...connect.Handl
 		connect.WithSchema(learningServiceMethods.ByName("CollectLexeme")),
 		connect.WithHandlerOptions(opts...),
 	)
+	learningServiceGetLearnedLexemeByTermHandler := connect.NewUnaryHandler(
+		LearningServiceGetLearnedLexemeByTermProcedure,
+		svc.GetLearnedLexemeByTerm,
+		connect.WithSchema(learningServiceMethods.ByName("GetLearnedLexemeByTerm")),
+		connect.WithHandlerOptions(opts...),
+	)
 	learningServiceUncollectLexemeHandler := connect.NewUnaryHandler(
 		LearningServiceUncollectLexemeProcedure,
 		svc.UncollectLexeme,
 		connect.WithSchema(learningServiceMethods.ByName("UncollectLexeme")),
 		connect.WithHandlerOptions(opts...),
 	)
+	learningServiceBatchUncollectHandler := connect.NewUnaryHandler(
+		LearningServiceBatchUncollectProcedure,
+		svc.BatchUncollect,
+		connect.WithSchema(learningServiceMethods.ByName("BatchUncollect")),
+		connect.WithHandlerOptions(opts...),
+	)
 	learningServiceListLearnedLexemesHandler := connect.NewUnaryHandler(
 		LearningServiceListLearnedLexemesProcedure,
 		svc.ListLearnedLexemes,
 		connect.WithSchema(learningServiceMethods.ByName("ListLearnedLexemes")),
 		connect.WithHandlerOptions(opts...),
 	)
+	learningServiceCountLearnedLexemesHandler := connect.NewUnaryHandler(
+		LearningServiceCountLearnedLexemesProcedure,
+		svc.CountLearnedLexemes,
+		connect.WithSchema(learningServiceMethods.ByName("CountLearnedLexemes")),
+		connect.WithHandlerOptions(opts...),
+	)
 	learningServiceUpdateMasteryHandler := connect.NewUnaryHandler(
 		LearningServiceUpdateMasteryProcedure,
 		svc.UpdateMastery,
 		connect.WithSchema(learningServiceMethods.ByName("UpdateMastery")),
 		connect.WithHandlerOptions(opts...),
 	)
+	learningServiceRenameLexemeHandler := connect.NewUnaryHandler(
+		LearningServiceRenameLexemeProcedure,
+		svc.RenameLexeme,
+		connect.WithSchema(learningServiceMethods.ByName("RenameLexeme")),
+		connect.WithHandlerOptions(opts...),
+	)
+	learningServiceBatchUpdateMasteryHandler := connect.NewUnaryHandler(
+		LearningServiceBatchUpdateMasteryProcedure,
+		svc.BatchUpdateMastery,
+		connect.WithSchema(learningServiceMethods.ByName("BatchUpdateMastery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	learningServiceLookupWordHandler := connect.NewUnaryHandler(
+		LearningServiceLookupWordProcedure,
+		svc.LookupWord,
+		connect.WithSchema(learningServiceMethods.ByName("LookupWord")),
+		connect.WithHandlerOptions(opts...),
+	)
+	learningServiceListLexemesByLemmaHandler := connect.NewUnaryHandler(
+		LearningServiceListLexemesByLemmaProcedure,
+		svc.ListLexemesByLemma,
+		connect.WithSchema(learningServiceMethods.ByName("ListLexemesByLemma")),
+		connect.WithHandlerOptions(opts...),
+	)
+	learningServiceGetDueCountHandler := connect.NewUnaryHandler(
+		LearningServiceGetDueCountProcedure,
+		svc.GetDueCount,
+		connect.WithSchema(learningServiceMethods.ByName("GetDueCount")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/learning.v1.LearningService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case LearningServiceCollectLexemeProcedure:
 			learningServiceCollectLexemeHandler.ServeHTTP(w, r)
+		case LearningServiceGetLearnedLexemeByTermProcedure:
+			learningServiceGetLearnedLexemeByTermHandler.ServeHTTP(w, r)
 		case LearningServiceUncollectLexemeProcedure:
 			learningServiceUncollectLexemeHandler.ServeHTTP(w, r)
+		case LearningServiceBatchUncollectProcedure:
+			learningServiceBatchUncollectHandler.ServeHTTP(w, r)
 		case LearningServiceListLearnedLexemesProcedure:
 			learningServiceListLearnedLexemesHandler.ServeHTTP(w, r)
+		case LearningServiceCountLearnedLexemesProcedure:
+			learningServiceCountLearnedLexemesHandler.ServeHTTP(w, r)
 		case LearningServiceUpdateMasteryProcedure:
 			learningServiceUpdateMasteryHandler.ServeHTTP(w, r)
+		case LearningServiceRenameLexemeProcedure:
+			learningServiceRenameLexemeHandler.ServeHTTP(w, r)
+		case LearningServiceBatchUpdateMasteryProcedure:
+			learningServiceBatchUpdateMasteryHandler.ServeHTTP(w, r)
+		case LearningServiceLookupWordProcedure:
+			learningServiceLookupWordHandler.ServeHTTP(w, r)
+		case LearningServiceListLexemesByLemmaProcedure:
+			learningServiceListLexemesByLemmaHandler.ServeHTTP(w, r)
+		case LearningServiceGetDueCountProcedure:
+			learningServiceGetDueCountHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -193,14 +413,46 @@ func (UnimplementedLearningServiceHandler) CollectLexeme(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.CollectLexeme is not implemented"))
 }
 
+func (UnimplementedLearningServiceHandler) GetLearnedLexemeByTerm(context.Context, *connect.Request[v1.GetLearnedLexemeByTermRequest]) (*connect.Response[v1.LearnedLexeme], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.GetLearnedLexemeByTerm is not implemented"))
+}
+
 func (UnimplementedLearningServiceHandler) UncollectLexeme(context.Context, *connect.Request[v11.IDRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.UncollectLexeme is not implemented"))
 }
 
+func (UnimplementedLearningServiceHandler) BatchUncollect(context.Context, *connect.Request[v1.BatchUncollectRequest]) (*connect.Response[v1.BatchUncollectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.BatchUncollect is not implemented"))
+}
+
 func (UnimplementedLearningServiceHandler) ListLearnedLexemes(context.Context, *connect.Request[v1.ListLearnedLexemesRequest]) (*connect.Response[v1.ListLearnedLexemesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.ListLearnedLexemes is not implemented"))
 }
 
+func (UnimplementedLearningServiceHandler) CountLearnedLexemes(context.Context, *connect.Request[v1.CountLearnedLexemesRequest]) (*connect.Response[v1.CountLearnedLexemesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.CountLearnedLexemes is not implemented"))
+}
+
 func (UnimplementedLearningServiceHandler) UpdateMastery(context.Context, *connect.Request[v1.UpdateMasteryRequest]) (*connect.Response[v1.LearnedLexeme], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.UpdateMastery is not implemented"))
 }
+
+func (UnimplementedLearningServiceHandler) RenameLexeme(context.Context, *connect.Request[v1.RenameLexemeRequest]) (*connect.Response[v1.LearnedLexeme], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.RenameLexeme is not implemented"))
+}
+
+func (UnimplementedLearningServiceHandler) BatchUpdateMastery(context.Context, *connect.Request[v1.BatchUpdateMasteryRequest]) (*connect.Response[v1.BatchUpdateMasteryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.BatchUpdateMastery is not implemented"))
+}
+
+func (UnimplementedLearningServiceHandler) LookupWord(context.Context, *connect.Request[v1.LookupWordRequest]) (*connect.Response[v1.LookupWordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.LookupWord is not implemented"))
+}
+
+func (UnimplementedLearningServiceHandler) ListLexemesByLemma(context.Context, *connect.Request[v1.ListLexemesByLemmaRequest]) (*connect.Response[v1.ListLexemesByLemmaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.ListLexemesByLemma is not implemented"))
+}
+
+func (UnimplementedLearningServiceHandler) GetDueCount(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetDueCountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("learning.v1.LearningService.GetDueCount is not implemented"))
+}
@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: system/v1/system_service.proto
+
+package systemv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetServerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoRequest) Reset() {
+	*x = GetServerInfoRequest{}
+	mi := &file_system_v1_system_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoRequest) ProtoMessage() {}
+
+func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ServerInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Build version set at link time, "dev" for local builds
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Hash of the database schema, the ent_schema_hash written into backups
+	SchemaHash string `protobuf:"bytes,2,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+	// Configured database driver, e.g. "sqlite3" or "postgres"
+	DatabaseDriver string `protobuf:"bytes,3,opt,name=database_driver,json=databaseDriver,proto3" json:"database_driver,omitempty"`
+	// When the server process started
+	StartedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Time since started_at
+	Uptime        *durationpb.Duration `protobuf:"bytes,5,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	mi := &file_system_v1_system_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ServerInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetSchemaHash() string {
+	if x != nil {
+		return x.SchemaHash
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetDatabaseDriver() string {
+	if x != nil {
+		return x.DatabaseDriver
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetUptime() *durationpb.Duration {
+	if x != nil {
+		return x.Uptime
+	}
+	return nil
+}
+
+var File_system_v1_system_service_proto protoreflect.FileDescriptor
+
+const file_system_v1_system_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1esystem/v1/system_service.proto\x12\tsystem.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x16\n" +
+	"\x14GetServerInfoRequest\"\xde\x01\n" +
+	"\n" +
+	"ServerInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x1f\n" +
+	"\vschema_hash\x18\x02 \x01(\tR\n" +
+	"schemaHash\x12'\n" +
+	"\x0fdatabase_driver\x18\x03 \x01(\tR\x0edatabaseDriver\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x121\n" +
+	"\x06uptime\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x06uptime2Z\n" +
+	"\rSystemService\x12I\n" +
+	"\rGetServerInfo\x12\x1f.system.v1.GetServerInfoRequest\x1a\x15.system.v1.ServerInfo\"\x00B\x9e\x01\n" +
+	"\rcom.system.v1B\x12SystemServiceProtoP\x01Z4github.com/eslsoft/vocnet/pkg/api/system/v1;systemv1\xa2\x02\x03SXX\xaa\x02\tSystem.V1\xca\x02\tSystem\\V1\xe2\x02\x15System\\V1\\GPBMetadata\xea\x02\n" +
+	"System::V1b\x06proto3"
+
+var (
+	file_system_v1_system_service_proto_rawDescOnce sync.Once
+	file_system_v1_system_service_proto_rawDescData []byte
+)
+
+func file_system_v1_system_service_proto_rawDescGZIP() []byte {
+	file_system_v1_system_service_proto_rawDescOnce.Do(func() {
+		file_system_v1_system_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_system_v1_system_service_proto_rawDesc), len(file_system_v1_system_service_proto_rawDesc)))
+	})
+	return file_system_v1_system_service_proto_rawDescData
+}
+
+var file_system_v1_system_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_system_v1_system_service_proto_goTypes = []any{
+	(*GetServerInfoRequest)(nil),  // 0: system.v1.GetServerInfoRequest
+	(*ServerInfo)(nil),            // 1: system.v1.ServerInfo
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+}
+var file_system_v1_system_service_proto_depIdxs = []int32{
+	2, // 0: system.v1.ServerInfo.started_at:type_name -> google.protobuf.Timestamp
+	3, // 1: system.v1.ServerInfo.uptime:type_name -> google.protobuf.Duration
+	0, // 2: system.v1.SystemService.GetServerInfo:input_type -> system.v1.GetServerInfoRequest
+	1, // 3: system.v1.SystemService.GetServerInfo:output_type -> system.v1.ServerInfo
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_system_v1_system_service_proto_init() }
+func file_system_v1_system_service_proto_init() {
+	if File_system_v1_system_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_system_v1_system_service_proto_rawDesc), len(file_system_v1_system_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_system_v1_system_service_proto_goTypes,
+		DependencyIndexes: file_system_v1_system_service_proto_depIdxs,
+		MessageInfos:      file_system_v1_system_service_proto_msgTypes,
+	}.Build()
+	File_system_v1_system_service_proto = out.File
+	file_system_v1_system_service_proto_goTypes = nil
+	file_system_v1_system_service_proto_depIdxs = nil
+}
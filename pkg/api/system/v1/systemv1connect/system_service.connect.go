@@ -0,0 +1,113 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: system/v1/system_service.proto
+
+package systemv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/eslsoft/vocnet/pkg/api/system/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// SystemServiceName is the fully-qualified name of the SystemService service.
+	SystemServiceName = "system.v1.SystemService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// SystemServiceGetServerInfoProcedure is the fully-qualified name of the SystemService's
+	// GetServerInfo RPC.
+	SystemServiceGetServerInfoProcedure = "/system.v1.SystemService/GetServerInfo"
+)
+
+// SystemServiceClient is a client for the system.v1.SystemService service.
+type SystemServiceClient interface {
+	// GetServerInfo reports the build version, schema hash, database driver and uptime. It needs no
+	// user and is rate limited
+	GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error)
+}
+
+// NewSystemServiceClient constructs a client for the system.v1.SystemService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSystemServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SystemServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	systemServiceMethods := v1.File_system_v1_system_service_proto.Services().ByName("SystemService").Methods()
+	return &systemServiceClient{
+		getServerInfo: connect.NewClient[v1.GetServerInfoRequest, v1.ServerInfo](
+			httpClient,
+			baseURL+SystemServiceGetServerInfoProcedure,
+			connect.WithSchema(systemServiceMethods.ByName("GetServerInfo")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// systemServiceClient implements SystemServiceClient.
+type systemServiceClient struct {
+	getServerInfo *connect.Client[v1.GetServerInfoRequest, v1.ServerInfo]
+}
+
+// GetServerInfo calls system.v1.SystemService.GetServerInfo.
+func (c *systemServiceClient) GetServerInfo(ctx context.Context, req *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error) {
+	return c.getServerInfo.CallUnary(ctx, req)
+}
+
+// SystemServiceHandler is an implementation of the system.v1.SystemService service.
+type SystemServiceHandler interface {
+	// GetServerInfo reports the build version, schema hash, database driver and uptime. It needs no
+	// user and is rate limited
+	GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error)
+}
+
+// NewSystemServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSystemServiceHandler(svc SystemServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	systemServiceMethods := v1.File_system_v1_system_service_proto.Services().ByName("SystemService").Methods()
+	systemServiceGetServerInfoHandler := connect.NewUnaryHandler(
+		SystemServiceGetServerInfoProcedure,
+		svc.GetServerInfo,
+		connect.WithSchema(systemServiceMethods.ByName("GetServerInfo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/system.v1.SystemService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SystemServiceGetServerInfoProcedure:
+			systemServiceGetServerInfoHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSystemServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSystemServiceHandler struct{}
+
+func (UnimplementedSystemServiceHandler) GetServerInfo(context.Context, *connect.Request[v1.GetServerInfoRequest]) (*connect.Response[v1.ServerInfo], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("system.v1.SystemService.GetServerInfo is not implemented"))
+}
@@ -39,6 +39,7 @@ const (
 )
 
 // SetterFunc allows custom assignment of literal values to struct fields.
+// Number literals are passed as int64, uint64 or float64 depending on how they were written.
 type SetterFunc func(field reflect.Value, value any) error
 
 // FilterField describes how a filter field maps to a params struct field and which operations are allowed.
@@ -68,6 +69,58 @@ type OrderSchema struct {
 type ResourceSchema struct {
 	Filter map[string]FilterField
 	Order  OrderSchema
+	Limits FilterLimits
+}
+
+// Default filter complexity limits, used when FilterLimits leaves a limit at zero.
+const (
+	DefaultMaxPredicates = 16
+	DefaultMaxDepth      = 12
+)
+
+// FilterLimits bounds how complex a filter may be. Zero uses the default limit, negative disables it.
+type FilterLimits struct {
+	// MaxPredicates caps the number of ANDed clauses.
+	MaxPredicates int
+	// MaxDepth caps how deeply the expression nests. The parser enforces it as it descends, counting
+	// repeated grammar rules such as parentheses and unary operators, so deeper input is rejected
+	// before it is fully parsed. AND chains do not nest.
+	MaxDepth int
+}
+
+func (l FilterLimits) maxPredicates() int {
+	if l.MaxPredicates == 0 {
+		return DefaultMaxPredicates
+	}
+	return l.MaxPredicates
+}
+
+func (l FilterLimits) maxDepth() int {
+	if l.MaxDepth == 0 {
+		return DefaultMaxDepth
+	}
+	return l.MaxDepth
+}
+
+// FilterTooComplexError reports a filter exceeding FilterLimits.
+// Either the predicate fields or MaxDepth is set, depending on the limit hit.
+type FilterTooComplexError struct {
+	Predicates    int
+	MaxPredicates int
+	MaxDepth      int
+}
+
+func (e *FilterTooComplexError) Error() string {
+	if e.MaxDepth > 0 {
+		return fmt.Sprintf("filter too complex: nested deeper than %d levels", e.MaxDepth)
+	}
+	return fmt.Sprintf("filter too complex: %d predicates, max %d", e.Predicates, e.MaxPredicates)
+}
+
+// WithLimits returns a copy of s using limits.
+func (s ResourceSchema) WithLimits(limits FilterLimits) ResourceSchema {
+	s.Limits = limits
+	return s
 }
 
 var timeType = reflect.TypeOf(time.Time{})
@@ -78,7 +131,7 @@ func Bind[M Msg, P any](msg M, binding *P, schema ResourceSchema) error {
 		return errors.New("binding must not be nil")
 	}
 
-	if err := bindFilterTo(binding, msg.GetFilter(), schema.Filter); err != nil {
+	if err := bindFilterTo(binding, msg.GetFilter(), schema.Filter, schema.Limits); err != nil {
 		return fmt.Errorf("filter: %w", err)
 	}
 
@@ -94,86 +147,110 @@ func Bind[M Msg, P any](msg M, binding *P, schema ResourceSchema) error {
 	return nil
 }
 
-func bindFilterTo(binding any, filter string, fields map[string]FilterField) error { //nolint:gocognit,gocyclo // CEL predicate parsing requires structured validation flows
+func bindFilterTo(binding any, filter string, fields map[string]FilterField, limits FilterLimits) error {
+	preds, err := parseFilter(filter, fields, limits)
+	if err != nil || len(preds) == 0 {
+		return err
+	}
+
+	paramsVal := reflect.ValueOf(binding)
+	if paramsVal.Kind() != reflect.Ptr || paramsVal.IsNil() {
+		return errors.New("binding must be a non-nil pointer")
+	}
+
+	dest := paramsVal.Elem()
+	if dest.Kind() != reflect.Struct {
+		return errors.New("binding must point to a struct")
+	}
+
+	for _, pred := range preds {
+		rule := fields[pred.Field]
+		targetName := rule.Ops[pred.Op]
+
+		field := dest.FieldByName(targetName)
+		if !field.IsValid() {
+			return fmt.Errorf("params struct %s has no field named %q", dest.Type(), targetName)
+		}
+		if !field.CanSet() {
+			return fmt.Errorf("cannot set field %q on params struct", targetName)
+		}
+
+		if rule.Setter != nil {
+			if err := callSetter(rule.Setter, field, pred.Value); err != nil {
+				return fmt.Errorf("setter for field %q failed: %w", targetName, err)
+			}
+			continue
+		}
+
+		if err := assignValue(field, pred.Value); err != nil {
+			return fmt.Errorf("failed to assign field %q: %w", targetName, err)
+		}
+	}
+
+	return nil
+}
+
+// parseFilter parses filter into validated predicates: every field, operator and literal is checked
+// against fields, and the expression against limits.
+func parseFilter(filter string, fields map[string]FilterField, limits FilterLimits) ([]atomicPredicate, error) {
 	filter = strings.TrimSpace(filter)
 	if filter == "" {
-		return nil
+		return nil, nil
 	}
 
 	if len(fields) == 0 {
-		return errors.New("filter schema has no fields defined")
+		return nil, errors.New("filter schema has no fields defined")
 	}
 
-	env, err := buildEnv(fields)
+	maxDepth := limits.maxDepth()
+	env, err := buildEnv(fields, maxDepth)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	ast, issues := env.Parse(filter)
 	if issues != nil && issues.Err() != nil {
-		return fmt.Errorf("invalid filter: %w", issues.Err())
+		if maxDepth > 0 && strings.Contains(issues.Err().Error(), "recursion") {
+			return nil, &FilterTooComplexError{MaxDepth: maxDepth}
+		}
+		return nil, fmt.Errorf("invalid filter: %w", issues.Err())
 	}
 
 	parsed, err := cel.AstToParsedExpr(ast)
 	if err != nil {
-		return fmt.Errorf("failed to convert AST: %w", err)
+		return nil, fmt.Errorf("failed to convert AST: %w", err)
 	}
 	conjuncts, err := extractConjuncts(parsed.GetExpr())
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	paramsVal := reflect.ValueOf(binding)
-	if paramsVal.Kind() != reflect.Ptr || paramsVal.IsNil() {
-		return errors.New("binding must be a non-nil pointer")
-	}
-
-	dest := paramsVal.Elem()
-	if dest.Kind() != reflect.Struct {
-		return errors.New("binding must point to a struct")
+	if maxPredicates := limits.maxPredicates(); maxPredicates > 0 && len(conjuncts) > maxPredicates {
+		return nil, &FilterTooComplexError{Predicates: len(conjuncts), MaxPredicates: maxPredicates}
 	}
 
+	preds := make([]atomicPredicate, 0, len(conjuncts))
 	for _, expr := range conjuncts {
 		pred, err := parseAtomicPredicate(expr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		rule, ok := fields[pred.Field]
 		if !ok {
-			return fmt.Errorf("field %q is not allowed", pred.Field)
+			return nil, fmt.Errorf("field %q is not allowed", pred.Field)
 		}
 
-		targetName, ok := rule.Ops[pred.Op]
-		if !ok {
-			return fmt.Errorf("operator %q is not allowed for field %q", string(pred.Op), pred.Field)
+		if _, ok := rule.Ops[pred.Op]; !ok {
+			return nil, fmt.Errorf("operator %q is not allowed for field %q", string(pred.Op), pred.Field)
 		}
 
 		if err := validateLiteral(rule.Kind, pred.Op, pred.Value); err != nil {
-			return fmt.Errorf("field %q: %w", pred.Field, err)
-		}
-
-		field := dest.FieldByName(targetName)
-		if !field.IsValid() {
-			return fmt.Errorf("params struct %s has no field named %q", dest.Type(), targetName)
-		}
-		if !field.CanSet() {
-			return fmt.Errorf("cannot set field %q on params struct", targetName)
-		}
-
-		if rule.Setter != nil {
-			if err := callSetter(rule.Setter, field, pred.Value); err != nil {
-				return fmt.Errorf("setter for field %q failed: %w", targetName, err)
-			}
-			continue
-		}
-
-		if err := assignValue(field, pred.Value); err != nil {
-			return fmt.Errorf("failed to assign field %q: %w", targetName, err)
+			return nil, fmt.Errorf("field %q: %w", pred.Field, err)
 		}
+		preds = append(preds, pred)
 	}
 
-	return nil
+	return preds, nil
 }
 
 type atomicPredicate struct {
@@ -182,7 +259,8 @@ type atomicPredicate struct {
 	Value any
 }
 
-func buildEnv(fields map[string]FilterField) (*cel.Env, error) {
+// buildEnv declares fields as CEL variables; a positive maxDepth limits parser recursion.
+func buildEnv(fields map[string]FilterField, maxDepth int) (*cel.Env, error) {
 	opts := make([]cel.EnvOption, 0, len(fields))
 	for name, rule := range fields {
 		celType, err := celTypeForKind(rule.Kind)
@@ -192,6 +270,9 @@ func buildEnv(fields map[string]FilterField) (*cel.Env, error) {
 		opts = append(opts, cel.Variable(name, celType))
 	}
 	opts = append(opts, cel.CrossTypeNumericComparisons(true))
+	if maxDepth > 0 {
+		opts = append(opts, cel.ParserRecursionLimit(maxDepth))
+	}
 
 	// NOTE: cel-go v0.26.1 does not export an EnvOption for variadic logical operators.
 	// We accept the default binary AST shape and flatten nested AND chains in extractConjuncts.
@@ -235,7 +316,7 @@ func extractConjuncts(expr *exprpb.Expr) ([]*exprpb.Expr, error) {
 			result = append(result, conjuncts...)
 		}
 		return result, nil
-	case "_||_", "_?_:_", "!":
+	case "_||_", "_?_:_", "!_", "!":
 		return nil, fmt.Errorf("logical operator %q is not supported; only AND is allowed", call.Function)
 	default:
 		return []*exprpb.Expr{expr}, nil
@@ -363,9 +444,9 @@ func parseLiteral(expr *exprpb.Expr) (any, error) { //nolint:gocognit,gocyclo //
 		case *exprpb.Constant_StringValue:
 			return constant.GetStringValue(), nil
 		case *exprpb.Constant_Int64Value:
-			return float64(constant.GetInt64Value()), nil
+			return constant.GetInt64Value(), nil
 		case *exprpb.Constant_Uint64Value:
-			return float64(constant.GetUint64Value()), nil
+			return constant.GetUint64Value(), nil
 		case *exprpb.Constant_DoubleValue:
 			return constant.GetDoubleValue(), nil
 		default:
@@ -373,6 +454,18 @@ func parseLiteral(expr *exprpb.Expr) (any, error) { //nolint:gocognit,gocyclo //
 		}
 	}
 
+	// CEL folds "-5" into a constant, but "-(5)" stays a unary negation call.
+	if call := expr.GetCallExpr(); call != nil && call.Function == "-_" {
+		if call.Target != nil || len(call.Args) != 1 {
+			return nil, errors.New("unary minus expects a single operand")
+		}
+		value, err := parseLiteral(call.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		return negateNumber(value)
+	}
+
 	if list := expr.GetListExpr(); list != nil {
 		elements := list.GetElements()
 		values := make([]string, len(elements))
@@ -415,6 +508,25 @@ func parseLiteral(expr *exprpb.Expr) (any, error) { //nolint:gocognit,gocyclo //
 	return nil, errors.New("right-hand side must be a literal, list literal, or timestamp() call")
 }
 
+func negateNumber(value any) (any, error) {
+	switch v := value.(type) {
+	case int64:
+		if v == math.MinInt64 {
+			return nil, fmt.Errorf("negated literal %d overflows int64", v)
+		}
+		return -v, nil
+	case uint64:
+		if v > 1<<63 {
+			return nil, fmt.Errorf("negated literal %d overflows int64", v)
+		}
+		return int64(-v), nil //nolint:gosec // range checked above; two's complement yields -v
+	case float64:
+		return -v, nil
+	default:
+		return nil, errors.New("unary minus requires a numeric literal")
+	}
+}
+
 func validateLiteral(kind ValueKind, op Op, value any) error {
 	switch kind {
 	case KindString:
@@ -438,7 +550,9 @@ func validateLiteral(kind ValueKind, op Op, value any) error {
 			}
 		}
 	case KindNumber:
-		if _, ok := value.(float64); !ok {
+		switch value.(type) {
+		case int64, uint64, float64:
+		default:
 			return fmt.Errorf("expected %s literal", kind)
 		}
 	case KindTimestamp:
@@ -490,7 +604,7 @@ func assignValue(field reflect.Value, value any) error {
 		clone := make([]string, len(v))
 		copy(clone, v)
 		field.Set(reflect.ValueOf(clone))
-	case float64:
+	case int64, uint64, float64:
 		return assignNumeric(field, v)
 	case time.Time:
 		if field.Type() != timeType {
@@ -504,36 +618,70 @@ func assignValue(field reflect.Value, value any) error {
 	return nil
 }
 
-func assignNumeric(field reflect.Value, value float64) error {
+// assignNumeric stores an int64, uint64 or float64 literal. Integer literals are assigned to integer
+// fields without a float64 round trip so values beyond 2^53 keep full precision.
+func assignNumeric(field reflect.Value, value any) error { //nolint:gocognit,gocyclo // each literal/destination pair needs its own range check
 	switch field.Kind() {
 	case reflect.Float32, reflect.Float64:
-		field.SetFloat(value)
+		switch v := value.(type) {
+		case int64:
+			field.SetFloat(float64(v))
+		case uint64:
+			field.SetFloat(float64(v))
+		case float64:
+			field.SetFloat(v)
+		}
 		return nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		if math.Trunc(value) != value {
-			return fmt.Errorf("cannot assign non-integer value %v to integer field", value)
+		var n int64
+		switch v := value.(type) {
+		case int64:
+			n = v
+		case uint64:
+			if v > math.MaxInt64 {
+				return fmt.Errorf("value %v overflows integer field", v)
+			}
+			n = int64(v)
+		case float64:
+			if math.Trunc(v) != v {
+				return fmt.Errorf("cannot assign non-integer value %v to integer field", v)
+			}
+			if v < math.MinInt64 || v >= math.MaxInt64 {
+				return fmt.Errorf("value %v overflows integer field", v)
+			}
+			n = int64(v)
 		}
-		bits := field.Type().Bits()
-		min := -1 << (bits - 1)
-		max := (1 << (bits - 1)) - 1
-		if value < float64(min) || value > float64(max) {
-			return fmt.Errorf("value %v overflows integer field", value)
+		if field.OverflowInt(n) {
+			return fmt.Errorf("value %v overflows integer field", n)
 		}
-		field.SetInt(int64(value))
+		field.SetInt(n)
 		return nil
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		if math.Trunc(value) != value {
-			return fmt.Errorf("cannot assign non-integer value %v to unsigned integer field", value)
-		}
-		if value < 0 {
-			return fmt.Errorf("cannot assign negative value %v to unsigned integer field", value)
+		var n uint64
+		switch v := value.(type) {
+		case int64:
+			if v < 0 {
+				return fmt.Errorf("cannot assign negative value %v to unsigned integer field", v)
+			}
+			n = uint64(v)
+		case uint64:
+			n = v
+		case float64:
+			if math.Trunc(v) != v {
+				return fmt.Errorf("cannot assign non-integer value %v to unsigned integer field", v)
+			}
+			if v < 0 {
+				return fmt.Errorf("cannot assign negative value %v to unsigned integer field", v)
+			}
+			if v >= math.MaxUint64 {
+				return fmt.Errorf("value %v overflows unsigned integer field", v)
+			}
+			n = uint64(v)
 		}
-		bits := field.Type().Bits()
-		max := (uint64(1) << bits) - 1
-		if value > float64(max) {
-			return fmt.Errorf("value %v overflows unsigned integer field", value)
+		if field.OverflowUint(n) {
+			return fmt.Errorf("value %v overflows unsigned integer field", n)
 		}
-		field.SetUint(uint64(value))
+		field.SetUint(n)
 		return nil
 	default:
 		return fmt.Errorf("numeric assignment requires integer or float field, got %s", field.Kind())
@@ -1,6 +1,7 @@
 package filterexpr
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -241,3 +242,140 @@ func TestBind_InvalidBinding(t *testing.T) {
 		t.Fatalf("expected error when binding is nil pointer")
 	}
 }
+
+func TestBind_NegativeNumbers(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		wantMin float64
+		wantMax float64
+	}{
+		{"negative integer", "price >= -5 && price <= -1", -5, -1},
+		{"negative float", "price >= -5.25 && price <= -0.5", -5.25, -0.5},
+		{"parenthesized negation", "price >= -(5) && price <= -(-(2.5))", -5, 2.5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var params listParams
+			if err := Bind(listMsg{filter: tc.filter}, &params, testSchema); err != nil {
+				t.Fatalf("Bind returned error: %v", err)
+			}
+			if params.PriceMin == nil || *params.PriceMin != tc.wantMin {
+				t.Fatalf("expected PriceMin %v, got %v", tc.wantMin, params.PriceMin)
+			}
+			if params.PriceMax == nil || *params.PriceMax != tc.wantMax {
+				t.Fatalf("expected PriceMax %v, got %v", tc.wantMax, params.PriceMax)
+			}
+		})
+	}
+}
+
+func TestBind_NegatedNonLiteralRejected(t *testing.T) {
+	for _, filter := range []string{"price >= -price", "price <= -(price)", "state == -('A')"} {
+		var params listParams
+		err := Bind(listMsg{filter: filter}, &params, testSchema)
+		if err == nil {
+			t.Fatalf("expected error for %q", filter)
+		}
+		if !strings.Contains(err.Error(), "right-hand") && !strings.Contains(err.Error(), "numeric literal") {
+			t.Fatalf("unexpected error for %q: %v", filter, err)
+		}
+	}
+}
+
+func TestBind_LargeIntegersKeepPrecision(t *testing.T) {
+	type intParams struct {
+		Min           *int64
+		Max           *int64
+		Small         *int16
+		PrimaryKey    string
+		PrimaryDesc   bool
+		SecondaryKey  string
+		SecondaryDesc bool
+	}
+	schema := ResourceSchema{
+		Filter: map[string]FilterField{
+			"id":    {Kind: KindNumber, Ops: map[Op]string{OpGTE: "Min", OpLTE: "Max"}},
+			"small": {Kind: KindNumber, Ops: map[Op]string{OpEQ: "Small"}},
+		},
+		Order: testSchema.Order,
+	}
+
+	var params intParams
+	if err := Bind(listMsg{filter: "id >= 9007199254740993 && id <= -9223372036854775808"}, &params, schema); err != nil {
+		t.Fatalf("Bind returned error: %v", err)
+	}
+	if params.Min == nil || *params.Min != 9007199254740993 {
+		t.Fatalf("expected Min 9007199254740993, got %v", params.Min)
+	}
+	if params.Max == nil || *params.Max != -9223372036854775808 {
+		t.Fatalf("expected Max MinInt64, got %v", params.Max)
+	}
+
+	params = intParams{}
+	if err := Bind(listMsg{filter: "id <= 9223372036854775807"}, &params, schema); err != nil {
+		t.Fatalf("Bind returned error: %v", err)
+	}
+	if params.Max == nil || *params.Max != 9223372036854775807 {
+		t.Fatalf("expected Max MaxInt64, got %v", params.Max)
+	}
+
+	for _, filter := range []string{"id >= 9223372036854775808u", "small == 40000", "small == -40000", "small == 1.5"} {
+		params = intParams{}
+		if err := Bind(listMsg{filter: filter}, &params, schema); err == nil {
+			t.Fatalf("expected error for %q", filter)
+		}
+	}
+}
+
+func TestBind_FilterComplexityLimits(t *testing.T) {
+	conjunction := func(n int) string {
+		clauses := make([]string, n)
+		for i := range clauses {
+			clauses[i] = fmt.Sprintf("price >= %d", i)
+		}
+		return strings.Join(clauses, " && ")
+	}
+	schema := testSchema
+	schema.Limits = FilterLimits{MaxPredicates: 4, MaxDepth: 6}
+
+	tests := []struct {
+		name    string
+		filter  string
+		limits  FilterLimits
+		wantErr string
+	}{
+		{"below predicate limit", conjunction(3), schema.Limits, ""},
+		{"at predicate limit", conjunction(4), schema.Limits, ""},
+		{"above predicate limit", conjunction(5), schema.Limits, "filter too complex: 5 predicates, max 4"},
+		{"predicate limit disabled", conjunction(40), FilterLimits{MaxPredicates: -1, MaxDepth: -1}, ""},
+		{"default predicate limit", conjunction(DefaultMaxPredicates + 1), FilterLimits{}, fmt.Sprintf("max %d", DefaultMaxPredicates)},
+		// Each negation nests the unary and parenthesized rules one level deeper.
+		{"below depth limit", "price >= -(-(1))", FilterLimits{MaxDepth: 5}, ""},
+		{"at depth limit", "price >= -(-(-(-(1))))", FilterLimits{MaxDepth: 6}, ""},
+		{"above depth limit", "price >= -(-(-(-(-(1)))))", FilterLimits{MaxDepth: 6}, "nested deeper than 6 levels"},
+		{"depth checked while parsing", strings.Repeat("(", 5000) + "price >= 1" + strings.Repeat(")", 5000), FilterLimits{MaxDepth: 6}, "nested deeper than 6 levels"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			schema.Limits = tc.limits
+			var params listParams
+			err := Bind(listMsg{filter: tc.filter}, &params, schema)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Bind(%q) returned error: %v", tc.filter, err)
+				}
+				return
+			}
+			var complexErr *FilterTooComplexError
+			if !errors.As(err, &complexErr) {
+				t.Fatalf("Bind(%q): expected FilterTooComplexError, got %v", tc.filter, err)
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error to contain %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package filterexpr
+
+import "fmt"
+
+// Predicate is a single validated filter clause.
+type Predicate struct {
+	Field string
+	Op    Op
+	Value any
+}
+
+// OrderTerm is one resolved order key, after defaults and fallbacks are applied.
+type OrderTerm struct {
+	Key  string
+	Desc bool
+}
+
+// ExplainResult describes how a filter/order_by pair is interpreted against a schema.
+type ExplainResult struct {
+	Predicates []Predicate
+	Order      []OrderTerm
+}
+
+// Explain validates filter and orderBy against schema the same way Bind does and reports
+// the parsed predicates (in source order) and the resolved order terms without a binding struct.
+func Explain(filter, orderBy string, schema ResourceSchema) (ExplainResult, error) {
+	preds, err := parseFilter(filter, schema.Filter, schema.Limits)
+	if err != nil {
+		return ExplainResult{}, fmt.Errorf("filter: %w", err)
+	}
+
+	order, err := parseOrderBy(orderBy, schema.Order)
+	if err != nil {
+		return ExplainResult{}, fmt.Errorf("order_by: %w", err)
+	}
+
+	result := ExplainResult{
+		Predicates: make([]Predicate, 0, len(preds)),
+		Order: []OrderTerm{
+			{Key: order.PrimaryKey, Desc: order.PrimaryDesc},
+			{Key: order.SecondaryKey, Desc: order.SecondaryDesc},
+		},
+	}
+	for _, pred := range preds {
+		result.Predicates = append(result.Predicates, Predicate(pred))
+	}
+	return result, nil
+}
@@ -0,0 +1,94 @@
+package filterexpr
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExplain_MultiClause(t *testing.T) {
+	got, err := Explain(
+		"state == 'ACTIVE' && price >= 10 && price <= 99.5 && name in ['a', 'b'] && name.startsWith('A') && create_time >= timestamp('2025-01-01T00:00:00Z')",
+		"text desc",
+		testSchema,
+	)
+	if err != nil {
+		t.Fatalf("Explain returned error: %v", err)
+	}
+
+	want := ExplainResult{
+		Predicates: []Predicate{
+			{Field: "state", Op: OpEQ, Value: "ACTIVE"},
+			{Field: "price", Op: OpGTE, Value: int64(10)},
+			{Field: "price", Op: OpLTE, Value: 99.5},
+			{Field: "name", Op: OpIN, Value: []string{"a", "b"}},
+			{Field: "name", Op: OpSW, Value: "A"},
+			{Field: "create_time", Op: OpGTE, Value: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		Order: []OrderTerm{
+			{Key: "text", Desc: true},
+			{Key: "id", Desc: false},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Explain mismatch\n got: %+v\nwant: %+v", got, want)
+	}
+}
+
+func TestExplain_EmptyUsesDefaults(t *testing.T) {
+	got, err := Explain("  ", "", testSchema)
+	if err != nil {
+		t.Fatalf("Explain returned error: %v", err)
+	}
+	if len(got.Predicates) != 0 {
+		t.Fatalf("expected no predicates, got %+v", got.Predicates)
+	}
+	want := []OrderTerm{{Key: "create_time", Desc: true}, {Key: "id", Desc: false}}
+	if !reflect.DeepEqual(got.Order, want) {
+		t.Fatalf("expected default order %+v, got %+v", want, got.Order)
+	}
+}
+
+func TestExplain_Errors(t *testing.T) {
+	cases := []struct {
+		name    string
+		filter  string
+		orderBy string
+		schema  ResourceSchema
+		wantErr string
+	}{
+		{name: "syntax", filter: "state ==", wantErr: "filter: invalid filter"},
+		{name: "or", filter: "state == 'A' || state == 'B'", wantErr: "only AND is allowed"},
+		{name: "negation", filter: "!(state == 'A')", wantErr: "only AND is allowed"},
+		{name: "unknown field", filter: "color == 'red'", wantErr: `field "color" is not allowed`},
+		{name: "operator not allowed", filter: "state >= 'A'", wantErr: `operator ">=" is not allowed for field "state"`},
+		{name: "unsupported function", filter: "name.endsWith('A')", wantErr: `function "endsWith" is not supported`},
+		{name: "wrong literal kind", filter: "price >= 'cheap'", wantErr: `field "price": expected number literal`},
+		{name: "empty list", filter: "name in []", wantErr: "list literal must not be empty"},
+		{name: "bad timestamp", filter: "create_time >= timestamp('yesterday')", wantErr: "is not RFC3339"},
+		{name: "non-literal rhs", filter: "state == name", wantErr: "right-hand side must be a literal"},
+		{name: "no filter fields", filter: "state == 'A'", schema: ResourceSchema{Order: testSchema.Order}, wantErr: "filter schema has no fields defined"},
+		{name: "unknown order key", orderBy: "color", wantErr: `order_by: field "color" cannot be used for ordering`},
+		{name: "bad direction", orderBy: "text up", wantErr: `invalid direction "up"`},
+		{name: "duplicate order key", orderBy: "text, text desc", wantErr: `duplicate order key "text"`},
+		{name: "too many order keys", orderBy: "text, id, updated_at", wantErr: "at most two keys"},
+		{name: "missing order defaults", schema: ResourceSchema{Filter: testSchema.Filter}, wantErr: "order schema default primary key required"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			schema := tc.schema
+			if schema.Filter == nil && schema.Order.Fields == nil {
+				schema = testSchema
+			}
+			_, err := Explain(tc.filter, tc.orderBy, schema)
+			if err == nil {
+				t.Fatalf("expected error containing %q", tc.wantErr)
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
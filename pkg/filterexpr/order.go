@@ -7,6 +7,16 @@ import (
 	"strings"
 )
 
+// UnsupportedOrderKeyError reports an order_by key that cannot be used for ordering, either
+// because the schema does not whitelist it or because the backend has no handler for it.
+type UnsupportedOrderKeyError struct {
+	Key string
+}
+
+func (e *UnsupportedOrderKeyError) Error() string {
+	return fmt.Sprintf("field %q cannot be used for ordering", e.Key)
+}
+
 type orderParams struct {
 	PrimaryKey    string
 	PrimaryDesc   bool
@@ -60,7 +70,7 @@ func parseOrderBy(raw string, schema OrderSchema) (orderParams, error) { //nolin
 		}
 		key := parts[0]
 		if _, ok := schema.Fields[key]; !ok {
-			return orderParams{}, fmt.Errorf("field %q cannot be used for ordering", key)
+			return orderParams{}, &UnsupportedOrderKeyError{Key: key}
 		}
 
 		var desc bool